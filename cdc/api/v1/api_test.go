@@ -79,6 +79,11 @@ func (p *mockStatusProvider) GetAllTaskStatuses(ctx context.Context, changefeedI
 	return args.Get(0).(map[model.CaptureID]*model.TaskStatus), args.Error(1)
 }
 
+func (p *mockStatusProvider) GetTableStatuses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableReplicationStatus, error) {
+	args := p.Called(ctx)
+	return args.Get(0).([]*model.TableReplicationStatus), args.Error(1)
+}
+
 func (p *mockStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	args := p.Called(ctx)
 	return args.Get(0).([]*model.ProcInfoSnap), args.Error(1)
@@ -46,6 +46,9 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	v2.GET("health", api.health)
 	v2.GET("status", api.serverStatus)
 	v2.POST("log", api.setLogLevel)
+	// The debug info is collected from the capture which serves the request,
+	// so the request is not forwarded to the owner.
+	v2.GET("/debug/info", api.getDebugInfo)
 
 	// changefeed apis
 	changefeedGroup := v2.Group("/changefeeds")
@@ -59,6 +62,19 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.POST("/:changefeed_id/resume", api.resumeChangefeed)
 	changefeedGroup.POST("/:changefeed_id/pause", api.pauseChangefeed)
 	changefeedGroup.GET("/:changefeed_id/status", api.status)
+	changefeedGroup.GET("/:changefeed_id/config", api.getChangeFeedConfig)
+	changefeedGroup.GET("/:changefeed_id/errors", api.getChangeFeedErrors)
+	changefeedGroup.GET("/:changefeed_id/tables", api.listChangeFeedTables)
+	// The sink stats are collected from the capture which serves the request,
+	// so the request is not forwarded to the owner.
+	v2.GET("/changefeeds/:changefeed_id/sink/stats", api.getSinkStats)
+
+	// upstream apis
+	upstreamGroup := v2.Group("/upstreams")
+	upstreamGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	upstreamGroup.POST("", api.addUpstream)
+	upstreamGroup.GET("", api.listUpstreams)
+	upstreamGroup.DELETE("/:upstream_id", api.deleteUpstream)
 
 	// capture apis
 	captureGroup := v2.Group("/captures")
@@ -90,4 +106,9 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 
 	// common APIs
 	v2.POST("/tso", api.QueryTso)
+
+	// gc apis
+	gcGroup := v2.Group("/gc")
+	gcGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	gcGroup.GET("", api.getGCStatus)
 }
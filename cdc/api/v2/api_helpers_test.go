@@ -58,6 +58,13 @@ func TestVerifyCreateChangefeedConfig(t *testing.T) {
 	require.Equal(t, model.DefaultNamespace, cfInfo.Namespace)
 	require.NotEqual(t, 0, cfInfo.Epoch)
 
+	// upstream ID mismatches the cluster ID of pd
+	cfg.ID = ""
+	cfg.UpstreamID = 1
+	_, err = h.verifyCreateChangefeedConfig(ctx, cfg, pdClient, provider, "en", storage)
+	require.True(t, cerror.ErrUpstreamMissMatch.Equal(err))
+	cfg.UpstreamID = 0
+
 	cfg.ID = "abdc/sss"
 	cfInfo, err = h.verifyCreateChangefeedConfig(ctx, cfg, pdClient, provider, "en", storage)
 	require.NotNil(t, err)
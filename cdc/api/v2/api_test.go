@@ -66,6 +66,7 @@ type mockStatusProvider struct {
 	changefeedInfo     *model.ChangeFeedInfo
 	processors         []*model.ProcInfoSnap
 	taskStatus         map[model.CaptureID]*model.TaskStatus
+	tableStatuses      []*model.TableReplicationStatus
 	changefeedInfos    map[model.ChangeFeedID]*model.ChangeFeedInfo
 	changefeedStatuses map[model.ChangeFeedID]*model.ChangeFeedStatus
 	err                error
@@ -104,6 +105,17 @@ func (m *mockStatusProvider) GetAllTaskStatuses(
 	return m.taskStatus, m.err
 }
 
+// GetTableStatuses returns a list of mock table statuses.
+func (m *mockStatusProvider) GetTableStatuses(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (
+	[]*model.TableReplicationStatus,
+	error,
+) {
+	return m.tableStatuses, m.err
+}
+
 // GetAllChangeFeedInfo returns a list of mock changefeed info.
 func (m *mockStatusProvider) GetAllChangeFeedInfo(_ context.Context) (
 	map[model.ChangeFeedID]*model.ChangeFeedInfo,
@@ -15,6 +15,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sort"
 	"strings"
@@ -26,7 +27,11 @@ import (
 	"github.com/pingcap/tiflow/cdc/api"
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/owner"
+	sinkmetrics "github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	pkgmetrics "github.com/pingcap/tiflow/pkg/metrics"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
@@ -42,8 +47,23 @@ const (
 	apiOpVarChangefeedState = "state"
 	// apiOpVarChangefeedID is the key of changefeed ID in HTTP API
 	apiOpVarChangefeedID = "changefeed_id"
+	// apiOpVarNamespace is the key of changefeed namespace in HTTP API
+	apiOpVarNamespace = "namespace"
 )
 
+// getChangefeedID returns the ID of the changefeed in the request path, the
+// namespace is taken from the query, and it's the default namespace if absent.
+func getChangefeedID(c *gin.Context) model.ChangeFeedID {
+	namespace := c.Query(apiOpVarNamespace)
+	if namespace == "" {
+		namespace = model.DefaultNamespace
+	}
+	return model.ChangeFeedID{
+		Namespace: namespace,
+		ID:        c.Param(apiOpVarChangefeedID),
+	}
+}
+
 // createChangefeed handles create changefeed request,
 // it returns the changefeed's changefeedInfo that it just created
 // CreateChangefeed creates a changefeed
@@ -64,7 +84,18 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
-	if len(cfg.PDAddrs) == 0 {
+	if cfg.Namespace == "" {
+		cfg.Namespace = model.DefaultNamespace
+	}
+	if len(cfg.PDAddrs) == 0 && cfg.UpstreamID != 0 {
+		upstreamInfo, err := h.capture.GetEtcdClient().
+			GetUpstreamInfo(ctx, cfg.UpstreamID, cfg.Namespace)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		cfg.PDConfig = toUpstreamConfig(upstreamInfo).PDConfig
+	} else if len(cfg.PDAddrs) == 0 {
 		up, err := getCaptureDefaultUpstream(h.capture)
 		if err != nil {
 			_ = c.Error(err)
@@ -112,13 +143,21 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 			ctx,
 			pdClient,
 			h.capture.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceCreating),
-			model.DefaultChangeFeedID(cfg.ID),
+			model.ChangeFeedID{Namespace: cfg.Namespace, ID: cfg.ID},
 		)
 		if err != nil {
 			_ = c.Error(err)
 			return
 		}
 	}()
+	err = checkNamespaceQuota(ctx, h.capture.StatusProvider(),
+		model.ChangeFeedID{Namespace: info.Namespace, ID: info.ID},
+		info.Config, true)
+	if err != nil {
+		needRemoveGCSafePoint = true
+		_ = c.Error(err)
+		return
+	}
 	upstreamInfo := &model.UpstreamInfo{
 		ID:            info.UpstreamID,
 		PDEndpoints:   strings.Join(cfg.PDAddrs, ","),
@@ -159,7 +198,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 	err = h.capture.GetEtcdClient().CreateChangefeedInfo(ctx,
 		upstreamInfo,
 		info,
-		model.DefaultChangeFeedID(info.ID))
+		model.ChangeFeedID{Namespace: info.Namespace, ID: info.ID})
 	if err != nil {
 		needRemoveGCSafePoint = true
 		_ = c.Error(err)
@@ -209,6 +248,48 @@ func hasRunningImport(ctx context.Context, cli *clientv3.Client) error {
 	return nil
 }
 
+// checkNamespaceQuota checks whether the changefeeds of the namespace still
+// fit in the quota of the namespace after the changefeed is created, or
+// updated with the given replica config.
+func checkNamespaceQuota(
+	ctx context.Context, provider owner.StatusProvider,
+	changefeedID model.ChangeFeedID, cfg *config.ReplicaConfig, isCreate bool,
+) error {
+	quota := config.GetGlobalServerConfig().NamespaceQuotas[changefeedID.Namespace]
+	if quota == nil {
+		return nil
+	}
+	var memoryQuota uint64
+	if cfg != nil {
+		memoryQuota = cfg.MemoryQuota
+	}
+	infos, err := provider.GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	count, totalMemoryQuota := 1, memoryQuota
+	for id, info := range infos {
+		if id.Namespace != changefeedID.Namespace || id == changefeedID {
+			continue
+		}
+		count++
+		if info.Config != nil {
+			totalMemoryQuota += info.Config.MemoryQuota
+		}
+	}
+	if isCreate && quota.MaxChangefeedCount > 0 && count > quota.MaxChangefeedCount {
+		return cerror.ErrNamespaceQuotaExceeded.GenWithStackByArgs(changefeedID.Namespace,
+			fmt.Sprintf("the number of changefeeds exceeds %d", quota.MaxChangefeedCount))
+	}
+	if quota.MaxMemoryQuota > 0 && totalMemoryQuota > quota.MaxMemoryQuota {
+		return cerror.ErrNamespaceQuotaExceeded.GenWithStackByArgs(changefeedID.Namespace,
+			fmt.Sprintf("the total memory quota of changefeeds %d exceeds %d",
+				totalMemoryQuota, quota.MaxMemoryQuota))
+	}
+	return nil
+}
+
 // listChangeFeeds lists all changgefeeds in cdc cluster
 // @Summary List changefeed
 // @Description list all changefeeds in cdc cluster
@@ -216,12 +297,14 @@ func hasRunningImport(ctx context.Context, cli *clientv3.Client) error {
 // @Accept json
 // @Produce json
 // @Param state query string false "state"
+// @Param namespace query string false "namespace"
 // @Success 200 {array} ChangefeedCommonInfo
 // @Failure 500 {object} model.HTTPError
 // @Router /api/v2/changefeeds [get]
 func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
 	ctx := c.Request.Context()
 	state := c.Query(apiOpVarChangefeedState)
+	namespace := c.Query(apiOpVarNamespace)
 	statuses, err := h.capture.StatusProvider().GetAllChangeFeedStatuses(ctx)
 	if err != nil {
 		_ = c.Error(err)
@@ -260,6 +343,9 @@ func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
 			// with state 'normal', 'stopped', 'failed'
 			continue
 		}
+		if namespace != "" && cfID.Namespace != namespace {
+			continue
+		}
 
 		// return the common info only.
 		commonInfo := &ChangefeedCommonInfo{
@@ -351,6 +437,7 @@ func (h *OpenAPIV2) verifyTable(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
 // @Param changefeedConfig body ChangefeedConfig true "changefeed config"
 // @Success 200 {object} ChangeFeedInfo
 // @Failure 500,400 {object} model.HTTPError
@@ -358,7 +445,7 @@ func (h *OpenAPIV2) verifyTable(c *gin.Context) {
 func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -371,8 +458,24 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 		return
 	}
 
+	// The request body is bound before checking the state, because a running
+	// changefeed can be updated if the request is an online update.
+	updateCfConfig := &ChangefeedConfig{}
+	bindErr := c.ShouldBindJSON(updateCfConfig)
+
 	switch oldCfInfo.State {
 	case model.StateStopped, model.StateFailed:
+	case model.StateNormal, model.StateError:
+		if bindErr == nil && updateCfConfig.Online {
+			break
+		}
+		_ = c.Error(
+			cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+				"can only update changefeed config when it is stopped or failed, " +
+					"or update it online when it is running",
+			),
+		)
+		return
 	default:
 		_ = c.Error(
 			cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
@@ -397,9 +500,8 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 		return
 	}
 
-	updateCfConfig := &ChangefeedConfig{}
-	if err = c.BindJSON(updateCfConfig); err != nil {
-		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+	if bindErr != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, bindErr))
 		return
 	}
 
@@ -439,6 +541,20 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 		return
 	}
 
+	err = checkNamespaceQuota(ctx, h.capture.StatusProvider(),
+		changefeedID, newCfInfo.Config, false)
+	if err != nil {
+		_ = c.Error(errors.Trace(err))
+		return
+	}
+
+	if updateCfConfig.Online {
+		if err = verifyOnlineUpdate(oldCfInfo, newCfInfo, updateCfConfig); err != nil {
+			_ = c.Error(errors.Trace(err))
+			return
+		}
+	}
+
 	log.Info("New ChangeFeed and Upstream Info",
 		zap.String("changefeedInfo", newCfInfo.String()),
 		zap.Any("upstreamInfo", newUpInfo))
@@ -453,6 +569,45 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 		cfStatus.ResolvedTs, cfStatus.CheckpointTs, nil, true))
 }
 
+// verifyOnlineUpdate checks that an online update only changes the configs
+// which can be applied to a running changefeed, i.e. memory-quota,
+// sink.max-rows-per-second and sink.max-bytes-per-second.
+func verifyOnlineUpdate(
+	oldInfo, newInfo *model.ChangeFeedInfo, cfg *ChangefeedConfig,
+) error {
+	if len(cfg.PDAddrs) != 0 {
+		return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can not update upstream of a running changefeed")
+	}
+	if newInfo.SinkURI != oldInfo.SinkURI || newInfo.TargetTs != oldInfo.TargetTs {
+		return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can not update sink-uri or target-ts of a running changefeed")
+	}
+	if newInfo.Config == nil || oldInfo.Config == nil {
+		return nil
+	}
+	newConfig := newInfo.Config.Clone()
+	newConfig.MemoryQuota = oldInfo.Config.MemoryQuota
+	if newConfig.Sink != nil && oldInfo.Config.Sink != nil {
+		newConfig.Sink.MaxRowsPerSecond = oldInfo.Config.Sink.MaxRowsPerSecond
+		newConfig.Sink.MaxBytesPerSecond = oldInfo.Config.Sink.MaxBytesPerSecond
+	}
+	newData, err := newConfig.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	oldData, err := oldInfo.Config.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if newData != oldData {
+		return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"only memory-quota, sink.max-rows-per-second and " +
+				"sink.max-bytes-per-second can be updated online")
+	}
+	return nil
+}
+
 // getChangefeed get detailed info of a changefeed
 // @Summary Get changefeed
 // @Description get detail information of a changefeed
@@ -460,12 +615,13 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
 // @Success 200 {object} ChangeFeedInfo
 // @Failure 500,400 {object} model.HTTPError
 // @Router /api/v2/changefeeds/{changefeed_id} [get]
 func (h *OpenAPIV2) getChangeFeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(
 			cerror.ErrAPIInvalidParam.GenWithStack(
@@ -527,12 +683,13 @@ func (h *OpenAPIV2) getChangeFeed(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param changefeed_id path string true "changefeed_id"
+// @Param namespace query string false "default"
 // @Success 200 {object} EmptyResponse
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/changefeeds/{changefeed_id} [delete]
 func (h *OpenAPIV2) deleteChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -586,7 +743,7 @@ func (h *OpenAPIV2) deleteChangefeed(c *gin.Context) {
 func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -639,13 +796,14 @@ func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param changefeed_id path string true "changefeed_id"
+// @Param namespace query string false "default"
 // @Param resumeConfig body ResumeChangefeedConfig true "resume config"
 // @Success 200 {object} EmptyResponse
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/changefeeds/{changefeed_id}/resume [post]
 func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	err := model.ValidateChangefeedID(changefeedID.ID)
 	if err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
@@ -734,13 +892,14 @@ func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
 // @Success 200 {object} EmptyResponse
 // @Failure 500,400 {object} model.HTTPError
 // @Router /api/v2/changefeeds/{changefeed_id}/pause [post]
 func (h *OpenAPIV2) pauseChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -765,10 +924,190 @@ func (h *OpenAPIV2) pauseChangefeed(c *gin.Context) {
 	c.JSON(http.StatusOK, &EmptyResponse{})
 }
 
+// getChangeFeedConfig returns the effective replica config of a changefeed
+// @Summary Get the replica config of a changefeed
+// @Description get the effective replica config of a changefeed, the missing
+// parts of the stored config are completed with the default values
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
+// @Success 200 {object} ReplicaConfig
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/config [get]
+func (h *OpenAPIV2) getChangeFeedConfig(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// clone the info to avoid modifying the cached one.
+	info, err = info.Clone()
+	if err != nil {
+		_ = c.Error(errors.Trace(err))
+		return
+	}
+	if err = info.VerifyAndComplete(); err != nil {
+		_ = c.Error(errors.Trace(err))
+		return
+	}
+	c.JSON(http.StatusOK, ToAPIReplicaConfig(info.Config))
+}
+
+// getChangeFeedErrors returns the error history of a changefeed
+// @Summary Get the error history of a changefeed
+// @Description get the latest errors of a changefeed in chronological order
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
+// @Success 200 {object} ListResponse[RunningError]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/errors [get]
+func (h *OpenAPIV2) getChangeFeedErrors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	errs := make([]RunningError, 0, len(info.ErrorHistory))
+	for _, e := range info.ErrorHistory {
+		errTime := e.Time
+		errs = append(errs, RunningError{
+			Time:    &errTime,
+			Addr:    e.Addr,
+			Code:    e.Code,
+			Message: e.Message,
+		})
+	}
+	c.JSON(http.StatusOK, &ListResponse[RunningError]{
+		Total: len(errs),
+		Items: errs,
+	})
+}
+
+// getSinkStats returns the sink statistics of a changefeed in the capture
+// @Summary Get the sink statistics of a changefeed
+// @Description get the sink statistics of a changefeed in the capture which serves the request
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
+// @Success 200 {object} SinkStats
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/sink/stats [get]
+func (h *OpenAPIV2) getSinkStats(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	// Check the existence of the changefeed by etcd,
+	// since the capture may not be the owner.
+	if _, err := h.capture.GetEtcdClient().GetChangeFeedInfo(ctx, changefeedID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	captureInfo, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	stats, err := sinkmetrics.CollectStats(pkgmetrics.GetRegistry(), changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := &SinkStats{
+		CaptureID:                     captureInfo.ID,
+		Namespace:                     changefeedID.Namespace,
+		ID:                            changefeedID.ID,
+		Rows:                          stats.Rows,
+		BatchCount:                    stats.BatchCount,
+		BatchRows:                     stats.BatchRows,
+		BatchExecDurationSeconds:      stats.BatchExecDuration,
+		ConflictDetectCount:           stats.ConflictDetectCount,
+		ConflictDetectDurationSeconds: stats.ConflictDetectDuration,
+		ExecutionErrors:               stats.ExecutionErrors,
+	}
+	if len(stats.TableRows) > 0 {
+		resp.TableRows = stats.TableRows
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// listChangeFeedTables lists the replication progress of all tables
+// @Summary List the tables of a changefeed
+// @Description list the checkpoint, resolved ts and the replicating captures
+// of all tables of a changefeed, which are sorted by table id
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace  query  string  false  "default"
+// @Success 200 {object} ListResponse[TableStatus]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables [get]
+func (h *OpenAPIV2) listChangeFeedTables(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	statuses, err := h.capture.StatusProvider().GetTableStatuses(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	now := time.Now()
+	tables := make([]TableStatus, 0, len(statuses))
+	for _, status := range statuses {
+		checkpointTime := oracle.GetTimeFromTS(status.CheckpointTs)
+		tables = append(tables, TableStatus{
+			TableID:        status.TableID,
+			CheckpointTs:   status.CheckpointTs,
+			ResolvedTs:     status.ResolvedTs,
+			CheckpointTime: model.JSONTime(checkpointTime),
+			CheckpointLag:  now.Sub(checkpointTime).Seconds(),
+			Captures:       status.Captures,
+			State:          status.State,
+			SpanCount:      status.SpanCount,
+		})
+	}
+	c.JSON(http.StatusOK, &ListResponse[TableStatus]{
+		Total: len(tables),
+		Items: tables,
+	})
+}
+
 func (h *OpenAPIV2) status(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	tidbkv "github.com/pingcap/tidb/kv"
@@ -37,6 +38,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/tests/v3/integration"
@@ -318,6 +320,246 @@ func TestGetChangeFeed(t *testing.T) {
 	require.Nil(t, resp.Error)
 }
 
+func TestGetChangeFeedConfig(t *testing.T) {
+	t.Parallel()
+
+	cfConfig := testCase{url: "/api/v2/changefeeds/%s/config", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// invalid id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		cfConfig.method, fmt.Sprintf(cfConfig.url, "@^Invalid"), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// valid id but not exists
+	validID := "changefeed-valid-id"
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		cfConfig.method, fmt.Sprintf(cfConfig.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success, the missing parts are completed with the default values
+	statusProvider.err = nil
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{
+		ID: validID,
+		Config: &config.ReplicaConfig{
+			MemoryQuota:   1024,
+			CaseSensitive: true,
+		},
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		cfConfig.method, fmt.Sprintf(cfConfig.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ReplicaConfig{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1024), resp.MemoryQuota)
+	require.True(t, resp.CaseSensitive)
+	defaultConfig := ToAPIReplicaConfig(config.GetDefaultReplicaConfig())
+	require.Equal(t, defaultConfig.Filter, resp.Filter)
+	require.Equal(t, defaultConfig.Sink, resp.Sink)
+	require.Equal(t, defaultConfig.Consistent, resp.Consistent)
+	// the cached info is not modified.
+	require.Nil(t, statusProvider.changefeedInfo.Config.Filter)
+}
+
+func TestGetChangeFeedErrors(t *testing.T) {
+	t.Parallel()
+
+	cfErrors := testCase{url: "/api/v2/changefeeds/%s/errors", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// invalid id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		cfErrors.method, fmt.Sprintf(cfErrors.url, "@^Invalid"), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// valid id but not exists
+	validID := "changefeed-valid-id"
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		cfErrors.method, fmt.Sprintf(cfErrors.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success
+	statusProvider.err = nil
+	now := time.Now()
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{
+		ID: validID,
+		ErrorHistory: []*model.RunningError{
+			{Time: now.Add(-time.Second), Addr: "127.0.0.1:8300", Code: "CDC:ErrEtcdSessionDone"},
+			{Time: now, Addr: "127.0.0.1:8301", Code: "CDC:ErrMySQLConnectionError"},
+		},
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		cfErrors.method, fmt.Sprintf(cfErrors.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ListResponse[RunningError]{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, "127.0.0.1:8300", resp.Items[0].Addr)
+	require.Equal(t, "CDC:ErrMySQLConnectionError", resp.Items[1].Code)
+	require.True(t, now.Equal(*resp.Items[1].Time))
+}
+
+func TestGetSinkStats(t *testing.T) {
+	t.Parallel()
+
+	sinkStats := testCase{url: "/api/v2/changefeeds/%s/sink/stats", method: "GET"}
+	ctrl := gomock.NewController(t)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	// The request is served by the non-owner capture.
+	cp.EXPECT().IsOwner().Return(false).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// changefeed not exists
+	validID := "changefeed-sink-stats"
+	etcdClient.EXPECT().GetChangeFeedInfo(gomock.Any(), gomock.Any()).
+		Return(nil, cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)).Times(1)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		sinkStats.method, fmt.Sprintf(sinkStats.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success
+	etcdClient.EXPECT().GetChangeFeedInfo(gomock.Any(), gomock.Any()).
+		Return(&model.ChangeFeedInfo{ID: validID}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		sinkStats.method, fmt.Sprintf(sinkStats.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := SinkStats{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, "capture-1", resp.CaptureID)
+	require.Equal(t, validID, resp.ID)
+	require.Equal(t, model.DefaultNamespace, resp.Namespace)
+}
+
+func TestListChangeFeedTables(t *testing.T) {
+	t.Parallel()
+
+	cfTables := testCase{url: "/api/v2/changefeeds/%s/tables", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// invalid id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		cfTables.method, fmt.Sprintf(cfTables.url, "@^Invalid"), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// valid id but not exists
+	validID := "changefeed-valid-id"
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		cfTables.method, fmt.Sprintf(cfTables.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success
+	statusProvider.err = nil
+	checkpointTs := oracle.GoTimeToTS(time.Now().Add(-time.Minute))
+	statusProvider.tableStatuses = []*model.TableReplicationStatus{{
+		TableID:      1,
+		CheckpointTs: checkpointTs,
+		ResolvedTs:   checkpointTs + 1,
+		Captures:     []model.CaptureID{"capture-1"},
+		State:        "Replicating",
+		SpanCount:    1,
+	}, {
+		TableID:      2,
+		CheckpointTs: checkpointTs,
+		ResolvedTs:   checkpointTs,
+		Captures:     []model.CaptureID{"capture-1", "capture-2"},
+		State:        "Prepare",
+		SpanCount:    2,
+	}}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		cfTables.method, fmt.Sprintf(cfTables.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ListResponse[TableStatus]{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, int64(1), resp.Items[0].TableID)
+	require.Equal(t, checkpointTs+1, resp.Items[0].ResolvedTs)
+	require.GreaterOrEqual(t, resp.Items[0].CheckpointLag, float64(60))
+	require.Equal(t, []string{"capture-1", "capture-2"}, resp.Items[1].Captures)
+	require.Equal(t, "Prepare", resp.Items[1].State)
+	require.Equal(t, 2, resp.Items[1].SpanCount)
+}
+
 func TestUpdateChangefeed(t *testing.T) {
 	t.Parallel()
 	update := testCase{url: "/api/v2/changefeeds/%s", method: "PUT"}
@@ -506,6 +748,105 @@ func TestUpdateChangefeed(t *testing.T) {
 	require.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestUpdateChangefeedOnline(t *testing.T) {
+	t.Parallel()
+	update := testCase{url: "/api/v2/changefeeds/%s", method: "PUT"}
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	oldCfInfo := &model.ChangeFeedInfo{
+		ID:         changeFeedID.ID,
+		Namespace:  model.DefaultNamespace,
+		State:      model.StateNormal,
+		UpstreamID: 1,
+		SinkURI:    "blackhole://",
+		Config:     config.GetDefaultReplicaConfig(),
+	}
+	statusProvider := &mockStatusProvider{
+		changefeedInfo:   oldCfInfo,
+		changefeedStatus: &model.ChangeFeedStatus{CheckpointTs: 1},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	etcdClient.EXPECT().
+		GetUpstreamInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	helpers.EXPECT().
+		verifyUpstream(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).AnyTimes()
+	helpers.EXPECT().
+		createTiStore(gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+
+	cloneInfo := func() *model.ChangeFeedInfo {
+		info, err := oldCfInfo.Clone()
+		require.Nil(t, err)
+		return info
+	}
+	doUpdate := func(cfg *ChangefeedConfig) *httptest.ResponseRecorder {
+		body, err := json.Marshal(cfg)
+		require.Nil(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), update.method,
+			fmt.Sprintf(update.url, changeFeedID.ID), bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+	requireRefused := func(w *httptest.ResponseRecorder) {
+		respErr := model.HTTPError{}
+		err := json.NewDecoder(w.Body).Decode(&respErr)
+		require.Nil(t, err)
+		require.Contains(t, respErr.Code, "ErrChangefeedUpdateRefused")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	}
+
+	// case 1: a running changefeed can not be updated without online
+	requireRefused(doUpdate(&ChangefeedConfig{}))
+
+	// case 2: memory quota and sink rate limits can be updated online
+	newCfInfo := cloneInfo()
+	newCfInfo.Config.MemoryQuota = 1024
+	maxRows := uint64(100)
+	newCfInfo.Config.Sink.MaxRowsPerSecond = &maxRows
+	helpers.EXPECT().
+		verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newCfInfo, &model.UpstreamInfo{}, nil).Times(1)
+	etcdClient.EXPECT().
+		UpdateChangefeedAndUpstream(gomock.Any(), gomock.Any(), gomock.Eq(newCfInfo), gomock.Any()).
+		Return(nil).Times(1)
+	w := doUpdate(&ChangefeedConfig{Online: true})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// case 3: other configs can not be updated online
+	newCfInfo = cloneInfo()
+	newCfInfo.Config.ForceReplicate = true
+	helpers.EXPECT().
+		verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newCfInfo, &model.UpstreamInfo{}, nil).Times(1)
+	requireRefused(doUpdate(&ChangefeedConfig{Online: true}))
+
+	newCfInfo = cloneInfo()
+	newCfInfo.SinkURI = "blackhole://?a=b"
+	helpers.EXPECT().
+		verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newCfInfo, &model.UpstreamInfo{}, nil).Times(1)
+	requireRefused(doUpdate(&ChangefeedConfig{Online: true}))
+
+	// case 4: the upstream can not be updated online
+	helpers.EXPECT().
+		verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(cloneInfo(), &model.UpstreamInfo{}, nil).Times(1)
+	requireRefused(doUpdate(&ChangefeedConfig{
+		Online:   true,
+		PDConfig: PDConfig{PDAddrs: []string{"http://127.0.0.1:2379"}},
+	}))
+}
+
 func TestListChangeFeeds(t *testing.T) {
 	t.Parallel()
 
@@ -537,11 +878,15 @@ func TestListChangeFeeds(t *testing.T) {
 			model.DefaultChangeFeedID("cf3"): {
 				State: model.StateStopped,
 			},
+			model.ChangeFeedID{Namespace: "ns1", ID: "cf1"}: {
+				State: model.StateNormal,
+			},
 		},
 		changefeedStatuses: map[model.ChangeFeedID]*model.ChangeFeedStatus{
-			model.DefaultChangeFeedID("cf1"): {},
-			model.DefaultChangeFeedID("cf2"): {},
-			model.DefaultChangeFeedID("cf3"): {},
+			model.DefaultChangeFeedID("cf1"):                {},
+			model.DefaultChangeFeedID("cf2"):                {},
+			model.DefaultChangeFeedID("cf3"):                {},
+			model.ChangeFeedID{Namespace: "ns1", ID: "cf1"}: {},
 		},
 	}
 	cp.EXPECT().StatusProvider().Return(provider1).AnyTimes()
@@ -560,7 +905,7 @@ func TestListChangeFeeds(t *testing.T) {
 	resp := ListResponse[model.ChangefeedCommonInfo]{}
 	err := json.NewDecoder(w.Body).Decode(&resp)
 	require.Nil(t, err)
-	require.Equal(t, 3, resp.Total)
+	require.Equal(t, 4, resp.Total)
 	// changefeed info must be sorted by ID
 	require.Equal(t, true, sorted(resp.Items))
 
@@ -579,9 +924,65 @@ func TestListChangeFeeds(t *testing.T) {
 	resp2 := ListResponse[model.ChangefeedCommonInfo]{}
 	err = json.NewDecoder(w.Body).Decode(&resp2)
 	require.Nil(t, err)
-	require.Equal(t, 2, resp2.Total)
+	require.Equal(t, 3, resp2.Total)
 	// changefeed info must be sorted by ID
 	require.Equal(t, true, sorted(resp2.Items))
+
+	// case 3: only list changefeed in the given namespace
+	req3, _ := http.NewRequestWithContext(
+		context.Background(),
+		"GET",
+		"/api/v2/changefeeds?state=all&namespace=ns1",
+		nil,
+	)
+	router.ServeHTTP(w, req3)
+	resp3 := ListResponse[model.ChangefeedCommonInfo]{}
+	err = json.NewDecoder(w.Body).Decode(&resp3)
+	require.Nil(t, err)
+	require.Equal(t, 1, resp3.Total)
+	require.Equal(t, "ns1", resp3.Items[0].Namespace)
+}
+
+func TestCheckNamespaceQuota(t *testing.T) {
+	originalConfig := config.GetGlobalServerConfig()
+	defer config.StoreGlobalServerConfig(originalConfig)
+	conf := originalConfig.Clone()
+	conf.NamespaceQuotas = map[string]*config.NamespaceQuota{
+		"ns1": {MaxChangefeedCount: 2, MaxMemoryQuota: 1000},
+	}
+	config.StoreGlobalServerConfig(conf)
+
+	newInfo := func(memoryQuota uint64) *model.ChangeFeedInfo {
+		return &model.ChangeFeedInfo{
+			Config: &config.ReplicaConfig{MemoryQuota: memoryQuota},
+		}
+	}
+	provider := &mockStatusProvider{
+		changefeedInfos: map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			{Namespace: "ns1", ID: "cf1"}:    newInfo(400),
+			model.DefaultChangeFeedID("cf1"): newInfo(4000),
+		},
+	}
+	ctx := context.Background()
+
+	// The namespaces without a quota are unlimited.
+	require.NoError(t, checkNamespaceQuota(ctx, provider,
+		model.DefaultChangeFeedID("cf2"), newInfo(10000).Config, true))
+
+	cf2 := model.ChangeFeedID{Namespace: "ns1", ID: "cf2"}
+	require.NoError(t, checkNamespaceQuota(ctx, provider, cf2, newInfo(600).Config, true))
+	err := checkNamespaceQuota(ctx, provider, cf2, newInfo(601).Config, true)
+	require.True(t, cerrors.ErrNamespaceQuotaExceeded.Equal(err))
+
+	provider.changefeedInfos[cf2] = newInfo(600)
+	err = checkNamespaceQuota(ctx, provider,
+		model.ChangeFeedID{Namespace: "ns1", ID: "cf3"}, nil, true)
+	require.True(t, cerrors.ErrNamespaceQuotaExceeded.Equal(err))
+
+	// The changefeed being updated is not counted twice.
+	require.NoError(t, checkNamespaceQuota(ctx, provider, cf2, newInfo(500).Config, false))
+	err = checkNamespaceQuota(ctx, provider, cf2, newInfo(700).Config, false)
+	require.True(t, cerrors.ErrNamespaceQuotaExceeded.Equal(err))
 }
 
 func TestVerifyTable(t *testing.T) {
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// getDebugInfo dumps the runtime state of the capture
+// @Summary Get the debug info of a TiCDC server
+// @Description Dump the state of the owner, the schedulers and the processors
+// in the capture which serves the request, as well as the backlog of the sinks
+// and the disk usage of the sort engine. It's used to diagnose stuck changefeeds.
+// @Tags common,v2
+// @Produce json
+// @Success 200 {object} DebugInfo
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/debug/info [get]
+func (h *OpenAPIV2) getDebugInfo(c *gin.Context) {
+	info, err := h.capture.DebugInfo(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	resp := &DebugInfo{
+		CaptureID:           info.Capture.ID,
+		AdvertiseAddr:       info.Capture.AdvertiseAddr,
+		Processors:          make([]ProcessorDebugInfo, 0, len(info.Processors)),
+		SortEngineDiskUsage: info.SortEngineDiskUsage,
+	}
+	if info.Owner != nil {
+		resp.Owner = h.toAPIOwnerDebugInfo(info.Capture.ID, info.Owner)
+	}
+	for _, p := range info.Processors {
+		resp.Processors = append(resp.Processors, toAPIProcessorDebugInfo(p))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *OpenAPIV2) toAPIOwnerDebugInfo(
+	ownerID model.CaptureID, info *model.OwnerDebugInfo,
+) *OwnerDebugInfo {
+	clusterID := h.capture.GetEtcdClient().GetClusterID()
+	resp := &OwnerDebugInfo{
+		Captures:    make([]Capture, 0, len(info.Captures)),
+		Changefeeds: make([]ChangefeedDebugInfo, 0, len(info.Changefeeds)),
+	}
+	for _, c := range info.Captures {
+		resp.Captures = append(resp.Captures, Capture{
+			ID:            c.ID,
+			IsOwner:       c.ID == ownerID,
+			AdvertiseAddr: c.AdvertiseAddr,
+			ClusterID:     clusterID,
+		})
+	}
+
+	now := time.Now()
+	for _, cf := range info.Changefeeds {
+		changefeed := ChangefeedDebugInfo{
+			Namespace:            cf.ID.Namespace,
+			ID:                   cf.ID.ID,
+			State:                string(cf.State),
+			CheckpointTs:         cf.CheckpointTs,
+			ResolvedTs:           cf.ResolvedTs,
+			SchedulerInitialized: cf.SchedulerInitialized,
+			Tables:               make([]TableStatus, 0, len(cf.Tables)),
+			RunningTasks:         make([]ScheduleTaskInfo, 0, len(cf.RunningTasks)),
+		}
+		for _, status := range cf.Tables {
+			checkpointTime := oracle.GetTimeFromTS(status.CheckpointTs)
+			changefeed.Tables = append(changefeed.Tables, TableStatus{
+				TableID:        status.TableID,
+				CheckpointTs:   status.CheckpointTs,
+				ResolvedTs:     status.ResolvedTs,
+				CheckpointTime: model.JSONTime(checkpointTime),
+				CheckpointLag:  now.Sub(checkpointTime).Seconds(),
+				Captures:       status.Captures,
+				State:          status.State,
+				SpanCount:      status.SpanCount,
+			})
+		}
+		for _, task := range cf.RunningTasks {
+			changefeed.RunningTasks = append(changefeed.RunningTasks, ScheduleTaskInfo{
+				Type:     task.Type,
+				TableID:  task.TableID,
+				Span:     task.Span,
+				State:    task.State,
+				Captures: task.Captures,
+			})
+		}
+		resp.Changefeeds = append(resp.Changefeeds, changefeed)
+	}
+	return resp
+}
+
+func toAPIProcessorDebugInfo(info *model.ProcessorDebugInfo) ProcessorDebugInfo {
+	resp := ProcessorDebugInfo{
+		Namespace:         info.ChangefeedID.Namespace,
+		ChangefeedID:      info.ChangefeedID.ID,
+		Initialized:       info.Initialized,
+		Tables:            make([]TableSpanDebugInfo, 0, len(info.Tables)),
+		SinkBacklogEvents: info.SinkBacklogEvents,
+		SinkMemoryUsage:   info.SinkMemoryUsage,
+		SinkMemoryQuota:   info.SinkMemoryQuota,
+		RedoMemoryUsage:   info.RedoMemoryUsage,
+	}
+	for _, table := range info.Tables {
+		resp.Tables = append(resp.Tables, TableSpanDebugInfo(*table))
+	}
+	return resp
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	debugInfo := testCase{url: "/api/v2/debug/info", method: "GET"}
+	ctrl := gomock.NewController(t)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	etcdClient.EXPECT().GetClusterID().Return("default").AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	getDebugInfo := func(expectedCode int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			debugInfo.method, debugInfo.url, nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, expectedCode, w.Code)
+		return w
+	}
+
+	// the owner is closed while collecting the debug info
+	cp.EXPECT().DebugInfo(gomock.Any()).
+		Return(nil, cerror.ErrOwnerNotFound.FastGenByArgs()).Times(1)
+	w := getDebugInfo(http.StatusInternalServerError)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrOwnerNotFound")
+
+	// success
+	changefeedID := model.DefaultChangeFeedID("test")
+	span := "{table_id:1}"
+	cp.EXPECT().DebugInfo(gomock.Any()).Return(&model.CaptureDebugInfo{
+		Capture: &model.CaptureInfo{ID: "capture-1", AdvertiseAddr: "127.0.0.1:8300"},
+		Owner: &model.OwnerDebugInfo{
+			Captures: []*model.CaptureInfo{
+				{ID: "capture-1", AdvertiseAddr: "127.0.0.1:8300"},
+				{ID: "capture-2", AdvertiseAddr: "127.0.0.1:8301"},
+			},
+			Changefeeds: []*model.ChangefeedDebugInfo{{
+				ID:                   changefeedID,
+				State:                model.StateNormal,
+				CheckpointTs:         1,
+				ResolvedTs:           2,
+				SchedulerInitialized: true,
+				Tables: []*model.TableReplicationStatus{{
+					TableID: 1, CheckpointTs: 1, ResolvedTs: 2,
+					Captures: []string{"capture-2"}, State: "Replicating", SpanCount: 1,
+				}},
+				RunningTasks: []*model.ScheduleTaskInfo{{
+					Type: "moveTable", TableID: 1, Span: span, State: "Prepare",
+					Captures: []string{"capture-1", "capture-2"},
+				}},
+			}},
+		},
+		Processors: []*model.ProcessorDebugInfo{{
+			ChangefeedID: changefeedID,
+			Initialized:  true,
+			Tables: []*model.TableSpanDebugInfo{{
+				TableID: 1, Span: span, State: "Preparing", CheckpointTs: 1,
+			}},
+			SinkBacklogEvents: 10,
+		}},
+		SortEngineDiskUsage: 1024,
+	}, nil).Times(1)
+	w = getDebugInfo(http.StatusOK)
+	resp := DebugInfo{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, "capture-1", resp.CaptureID)
+	require.Equal(t, uint64(1024), resp.SortEngineDiskUsage)
+
+	require.NotNil(t, resp.Owner)
+	require.Len(t, resp.Owner.Captures, 2)
+	require.True(t, resp.Owner.Captures[0].IsOwner)
+	require.False(t, resp.Owner.Captures[1].IsOwner)
+	require.Len(t, resp.Owner.Changefeeds, 1)
+	changefeed := resp.Owner.Changefeeds[0]
+	require.Equal(t, "test", changefeed.ID)
+	require.Equal(t, string(model.StateNormal), changefeed.State)
+	require.True(t, changefeed.SchedulerInitialized)
+	require.Len(t, changefeed.Tables, 1)
+	require.Equal(t, []string{"capture-2"}, changefeed.Tables[0].Captures)
+	require.Equal(t, []ScheduleTaskInfo{{
+		Type: "moveTable", TableID: 1, Span: span, State: "Prepare",
+		Captures: []string{"capture-1", "capture-2"},
+	}}, changefeed.RunningTasks)
+
+	require.Len(t, resp.Processors, 1)
+	require.Equal(t, "test", resp.Processors[0].ChangefeedID)
+	require.Equal(t, int64(10), resp.Processors[0].SinkBacklogEvents)
+	require.Equal(t, []TableSpanDebugInfo{{
+		TableID: 1, Span: span, State: "Preparing", CheckpointTs: 1,
+	}}, resp.Processors[0].Tables)
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// getGCStatus gets the service GC safepoints of upstreams
+// @Summary Get the service GC safepoints of upstreams
+// @Description This API returns the service GC safepoint of each upstream,
+// the time it is last updated successfully, and the lag between the
+// checkpoint of each changefeed and the safepoint.
+//
+// @Tags common,v2
+// @Produce json
+// @Success 200 {object} GCStatus
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/gc [get]
+func (h *OpenAPIV2) getGCStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	infos, err := h.capture.StatusProvider().GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	statuses, err := h.capture.StatusProvider().GetAllChangeFeedStatuses(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	resp := &GCStatus{Upstreams: []UpstreamGCStatus{}}
+	err = upManager.Visit(func(up *upstream.Upstream) error {
+		upStatus := UpstreamGCStatus{
+			UpstreamID:         up.ID,
+			ServiceGCSafePoint: up.GCManager.LastSafePointTs(),
+			Changefeeds:        []ChangefeedGCStatus{},
+		}
+		if lastUpdateTime := up.GCManager.LastUpdatedTime(); !lastUpdateTime.IsZero() {
+			upStatus.LastUpdateTime = &lastUpdateTime
+		}
+		for id, info := range infos {
+			if info.UpstreamID != up.ID {
+				continue
+			}
+			cfStatus := ChangefeedGCStatus{
+				Namespace: id.Namespace,
+				ID:        id.ID,
+				State:     info.State,
+			}
+			if status, ok := statuses[id]; ok {
+				cfStatus.CheckpointTs = status.CheckpointTs
+			}
+			if upStatus.ServiceGCSafePoint != 0 && cfStatus.CheckpointTs != 0 {
+				cfStatus.SafePointLag = oracle.GetTimeFromTS(cfStatus.CheckpointTs).
+					Sub(oracle.GetTimeFromTS(upStatus.ServiceGCSafePoint)).Seconds()
+			}
+			upStatus.Changefeeds = append(upStatus.Changefeeds, cfStatus)
+		}
+		sort.Slice(upStatus.Changefeeds, func(i, j int) bool {
+			a, b := upStatus.Changefeeds[i], upStatus.Changefeeds[j]
+			if a.Namespace != b.Namespace {
+				return a.Namespace < b.Namespace
+			}
+			return a.ID < b.ID
+		})
+		resp.Upstreams = append(resp.Upstreams, upStatus)
+		return nil
+	})
+	if err != nil {
+		_ = c.Error(errors.Trace(err))
+		return
+	}
+	sort.Slice(resp.Upstreams, func(i, j int) bool {
+		return resp.Upstreams[i].UpstreamID < resp.Upstreams[j].UpstreamID
+	})
+	c.IndentedJSON(http.StatusOK, resp)
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestGetGCStatus(t *testing.T) {
+	t.Parallel()
+
+	getGCStatus := testCase{url: "/api/v2/gc", method: "GET"}
+	mockUpManager := upstream.NewManager4Test(&mockPDClient{})
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(mockUpManager, nil).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// case 1: failed to get changefeeds.
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs("a")
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		getGCStatus.method, getGCStatus.url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// case 2: the safepoint has not been written yet.
+	now := time.Now()
+	checkpointTs := oracle.GoTimeToTS(now)
+	statusProvider.err = nil
+	statusProvider.changefeedInfos = map[model.ChangeFeedID]*model.ChangeFeedInfo{
+		model.DefaultChangeFeedID("b"): {State: model.StateNormal},
+		model.DefaultChangeFeedID("a"): {State: model.StateStopped},
+		// Changefeeds of other upstreams are not listed.
+		model.DefaultChangeFeedID("c"): {UpstreamID: 1, State: model.StateNormal},
+	}
+	statusProvider.changefeedStatuses = map[model.ChangeFeedID]*model.ChangeFeedStatus{
+		model.DefaultChangeFeedID("a"): {CheckpointTs: checkpointTs},
+		model.DefaultChangeFeedID("b"): {CheckpointTs: checkpointTs},
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		getGCStatus.method, getGCStatus.url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := GCStatus{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Len(t, resp.Upstreams, 1)
+	require.Zero(t, resp.Upstreams[0].ServiceGCSafePoint)
+	require.Nil(t, resp.Upstreams[0].LastUpdateTime)
+	require.Len(t, resp.Upstreams[0].Changefeeds, 2)
+	require.Zero(t, resp.Upstreams[0].Changefeeds[0].SafePointLag)
+
+	// case 3: the checkpoints are 10 seconds ahead of the safepoint.
+	up, err := mockUpManager.GetDefaultUpstream()
+	require.Nil(t, err)
+	safePoint := oracle.GoTimeToTS(now.Add(-10 * time.Second))
+	require.Nil(t, up.GCManager.TryUpdateGCSafePoint(context.Background(), safePoint, true))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		getGCStatus.method, getGCStatus.url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = GCStatus{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Len(t, resp.Upstreams, 1)
+	upStatus := resp.Upstreams[0]
+	require.Equal(t, uint64(0), upStatus.UpstreamID)
+	require.Equal(t, safePoint, upStatus.ServiceGCSafePoint)
+	require.NotNil(t, upStatus.LastUpdateTime)
+	require.Equal(t, []ChangefeedGCStatus{
+		{
+			Namespace: model.DefaultNamespace, ID: "a", State: model.StateStopped,
+			CheckpointTs: checkpointTs, SafePointLag: 10,
+		},
+		{
+			Namespace: model.DefaultNamespace, ID: "b", State: model.StateNormal,
+			CheckpointTs: checkpointTs, SafePointLag: 10,
+		},
+	}, upStatus.Changefeeds)
+}
+
+func TestGetGCStatusConcurrentWithPushes(t *testing.T) {
+	t.Parallel()
+
+	getGCStatus := testCase{url: "/api/v2/gc", method: "GET"}
+	mockUpManager := upstream.NewManager4Test(&mockPDClient{})
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(mockUpManager, nil).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	up, err := mockUpManager.GetDefaultUpstream()
+	require.Nil(t, err)
+
+	// The owner pushes the safepoint while the API reads it, run with the
+	// race detector to check the GC manager is read safely.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		safePoint := oracle.GoTimeToTS(time.Now())
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			safePoint++
+			require.Nil(t, up.GCManager.TryUpdateGCSafePoint(
+				context.Background(), safePoint, true))
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			getGCStatus.method, getGCStatus.url, nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := GCStatus{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Len(t, resp.Upstreams, 1)
+	}
+	close(done)
+	wg.Wait()
+}
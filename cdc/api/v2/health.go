@@ -14,36 +14,118 @@
 package v2
 
 import (
+	"context"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/pingcap/tiflow/cdc/api/middleware"
-	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/errors"
+	tidbkv "github.com/pingcap/tidb/kv"
 )
 
-// @Summary Check the health status of a TiCDC cluster
-// @Description Check the health status of a TiCDC cluster
+const (
+	// healthProbeTimeout is the timeout of probing all dependencies.
+	healthProbeTimeout = 3 * time.Second
+
+	componentEtcd = "etcd"
+	componentPD   = "pd"
+	componentTiKV = "tikv"
+)
+
+// healthProbeKey is read from the upstream TiKV to check its connectivity,
+// it's not required to exist.
+var healthProbeKey = []byte("tidb_cdc_health_probe")
+
+// healthProbe checks the connectivity of a dependency.
+type healthProbe struct {
+	component string
+	probe     func(ctx context.Context) error
+}
+
+// @Summary Check the health status of a TiCDC server
+// @Description Probe etcd, PD and TiKV from the TiCDC server, and report the
+// status and the round-trip latency of each component. The status code is
+// 503 if any component is unhealthy.
 // @Tags common,v2
 // @Produce json
-// @Success 200 {object} EmptyResponse
-// @Failure 500,400 {object} model.HTTPError
+// @Success 200 {object} HealthStatus
+// @Failure 503 {object} HealthStatus
 // @Router	/api/v2/health [get]
 func (h *OpenAPIV2) health(c *gin.Context) {
-	if !h.capture.IsOwner() {
-		middleware.ForwardToOwnerMiddleware(h.capture)(c)
-		return
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthProbeTimeout)
+	defer cancel()
+
+	probes := h.healthProbes()
+	status := HealthStatus{
+		Healthy:    true,
+		Components: make([]ComponentHealth, len(probes)),
+	}
+	var wg sync.WaitGroup
+	for i := range probes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start := time.Now()
+			err := probes[i].probe(ctx)
+			status.Components[i] = ComponentHealth{
+				Name:      probes[i].component,
+				Healthy:   err == nil,
+				LatencyMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				status.Components[i].Error = err.Error()
+			}
+		}(i)
 	}
+	wg.Wait()
 
-	ctx := c.Request.Context()
-	health, err := h.capture.StatusProvider().IsHealthy(ctx)
-	if err != nil {
-		_ = c.Error(err)
-		return
+	for _, component := range status.Components {
+		status.Healthy = status.Healthy && component.Healthy
 	}
-	if !health {
-		err = cerror.ErrClusterIsUnhealthy.FastGenByArgs()
-		_ = c.Error(err)
+	if !status.Healthy {
+		c.JSON(http.StatusServiceUnavailable, &status)
 		return
 	}
-	c.JSON(http.StatusOK, &EmptyResponse{})
+	c.JSON(http.StatusOK, &status)
+}
+
+func (h *OpenAPIV2) healthProbes() []healthProbe {
+	return []healthProbe{
+		{
+			component: componentEtcd,
+			probe: func(ctx context.Context) error {
+				_, _, err := h.capture.GetEtcdClient().GetCaptures(ctx)
+				return errors.Trace(err)
+			},
+		},
+		{
+			component: componentPD,
+			probe: func(ctx context.Context) error {
+				up, err := getCaptureDefaultUpstream(h.capture)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				_, _, err = up.PDClient.GetTS(ctx)
+				return errors.Trace(err)
+			},
+		},
+		{
+			component: componentTiKV,
+			probe: func(ctx context.Context) error {
+				up, err := getCaptureDefaultUpstream(h.capture)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				if up.KVStorage == nil {
+					return errors.New("kv storage is not initialized")
+				}
+				_, err = up.KVStorage.GetSnapshot(tidbkv.MaxVersion).Get(ctx, healthProbeKey)
+				if err != nil && !tidbkv.IsErrNotFound(err) {
+					return errors.Trace(err)
+				}
+				return nil
+			},
+		},
+	}
 }
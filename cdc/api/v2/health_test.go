@@ -21,10 +21,11 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pingcap/tidb/store/mockstore"
 	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
-	"github.com/pingcap/tiflow/cdc/model"
-	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,39 +37,60 @@ func TestHealth(t *testing.T) {
 	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
 	router := newRouter(apiV2)
 
-	statusProvider := mock_owner.NewMockStatusProvider(gomock.NewController(t))
-	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
 	cp.EXPECT().IsReady().Return(true).AnyTimes()
-	cp.EXPECT().IsOwner().Return(true).AnyTimes()
 
-	statusProvider.EXPECT().IsHealthy(gomock.Any()).Return(false,
-		cerror.ErrUnknown.FastGenByArgs())
-	w := httptest.NewRecorder()
-	req, _ := http.NewRequestWithContext(context.Background(), health.method,
-		health.url, nil)
-	router.ServeHTTP(w, req)
-	require.Equal(t, http.StatusInternalServerError, w.Code)
-	respErr := model.HTTPError{}
-	err := json.NewDecoder(w.Body).Decode(&respErr)
-	require.Nil(t, err)
-	require.Contains(t, respErr.Code, "ErrUnknown")
+	getHealth := func(expectedCode int) HealthStatus {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), health.method,
+			health.url, nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, expectedCode, w.Code)
+		status := HealthStatus{}
+		err := json.NewDecoder(w.Body).Decode(&status)
+		require.Nil(t, err)
+		require.Len(t, status.Components, 3)
+		require.Equal(t, componentEtcd, status.Components[0].Name)
+		require.Equal(t, componentPD, status.Components[1].Name)
+		require.Equal(t, componentTiKV, status.Components[2].Name)
+		return status
+	}
 
-	statusProvider.EXPECT().IsHealthy(gomock.Any()).Return(false, nil)
-	w = httptest.NewRecorder()
-	req, _ = http.NewRequestWithContext(context.Background(), health.method,
-		health.url, nil)
-	router.ServeHTTP(w, req)
-	require.Equal(t, http.StatusInternalServerError, w.Code)
-	respErr = model.HTTPError{}
-	err = json.NewDecoder(w.Body).Decode(&respErr)
-	require.Nil(t, err)
-	require.Contains(t, respErr.Code, "ErrClusterIsUnhealthy")
+	// All components are unhealthy.
+	etcdClient.EXPECT().GetCaptures(gomock.Any()).
+		Return(int64(0), nil, cerror.ErrPDEtcdAPIError.FastGenByArgs()).Times(1)
+	cp.EXPECT().GetUpstreamManager().
+		Return(nil, cerror.ErrUpstreamNotFound.FastGenByArgs()).Times(2)
+	status := getHealth(http.StatusServiceUnavailable)
+	require.False(t, status.Healthy)
+	for _, component := range status.Components {
+		require.False(t, component.Healthy)
+		require.NotEmpty(t, component.Error)
+	}
+
+	// TiKV is unhealthy.
+	upManager := upstream.NewManager4Test(&mockPDClient{})
+	etcdClient.EXPECT().GetCaptures(gomock.Any()).Return(int64(0), nil, nil).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(upManager, nil).AnyTimes()
+	status = getHealth(http.StatusServiceUnavailable)
+	require.False(t, status.Healthy)
+	require.True(t, status.Components[0].Healthy)
+	require.True(t, status.Components[1].Healthy)
+	require.False(t, status.Components[2].Healthy)
+	require.Contains(t, status.Components[2].Error, "kv storage is not initialized")
 
-	statusProvider.EXPECT().IsHealthy(gomock.Any()).Return(true, nil)
-	w = httptest.NewRecorder()
-	req, _ = http.NewRequestWithContext(context.Background(), health.method,
-		health.url, nil)
-	router.ServeHTTP(w, req)
-	require.Equal(t, http.StatusOK, w.Code)
-	require.Equal(t, "{}", w.Body.String())
+	// All components are healthy.
+	store, err := mockstore.NewMockStore()
+	require.Nil(t, err)
+	defer store.Close() //nolint:errcheck
+	up, err := upManager.GetDefaultUpstream()
+	require.Nil(t, err)
+	up.KVStorage = store
+	status = getHealth(http.StatusOK)
+	require.True(t, status.Healthy)
+	for _, component := range status.Components {
+		require.True(t, component.Healthy)
+		require.Empty(t, component.Error)
+	}
 }
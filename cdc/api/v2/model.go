@@ -47,6 +47,34 @@ type Tso struct {
 	LogicTime int64 `json:"logic_time"`
 }
 
+// GCStatus contains the service GC safepoints of upstreams and how far the
+// checkpoints of changefeeds are ahead of them
+type GCStatus struct {
+	Upstreams []UpstreamGCStatus `json:"upstreams"`
+}
+
+// UpstreamGCStatus contains the service GC safepoint of an upstream
+type UpstreamGCStatus struct {
+	UpstreamID uint64 `json:"upstream_id"`
+	// ServiceGCSafePoint is 0 if it has not been written or loaded yet.
+	ServiceGCSafePoint uint64 `json:"service_gc_safepoint"`
+	// LastUpdateTime is nil if the safepoint has never been written.
+	LastUpdateTime *time.Time           `json:"last_update_time,omitempty"`
+	Changefeeds    []ChangefeedGCStatus `json:"changefeeds"`
+}
+
+// ChangefeedGCStatus contains the checkpoint of a changefeed and its lag
+// versus the service GC safepoint of the upstream
+type ChangefeedGCStatus struct {
+	Namespace    string          `json:"namespace"`
+	ID           string          `json:"id"`
+	State        model.FeedState `json:"state"`
+	CheckpointTs uint64          `json:"checkpoint_ts"`
+	// SafePointLag is the lag in seconds between the checkpoint and the
+	// service GC safepoint, it is negative if the checkpoint is behind.
+	SafePointLag float64 `json:"safepoint_lag"`
+}
+
 // Tables contains IneligibleTables and EligibleTables
 type Tables struct {
 	IneligibleTables []TableName `json:"ineligible_tables,omitempty"`
@@ -109,6 +137,12 @@ type ChangefeedConfig struct {
 	TargetTs      uint64         `json:"target_ts"`
 	SinkURI       string         `json:"sink_uri"`
 	ReplicaConfig *ReplicaConfig `json:"replica_config"`
+	// Online indicates whether to update a running changefeed, only
+	// memory_quota and the sink rate limits can be updated online.
+	Online bool `json:"online"`
+	// UpstreamID is the ID of a registered upstream, it's used to connect to
+	// the upstream if pd_addrs is not specified.
+	UpstreamID uint64 `json:"upstream_id,omitempty"`
 	PDConfig
 }
 
@@ -165,12 +199,18 @@ type ReplicaConfig struct {
 	SyncPointInterval  *JSONDuration `json:"sync_point_interval" swaggertype:"string"`
 	SyncPointRetention *JSONDuration `json:"sync_point_retention" swaggertype:"string"`
 
+	FailedChangefeedRetention *JSONDuration `json:"failed_changefeed_retention" swaggertype:"string"`
+	GCTTL                     int64         `json:"gc_ttl"`
+
 	Filter     *FilterConfig              `json:"filter"`
 	Mounter    *MounterConfig             `json:"mounter"`
 	Sink       *SinkConfig                `json:"sink"`
 	Consistent *ConsistentConfig          `json:"consistent"`
 	Scheduler  *ChangefeedSchedulerConfig `json:"scheduler"`
 	Integrity  *IntegrityConfig           `json:"integrity"`
+	KVClient   *KVClientReplicaConfig     `json:"kv_client,omitempty"`
+	Retry      *ChangefeedRetryConfig     `json:"retry,omitempty"`
+	AutoResume *AutoResumeConfig          `json:"auto_resume,omitempty"`
 }
 
 // ToInternalReplicaConfig coverts *v2.ReplicaConfig into *config.ReplicaConfig
@@ -194,6 +234,10 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 	if c.SyncPointRetention != nil {
 		res.SyncPointRetention = c.SyncPointRetention.duration
 	}
+	if c.FailedChangefeedRetention != nil {
+		res.FailedChangefeedRetention = c.FailedChangefeedRetention.duration
+	}
+	res.GCTTL = c.GCTTL
 	res.BDRMode = c.BDRMode
 
 	if c.Filter != nil {
@@ -281,6 +325,16 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 					AvroEnableWatermark:            oldConfig.AvroEnableWatermark,
 					AvroDecimalHandlingMode:        oldConfig.AvroDecimalHandlingMode,
 					AvroBigintUnsignedHandlingMode: oldConfig.AvroBigintUnsignedHandlingMode,
+					AvroSubjectNameStrategy:        oldConfig.AvroSubjectNameStrategy,
+					AvroSchemaCompatibility:        oldConfig.AvroSchemaCompatibility,
+				}
+			}
+			var largeMessageHandle *config.LargeMessageHandleConfig
+			if c.Sink.KafkaConfig.LargeMessageHandle != nil {
+				oldConfig := c.Sink.KafkaConfig.LargeMessageHandle
+				largeMessageHandle = &config.LargeMessageHandleConfig{
+					LargeMessageHandleOption: oldConfig.LargeMessageHandleOption,
+					ClaimCheckStorageURI:     oldConfig.ClaimCheckStorageURI,
 				}
 			}
 			kafkaConfig = &config.KafkaConfig{
@@ -312,6 +366,7 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				Key:                          c.Sink.KafkaConfig.Key,
 				InsecureSkipVerify:           c.Sink.KafkaConfig.InsecureSkipVerify,
 				CodecConfig:                  codeConfig,
+				LargeMessageHandle:           largeMessageHandle,
 			}
 		}
 		var mysqlConfig *config.MySQLConfig
@@ -332,6 +387,11 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				EnableBatchDML:               c.Sink.MySQLConfig.EnableBatchDML,
 				EnableMultiStatement:         c.Sink.MySQLConfig.EnableMultiStatement,
 				EnableCachePreparedStatement: c.Sink.MySQLConfig.EnableCachePreparedStatement,
+				EnableDMLCompaction:          c.Sink.MySQLConfig.EnableDMLCompaction,
+				EnableAsyncDDL:               c.Sink.MySQLConfig.EnableAsyncDDL,
+				MaxCachedPreparedStatements:  c.Sink.MySQLConfig.MaxCachedPreparedStatements,
+				ConnPoolSize:                 c.Sink.MySQLConfig.ConnPoolSize,
+				TransactionIsolation:         c.Sink.MySQLConfig.TransactionIsolation,
 			}
 		}
 		var cloudStorageConfig *config.CloudStorageConfig
@@ -361,6 +421,9 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			MySQLConfig:              mysqlConfig,
 			CloudStorageConfig:       cloudStorageConfig,
 			SafeMode:                 c.Sink.SafeMode,
+			MaxRowsPerSecond:         c.Sink.MaxRowsPerSecond,
+			MaxBytesPerSecond:        c.Sink.MaxBytesPerSecond,
+			EnableTableMetrics:       c.Sink.EnableTableMetrics,
 		}
 	}
 	if c.Mounter != nil {
@@ -373,6 +436,7 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			EnableTableAcrossNodes: c.Scheduler.EnableTableAcrossNodes,
 			RegionThreshold:        c.Scheduler.RegionThreshold,
 			WriteKeyThreshold:      c.Scheduler.WriteKeyThreshold,
+			BalanceStrategy:        c.Scheduler.BalanceStrategy,
 		}
 	}
 	if c.Integrity != nil {
@@ -381,6 +445,49 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			CorruptionHandleLevel: c.Integrity.CorruptionHandleLevel,
 		}
 	}
+	if c.KVClient != nil {
+		res.KVClient = &config.KVClientReplicaConfig{
+			RegionScanLimit: c.KVClient.RegionScanLimit,
+		}
+		if c.KVClient.RegionBackoffBaseDelay != nil {
+			d := config.TomlDuration(c.KVClient.RegionBackoffBaseDelay.duration)
+			res.KVClient.RegionBackoffBaseDelay = &d
+		}
+		if c.KVClient.RegionBackoffMaxDelay != nil {
+			d := config.TomlDuration(c.KVClient.RegionBackoffMaxDelay.duration)
+			res.KVClient.RegionBackoffMaxDelay = &d
+		}
+	}
+	if c.Retry != nil {
+		res.Retry = &config.ChangefeedRetryConfig{
+			MaxRetries:     c.Retry.MaxRetries,
+			FastFailErrors: c.Retry.FastFailErrors,
+		}
+		if c.Retry.BackoffInitInterval != nil {
+			res.Retry.BackoffInitInterval = config.TomlDuration(c.Retry.BackoffInitInterval.duration)
+		}
+		if c.Retry.BackoffMaxInterval != nil {
+			res.Retry.BackoffMaxInterval = config.TomlDuration(c.Retry.BackoffMaxInterval.duration)
+		}
+		if c.Retry.BackoffMaxElapsedTime != nil {
+			res.Retry.BackoffMaxElapsedTime = config.TomlDuration(c.Retry.BackoffMaxElapsedTime.duration)
+		}
+	}
+	if c.AutoResume != nil {
+		res.AutoResume = &config.AutoResumeConfig{
+			Enable:          c.AutoResume.Enable,
+			RetryableErrors: c.AutoResume.RetryableErrors,
+		}
+		if c.AutoResume.BackoffInitInterval != nil {
+			res.AutoResume.BackoffInitInterval = config.TomlDuration(c.AutoResume.BackoffInitInterval.duration)
+		}
+		if c.AutoResume.BackoffMaxInterval != nil {
+			res.AutoResume.BackoffMaxInterval = config.TomlDuration(c.AutoResume.BackoffMaxInterval.duration)
+		}
+		if c.AutoResume.MaxOutage != nil {
+			res.AutoResume.MaxOutage = config.TomlDuration(c.AutoResume.MaxOutage.duration)
+		}
+	}
 	return res
 }
 
@@ -398,6 +505,9 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 		SyncPointInterval:     &JSONDuration{cloned.SyncPointInterval},
 		SyncPointRetention:    &JSONDuration{cloned.SyncPointRetention},
 		BDRMode:               cloned.BDRMode,
+
+		FailedChangefeedRetention: &JSONDuration{cloned.FailedChangefeedRetention},
+		GCTTL:                     cloned.GCTTL,
 	}
 
 	if cloned.Filter != nil {
@@ -477,6 +587,16 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 					AvroEnableWatermark:            oldConfig.AvroEnableWatermark,
 					AvroDecimalHandlingMode:        oldConfig.AvroDecimalHandlingMode,
 					AvroBigintUnsignedHandlingMode: oldConfig.AvroBigintUnsignedHandlingMode,
+					AvroSubjectNameStrategy:        oldConfig.AvroSubjectNameStrategy,
+					AvroSchemaCompatibility:        oldConfig.AvroSchemaCompatibility,
+				}
+			}
+			var largeMessageHandle *LargeMessageHandleConfig
+			if cloned.Sink.KafkaConfig.LargeMessageHandle != nil {
+				oldConfig := cloned.Sink.KafkaConfig.LargeMessageHandle
+				largeMessageHandle = &LargeMessageHandleConfig{
+					LargeMessageHandleOption: oldConfig.LargeMessageHandleOption,
+					ClaimCheckStorageURI:     oldConfig.ClaimCheckStorageURI,
 				}
 			}
 			kafkaConfig = &KafkaConfig{
@@ -508,6 +628,7 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Key:                          cloned.Sink.KafkaConfig.Key,
 				InsecureSkipVerify:           cloned.Sink.KafkaConfig.InsecureSkipVerify,
 				CodecConfig:                  codeConfig,
+				LargeMessageHandle:           largeMessageHandle,
 			}
 		}
 		var mysqlConfig *MySQLConfig
@@ -528,6 +649,11 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				EnableBatchDML:               cloned.Sink.MySQLConfig.EnableBatchDML,
 				EnableMultiStatement:         cloned.Sink.MySQLConfig.EnableMultiStatement,
 				EnableCachePreparedStatement: cloned.Sink.MySQLConfig.EnableCachePreparedStatement,
+				EnableDMLCompaction:          cloned.Sink.MySQLConfig.EnableDMLCompaction,
+				EnableAsyncDDL:               cloned.Sink.MySQLConfig.EnableAsyncDDL,
+				MaxCachedPreparedStatements:  cloned.Sink.MySQLConfig.MaxCachedPreparedStatements,
+				ConnPoolSize:                 cloned.Sink.MySQLConfig.ConnPoolSize,
+				TransactionIsolation:         cloned.Sink.MySQLConfig.TransactionIsolation,
 			}
 		}
 		var cloudStorageConfig *CloudStorageConfig
@@ -557,6 +683,9 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			MySQLConfig:              mysqlConfig,
 			CloudStorageConfig:       cloudStorageConfig,
 			SafeMode:                 cloned.Sink.SafeMode,
+			MaxRowsPerSecond:         cloned.Sink.MaxRowsPerSecond,
+			MaxBytesPerSecond:        cloned.Sink.MaxBytesPerSecond,
+			EnableTableMetrics:       cloned.Sink.EnableTableMetrics,
 		}
 	}
 	if cloned.Consistent != nil {
@@ -578,6 +707,7 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			EnableTableAcrossNodes: cloned.Scheduler.EnableTableAcrossNodes,
 			RegionThreshold:        cloned.Scheduler.RegionThreshold,
 			WriteKeyThreshold:      cloned.Scheduler.WriteKeyThreshold,
+			BalanceStrategy:        cloned.Scheduler.BalanceStrategy,
 		}
 	}
 
@@ -588,6 +718,42 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 		}
 	}
 
+	if cloned.KVClient != nil {
+		res.KVClient = &KVClientReplicaConfig{
+			RegionScanLimit: cloned.KVClient.RegionScanLimit,
+		}
+		if cloned.KVClient.RegionBackoffBaseDelay != nil {
+			res.KVClient.RegionBackoffBaseDelay = &JSONDuration{
+				time.Duration(*cloned.KVClient.RegionBackoffBaseDelay),
+			}
+		}
+		if cloned.KVClient.RegionBackoffMaxDelay != nil {
+			res.KVClient.RegionBackoffMaxDelay = &JSONDuration{
+				time.Duration(*cloned.KVClient.RegionBackoffMaxDelay),
+			}
+		}
+	}
+
+	if cloned.Retry != nil {
+		res.Retry = &ChangefeedRetryConfig{
+			MaxRetries:            cloned.Retry.MaxRetries,
+			BackoffInitInterval:   &JSONDuration{time.Duration(cloned.Retry.BackoffInitInterval)},
+			BackoffMaxInterval:    &JSONDuration{time.Duration(cloned.Retry.BackoffMaxInterval)},
+			BackoffMaxElapsedTime: &JSONDuration{time.Duration(cloned.Retry.BackoffMaxElapsedTime)},
+			FastFailErrors:        cloned.Retry.FastFailErrors,
+		}
+	}
+
+	if cloned.AutoResume != nil {
+		res.AutoResume = &AutoResumeConfig{
+			Enable:              cloned.AutoResume.Enable,
+			BackoffInitInterval: &JSONDuration{time.Duration(cloned.AutoResume.BackoffInitInterval)},
+			BackoffMaxInterval:  &JSONDuration{time.Duration(cloned.AutoResume.BackoffMaxInterval)},
+			MaxOutage:           &JSONDuration{time.Duration(cloned.AutoResume.MaxOutage)},
+			RetryableErrors:     cloned.AutoResume.RetryableErrors,
+		}
+	}
+
 	return res
 }
 
@@ -705,6 +871,9 @@ type SinkConfig struct {
 	EnableKafkaSinkV2        bool                `json:"enable_kafka_sink_v2"`
 	OnlyOutputUpdatedColumns *bool               `json:"only_output_updated_columns"`
 	SafeMode                 *bool               `json:"safe_mode,omitempty"`
+	MaxRowsPerSecond         *uint64             `json:"max_rows_per_second,omitempty"`
+	MaxBytesPerSecond        *uint64             `json:"max_bytes_per_second,omitempty"`
+	EnableTableMetrics       *bool               `json:"enable_table_metrics,omitempty"`
 	KafkaConfig              *KafkaConfig        `json:"kafka_config,omitempty"`
 	MySQLConfig              *MySQLConfig        `json:"mysql_config,omitempty"`
 	CloudStorageConfig       *CloudStorageConfig `json:"cloud_storage_config,omitempty"`
@@ -754,6 +923,8 @@ type ChangefeedSchedulerConfig struct {
 	RegionThreshold int `toml:"region_threshold" json:"region_threshold"`
 	// WriteKeyThreshold is the written keys threshold of splitting a table.
 	WriteKeyThreshold int `toml:"write_key_threshold" json:"write_key_threshold"`
+	// BalanceStrategy is how tables are balanced among captures.
+	BalanceStrategy string `toml:"balance_strategy" json:"balance_strategy"`
 }
 
 // IntegrityConfig is the config for integrity check
@@ -763,6 +934,38 @@ type IntegrityConfig struct {
 	CorruptionHandleLevel string `json:"corruption_handle_level"`
 }
 
+// KVClientReplicaConfig overrides the kv client config of the server for a
+// changefeed.
+// This is a duplicate of config.KVClientReplicaConfig
+type KVClientReplicaConfig struct {
+	RegionScanLimit        *int          `json:"region_scan_limit,omitempty"`
+	RegionBackoffBaseDelay *JSONDuration `json:"region_backoff_base_delay,omitempty" swaggertype:"string"`
+	RegionBackoffMaxDelay  *JSONDuration `json:"region_backoff_max_delay,omitempty" swaggertype:"string"`
+}
+
+// ChangefeedRetryConfig is the policy of restarting a changefeed after it
+// meets retryable errors, zero values mean the defaults of the owner.
+// This is a duplicate of config.ChangefeedRetryConfig
+type ChangefeedRetryConfig struct {
+	MaxRetries            uint64        `json:"max_retries"`
+	BackoffInitInterval   *JSONDuration `json:"backoff_init_interval,omitempty" swaggertype:"string"`
+	BackoffMaxInterval    *JSONDuration `json:"backoff_max_interval,omitempty" swaggertype:"string"`
+	BackoffMaxElapsedTime *JSONDuration `json:"backoff_max_elapsed_time,omitempty" swaggertype:"string"`
+	FastFailErrors        []string      `json:"fast_fail_errors,omitempty"`
+}
+
+// AutoResumeConfig is the policy of resuming a changefeed automatically after
+// it's failed by retryable downstream errors, zero values mean the defaults
+// of the owner.
+// This is a duplicate of config.AutoResumeConfig
+type AutoResumeConfig struct {
+	Enable              bool          `json:"enable"`
+	BackoffInitInterval *JSONDuration `json:"backoff_init_interval,omitempty" swaggertype:"string"`
+	BackoffMaxInterval  *JSONDuration `json:"backoff_max_interval,omitempty" swaggertype:"string"`
+	MaxOutage           *JSONDuration `json:"max_outage,omitempty" swaggertype:"string"`
+	RetryableErrors     []string      `json:"retryable_errors,omitempty"`
+}
+
 // EtcdData contains key/value pair of etcd data
 type EtcdData struct {
 	Key   string `json:"key,omitempty"`
@@ -860,10 +1063,43 @@ type ProcessorDetail struct {
 	Tables []int64 `json:"table_ids"`
 }
 
+// SinkStats holds the statistics of the sink of a changefeed in a capture.
+type SinkStats struct {
+	CaptureID string `json:"capture_id"`
+	Namespace string `json:"namespace"`
+	ID        string `json:"id"`
+	// Rows is the number of rows emitted to the downstream by the DML type.
+	Rows map[string]uint64 `json:"rows"`
+	// TableRows is only set if the table metrics of the changefeed is enabled.
+	TableRows map[string]map[string]uint64 `json:"table_rows,omitempty"`
+
+	BatchCount                    uint64  `json:"batch_count"`
+	BatchRows                     uint64  `json:"batch_rows"`
+	BatchExecDurationSeconds      float64 `json:"batch_exec_duration_seconds"`
+	ConflictDetectCount           uint64  `json:"conflict_detect_count"`
+	ConflictDetectDurationSeconds float64 `json:"conflict_detect_duration_seconds"`
+	ExecutionErrors               uint64  `json:"execution_errors"`
+}
+
 // Liveness is the liveness status of a capture.
 // Liveness can only be changed from alive to stopping, and no way back.
 type Liveness int32
 
+// HealthStatus holds the health status of a TiCDC server and its dependencies
+type HealthStatus struct {
+	Healthy    bool              `json:"healthy"`
+	Components []ComponentHealth `json:"components"`
+}
+
+// ComponentHealth holds the health status of a dependency of a TiCDC server
+type ComponentHealth struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	// LatencyMs is the round-trip latency of the probe in milliseconds.
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
 // ServerStatus holds some common information of a server
 type ServerStatus struct {
 	Version   string   `json:"version"`
@@ -873,6 +1109,8 @@ type ServerStatus struct {
 	Pid       int      `json:"pid"`
 	IsOwner   bool     `json:"is_owner"`
 	Liveness  Liveness `json:"liveness"`
+	// MemoryQuota is nil if the server memory quota is not set.
+	MemoryQuota *model.MemoryQuotaStatus `json:"memory_quota,omitempty"`
 }
 
 // Capture holds common information of a capture in cdc
@@ -890,6 +1128,8 @@ type CodecConfig struct {
 	AvroEnableWatermark            *bool   `json:"avro_enable_watermark"`
 	AvroDecimalHandlingMode        *string `json:"avro_decimal_handling_mode,omitempty"`
 	AvroBigintUnsignedHandlingMode *string `json:"avro_bigint_unsigned_handling_mode,omitempty"`
+	AvroSubjectNameStrategy        *string `json:"avro_subject_name_strategy,omitempty"`
+	AvroSchemaCompatibility        *string `json:"avro_schema_compatibility,omitempty"`
 }
 
 // KafkaConfig represents a kafka sink configuration
@@ -922,6 +1162,14 @@ type KafkaConfig struct {
 	Key                          *string      `json:"key,omitempty"`
 	InsecureSkipVerify           *bool        `json:"insecure_skip_verify,omitempty"`
 	CodecConfig                  *CodecConfig `json:"codec_config,omitempty"`
+
+	LargeMessageHandle *LargeMessageHandleConfig `json:"large_message_handle,omitempty"`
+}
+
+// LargeMessageHandleConfig denotes the large message handling config
+type LargeMessageHandleConfig struct {
+	LargeMessageHandleOption string `json:"large_message_handle_option"`
+	ClaimCheckStorageURI     string `json:"claim_check_storage_uri"`
 }
 
 // MySQLConfig represents a MySQL sink configuration
@@ -941,6 +1189,11 @@ type MySQLConfig struct {
 	EnableBatchDML               *bool   `json:"enable_batch_dml,omitempty"`
 	EnableMultiStatement         *bool   `json:"enable_multi_statement,omitempty"`
 	EnableCachePreparedStatement *bool   `json:"enable_cache_prepared_statement,omitempty"`
+	EnableDMLCompaction          *bool   `json:"enable_dml_compaction,omitempty"`
+	EnableAsyncDDL               *bool   `json:"enable_async_ddl,omitempty"`
+	MaxCachedPreparedStatements  *int    `json:"max_cached_prepared_statements,omitempty"`
+	ConnPoolSize                 *int    `json:"conn_pool_size,omitempty"`
+	TransactionIsolation         *string `json:"transaction_isolation,omitempty"`
 }
 
 // CloudStorageConfig represents a cloud storage sink configuration
@@ -957,3 +1210,79 @@ type ChangefeedStatus struct {
 	CheckpointTs uint64        `json:"checkpoint_ts"`
 	LastError    *RunningError `json:"last_error,omitempty"`
 }
+
+// TableStatus holds the replication progress of a table in a changefeed
+type TableStatus struct {
+	TableID        int64          `json:"table_id"`
+	CheckpointTs   uint64         `json:"checkpoint_ts"`
+	ResolvedTs     uint64         `json:"resolved_ts"`
+	CheckpointTime model.JSONTime `json:"checkpoint_time"`
+	// CheckpointLag is the lag of the checkpoint of the table in seconds.
+	CheckpointLag float64  `json:"checkpoint_lag"`
+	Captures      []string `json:"captures"`
+	State         string   `json:"state"`
+	SpanCount     int      `json:"span_count"`
+}
+
+// DebugInfo holds the runtime state of a capture, it's used to diagnose
+// the changefeeds which are stuck.
+type DebugInfo struct {
+	CaptureID     string `json:"capture_id"`
+	AdvertiseAddr string `json:"address"`
+	// Owner is only set if the capture is the owner.
+	Owner      *OwnerDebugInfo      `json:"owner,omitempty"`
+	Processors []ProcessorDebugInfo `json:"processors"`
+	// SortEngineDiskUsage is the on-disk data size of the sort engine in bytes.
+	SortEngineDiskUsage uint64 `json:"sort_engine_disk_usage"`
+}
+
+// OwnerDebugInfo holds the state of the owner.
+type OwnerDebugInfo struct {
+	Captures    []Capture             `json:"captures"`
+	Changefeeds []ChangefeedDebugInfo `json:"changefeeds"`
+}
+
+// ChangefeedDebugInfo holds the state of a changefeed in the owner.
+type ChangefeedDebugInfo struct {
+	Namespace            string             `json:"namespace"`
+	ID                   string             `json:"id"`
+	State                string             `json:"state"`
+	CheckpointTs         uint64             `json:"checkpoint_ts"`
+	ResolvedTs           uint64             `json:"resolved_ts"`
+	SchedulerInitialized bool               `json:"scheduler_initialized"`
+	Tables               []TableStatus      `json:"tables"`
+	RunningTasks         []ScheduleTaskInfo `json:"running_tasks"`
+}
+
+// ScheduleTaskInfo holds a schedule task which is running in the scheduler.
+type ScheduleTaskInfo struct {
+	Type     string   `json:"type"`
+	TableID  int64    `json:"table_id"`
+	Span     string   `json:"span"`
+	State    string   `json:"state"`
+	Captures []string `json:"captures"`
+}
+
+// ProcessorDebugInfo holds the state of a processor in the capture.
+type ProcessorDebugInfo struct {
+	Namespace         string               `json:"namespace"`
+	ChangefeedID      string               `json:"changefeed_id"`
+	Initialized       bool                 `json:"initialized"`
+	Tables            []TableSpanDebugInfo `json:"tables"`
+	SinkBacklogEvents int64                `json:"sink_backlog_events"`
+	SinkMemoryUsage   uint64               `json:"sink_memory_usage"`
+	SinkMemoryQuota   uint64               `json:"sink_memory_quota"`
+	RedoMemoryUsage   uint64               `json:"redo_memory_usage"`
+}
+
+// TableSpanDebugInfo holds the state of a table span in a processor.
+type TableSpanDebugInfo struct {
+	TableID               int64  `json:"table_id"`
+	Span                  string `json:"span"`
+	State                 string `json:"state"`
+	CheckpointTs          uint64 `json:"checkpoint_ts"`
+	ResolvedTs            uint64 `json:"resolved_ts"`
+	BarrierTs             uint64 `json:"barrier_ts"`
+	ReceivedMaxCommitTs   uint64 `json:"received_max_commit_ts"`
+	ReceivedMaxResolvedTs uint64 `json:"received_max_resolved_ts"`
+}
@@ -67,11 +67,14 @@ var defaultAPIConfig = &ReplicaConfig{
 			Scheduler.RegionThreshold,
 		WriteKeyThreshold: config.GetDefaultReplicaConfig().
 			Scheduler.WriteKeyThreshold,
+		BalanceStrategy: config.GetDefaultReplicaConfig().
+			Scheduler.BalanceStrategy,
 	},
 	Integrity: &IntegrityConfig{
 		IntegrityCheckLevel:   config.GetDefaultReplicaConfig().Integrity.IntegrityCheckLevel,
 		CorruptionHandleLevel: config.GetDefaultReplicaConfig().Integrity.CorruptionHandleLevel,
 	},
+	FailedChangefeedRetention: &JSONDuration{0},
 }
 
 func TestDefaultReplicaConfig(t *testing.T) {
@@ -145,6 +148,13 @@ func TestToAPIReplicaConfig(t *testing.T) {
 	cfg.Mounter = &config.MounterConfig{WorkerNum: 11}
 	cfg.Scheduler = &config.ChangefeedSchedulerConfig{
 		EnableTableAcrossNodes: true, RegionThreshold: 10001, WriteKeyThreshold: 10001,
+		BalanceStrategy: config.BalanceStrategyWorkload,
+	}
+	scanLimit := 10
+	backoffMaxDelay := config.TomlDuration(time.Second)
+	cfg.KVClient = &config.KVClientReplicaConfig{
+		RegionScanLimit:       &scanLimit,
+		RegionBackoffMaxDelay: &backoffMaxDelay,
 	}
 	cfg2 := ToAPIReplicaConfig(cfg).ToInternalReplicaConfig()
 	require.Equal(t, "", cfg2.Sink.DispatchRules[0].DispatcherRule)
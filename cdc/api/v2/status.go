@@ -19,6 +19,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	"github.com/pingcap/tiflow/pkg/version"
 )
 
@@ -49,5 +50,21 @@ func (h *OpenAPIV2) serverStatus(c *gin.Context) {
 		IsOwner:   h.capture.IsOwner(),
 		Liveness:  h.capture.Liveness(),
 	}
+	if quota := memquota.GetServerMemQuota(); quota != nil {
+		usages := quota.GetChangefeedUsages()
+		status.MemoryQuota = &model.MemoryQuotaStatus{
+			TotalBytes:  quota.GetTotalBytes(),
+			UsedBytes:   quota.GetUsedBytes(),
+			Changefeeds: make([]model.ChangefeedMemoryUsage, 0, len(usages)),
+		}
+		for _, usage := range usages {
+			status.MemoryQuota.Changefeeds = append(status.MemoryQuota.Changefeeds,
+				model.ChangefeedMemoryUsage{
+					Namespace: usage.ChangefeedID.Namespace,
+					ID:        usage.ChangefeedID.ID,
+					UsedBytes: usage.UsedBytes,
+				})
+		}
+	}
 	c.IndentedJSON(http.StatusOK, status)
 }
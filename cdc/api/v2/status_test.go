@@ -23,6 +23,7 @@ import (
 	"github.com/golang/mock/gomock"
 	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -43,7 +44,7 @@ func TestGetStatus(t *testing.T) {
 	cp.EXPECT().Liveness().Return(model.LivenessCaptureStopping).AnyTimes()
 	cp.EXPECT().Info().Return(model.CaptureInfo{
 		ID: "capture-id",
-	}, nil)
+	}, nil).Times(2)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequestWithContext(context.Background(), status.method, status.url, nil)
@@ -55,5 +56,27 @@ func TestGetStatus(t *testing.T) {
 	require.Equal(t, model.LivenessCaptureStopping, resp.Liveness)
 	require.True(t, resp.IsOwner)
 	require.Equal(t, "capture-id", resp.ID)
+	require.Nil(t, resp.MemoryQuota)
 	require.Equal(t, http.StatusOK, w.Code)
+
+	// The usage of the server memory quota is returned if it's set.
+	memquota.InitServerMemQuota(100)
+	defer memquota.InitServerMemQuota(0)
+	quota := memquota.NewMemQuota(model.DefaultChangeFeedID("test"), 100, "sink")
+	defer quota.Close()
+	require.True(t, quota.TryAcquire(10))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), status.method, status.url, nil)
+	router.ServeHTTP(w, req)
+	resp = model.ServerStatus{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, &model.MemoryQuotaStatus{
+		TotalBytes: 100,
+		UsedBytes:  10,
+		Changefeeds: []model.ChangefeedMemoryUsage{
+			{Namespace: "default", ID: "test", UsedBytes: 10},
+		},
+	}, resp.MemoryQuota)
 }
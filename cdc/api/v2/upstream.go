@@ -0,0 +1,180 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// apiOpVarUpstreamID is the key of upstream ID in HTTP API
+	apiOpVarUpstreamID = "upstream_id"
+)
+
+// addUpstream registers an upstream
+// @Summary Register an upstream
+// @Description Register the PD endpoints and credentials of an upstream TiDB
+// cluster, changefeeds can be created from it by the upstream ID, which is
+// the cluster ID of the PD cluster. The existing upstream with the same ID is
+// overwritten.
+// @Tags upstream,v2
+// @Accept json
+// @Produce json
+// @Param upstream body UpstreamConfig true "upstream config"
+// @Success 200 {object} UpstreamConfig
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams [post]
+func (h *OpenAPIV2) addUpstream(c *gin.Context) {
+	ctx := c.Request.Context()
+	cfg := &UpstreamConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if len(cfg.PDAddrs) == 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"pd_addrs of the upstream is required"))
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	pdClient, err := h.helpers.getPDClient(timeoutCtx, cfg.PDAddrs, cfg.toCredential())
+	if err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIGetPDClientFailed, err))
+		return
+	}
+	defer pdClient.Close()
+	clusterID := pdClient.GetClusterID(ctx)
+	if cfg.ID != 0 && cfg.ID != clusterID {
+		_ = c.Error(cerror.ErrUpstreamMissMatch.GenWithStackByArgs(cfg.ID, clusterID))
+		return
+	}
+	cfg.ID = clusterID
+
+	info := &model.UpstreamInfo{
+		ID:            cfg.ID,
+		PDEndpoints:   strings.Join(cfg.PDAddrs, ","),
+		KeyPath:       cfg.KeyPath,
+		CertPath:      cfg.CertPath,
+		CAPath:        cfg.CAPath,
+		CertAllowedCN: cfg.CertAllowedCN,
+	}
+	err = h.capture.GetEtcdClient().SaveUpstreamInfo(ctx, info, model.DefaultNamespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("Add upstream successfully!",
+		zap.Uint64("upstreamID", info.ID),
+		zap.String("pdEndpoints", info.PDEndpoints))
+	c.JSON(http.StatusOK, cfg)
+}
+
+// listUpstreams lists all registered upstreams
+// @Summary List upstreams
+// @Description list all upstreams registered in the TiCDC cluster
+// @Tags upstream,v2
+// @Produce json
+// @Success 200 {array} UpstreamConfig
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams [get]
+func (h *OpenAPIV2) listUpstreams(c *gin.Context) {
+	infos, err := h.capture.GetEtcdClient().
+		GetAllUpstreamInfo(c.Request.Context(), model.DefaultNamespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upstreams := make([]UpstreamConfig, 0, len(infos))
+	for _, info := range infos {
+		upstreams = append(upstreams, toUpstreamConfig(info))
+	}
+	sort.Slice(upstreams, func(i, j int) bool {
+		return upstreams[i].ID < upstreams[j].ID
+	})
+	c.JSON(http.StatusOK, &ListResponse[UpstreamConfig]{
+		Total: len(upstreams),
+		Items: upstreams,
+	})
+}
+
+// deleteUpstream removes a registered upstream
+// @Summary Remove an upstream
+// @Description Remove an upstream, it's refused if the upstream is still
+// used by any changefeed.
+// @Tags upstream,v2
+// @Produce json
+// @Param upstream_id path string true "upstream_id"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams/{upstream_id} [delete]
+func (h *OpenAPIV2) deleteUpstream(c *gin.Context) {
+	ctx := c.Request.Context()
+	upstreamID, err := strconv.ParseUint(c.Param(apiOpVarUpstreamID), 10, 64)
+	if err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid upstream_id: %s", c.Param(apiOpVarUpstreamID)))
+		return
+	}
+	etcdClient := h.capture.GetEtcdClient()
+	if _, err := etcdClient.GetUpstreamInfo(ctx, upstreamID, model.DefaultNamespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	infos, err := h.capture.StatusProvider().GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	for id, info := range infos {
+		if id.Namespace == model.DefaultNamespace && info.UpstreamID == upstreamID {
+			_ = c.Error(cerror.ErrUpstreamInUse.GenWithStackByArgs(id.ID, upstreamID))
+			return
+		}
+	}
+
+	if err := etcdClient.DeleteUpstreamInfo(ctx, upstreamID, model.DefaultNamespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("Remove upstream successfully!", zap.Uint64("upstreamID", upstreamID))
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
+
+// toUpstreamConfig converts an UpstreamInfo to an UpstreamConfig
+func toUpstreamConfig(info *model.UpstreamInfo) UpstreamConfig {
+	return UpstreamConfig{
+		ID: info.ID,
+		PDConfig: PDConfig{
+			PDAddrs:       strings.Split(info.PDEndpoints, ","),
+			CAPath:        info.CAPath,
+			CertPath:      info.CertPath,
+			KeyPath:       info.KeyPath,
+			CertAllowedCN: info.CertAllowedCN,
+		},
+	}
+}
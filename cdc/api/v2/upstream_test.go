@@ -0,0 +1,165 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddUpstream(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	helpers := NewMockAPIV2Helpers(ctrl)
+	cp := mock_capture.NewMockCapture(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	router := newRouter(NewOpenAPIV2ForTest(cp, helpers))
+
+	post := func(cfg *UpstreamConfig) *httptest.ResponseRecorder {
+		body, err := json.Marshal(cfg)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			http.MethodPost, "/api/v2/upstreams", bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: pd addrs are not specified
+	w := post(&UpstreamConfig{})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// case 2: the upstream ID mismatches the cluster ID of pd
+	helpers.EXPECT().getPDClient(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&mockPDClient{}, nil).Times(2)
+	w = post(&UpstreamConfig{
+		ID:       1,
+		PDConfig: PDConfig{PDAddrs: []string{"http://127.0.0.1:2379"}},
+	})
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	respErr = model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrUpstreamMissMatch")
+
+	// case 3: success, the upstream ID is the cluster ID of pd
+	etcdClient.EXPECT().SaveUpstreamInfo(gomock.Any(), gomock.Any(), model.DefaultNamespace).
+		DoAndReturn(func(_ context.Context, info *model.UpstreamInfo, _ string) error {
+			require.Equal(t, uint64(123), info.ID)
+			require.Equal(t, "http://127.0.0.1:2379,http://127.0.0.1:2479", info.PDEndpoints)
+			return nil
+		}).Times(1)
+	w = post(&UpstreamConfig{
+		PDConfig: PDConfig{PDAddrs: []string{
+			"http://127.0.0.1:2379", "http://127.0.0.1:2479",
+		}},
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := UpstreamConfig{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, uint64(123), resp.ID)
+}
+
+func TestListUpstreams(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	router := newRouter(NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(ctrl)))
+
+	etcdClient.EXPECT().GetAllUpstreamInfo(gomock.Any(), model.DefaultNamespace).
+		Return(map[model.UpstreamID]*model.UpstreamInfo{
+			2: {ID: 2, PDEndpoints: "http://127.0.0.1:2479"},
+			1: {ID: 1, PDEndpoints: "http://127.0.0.1:2379,http://127.0.0.1:2380"},
+		}, nil).Times(1)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/api/v2/upstreams", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ListResponse[UpstreamConfig]{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, uint64(1), resp.Items[0].ID)
+	require.Equal(t, []string{"http://127.0.0.1:2379", "http://127.0.0.1:2380"},
+		resp.Items[0].PDAddrs)
+	require.Equal(t, uint64(2), resp.Items[1].ID)
+}
+
+func TestDeleteUpstream(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	provider := &mockStatusProvider{
+		changefeedInfos: map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			model.DefaultChangeFeedID("cf1"): {UpstreamID: 1},
+		},
+	}
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+	router := newRouter(NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(ctrl)))
+
+	remove := func(id string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			http.MethodDelete, "/api/v2/upstreams/"+id, nil)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: invalid upstream ID
+	w := remove("abc")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the upstream is used by a changefeed
+	etcdClient.EXPECT().GetUpstreamInfo(gomock.Any(), uint64(1), model.DefaultNamespace).
+		Return(&model.UpstreamInfo{ID: 1}, nil).Times(1)
+	w = remove("1")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrUpstreamInUse")
+
+	// case 3: success
+	etcdClient.EXPECT().GetUpstreamInfo(gomock.Any(), uint64(2), model.DefaultNamespace).
+		Return(&model.UpstreamInfo{ID: 2}, nil).Times(1)
+	etcdClient.EXPECT().DeleteUpstreamInfo(gomock.Any(), uint64(2), model.DefaultNamespace).
+		Return(nil).Times(1)
+	w = remove("2")
+	require.Equal(t, http.StatusOK, w.Code)
+}
@@ -46,7 +46,12 @@ import (
 	"golang.org/x/time/rate"
 )
 
-const cleanMetaDuration = 10 * time.Second
+const (
+	cleanMetaDuration = 10 * time.Second
+	// drainCheckInterval is the interval to check whether all tables are
+	// moved away from a draining capture.
+	drainCheckInterval = 500 * time.Millisecond
+)
 
 // Capture represents a Capture server, it monitors the changefeed
 // information in etcd and schedules Task on it.
@@ -63,6 +68,8 @@ type Capture interface {
 	Info() (model.CaptureInfo, error)
 	StatusProvider() owner.StatusProvider
 	WriteDebugInfo(ctx context.Context, w io.Writer)
+	// DebugInfo returns the states of the owner and processors in the capture.
+	DebugInfo(ctx context.Context) (*model.CaptureDebugInfo, error)
 
 	GetUpstreamManager() (*upstream.Manager, error)
 	GetEtcdClient() etcd.CDCEtcdClient
@@ -626,6 +633,7 @@ func (c *captureImpl) Close() {
 func (c *captureImpl) Drain() <-chan struct{} {
 	done := make(chan struct{})
 	go func() {
+		defer close(done)
 		// Set liveness stopping first, no matter is the owner or not.
 		// this is triggered by user manually stop the TiCDC instance by sent signals.
 		// It may cost a few seconds before cdc server fully stop, set it to `stopping` to prevent
@@ -636,11 +644,81 @@ func (c *captureImpl) Drain() <-chan struct{} {
 		if o, _ := c.GetOwner(); o != nil {
 			o.AsyncStop()
 		}
-		close(done)
+
+		// The stopping liveness is reported to the owner by the heartbeat,
+		// then the owner moves all tables away from the capture.
+		c.waitTablesMovedAway()
 	}()
 	return done
 }
 
+// waitTablesMovedAway waits until there is no table in the capture,
+// or the drain timeout is reached.
+func (c *captureImpl) waitTablesMovedAway() {
+	timeout := time.Duration(c.config.DrainTimeout)
+	if timeout <= 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for {
+		count, err := c.queryTableCount(ctx)
+		if err != nil {
+			log.Warn("query table count failed, stop waiting for tables moved away",
+				zap.String("captureID", c.info.ID), zap.Error(err))
+			return
+		}
+		if count == 0 {
+			log.Info("all tables are moved away from the capture",
+				zap.String("captureID", c.info.ID))
+			return
+		}
+		select {
+		case <-ctx.Done():
+			log.Warn("drain timeout, some tables are still in the capture",
+				zap.String("captureID", c.info.ID),
+				zap.Int("tableCount", count),
+				zap.Duration("drainTimeout", timeout))
+			return
+		case <-ticker.C:
+			log.Info("waiting for tables moved away from the capture",
+				zap.String("captureID", c.info.ID),
+				zap.Int("tableCount", count))
+		}
+	}
+}
+
+// queryTableCount returns the number of tables in the capture.
+func (c *captureImpl) queryTableCount(ctx context.Context) (int, error) {
+	tableCh := make(chan int, 1)
+	done := make(chan error, 1)
+	c.captureMu.Lock()
+	if c.processorManager == nil {
+		c.captureMu.Unlock()
+		return 0, nil
+	}
+	c.processorManager.QueryTableCount(ctx, tableCh, done)
+	// Release the lock before waiting, the same as WriteDebugInfo.
+	c.captureMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return 0, errors.Trace(ctx.Err())
+	case err := <-done:
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	select {
+	case count := <-tableCh:
+		return count, nil
+	default:
+		return 0, errors.Trace(ctx.Err())
+	}
+}
+
 // Liveness returns liveness of the capture.
 func (c *captureImpl) Liveness() model.Liveness {
 	return c.liveness.Load()
@@ -684,6 +762,60 @@ func (c *captureImpl) WriteDebugInfo(ctx context.Context, w io.Writer) {
 	wait(doneM)
 }
 
+// DebugInfo returns the states of the owner and processors in the capture.
+// The states are collected by the owner and the processor manager in their
+// own goroutines, so they are consistent without stopping the capture.
+func (c *captureImpl) DebugInfo(ctx context.Context) (*model.CaptureDebugInfo, error) {
+	wait := func(done <-chan error) error {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case err := <-done:
+			return errors.Trace(err)
+		}
+	}
+
+	captureInfo, err := c.Info()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ret := &model.CaptureDebugInfo{Capture: &captureInfo}
+
+	if o, _ := c.GetOwner(); o != nil {
+		query := &owner.Query{Tp: owner.QueryDebugInfo}
+		doneOwner := make(chan error, 1)
+		o.Query(query, doneOwner)
+		if err := wait(doneOwner); err != nil {
+			return nil, err
+		}
+		ret.Owner = query.Data.(*model.OwnerDebugInfo)
+	}
+
+	infoCh := make(chan []*model.ProcessorDebugInfo, 1)
+	doneM := make(chan error, 1)
+	c.captureMu.Lock()
+	if c.processorManager != nil {
+		c.processorManager.QueryDebugInfo(ctx, infoCh, doneM)
+	} else {
+		close(doneM)
+	}
+	// Release the lock before waiting, the same as WriteDebugInfo.
+	c.captureMu.Unlock()
+	if err := wait(doneM); err != nil {
+		return nil, err
+	}
+	select {
+	case ret.Processors = <-infoCh:
+	default:
+		// The processor manager is not running.
+	}
+
+	if c.sortEngineFactory != nil {
+		ret.SortEngineDiskUsage = c.sortEngineFactory.DiskUsage()
+	}
+	return ret, nil
+}
+
 // IsOwner returns whether the capture is an owner
 func (c *captureImpl) IsOwner() bool {
 	c.ownerMu.Lock()
@@ -21,6 +21,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/owner"
 	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
 	mock_processor "github.com/pingcap/tiflow/cdc/processor/mock"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -150,6 +151,8 @@ func TestDrainCaptureBySignal(t *testing.T) {
 	}
 	require.Equal(t, model.LivenessCaptureAlive, cp.Liveness())
 
+	mm.EXPECT().QueryTableCount(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(mockQueryTableCount(0)).Times(1)
 	done := cp.Drain()
 	select {
 	case <-done:
@@ -159,6 +162,54 @@ func TestDrainCaptureBySignal(t *testing.T) {
 	}
 }
 
+func mockQueryTableCount(count int) func(context.Context, chan<- int, chan<- error) {
+	return func(_ context.Context, tableCh chan<- int, done chan<- error) {
+		tableCh <- count
+		close(done)
+	}
+}
+
+func TestDrainWaitsTablesMovedAway(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mm := mock_processor.NewMockManager(ctrl)
+	cp := &captureImpl{
+		info: &model.CaptureInfo{
+			ID:            "capture-for-test",
+			AdvertiseAddr: "127.0.0.1", Version: "test",
+		},
+		processorManager: mm,
+		config:           config.GetDefaultServerConfig(),
+	}
+
+	gomock.InOrder(
+		mm.EXPECT().QueryTableCount(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(mockQueryTableCount(2)).Times(1),
+		mm.EXPECT().QueryTableCount(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(mockQueryTableCount(0)).Times(1),
+	)
+	done := cp.Drain()
+	select {
+	case <-done:
+		require.Equal(t, model.LivenessCaptureStopping, cp.Liveness())
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout")
+	}
+
+	// The drain is bounded by the drain timeout.
+	cp.config = config.GetDefaultServerConfig().Clone()
+	cp.config.DrainTimeout = config.TomlDuration(time.Second)
+	mm.EXPECT().QueryTableCount(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(mockQueryTableCount(1)).MinTimes(1)
+	done = cp.Drain()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout")
+	}
+}
+
 func TestDrainWaitsOwnerResign(t *testing.T) {
 	t.Parallel()
 
@@ -179,6 +230,8 @@ func TestDrainWaitsOwnerResign(t *testing.T) {
 	require.Equal(t, model.LivenessCaptureAlive, cp.Liveness())
 
 	mo.EXPECT().AsyncStop().Do(func() {}).AnyTimes()
+	mm.EXPECT().QueryTableCount(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(mockQueryTableCount(0)).Times(1)
 
 	done := cp.Drain()
 	select {
@@ -189,6 +242,54 @@ func TestDrainWaitsOwnerResign(t *testing.T) {
 	}
 }
 
+func TestDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mo := mock_owner.NewMockOwner(ctrl)
+	mm := mock_processor.NewMockManager(ctrl)
+	cp := &captureImpl{
+		info: &model.CaptureInfo{
+			ID:            "capture-for-test",
+			AdvertiseAddr: "127.0.0.1", Version: "test",
+		},
+		processorManager: mm,
+		config:           config.GetDefaultServerConfig(),
+	}
+
+	processors := []*model.ProcessorDebugInfo{{
+		ChangefeedID: model.DefaultChangeFeedID("test"),
+		Initialized:  true,
+	}}
+	mm.EXPECT().QueryDebugInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, infoCh chan<- []*model.ProcessorDebugInfo, done chan<- error) {
+			infoCh <- processors
+			close(done)
+		}).Times(2)
+
+	// The owner info is absent if the capture is not the owner.
+	info, err := cp.DebugInfo(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, "capture-for-test", info.Capture.ID)
+	require.Nil(t, info.Owner)
+	require.Equal(t, processors, info.Processors)
+
+	ownerInfo := &model.OwnerDebugInfo{
+		Captures: []*model.CaptureInfo{cp.info},
+	}
+	mo.EXPECT().Query(gomock.Any(), gomock.Any()).
+		Do(func(query *owner.Query, done chan<- error) {
+			require.Equal(t, owner.QueryDebugInfo, query.Tp)
+			query.Data = ownerInfo
+			close(done)
+		}).Times(1)
+	cp.setOwner(mo)
+	info, err = cp.DebugInfo(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, ownerInfo, info.Owner)
+	require.Equal(t, processors, info.Processors)
+}
+
 type mockElection struct {
 	campaignRequestCh chan struct{}
 	campaignGrantCh   chan struct{}
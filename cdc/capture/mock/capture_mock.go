@@ -51,6 +51,21 @@ func (mr *MockCaptureMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCapture)(nil).Close))
 }
 
+// DebugInfo mocks base method.
+func (m *MockCapture) DebugInfo(ctx context.Context) (*model.CaptureDebugInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebugInfo", ctx)
+	ret0, _ := ret[0].(*model.CaptureDebugInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DebugInfo indicates an expected call of DebugInfo.
+func (mr *MockCaptureMockRecorder) DebugInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugInfo", reflect.TypeOf((*MockCapture)(nil).DebugInfo), ctx)
+}
+
 // Drain mocks base method.
 func (m *MockCapture) Drain() <-chan struct{} {
 	m.ctrl.T.Helper()
@@ -42,7 +42,7 @@ var (
 )
 
 // InitMetrics registers all metrics in this file
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(totalRowsCountGauge)
 	registry.MustRegister(ignoredDMLEventCounter)
 	registry.MustRegister(mounterGroupInputChanSizeGauge)
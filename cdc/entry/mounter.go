@@ -33,7 +33,9 @@ import (
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/rowcodec"
+	tfilter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pfilter "github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/integrity"
@@ -120,6 +122,36 @@ func NewMounter(schemaStorage SchemaStorage,
 	}
 }
 
+// NeedOldValue returns whether the old values of the table need to be read
+// from TiKV. Old values can be skipped only if the old value feature and the
+// integrity check are both disabled, no event filter rule is applied to the
+// table, and the handle of the table is its integer primary key, so that the
+// handle key column of a delete event can be decoded from the row key.
+func NeedOldValue(cfg *config.ReplicaConfig, tableInfo *model.TableInfo) bool {
+	if cfg.EnableOldValue || (cfg.Integrity != nil && cfg.Integrity.Enabled()) {
+		return true
+	}
+	if tableInfo == nil || !tableInfo.PKIsHandle {
+		return true
+	}
+	if cfg.Filter == nil {
+		return false
+	}
+	for _, rule := range cfg.Filter.EventFilters {
+		f, err := tfilter.Parse(rule.Matcher)
+		if err != nil {
+			return true
+		}
+		if !cfg.CaseSensitive {
+			f = tfilter.CaseInsensitive(f)
+		}
+		if f.MatchTable(tableInfo.TableName.Schema, tableInfo.TableName.Table) {
+			return true
+		}
+	}
+	return false
+}
+
 // DecodeEvent decode kv events using ddl puller's schemaStorage
 // this method could block indefinitely if the DDL puller is lagging.
 func (m *mounter) DecodeEvent(ctx context.Context, event *model.PolymorphicEvent) error {
@@ -150,7 +182,8 @@ func (m *mounter) unmarshalAndMountRowChanged(ctx context.Context, raw *model.Ra
 	if err != nil {
 		return nil, err
 	}
-	if len(raw.OldValue) == 0 && len(raw.Value) == 0 {
+	// The old value of a delete event is empty if it's not read, see NeedOldValue.
+	if len(raw.OldValue) == 0 && len(raw.Value) == 0 && raw.OpType != model.OpTypeDelete {
 		log.Warn("empty value and old value",
 			zap.String("namespace", m.changefeedID.Namespace),
 			zap.String("changefeed", m.changefeedID.ID),
@@ -238,7 +271,13 @@ func (m *mounter) unmarshalRowKVEntry(
 		return nil, errors.Trace(err)
 	}
 
-	preRow, preRowExist, err = m.decodeRow(rawOldValue, recordID, tableInfo, true)
+	if len(rawOldValue) == 0 && base.Delete && tableInfo.PKIsHandle {
+		// The old value is not read if it's unnecessary for the table, see
+		// NeedOldValue, so the handle key column is decoded from the key.
+		preRow, preRowExist, err = m.decodeHandle(recordID, tableInfo)
+	} else {
+		preRow, preRowExist, err = m.decodeRow(rawOldValue, recordID, tableInfo, true)
+	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -252,6 +291,19 @@ func (m *mounter) unmarshalRowKVEntry(
 	}, nil
 }
 
+// decodeHandle decodes the handle columns of a row from its record ID.
+func (m *mounter) decodeHandle(
+	recordID kv.Handle, tableInfo *model.TableInfo,
+) (map[int64]types.Datum, bool, error) {
+	handleColIDs, handleColFt, _ := tableInfo.GetRowColInfos()
+	datums, err := tablecodec.DecodeHandleToDatumMap(
+		recordID, handleColIDs, handleColFt, m.tz, make(map[int64]types.Datum))
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	return datums, true, nil
+}
+
 func (m *mounter) decodeRow(
 	rawValue []byte, recordID kv.Handle, tableInfo *model.TableInfo, isPreColumns bool,
 ) (map[int64]types.Datum, bool, error) {
@@ -1189,6 +1189,90 @@ func TestDecodeEventIgnoreRow(t *testing.T) {
 	}
 }
 
+func TestNeedOldValue(t *testing.T) {
+	helper := NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	newTableInfo := func(ddl string) *model.TableInfo {
+		job := helper.DDL2Job(ddl)
+		return model.WrapTableInfo(job.SchemaID, "test", job.BinlogInfo.FinishedTS,
+			job.BinlogInfo.TableInfo)
+	}
+	pkIsHandle := newTableInfo("create table test.t1(id int primary key, v int)")
+	noPK := newTableInfo("create table test.t2(id int, v int, unique key(id))")
+	clustered := newTableInfo("create table test.t3(id varchar(10) primary key clustered, v int)")
+
+	cfg := config.GetDefaultReplicaConfig()
+	require.True(t, NeedOldValue(cfg, pkIsHandle))
+
+	cfg.EnableOldValue = false
+	require.False(t, NeedOldValue(cfg, pkIsHandle))
+	require.True(t, NeedOldValue(cfg, noPK))
+	require.True(t, NeedOldValue(cfg, clustered))
+	require.True(t, NeedOldValue(cfg, nil))
+
+	cfg.Filter.EventFilters = []*config.EventFilterRule{{
+		Matcher:               []string{"test.T1"},
+		IgnoreDeleteValueExpr: "v > 0",
+	}}
+	require.False(t, NeedOldValue(cfg, pkIsHandle))
+	cfg.CaseSensitive = false
+	require.True(t, NeedOldValue(cfg, pkIsHandle))
+
+	cfg.Filter.EventFilters = nil
+	cfg.Integrity.IntegrityCheckLevel = integrity.CheckLevelCorrectness
+	require.True(t, NeedOldValue(cfg, pkIsHandle))
+}
+
+func TestDecodeDeleteWithoutOldValue(t *testing.T) {
+	helper := NewSchemaTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test;")
+
+	changefeed := model.DefaultChangeFeedID("changefeed-test-decode-delete")
+	ver, err := helper.Storage().CurrentVersion(oracle.GlobalTxnScope)
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.EnableOldValue = false
+	f, err := filter.NewFilter(cfg, "")
+	require.NoError(t, err)
+	schemaStorage, err := NewSchemaStorage(helper.GetCurrentMeta(),
+		ver.Ver, false, changefeed, util.RoleTester, f)
+	require.NoError(t, err)
+	job := helper.DDL2Job("create table test.t(id int primary key, v int)")
+	require.NoError(t, schemaStorage.HandleDDLJob(job))
+	ts := schemaStorage.GetLastSnapshot().CurrentTs()
+	schemaStorage.AdvanceResolvedTs(ver.Ver)
+
+	tableInfo, ok := schemaStorage.GetLastSnapshot().TableByName("test", "t")
+	require.True(t, ok)
+	require.False(t, NeedOldValue(cfg, tableInfo))
+
+	mounter := NewMounter(schemaStorage, changefeed, time.Local, f,
+		cfg.EnableOldValue, cfg.Integrity).(*mounter)
+	helper.Tk().MustExec("insert into t values(1, 10)")
+	rows := 0
+	walkTableSpanInStore(t, helper.Storage(), tableInfo.ID, func(key []byte, value []byte) {
+		row, err := mounter.unmarshalAndMountRowChanged(context.Background(), &model.RawKVEntry{
+			OpType:  model.OpTypeDelete,
+			Key:     key,
+			StartTs: ts - 1,
+			CRTs:    ts + 1,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, row)
+		require.True(t, row.IsDelete())
+		require.Len(t, row.PreColumns, 2)
+		require.Equal(t, "id", row.PreColumns[0].Name)
+		require.Equal(t, int64(1), row.PreColumns[0].Value)
+		require.True(t, row.PreColumns[0].Flag.IsHandleKey())
+		require.Nil(t, row.PreColumns[1])
+		rows++
+	})
+	require.Equal(t, 1, rows)
+}
+
 func TestBuildTableInfo(t *testing.T) {
 	cases := []struct {
 		origin              string
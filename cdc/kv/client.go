@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -148,6 +149,12 @@ type CDCKVClient interface {
 	ResolvedTs() model.Ts
 	// CommitTs returns the current ingress commit ts.
 	CommitTs() model.Ts
+	// UnavailableRegions returns the regions that are unavailable and block
+	// the span, sorted by region ID.
+	UnavailableRegions() []tablepb.RegionUnavailable
+	// CyclicFilteredCount returns the number of rows that are written by
+	// another TiCDC and filtered in BDR mode.
+	CyclicFilteredCount() uint64
 }
 
 // NewCDCKVClient is the constructor of CDC KV client
@@ -173,11 +180,21 @@ type CDCClient struct {
 		sync.Mutex
 		counts *list.List
 	}
+	// unavailableRegions records since when the failed regions are
+	// unavailable, a region is available again after it's initialized.
+	unavailableRegions struct {
+		sync.Mutex
+		since map[uint64]time.Time
+	}
 	ingressCommitTs   model.Ts
 	ingressResolvedTs model.Ts
+	// cyclicFilteredCount is the number of rows dropped by filterLoop.
+	cyclicFilteredCount uint64
 	// filterLoop is used in BDR mode, when it is true, tikv cdc component
 	// will filter data that are written by another TiCDC.
 	filterLoop bool
+	// readOldValue indicates whether to read old values of the changed rows.
+	readOldValue bool
 }
 
 // NewCDCClient creates a CDCClient instance
@@ -192,6 +209,7 @@ func NewCDCClient(
 	tableID model.TableID,
 	tableName string,
 	filterLoop bool,
+	readOldValue bool,
 ) (c CDCKVClient) {
 	clusterID := pd.GetClusterID(ctx)
 
@@ -212,7 +230,14 @@ func NewCDCClient(
 		}{
 			counts: list.New(),
 		},
-		filterLoop: filterLoop,
+		unavailableRegions: struct {
+			sync.Mutex
+			since map[uint64]time.Time
+		}{
+			since: make(map[uint64]time.Time),
+		},
+		filterLoop:   filterLoop,
+		readOldValue: readOldValue,
 	}
 	return
 }
@@ -295,6 +320,50 @@ func (c *CDCClient) CommitTs() model.Ts {
 	return atomic.LoadUint64(&c.ingressCommitTs)
 }
 
+// CyclicFilteredCount returns the number of rows filtered by filterLoop.
+func (c *CDCClient) CyclicFilteredCount() uint64 {
+	return atomic.LoadUint64(&c.cyclicFilteredCount)
+}
+
+// UnavailableRegions returns the regions that are unavailable and block
+// the span, sorted by region ID.
+func (c *CDCClient) UnavailableRegions() []tablepb.RegionUnavailable {
+	c.unavailableRegions.Lock()
+	defer c.unavailableRegions.Unlock()
+
+	if len(c.unavailableRegions.since) == 0 {
+		return nil
+	}
+	regions := make([]tablepb.RegionUnavailable, 0, len(c.unavailableRegions.since))
+	for regionID, since := range c.unavailableRegions.since {
+		regions = append(regions, tablepb.RegionUnavailable{
+			RegionID: regionID,
+			SinceMs:  since.UnixMilli(),
+		})
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].RegionID < regions[j].RegionID
+	})
+	return regions
+}
+
+// onRegionUnavailable marks the region as unavailable, it keeps the time
+// of the first failure if the region fails repeatedly.
+func (c *CDCClient) onRegionUnavailable(regionID uint64) {
+	c.unavailableRegions.Lock()
+	defer c.unavailableRegions.Unlock()
+	if _, ok := c.unavailableRegions.since[regionID]; !ok {
+		c.unavailableRegions.since[regionID] = time.Now()
+	}
+}
+
+// onRegionAvailable marks the region as available.
+func (c *CDCClient) onRegionAvailable(regionID uint64) {
+	c.unavailableRegions.Lock()
+	defer c.unavailableRegions.Unlock()
+	delete(c.unavailableRegions.since, regionID)
+}
+
 var currentID uint64 = 0
 
 func allocID() uint64 {
@@ -326,6 +395,8 @@ type eventFeedSession struct {
 	requestRangeCh *chann.DrainableChann[rangeRequestTask]
 
 	rangeLock *regionlock.RegionRangeLock
+	// scanLimiter limits the incremental scans of the session in each store.
+	scanLimiter *regionScanLimiter
 
 	// To identify metrics of different eventFeedSession
 	id                string
@@ -396,10 +467,12 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64, regionCount
 	s.regionCh = chann.NewAutoDrainChann[singleRegionInfo]()
 	s.regionRouter = chann.NewAutoDrainChann[singleRegionInfo]()
 	s.errCh = chann.NewAutoDrainChann[regionErrorInfo]()
+	s.scanLimiter = newRegionScanLimiter(s.client.config.RegionScanLimit, s.changefeed)
 
 	eventFeedGauge.Inc()
 	defer func() {
 		eventFeedGauge.Dec()
+		s.scanLimiter.close()
 		s.regionRouter.CloseAndDrain()
 		s.regionCh.CloseAndDrain()
 		s.errCh.CloseAndDrain()
@@ -445,7 +518,7 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64, regionCount
 				return ctx.Err()
 			case errInfo := <-s.errCh.Out():
 				s.errChSizeGauge.Dec()
-				if err := s.handleError(ctx, errInfo); err != nil {
+				if err := s.handleError(ctx, g, errInfo); err != nil {
 					return err
 				}
 				continue
@@ -550,8 +623,8 @@ func (s *eventFeedSession) onRegionFail(ctx context.Context, errorInfo regionErr
 	s.enqueueError(ctx, errorInfo)
 }
 
-// requestRegionToStore gets singleRegionInfo from regionRouter, which is a token
-// based limiter, sends request to TiKV.
+// requestRegionToStore gets singleRegionInfo from regionRouter, sends request to
+// TiKV once the region gets a scan token of its store from the scanLimiter.
 // If the send request to TiKV returns error, fail the region with sendRequestToStoreErr
 // and kv client will redispatch the region.
 // If initialize gPRC stream with an error, fail the region with connectToStoreErr
@@ -570,16 +643,55 @@ func (s *eventFeedSession) requestRegionToStore(
 		ClusterId:    s.client.clusterID,
 		TicdcVersion: version.ReleaseSemver(),
 	}
-	// Always read old value.
-	extraOp := kvrpcpb.ExtraOp_ReadOldValue
+	extraOp := kvrpcpb.ExtraOp_Noop
+	if s.client.readOldValue {
+		extraOp = kvrpcpb.ExtraOp_ReadOldValue
+	}
+
+	// Regions waiting for scan tokens of their stores, in the order of arrival.
+	waitingRegions := make(map[string][]singleRegionInfo)
+	// Regions which have got scan tokens and are going to be requested.
+	var readyRegions []singleRegionInfo
+	defer func() {
+		for storeAddr, regions := range waitingRegions {
+			cachedRegionSize.WithLabelValues(storeAddr, s.changefeed.Namespace, s.changefeed.ID).
+				Sub(float64(len(regions)))
+		}
+	}()
 
 	var sri singleRegionInfo
 	for {
-		select {
-		case <-ctx.Done():
-			return errors.Trace(ctx.Err())
-		case sri = <-s.regionRouter.Out():
+		if len(readyRegions) == 0 {
+			select {
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			case sri = <-s.regionRouter.Out():
+				storeAddr := sri.rpcCtx.Addr
+				if len(waitingRegions[storeAddr]) == 0 && s.scanLimiter.tryAcquire(storeAddr) {
+					readyRegions = append(readyRegions, sri)
+				} else {
+					waitingRegions[storeAddr] = append(waitingRegions[storeAddr], sri)
+					cachedRegionSize.WithLabelValues(storeAddr, s.changefeed.Namespace, s.changefeed.ID).Inc()
+				}
+			case <-s.scanLimiter.released:
+				for storeAddr, regions := range waitingRegions {
+					n := 0
+					for n < len(regions) && s.scanLimiter.tryAcquire(storeAddr) {
+						n++
+					}
+					readyRegions = append(readyRegions, regions[:n]...)
+					cachedRegionSize.WithLabelValues(storeAddr, s.changefeed.Namespace, s.changefeed.ID).
+						Sub(float64(n))
+					if n == len(regions) {
+						delete(waitingRegions, storeAddr)
+					} else {
+						waitingRegions[storeAddr] = regions[n:]
+					}
+				}
+			}
+			continue
 		}
+		sri, readyRegions = readyRegions[0], readyRegions[1:]
 		requestID := allocID()
 
 		rpcCtx := sri.rpcCtx
@@ -635,6 +747,7 @@ func (s *eventFeedSession) requestRegionToStore(
 				}
 				bo := tikv.NewBackoffer(ctx, tikvRequestMaxBackoff)
 				s.client.regionCache.OnSendFail(bo, rpcCtx, regionScheduleReload, err)
+				s.scanLimiter.release(storeAddr)
 				errInfo := newRegionErrorInfo(sri, &connectToStoreErr{})
 				s.onRegionFail(ctx, errInfo)
 				continue
@@ -667,6 +780,7 @@ func (s *eventFeedSession) requestRegionToStore(
 		}
 
 		state := newRegionFeedState(sri, requestID)
+		state.scanDone = func() { s.scanLimiter.release(storeAddr) }
 		pendingRegions.setByRequestID(requestID, state)
 
 		log.Debug("start new request",
@@ -719,6 +833,7 @@ func (s *eventFeedSession) requestRegionToStore(
 			if !ok {
 				continue
 			}
+			state.markScanDone()
 
 			errInfo := newRegionErrorInfo(sri, &sendRequestToStoreErr{})
 			s.onRegionFail(ctx, errInfo)
@@ -874,7 +989,9 @@ func (s *eventFeedSession) enqueueError(ctx context.Context, errorInfo regionErr
 // info will be sent to `regionCh`. Note if region channel is full, this function will be blocked.
 // CAUTION: Note that this should only be invoked in a context that the region is not locked, otherwise use onRegionFail
 // instead.
-func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorInfo) error {
+func (s *eventFeedSession) handleError(
+	ctx context.Context, g *errgroup.Group, errInfo regionErrorInfo,
+) error {
 	err := errInfo.err
 	switch eerr := errors.Cause(err).(type) {
 	case *eventError:
@@ -885,10 +1002,13 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 		} else if innerErr.GetEpochNotMatch() != nil {
 			// TODO: If only confver is updated, we don't need to reload the region from region cache.
 			metricFeedEpochNotMatchCounter.Inc()
+			// The region is split or merged, it won't be requested again.
+			s.client.onRegionAvailable(errInfo.verID.GetID())
 			s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs)
 			return nil
 		} else if innerErr.GetRegionNotFound() != nil {
 			metricFeedRegionNotFoundCounter.Inc()
+			s.client.onRegionAvailable(errInfo.verID.GetID())
 			s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs)
 			return nil
 		} else if duplicatedRequest := innerErr.GetDuplicateRequest(); duplicatedRequest != nil {
@@ -916,16 +1036,25 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 				zap.String("namespace", s.changefeed.Namespace),
 				zap.String("changefeed", s.changefeed.ID),
 				zap.Stringer("error", innerErr))
+			// Errors like server is busy are unknown to the kv client, TiKV may
+			// be too busy to serve the region, so back off before reconnecting.
+			s.client.onRegionUnavailable(errInfo.verID.GetID())
+			s.scheduleRegionRequestWithBackoff(ctx, g, errInfo.singleRegionInfo)
+			return nil
 		}
 	case *rpcCtxUnavailableErr:
 		metricFeedRPCCtxUnavailable.Inc()
+		s.client.onRegionUnavailable(errInfo.verID.GetID())
 		s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs)
 		return nil
 	case *connectToStoreErr:
 		metricConnectToStoreErr.Inc()
+		s.client.onRegionUnavailable(errInfo.verID.GetID())
 	case *sendRequestToStoreErr:
 		metricStoreSendRequestErr.Inc()
+		s.client.onRegionUnavailable(errInfo.verID.GetID())
 	default:
+		s.client.onRegionUnavailable(errInfo.verID.GetID())
 		//[TODO] Move all OnSendFail logic here
 		// We expect some unknown error to trigger RegionCache recheck its store state and change leader to peer to
 		// make some detection(peer may tell us where new leader is)
@@ -939,6 +1068,56 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 	return nil
 }
 
+// scheduleRegionRequestWithBackoff schedules the region request after a
+// backoff, which grows exponentially with jitter if the region is backed off
+// repeatedly.
+func (s *eventFeedSession) scheduleRegionRequestWithBackoff(
+	ctx context.Context, g *errgroup.Group, sri singleRegionInfo,
+) {
+	delay := regionBackoffDelay(
+		time.Duration(s.client.config.RegionBackoffBaseDelay),
+		time.Duration(s.client.config.RegionBackoffMaxDelay),
+		sri.backoffTimes)
+	sri.backoffTimes++
+	if delay <= 0 {
+		s.scheduleRegionRequest(ctx, sri)
+		return
+	}
+	log.Debug("back off before reconnecting region",
+		zap.String("namespace", s.changefeed.Namespace),
+		zap.String("changefeed", s.changefeed.ID),
+		zap.Uint64("regionID", sri.verID.GetID()),
+		zap.Int("backoffTimes", sri.backoffTimes),
+		zap.Duration("delay", delay))
+	g.Go(func() error {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+		s.scheduleRegionRequest(ctx, sri)
+		return nil
+	})
+}
+
+// regionBackoffDelay returns a random delay in [d/2, d], where d is
+// min(maxDelay, baseDelay * 2^times).
+func regionBackoffDelay(baseDelay, maxDelay time.Duration, times int) time.Duration {
+	if baseDelay <= 0 {
+		return 0
+	}
+	delay := baseDelay
+	for i := 0; i < times && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
 func (s *eventFeedSession) getRPCContextForRegion(ctx context.Context, id tikv.RegionVerID) (*tikv.RPCContext, error) {
 	// todo: add metrics to track rpc cost
 	bo := tikv.NewBackoffer(ctx, tikvRequestMaxBackoff)
@@ -978,6 +1157,7 @@ func (s *eventFeedSession) receiveFromStream(
 
 		remainingRegions := pendingRegions.takeAll()
 		for _, state := range remainingRegions {
+			state.markScanDone()
 			errInfo := newRegionErrorInfo(state.sri, cerror.ErrPendingRegionCancel.FastGenByArgs())
 			s.onRegionFail(ctx, errInfo)
 		}
@@ -985,6 +1165,8 @@ func (s *eventFeedSession) receiveFromStream(
 
 	metricSendEventBatchResolvedSize := batchResolvedEventSize.
 		WithLabelValues(s.changefeed.Namespace, s.changefeed.ID)
+	metricStreamEventCount := streamEventCounter.
+		WithLabelValues(addr, s.changefeed.Namespace, s.changefeed.ID)
 
 	// always create a new region worker, because `receiveFromStream` is ensured
 	// to call exactly once from outer code logic
@@ -1072,6 +1254,7 @@ func (s *eventFeedSession) receiveFromStream(
 		}
 
 		if len(cevent.Events) != 0 {
+			metricStreamEventCount.Add(float64(len(cevent.Events)))
 			if entries, ok := cevent.Events[0].Event.(*cdcpb.Event_Entries_); ok {
 				commitTs := entries.Entries.Entries[0].CommitTs
 				if maxCommitTs < commitTs {
@@ -86,6 +86,20 @@ var (
 			Name:      "cached_region",
 			Help:      "cached region that has not requested to TiKV in kv client",
 		}, []string{"store", "namespace", "changefeed"})
+	streamEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "kvclient",
+			Name:      "stream_event_count",
+			Help:      "The number of events received from gRPC streams of each store",
+		}, []string{"store", "namespace", "changefeed"})
+	streamReconnectCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "kvclient",
+			Name:      "stream_reconnect_count",
+			Help:      "The number of gRPC streams reconnected because resolved ts is stuck",
+		}, []string{"store", "namespace", "changefeed"})
 	batchResolvedEventSize = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "ticdc",
@@ -113,7 +127,7 @@ var (
 )
 
 // InitMetrics registers all metrics in the kv package
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(eventFeedErrorCounter)
 	registry.MustRegister(scanRegionsDuration)
 	registry.MustRegister(eventSize)
@@ -123,6 +137,8 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(clientChannelSize)
 	registry.MustRegister(clientRegionTokenSize)
 	registry.MustRegister(cachedRegionSize)
+	registry.MustRegister(streamEventCounter)
+	registry.MustRegister(streamReconnectCounter)
 	registry.MustRegister(batchResolvedEventSize)
 	registry.MustRegister(grpcPoolStreamGauge)
 	registry.MustRegister(regionEventsBatchSize)
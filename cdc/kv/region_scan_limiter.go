@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"sync"
+
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// regionScanLimiter limits the number of regions doing incremental scan in
+// each store for an event feed session. A region takes a token of its store
+// before its request is sent, and returns the token after it's initialized
+// or stopped.
+type regionScanLimiter struct {
+	// limit is the max tokens of each store, 0 means unlimited.
+	limit      int
+	changefeed model.ChangeFeedID
+
+	mu     sync.Mutex
+	tokens map[string]int
+	closed bool
+	// released is notified when a token is released.
+	released chan struct{}
+}
+
+func newRegionScanLimiter(limit int, changefeed model.ChangeFeedID) *regionScanLimiter {
+	return &regionScanLimiter{
+		limit:      limit,
+		changefeed: changefeed,
+		tokens:     make(map[string]int),
+		released:   make(chan struct{}, 1),
+	}
+}
+
+// tryAcquire takes a token of the store, it returns false if all tokens of
+// the store are taken.
+func (l *regionScanLimiter) tryAcquire(storeAddr string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit > 0 && l.tokens[storeAddr] >= l.limit {
+		return false
+	}
+	l.tokens[storeAddr]++
+	clientRegionTokenSize.
+		WithLabelValues(storeAddr, l.changefeed.Namespace, l.changefeed.ID).Inc()
+	return true
+}
+
+// release returns a token of the store and notifies the waiters.
+func (l *regionScanLimiter) release(storeAddr string) {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.tokens[storeAddr]--
+	if l.tokens[storeAddr] <= 0 {
+		delete(l.tokens, storeAddr)
+	}
+	clientRegionTokenSize.
+		WithLabelValues(storeAddr, l.changefeed.Namespace, l.changefeed.ID).Dec()
+	l.mu.Unlock()
+
+	select {
+	case l.released <- struct{}{}:
+	default:
+	}
+}
+
+// close returns all tokens taken, it's called when the session exits.
+func (l *regionScanLimiter) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for storeAddr, n := range l.tokens {
+		clientRegionTokenSize.
+			WithLabelValues(storeAddr, l.changefeed.Namespace, l.changefeed.ID).Sub(float64(n))
+	}
+	l.tokens = make(map[string]int)
+	l.closed = true
+}
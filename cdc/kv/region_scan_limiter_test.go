@@ -0,0 +1,71 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionScanLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := newRegionScanLimiter(2, model.DefaultChangeFeedID("test"))
+	require.True(t, l.tryAcquire("store1"))
+	require.True(t, l.tryAcquire("store1"))
+	require.False(t, l.tryAcquire("store1"))
+	// Tokens of different stores are independent.
+	require.True(t, l.tryAcquire("store2"))
+
+	l.release("store1")
+	select {
+	case <-l.released:
+	case <-time.After(time.Second):
+		require.FailNow(t, "release is not notified")
+	}
+	require.True(t, l.tryAcquire("store1"))
+	require.False(t, l.tryAcquire("store1"))
+
+	// Releasing after the limiter is closed is a no-op.
+	l.close()
+	l.release("store1")
+	require.Empty(t, l.tokens)
+
+	// 0 means unlimited.
+	l = newRegionScanLimiter(0, model.DefaultChangeFeedID("test"))
+	for i := 0; i < 100; i++ {
+		require.True(t, l.tryAcquire("store1"))
+	}
+}
+
+func TestRegionFeedStateScanDone(t *testing.T) {
+	t.Parallel()
+
+	released := 0
+	state := newRegionFeedState(singleRegionInfo{}, 1)
+	state.scanDone = func() { released++ }
+	state.setInitialized()
+	state.markStopped()
+	state.markScanDone()
+	require.Equal(t, 1, released)
+
+	// The backoff times is reset once the region is initialized.
+	state = newRegionFeedState(singleRegionInfo{backoffTimes: 3}, 2)
+	require.Equal(t, 3, state.getRegionInfo().backoffTimes)
+	state.setInitialized()
+	require.Equal(t, 0, state.getRegionInfo().backoffTimes)
+}
@@ -33,6 +33,9 @@ type singleRegionInfo struct {
 	span       tablepb.Span
 	resolvedTs uint64
 	rpcCtx     *tikv.RPCContext
+	// backoffTimes is how many times the region has been backed off in a row
+	// before reconnecting, it's reset once the region is initialized.
+	backoffTimes int
 }
 
 func newSingleRegionInfo(
@@ -58,6 +61,12 @@ type regionFeedState struct {
 	matcher        *matcher
 	startFeedTime  time.Time
 	lastResolvedTs uint64
+
+	// scanDone is called once when the incremental scan of the region is
+	// finished or the region is stopped, it releases the scan token of the
+	// region.
+	scanDone     func()
+	scanDoneOnce sync.Once
 }
 
 func newRegionFeedState(sri singleRegionInfo, requestID uint64) *regionFeedState {
@@ -76,6 +85,13 @@ func (s *regionFeedState) start() {
 
 func (s *regionFeedState) markStopped() {
 	atomic.StoreInt32(&s.stopped, 1)
+	s.markScanDone()
+}
+
+func (s *regionFeedState) markScanDone() {
+	if s.scanDone != nil {
+		s.scanDoneOnce.Do(s.scanDone)
+	}
 }
 
 func (s *regionFeedState) isStopped() bool {
@@ -88,6 +104,7 @@ func (s *regionFeedState) isInitialized() bool {
 
 func (s *regionFeedState) setInitialized() {
 	s.initialized.Store(true)
+	s.markScanDone()
 }
 
 func (s *regionFeedState) getRegionID() uint64 {
@@ -115,7 +132,11 @@ func (s *regionFeedState) setRegionInfoResolvedTs() {
 }
 
 func (s *regionFeedState) getRegionInfo() singleRegionInfo {
-	return s.sri
+	sri := s.sri
+	if s.isInitialized() {
+		sri.backoffTimes = 0
+	}
+	return sri
 }
 
 func (s *regionFeedState) getRegionMeta() (uint64, tablepb.Span, time.Time, string) {
@@ -58,6 +58,9 @@ var (
 const (
 	maxWorkerPoolSize      = 64
 	maxResolvedLockPerLoop = 64
+	// cdcWriteSourceMask is the mask of the txn source bits that are set
+	// by TiCDC when it writes to the downstream TiDB.
+	cdcWriteSourceMask = 1<<4 - 1
 )
 
 type regionWorkerMetrics struct {
@@ -549,6 +552,8 @@ func (w *regionWorker) collectWorkpoolError(ctx context.Context) error {
 
 func (w *regionWorker) checkErrorReconnect(err error) error {
 	if errors.Cause(err) == errReconnect {
+		streamReconnectCounter.WithLabelValues(
+			w.storeAddr, w.session.changefeed.Namespace, w.session.changefeed.ID).Inc()
 		w.cancelStream(time.Second)
 		// if stream is already deleted, just ignore errReconnect
 		return nil
@@ -612,6 +617,17 @@ func (w *regionWorker) run(parentCtx context.Context) error {
 	return retErr
 }
 
+// filterLoopRow returns true if the row is written by another TiCDC and
+// should be dropped in BDR mode. TiKV filters such rows if it supports
+// FilterLoop, rows from the ones that don't are dropped here.
+func (w *regionWorker) filterLoopRow(row *cdcpb.Event_Row) bool {
+	if !w.session.client.filterLoop || row.TxnSource&cdcWriteSourceMask == 0 {
+		return false
+	}
+	atomic.AddUint64(&w.session.client.cyclicFilteredCount, 1)
+	return true
+}
+
 func (w *regionWorker) handleEventEntry(
 	ctx context.Context,
 	x *cdcpb.Event_Entries_,
@@ -641,9 +657,13 @@ func (w *regionWorker) handleEventEntry(
 			w.metrics.metricPullEventInitializedCounter.Inc()
 
 			state.setInitialized()
+			w.session.client.onRegionAvailable(regionID)
 			// state is just initialized, so we know this must be true
 			cachedEvents := state.matcher.matchCachedRow(true)
 			for _, cachedEvent := range cachedEvents {
+				if w.filterLoopRow(cachedEvent) {
+					continue
+				}
 				revent, err := assembleRowEvent(regionID, cachedEvent)
 				if err != nil {
 					return errors.Trace(err)
@@ -658,6 +678,9 @@ func (w *regionWorker) handleEventEntry(
 			state.matcher.matchCachedRollbackRow(true)
 		case cdcpb.Event_COMMITTED:
 			w.metrics.metricPullEventCommittedCounter.Inc()
+			if w.filterLoopRow(entry) {
+				continue
+			}
 			revent, err := assembleRowEvent(regionID, entry)
 			if err != nil {
 				return errors.Trace(err)
@@ -703,6 +726,9 @@ func (w *regionWorker) handleEventEntry(
 					entry.GetStartTs(), entry.GetCommitTs(),
 					entry.GetType(), entry.GetOpType())
 			}
+			if w.filterLoopRow(entry) {
+				continue
+			}
 
 			revent, err := assembleRowEvent(regionID, entry)
 			if err != nil {
@@ -300,3 +300,68 @@ func TestRegionWorkerHandleResolvedTs(t *testing.T) {
 	require.Equal(t, 1, len(event.Resolved.Spans))
 	require.Equal(t, uint64(1), event.Resolved.Spans[0].Region)
 }
+
+func TestRegionWorkerHandleEventEntryFilterLoop(t *testing.T) {
+	ctx := context.Background()
+	eventCh := make(chan model.RegionFeedEvent, 2)
+	s := createFakeEventFeedSession()
+	s.eventCh = eventCh
+	s.client.filterLoop = true
+	state := newRegionFeedState(newSingleRegionInfo(
+		tikv.RegionVerID{},
+		spanz.ToSpan([]byte{}, spanz.UpperBoundKey),
+		0, &tikv.RPCContext{}), 0)
+	state.start()
+	state.setInitialized()
+	worker := newRegionWorker(model.ChangeFeedID{}, s, "")
+
+	// Rows written by another TiCDC are filtered, the others are output.
+	events := &cdcpb.Event_Entries_{
+		Entries: &cdcpb.Event_Entries{
+			Entries: []*cdcpb.Event_Row{{
+				StartTs:   1,
+				CommitTs:  2,
+				Type:      cdcpb.Event_COMMITTED,
+				OpType:    cdcpb.Event_Row_PUT,
+				Key:       []byte("key1"),
+				TxnSource: 1,
+			}, {
+				StartTs:  3,
+				CommitTs: 4,
+				Type:     cdcpb.Event_COMMITTED,
+				OpType:   cdcpb.Event_Row_PUT,
+				Key:      []byte("key2"),
+			}, {
+				StartTs:   5,
+				Type:      cdcpb.Event_PREWRITE,
+				OpType:    cdcpb.Event_Row_PUT,
+				Key:       []byte("key3"),
+				Value:     []byte("value"),
+				TxnSource: 1,
+			}, {
+				StartTs:   5,
+				CommitTs:  6,
+				Type:      cdcpb.Event_COMMIT,
+				OpType:    cdcpb.Event_Row_PUT,
+				Key:       []byte("key3"),
+				TxnSource: 1,
+			}},
+		},
+	}
+	err := worker.handleEventEntry(ctx, events, state)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), s.client.CyclicFilteredCount())
+
+	event := <-eventCh
+	require.Equal(t, []byte("key2"), event.Val.Key)
+	require.Len(t, eventCh, 0)
+
+	// Nothing is filtered if filterLoop is disabled.
+	s.client.filterLoop = false
+	events.Entries.Entries = events.Entries.Entries[:1]
+	events.Entries.Entries[0].CommitTs = 7
+	err = worker.handleEventEntry(ctx, events, state)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), s.client.CyclicFilteredCount())
+	require.Len(t, eventCh, 1)
+}
@@ -144,6 +144,9 @@ type ChangeFeedInfo struct {
 	Config *config.ReplicaConfig `json:"config"`
 	State  FeedState             `json:"state"`
 	Error  *RunningError         `json:"error"`
+	// ErrorHistory is the latest errors of the changefeed in chronological
+	// order, at most ChangefeedErrorHistorySize errors are kept.
+	ErrorHistory []*RunningError `json:"error-history,omitempty"`
 
 	CreatorVersion string `json:"creator-version"`
 	// Epoch is the epoch of a changefeed, changes on every restart.
@@ -152,6 +155,10 @@ type ChangeFeedInfo struct {
 
 const changeFeedIDMaxLen = 128
 
+// ChangefeedErrorHistorySize is the max number of errors kept in the error
+// history of a changefeed.
+const ChangefeedErrorHistorySize = 16
+
 var changeFeedIDRe = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
 
 // ValidateChangefeedID returns true if the changefeed ID matches
@@ -246,6 +253,17 @@ func (info *ChangeFeedInfo) Unmarshal(data []byte) error {
 	return nil
 }
 
+// RecordError sets the error of the changefeed and appends it to the error
+// history, the oldest errors are dropped if the history is full.
+func (info *ChangeFeedInfo) RecordError(err *RunningError) {
+	info.Error = err
+	info.ErrorHistory = append(info.ErrorHistory, err)
+	if n := len(info.ErrorHistory); n > ChangefeedErrorHistorySize {
+		info.ErrorHistory = append([]*RunningError(nil),
+			info.ErrorHistory[n-ChangefeedErrorHistorySize:]...)
+	}
+}
+
 // Clone returns a cloned ChangeFeedInfo
 func (info *ChangeFeedInfo) Clone() (*ChangeFeedInfo, error) {
 	s, err := info.Marshal()
@@ -102,6 +102,23 @@ type ServerStatus struct {
 	Pid       int      `json:"pid"`
 	IsOwner   bool     `json:"is_owner"`
 	Liveness  Liveness `json:"liveness"`
+	// MemoryQuota is nil if the server memory quota is not set.
+	MemoryQuota *MemoryQuotaStatus `json:"memory_quota,omitempty"`
+}
+
+// MemoryQuotaStatus holds the usage of the memory quota shared by all
+// changefeeds on a server
+type MemoryQuotaStatus struct {
+	TotalBytes  uint64                  `json:"total_bytes"`
+	UsedBytes   uint64                  `json:"used_bytes"`
+	Changefeeds []ChangefeedMemoryUsage `json:"changefeeds"`
+}
+
+// ChangefeedMemoryUsage holds the memory used by a changefeed on a server
+type ChangefeedMemoryUsage struct {
+	Namespace string `json:"namespace"`
+	ID        string `json:"id"`
+	UsedBytes uint64 `json:"used_bytes"`
 }
 
 // ChangefeedCommonInfo holds some common usage information of a changefeed
@@ -287,3 +287,91 @@ type ProcInfoSnap struct {
 	CfID      ChangeFeedID `json:"changefeed-id"`
 	CaptureID string       `json:"capture-id"`
 }
+
+// TableReplicationStatus records the replication progress of a table, which
+// is collected by the scheduler from the heartbeats of captures.
+type TableReplicationStatus struct {
+	TableID      TableID `json:"table-id"`
+	CheckpointTs Ts      `json:"checkpoint-ts"`
+	ResolvedTs   Ts      `json:"resolved-ts"`
+	// Captures are the captures which replicate the spans of the table.
+	Captures []CaptureID `json:"captures"`
+	// State is the scheduling state of the table, it is the state of the
+	// first span which is not replicating if there are any.
+	State string `json:"state"`
+	// SpanCount is the number of spans the table is split into.
+	SpanCount int `json:"span-count"`
+}
+
+// ScheduleTaskInfo records a schedule task which is running in the scheduler.
+type ScheduleTaskInfo struct {
+	// Type is the type of the task, e.g. addTable, removeTable and moveTable.
+	Type    string  `json:"type"`
+	TableID TableID `json:"table-id"`
+	Span    string  `json:"span"`
+	// State is the scheduling state of the span.
+	State string `json:"state"`
+	// Captures are the captures which the span is being scheduled among.
+	Captures []CaptureID `json:"captures"`
+}
+
+// ChangefeedDebugInfo records the state of a changefeed in the owner.
+type ChangefeedDebugInfo struct {
+	ID           ChangeFeedID `json:"id"`
+	State        FeedState    `json:"state"`
+	CheckpointTs Ts           `json:"checkpoint-ts"`
+	ResolvedTs   Ts           `json:"resolved-ts"`
+	// SchedulerInitialized is false if the scheduler has not been created,
+	// or some captures have not reported their tables to the scheduler.
+	SchedulerInitialized bool                      `json:"scheduler-initialized"`
+	Tables               []*TableReplicationStatus `json:"tables"`
+	RunningTasks         []*ScheduleTaskInfo       `json:"running-tasks"`
+}
+
+// OwnerDebugInfo records the state of the owner.
+type OwnerDebugInfo struct {
+	Captures    []*CaptureInfo         `json:"captures"`
+	Changefeeds []*ChangefeedDebugInfo `json:"changefeeds"`
+}
+
+// TableSpanDebugInfo records the state of a table span in a processor.
+type TableSpanDebugInfo struct {
+	TableID      TableID `json:"table-id"`
+	Span         string  `json:"span"`
+	State        string  `json:"state"`
+	CheckpointTs Ts      `json:"checkpoint-ts"`
+	ResolvedTs   Ts      `json:"resolved-ts"`
+	BarrierTs    Ts      `json:"barrier-ts"`
+	// The max commit ts and resolved ts of the events received from the
+	// sort engine. The events between the checkpoint ts and them are the
+	// backlog of the table sink.
+	ReceivedMaxCommitTs   Ts `json:"received-max-commit-ts"`
+	ReceivedMaxResolvedTs Ts `json:"received-max-resolved-ts"`
+}
+
+// ProcessorDebugInfo records the state of a processor.
+type ProcessorDebugInfo struct {
+	ChangefeedID ChangeFeedID          `json:"changefeed-id"`
+	Initialized  bool                  `json:"initialized"`
+	Tables       []*TableSpanDebugInfo `json:"tables"`
+	// SinkBacklogEvents is the number of events which are received by the
+	// sort engine but not by the table sinks yet.
+	SinkBacklogEvents int64 `json:"sink-backlog-events"`
+	// SinkMemoryUsage is the memory used by the events which are fetched
+	// from the sort engine but not flushed by the sink yet.
+	SinkMemoryUsage uint64 `json:"sink-memory-usage"`
+	SinkMemoryQuota uint64 `json:"sink-memory-quota"`
+	RedoMemoryUsage uint64 `json:"redo-memory-usage"`
+}
+
+// CaptureDebugInfo records the runtime state of a capture. The states of
+// the owner and processors are collected in their own goroutines, so each
+// of them is consistent.
+type CaptureDebugInfo struct {
+	Capture *CaptureInfo `json:"capture"`
+	// Owner is nil if the capture is not the owner.
+	Owner      *OwnerDebugInfo       `json:"owner,omitempty"`
+	Processors []*ProcessorDebugInfo `json:"processors"`
+	// SortEngineDiskUsage is the on-disk data size of the sort engine in bytes.
+	SortEngineDiskUsage uint64 `json:"sort-engine-disk-usage"`
+}
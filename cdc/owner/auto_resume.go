@@ -0,0 +1,185 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// A failed changefeed is resumed with an exponential backoff from 1m to
+	// 30m, until it has been failed for 12h.
+	defaultAutoResumeInitInterval = time.Minute
+	defaultAutoResumeMaxInterval  = 30 * time.Minute
+	defaultAutoResumeMaxOutage    = 12 * time.Hour
+)
+
+// retryableDownstreamErrorCodes are the errors which are caused by transient
+// downstream outages, a changefeed failed by them can be resumed.
+var retryableDownstreamErrorCodes = []*errors.Error{
+	cerrors.ErrMySQLConnectionError,
+	cerrors.ErrKafkaSendMessage,
+	cerrors.ErrKafkaAsyncSendMessage,
+	cerrors.ErrKafkaNewProducer,
+	cerrors.ErrReachMaxTry,
+}
+
+// retryableDownstreamErrorMessages are the messages of the errors caused by
+// transient downstream outages, which are wrapped by errors of other codes,
+// e.g. a deadlock reported in CDC:ErrMySQLTxnError.
+var retryableDownstreamErrorMessages = []string{
+	"connection refused",
+	"connection reset by peer",
+	"broken pipe",
+	"i/o timeout",
+	"invalid connection",
+	"deadlock found",
+	"lock wait timeout exceeded",
+	"leader not available",
+	"leadership election",
+	"not leader for partition",
+	"notleaderforpartition",
+	"leadernotavailable",
+}
+
+// isRetryableDownstreamError returns true if the error is caused by a
+// transient downstream outage. extraCodes are the RFC codes of the errors
+// which are also regarded as retryable.
+func isRetryableDownstreamError(err *model.RunningError, extraCodes []string) bool {
+	if err == nil {
+		return false
+	}
+	code := errors.RFCErrorCode(err.Code)
+	for _, e := range retryableDownstreamErrorCodes {
+		if code == e.RFCCode() {
+			return true
+		}
+	}
+	for _, extra := range extraCodes {
+		if code == errors.RFCErrorCode(extra) {
+			return true
+		}
+	}
+	msg := strings.ToLower(err.Message)
+	for _, pattern := range retryableDownstreamErrorMessages {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// autoResumer resumes a changefeed automatically after it's failed by
+// retryable downstream errors, see config.AutoResumeConfig.
+type autoResumer struct {
+	backoff *backoff.ExponentialBackOff
+	// outageStart is the time when the changefeed is failed in the current
+	// outage, it's zero if the changefeed is not in an outage.
+	outageStart time.Time
+	// nextResume is the time when the changefeed can be resumed.
+	nextResume time.Time
+	// exhausted is true if the outage lasts longer than the max outage, the
+	// changefeed is not resumed anymore until it's resumed manually.
+	exhausted bool
+}
+
+// autoResumeConfig returns the auto resume policy of the changefeed, the zero
+// fields are filled by the defaults. It returns nil if auto resume is disabled.
+func autoResumeConfig(info *model.ChangeFeedInfo) *config.AutoResumeConfig {
+	if info == nil || info.Config == nil ||
+		info.Config.AutoResume == nil || !info.Config.AutoResume.Enable {
+		return nil
+	}
+	res := *info.Config.AutoResume
+	if res.BackoffInitInterval == 0 {
+		res.BackoffInitInterval = config.TomlDuration(defaultAutoResumeInitInterval)
+	}
+	if res.BackoffMaxInterval == 0 {
+		res.BackoffMaxInterval = config.TomlDuration(defaultAutoResumeMaxInterval)
+	}
+	if res.MaxOutage == 0 {
+		res.MaxOutage = config.TomlDuration(defaultAutoResumeMaxOutage)
+	}
+	return &res
+}
+
+// onFailed is called on every tick when the changefeed is failed, it returns
+// true if the changefeed should be resumed now. canResume tells whether the
+// error failing the changefeed can be resumed from.
+func (r *autoResumer) onFailed(
+	id model.ChangeFeedID, cfg *config.AutoResumeConfig, canResume bool, now time.Time,
+) bool {
+	if cfg == nil || !canResume || r.exhausted {
+		return false
+	}
+	if r.outageStart.IsZero() {
+		r.outageStart = now
+		r.backoff = backoff.NewExponentialBackOff()
+		r.backoff.InitialInterval = time.Duration(cfg.BackoffInitInterval)
+		r.backoff.MaxInterval = time.Duration(cfg.BackoffMaxInterval)
+		r.backoff.Multiplier = defaultBackoffMultiplier
+		r.backoff.RandomizationFactor = defaultBackoffRandomizationFactor
+		// The outage is bounded by the max outage instead of the backoff.
+		r.backoff.MaxElapsedTime = 0
+		r.backoff.Reset()
+		r.nextResume = now.Add(r.backoff.NextBackOff())
+		log.Info("changefeed is failed by a retryable downstream error, "+
+			"it will be resumed automatically",
+			zap.String("namespace", id.Namespace),
+			zap.String("changefeed", id.ID),
+			zap.Time("nextResume", r.nextResume),
+			zap.Duration("maxOutage", time.Duration(cfg.MaxOutage)))
+	}
+	if now.Sub(r.outageStart) >= time.Duration(cfg.MaxOutage) {
+		r.exhausted = true
+		log.Warn("The changefeed won't be resumed automatically "+
+			"as the outage lasts longer than the max outage",
+			zap.String("namespace", id.Namespace),
+			zap.String("changefeed", id.ID),
+			zap.Time("outageStart", r.outageStart),
+			zap.Duration("maxOutage", time.Duration(cfg.MaxOutage)))
+		return false
+	}
+	if now.Before(r.nextResume) {
+		return false
+	}
+	r.nextResume = now.Add(r.backoff.NextBackOff())
+	return true
+}
+
+// deadline returns the time until which the changefeed may be resumed, it's
+// zero if the changefeed is not going to be resumed.
+func (r *autoResumer) deadline(cfg *config.AutoResumeConfig) time.Time {
+	if cfg == nil || r.exhausted || r.outageStart.IsZero() {
+		return time.Time{}
+	}
+	return r.outageStart.Add(time.Duration(cfg.MaxOutage))
+}
+
+// reset ends the current outage.
+func (r *autoResumer) reset() {
+	r.outageStart = time.Time{}
+	r.nextResume = time.Time{}
+	r.exhausted = false
+	r.backoff = nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryableDownstreamError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		err       *model.RunningError
+		extra     []string
+		retryable bool
+	}{
+		{err: nil},
+		{err: &model.RunningError{Code: "CDC:ErrMySQLConnectionError"}, retryable: true},
+		{err: &model.RunningError{Code: "CDC:ErrKafkaAsyncSendMessage"}, retryable: true},
+		{
+			err: &model.RunningError{
+				Code:    "CDC:ErrMySQLTxnError",
+				Message: "Error 1213: Deadlock found when trying to get lock",
+			},
+			retryable: true,
+		},
+		{
+			err: &model.RunningError{
+				Code:    "CDC:ErrProcessorUnknown",
+				Message: "dial tcp 127.0.0.1:3306: connect: connection refused",
+			},
+			retryable: true,
+		},
+		{
+			err: &model.RunningError{
+				Code:    "CDC:ErrProcessorUnknown",
+				Message: "kafka server: In the middle of a leadership election",
+			},
+			retryable: true,
+		},
+		{err: &model.RunningError{Code: "CDC:ErrSnapshotLostByGC"}},
+		{err: &model.RunningError{Code: "CDC:ErrPulsarSendMessage"}},
+		{
+			err:       &model.RunningError{Code: "CDC:ErrPulsarSendMessage"},
+			extra:     []string{"CDC:ErrPulsarSendMessage"},
+			retryable: true,
+		},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.retryable, isRetryableDownstreamError(tc.err, tc.extra), tc.err)
+	}
+}
+
+func TestAutoResumer(t *testing.T) {
+	t.Parallel()
+
+	id := model.DefaultChangeFeedID("test")
+	info := &model.ChangeFeedInfo{Config: &config.ReplicaConfig{
+		AutoResume: &config.AutoResumeConfig{
+			Enable:              true,
+			BackoffInitInterval: config.TomlDuration(time.Minute),
+			MaxOutage:           config.TomlDuration(time.Hour),
+		},
+	}}
+	cfg := autoResumeConfig(info)
+	require.Equal(t, config.TomlDuration(defaultAutoResumeMaxInterval), cfg.BackoffMaxInterval)
+
+	r := &autoResumer{}
+	now := time.Now()
+	// Not resumable errors are never resumed.
+	require.False(t, r.onFailed(id, cfg, false, now))
+	require.True(t, r.deadline(cfg).IsZero())
+
+	require.False(t, r.onFailed(id, cfg, true, now))
+	require.Equal(t, now.Add(time.Hour), r.deadline(cfg))
+	// The backoff grows exponentially.
+	now = now.Add(2 * time.Minute)
+	require.True(t, r.onFailed(id, cfg, true, now))
+	require.False(t, r.onFailed(id, cfg, true, now.Add(time.Minute)))
+	now = now.Add(3 * time.Minute)
+	require.True(t, r.onFailed(id, cfg, true, now))
+
+	// Give up once the outage lasts longer than the max outage.
+	require.False(t, r.onFailed(id, cfg, true, now.Add(time.Hour)))
+	require.True(t, r.exhausted)
+	require.True(t, r.deadline(cfg).IsZero())
+
+	r.reset()
+	require.False(t, r.onFailed(id, cfg, true, now))
+	require.False(t, r.deadline(cfg).IsZero())
+
+	// Auto resume is disabled by default.
+	info.Config.AutoResume.Enable = false
+	require.Nil(t, autoResumeConfig(info))
+}
@@ -115,7 +115,9 @@ type changefeed struct {
 	metricsCurrentPDTsGauge                prometheus.Gauge
 
 	metricsChangefeedBarrierTsGauge prometheus.Gauge
-	metricsChangefeedTickDuration   prometheus.Observer
+
+	metricsChangefeedCheckpointGCSafePointLagGauge prometheus.Gauge
+	metricsChangefeedTickDuration                  prometheus.Observer
 
 	downstreamObserver observer.Observer
 	observerLastTick   *atomic.Time
@@ -684,6 +686,8 @@ func (c *changefeed) initMetrics() {
 		WithLabelValues(c.id.Namespace, c.id.ID)
 	c.metricsChangefeedTickDuration = changefeedTickDuration.
 		WithLabelValues(c.id.Namespace, c.id.ID)
+	c.metricsChangefeedCheckpointGCSafePointLagGauge = changefeedCheckpointGCSafePointLagGauge.
+		WithLabelValues(c.id.Namespace, c.id.ID)
 }
 
 // releaseResources is idempotent.
@@ -761,6 +765,9 @@ func (c *changefeed) cleanupMetrics() {
 
 	changefeedBarrierTsGauge.DeleteLabelValues(c.id.Namespace, c.id.ID)
 	c.metricsChangefeedBarrierTsGauge = nil
+
+	changefeedCheckpointGCSafePointLagGauge.DeleteLabelValues(c.id.Namespace, c.id.ID)
+	c.metricsChangefeedCheckpointGCSafePointLagGauge = nil
 }
 
 // cleanup redo logs if changefeed is removed and redo log is enabled
@@ -929,6 +936,13 @@ func (c *changefeed) updateMetrics(currentTs int64, checkpointTs, resolvedTs mod
 	c.metricsChangefeedResolvedTsLagDuration.Observe(resolvedLag)
 
 	c.metricsCurrentPDTsGauge.Set(float64(currentTs))
+
+	// The safepoint is 0 until it is written or loaded by the gc manager.
+	if safePoint := c.upstream.GCManager.LastSafePointTs(); safePoint != 0 {
+		phySafePoint := oracle.ExtractPhysical(safePoint)
+		c.metricsChangefeedCheckpointGCSafePointLagGauge.
+			Set(float64(phyCkpTs-phySafePoint) / 1e3)
+	}
 }
 
 func (c *changefeed) updateStatus(checkpointTs, resolvedTs, minTableBarrierTs model.Ts) {
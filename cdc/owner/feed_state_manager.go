@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/upstream"
@@ -62,6 +63,15 @@ type feedStateManager struct {
 	lastErrorTime   time.Time                   // time of last error for a changefeed
 	backoffInterval time.Duration               // the interval for restarting a changefeed in 'error' state
 	errBackoff      *backoff.ExponentialBackOff // an exponential backoff for restarting a changefeed
+	// defaultRetry is the retry policy used for the zero fields of the retry
+	// config of the changefeed.
+	defaultRetry config.ChangefeedRetryConfig
+	// retryCount is how many times the changefeed is restarted since the
+	// backoff is reset.
+	retryCount uint64
+	// autoResumer resumes the changefeed after it's failed by retryable
+	// downstream errors.
+	autoResumer autoResumer
 }
 
 // newFeedStateManager creates feedStateManager and initialize the exponential backoff
@@ -70,12 +80,14 @@ func newFeedStateManager(up *upstream.Upstream) *feedStateManager {
 	f.upstream = up
 
 	f.errBackoff = backoff.NewExponentialBackOff()
-	f.errBackoff.InitialInterval = defaultBackoffInitInterval
-	f.errBackoff.MaxInterval = defaultBackoffMaxInterval
 	f.errBackoff.Multiplier = defaultBackoffMultiplier
 	f.errBackoff.RandomizationFactor = defaultBackoffRandomizationFactor
-	// backoff will stop once the defaultBackoffMaxElapsedTime has elapsed.
-	f.errBackoff.MaxElapsedTime = defaultBackoffMaxElapsedTime
+	f.defaultRetry = config.ChangefeedRetryConfig{
+		BackoffInitInterval: config.TomlDuration(defaultBackoffInitInterval),
+		BackoffMaxInterval:  config.TomlDuration(defaultBackoffMaxInterval),
+		// backoff will stop once the defaultBackoffMaxElapsedTime has elapsed.
+		BackoffMaxElapsedTime: config.TomlDuration(defaultBackoffMaxElapsedTime),
+	}
 
 	f.resetErrBackoff()
 	f.lastErrorTime = time.Unix(0, 0)
@@ -85,8 +97,51 @@ func newFeedStateManager(up *upstream.Upstream) *feedStateManager {
 
 // resetErrBackoff reset the backoff-related fields
 func (m *feedStateManager) resetErrBackoff() {
+	retry := m.retryConfig()
+	m.errBackoff.InitialInterval = time.Duration(retry.BackoffInitInterval)
+	m.errBackoff.MaxInterval = time.Duration(retry.BackoffMaxInterval)
+	m.errBackoff.MaxElapsedTime = time.Duration(retry.BackoffMaxElapsedTime)
 	m.errBackoff.Reset()
 	m.backoffInterval = m.errBackoff.NextBackOff()
+	m.retryCount = 0
+}
+
+// retryConfig returns the retry policy of the changefeed, the zero fields of
+// the retry config of the changefeed are filled by the defaults.
+func (m *feedStateManager) retryConfig() config.ChangefeedRetryConfig {
+	res := m.defaultRetry
+	if m.state == nil || m.state.Info == nil ||
+		m.state.Info.Config == nil || m.state.Info.Config.Retry == nil {
+		return res
+	}
+	retry := m.state.Info.Config.Retry
+	res.MaxRetries = retry.MaxRetries
+	res.FastFailErrors = retry.FastFailErrors
+	if retry.BackoffInitInterval != 0 {
+		res.BackoffInitInterval = retry.BackoffInitInterval
+	}
+	if retry.BackoffMaxInterval != 0 {
+		res.BackoffMaxInterval = retry.BackoffMaxInterval
+	}
+	if retry.BackoffMaxElapsedTime != 0 {
+		res.BackoffMaxElapsedTime = retry.BackoffMaxElapsedTime
+	}
+	return res
+}
+
+// isFastFailError returns true if the error fails the changefeed without
+// retrying.
+func (m *feedStateManager) isFastFailError(err *model.RunningError) bool {
+	code := errors.RFCErrorCode(err.Code)
+	if cerrors.IsChangefeedFastFailErrorCode(code) {
+		return true
+	}
+	for _, fastFailCode := range m.retryConfig().FastFailErrors {
+		if code == errors.RFCErrorCode(fastFailCode) {
+			return true
+		}
+	}
+	return false
 }
 
 // isChangefeedStable check if there are states other than 'normal' in this sliding window.
@@ -130,7 +185,14 @@ func (m *feedStateManager) Tick(state *orchestrator.ChangefeedReactorState) (adm
 		m.shouldBeRunning = false
 		m.shouldBeRemoved = true
 		return
-	case model.StateStopped, model.StateFailed, model.StateFinished:
+	case model.StateFailed:
+		if m.tryAutoResume() {
+			adminJobPending = true
+			return
+		}
+		m.shouldBeRunning = false
+		return
+	case model.StateStopped, model.StateFinished:
 		m.shouldBeRunning = false
 		return
 	case model.StateError:
@@ -142,9 +204,65 @@ func (m *feedStateManager) Tick(state *orchestrator.ChangefeedReactorState) (adm
 	}
 	errs := m.errorsReportedByProcessors()
 	m.handleError(errs...)
+	// The outage is over once the resumed changefeed runs steadily.
+	if !m.autoResumer.outageStart.IsZero() && m.isChangefeedStable() {
+		m.resetAutoResume()
+	}
 	return
 }
 
+// tryAutoResume resumes the failed changefeed if it's failed by a retryable
+// downstream error and the outage is still within the max outage. Returns
+// true if the changefeed is resumed.
+func (m *feedStateManager) tryAutoResume() bool {
+	cfg := autoResumeConfig(m.state.Info)
+	if cfg == nil {
+		return false
+	}
+	err := m.state.Info.Error
+	canResume := isRetryableDownstreamError(err, cfg.RetryableErrors) && !m.isFastFailError(err)
+	resume := m.autoResumer.onFailed(m.state.ID, cfg, canResume, time.Now())
+	// Protect the checkpoint from GC only while the changefeed may be resumed.
+	m.setAutoResumeDeadline(m.autoResumer.deadline(cfg))
+	if !resume {
+		return false
+	}
+
+	log.Info("resume the failed changefeed automatically",
+		zap.String("namespace", m.state.ID.Namespace),
+		zap.String("changefeed", m.state.ID.ID),
+		zap.Any("error", err),
+		zap.Time("nextResume", m.autoResumer.nextResume))
+	m.shouldBeRunning = true
+	m.resetErrBackoff()
+	m.lastErrorTime = time.Unix(0, 0)
+	// The changefeed is not regarded as stable until it runs steadily for a
+	// whole window after resumed.
+	m.shiftStateWindow(model.StateFailed)
+	m.patchState(model.StateNormal)
+	m.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		if info == nil || info.Error == nil {
+			return info, false, nil
+		}
+		info.Error = nil
+		return info, true, nil
+	})
+	return true
+}
+
+// resetAutoResume ends the current outage of the changefeed.
+func (m *feedStateManager) resetAutoResume() {
+	m.autoResumer.reset()
+	m.setAutoResumeDeadline(time.Time{})
+}
+
+func (m *feedStateManager) setAutoResumeDeadline(deadline time.Time) {
+	if m.upstream == nil || m.upstream.GCManager == nil {
+		return
+	}
+	m.upstream.GCManager.SetAutoResumeDeadline(m.state.ID, deadline)
+}
+
 func (m *feedStateManager) ShouldRunning() bool {
 	return m.shouldBeRunning
 }
@@ -215,6 +333,7 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 		m.shouldBeRunning = false
 		m.shouldBeRemoved = true
 		jobsPending = true
+		m.resetAutoResume()
 
 		// remove info
 		m.state.PatchInfo(func(info *model.ChangeFeedInfo) (
@@ -248,6 +367,7 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 		m.shouldBeRunning = true
 		// when the changefeed is manually resumed, we must reset the backoff
 		m.resetErrBackoff()
+		m.resetAutoResume()
 		// The lastErrorTime also needs to be cleared before a fresh run.
 		m.lastErrorTime = time.Unix(0, 0)
 		jobsPending = true
@@ -426,12 +546,12 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 	// if there are a fastFail error in errs, we can just fastFail the changefeed
 	// and no need to patch other error to the changefeed info
 	for _, err := range errs {
-		if cerrors.IsChangefeedFastFailErrorCode(errors.RFCErrorCode(err.Code)) {
+		if m.isFastFailError(err) {
 			m.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
 				if info == nil {
 					return nil, false, nil
 				}
-				info.Error = err
+				info.RecordError(err)
 				return info, true, nil
 			})
 			m.shouldBeRunning = false
@@ -459,7 +579,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 				if info == nil {
 					return nil, false, nil
 				}
-				info.Error = err
+				info.RecordError(err)
 				return info, true, nil
 			})
 			m.shouldBeRunning = false
@@ -473,7 +593,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 			return nil, false, nil
 		}
 		for _, err := range errs {
-			info.Error = err
+			info.RecordError(err)
 		}
 		return info, len(errs) > 0, nil
 	})
@@ -523,6 +643,18 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 			return
 		}
 
+		if maxRetries := m.retryConfig().MaxRetries; maxRetries > 0 && m.retryCount >= maxRetries {
+			log.Warn("The changefeed won't be restarted "+
+				"as it has been restarted too many times",
+				zap.String("namespace", m.state.ID.Namespace),
+				zap.String("changefeed", m.state.ID.ID),
+				zap.Uint64("maxRetries", maxRetries))
+			m.shouldBeRunning = false
+			m.patchState(model.StateFailed)
+			return
+		}
+		m.retryCount++
+
 		log.Info("changefeed restart backoff interval is changed",
 			zap.String("namespace", m.state.ID.Namespace),
 			zap.String("changefeed", m.state.ID.ID),
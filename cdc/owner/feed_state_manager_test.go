@@ -56,11 +56,13 @@ func newFeedStateManager4Test(
 	f.upstream.PDClient = &mockPD{}
 
 	f.errBackoff = backoff.NewExponentialBackOff()
-	f.errBackoff.InitialInterval = initialIntervalInMs * time.Millisecond
-	f.errBackoff.MaxInterval = maxIntervalInMs * time.Millisecond
-	f.errBackoff.MaxElapsedTime = maxElapsedTimeInMs * time.Millisecond
 	f.errBackoff.Multiplier = multiplier
 	f.errBackoff.RandomizationFactor = 0
+	f.defaultRetry = config.ChangefeedRetryConfig{
+		BackoffInitInterval:   config.TomlDuration(initialIntervalInMs * time.Millisecond),
+		BackoffMaxInterval:    config.TomlDuration(maxIntervalInMs * time.Millisecond),
+		BackoffMaxElapsedTime: config.TomlDuration(maxElapsedTimeInMs * time.Millisecond),
+	}
 
 	f.resetErrBackoff()
 	f.lastErrorTime = time.Unix(0, 0)
@@ -648,6 +650,76 @@ func TestBackoffNeverStops(t *testing.T) {
 	}
 }
 
+func TestHandleErrorWithRetryConfig(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	manager := newFeedStateManager4Test(0, 0, 0, 1.0)
+	state := orchestrator.NewChangefeedReactorState(etcd.DefaultCDCClusterID,
+		ctx.ChangefeedVars().ID)
+	tester := orchestrator.NewReactorStateTester(t, state, nil)
+	state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		require.Nil(t, info)
+		return &model.ChangeFeedInfo{SinkURI: "123", Config: &config.ReplicaConfig{
+			Retry: &config.ChangefeedRetryConfig{
+				MaxRetries:          2,
+				BackoffInitInterval: config.TomlDuration(100 * time.Millisecond),
+				BackoffMaxInterval:  config.TomlDuration(100 * time.Millisecond),
+				FastFailErrors:      []string{"CDC:ErrMySQLConnectionError"},
+			},
+		}}, true, nil
+	})
+	state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+		require.Nil(t, status)
+		return &model.ChangeFeedStatus{}, true, nil
+	})
+	tester.MustApplyPatches()
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	// The backoff is reset with the retry config of the changefeed.
+	manager.resetErrBackoff()
+	require.Equal(t, 100*time.Millisecond, manager.backoffInterval)
+
+	reportError := func(code string) {
+		state.PatchTaskPosition(ctx.GlobalVars().CaptureInfo.ID,
+			func(position *model.TaskPosition) (*model.TaskPosition, bool, error) {
+				return &model.TaskPosition{Error: &model.RunningError{
+					Addr:    ctx.GlobalVars().CaptureInfo.AdvertiseAddr,
+					Code:    code,
+					Message: "fake error for test",
+				}}, true, nil
+			})
+		tester.MustApplyPatches()
+		manager.Tick(state)
+		tester.MustApplyPatches()
+	}
+
+	// The changefeed is restarted at most 2 times.
+	for i := 0; i < 3; i++ {
+		require.Equal(t, model.StateNormal, state.Info.State)
+		reportError("CDC:ErrEtcdSessionDone")
+		require.False(t, manager.ShouldRunning())
+		require.Equal(t, model.StateError, state.Info.State)
+		time.Sleep(100 * time.Millisecond)
+		manager.Tick(state)
+		tester.MustApplyPatches()
+	}
+	require.Equal(t, model.StateFailed, state.Info.State)
+	require.False(t, manager.ShouldRunning())
+	require.Len(t, state.Info.ErrorHistory, 3)
+
+	// The changefeed is failed by the configured fast fail error at once.
+	manager.resetErrBackoff()
+	state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		info.State = model.StateNormal
+		info.AdminJobType = model.AdminNone
+		return info, true, nil
+	})
+	tester.MustApplyPatches()
+	reportError("CDC:ErrMySQLConnectionError")
+	require.Equal(t, model.StateFailed, state.Info.State)
+	require.Len(t, state.Info.ErrorHistory, 4)
+	require.Equal(t, "CDC:ErrMySQLConnectionError", state.Info.ErrorHistory[3].Code)
+}
+
 func TestUpdateChangefeedEpoch(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(true)
 	// Set a long backoff time
@@ -700,3 +772,74 @@ func TestUpdateChangefeedEpoch(t *testing.T) {
 		}
 	}
 }
+
+func TestAutoResumeFailedChangefeed(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	manager := newFeedStateManager4Test(0, 0, 0, 1.0)
+	state := orchestrator.NewChangefeedReactorState(etcd.DefaultCDCClusterID,
+		ctx.ChangefeedVars().ID)
+	tester := orchestrator.NewReactorStateTester(t, state, nil)
+	state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		require.Nil(t, info)
+		return &model.ChangeFeedInfo{SinkURI: "123", Config: &config.ReplicaConfig{
+			Retry: &config.ChangefeedRetryConfig{MaxRetries: 1},
+			AutoResume: &config.AutoResumeConfig{
+				Enable:              true,
+				BackoffInitInterval: config.TomlDuration(100 * time.Millisecond),
+				BackoffMaxInterval:  config.TomlDuration(100 * time.Millisecond),
+			},
+		}}, true, nil
+	})
+	state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+		require.Nil(t, status)
+		return &model.ChangeFeedStatus{}, true, nil
+	})
+	tester.MustApplyPatches()
+	manager.Tick(state)
+	tester.MustApplyPatches()
+
+	tick := func() {
+		manager.Tick(state)
+		tester.MustApplyPatches()
+	}
+	failWith := func(code, message string) {
+		state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+			info.RecordError(&model.RunningError{Code: code, Message: message})
+			info.State = model.StateFailed
+			info.AdminJobType = model.AdminStop
+			return info, true, nil
+		})
+		tester.MustApplyPatches()
+	}
+
+	// The changefeed failed by a retryable downstream error is resumed
+	// after the backoff.
+	failWith("CDC:ErrMySQLTxnError", "Error 1213: Deadlock found when trying to get lock")
+	tick()
+	require.Equal(t, model.StateFailed, state.Info.State)
+	require.False(t, manager.ShouldRunning())
+	time.Sleep(150 * time.Millisecond)
+	tick()
+	require.Equal(t, model.StateNormal, state.Info.State)
+	require.True(t, manager.ShouldRunning())
+	require.Nil(t, state.Info.Error)
+
+	// The changefeed is not resumed after the max outage.
+	manager.autoResumer.outageStart = time.Now().Add(-defaultAutoResumeMaxOutage)
+	failWith("CDC:ErrMySQLConnectionError", "connection refused")
+	time.Sleep(150 * time.Millisecond)
+	tick()
+	require.Equal(t, model.StateFailed, state.Info.State)
+	require.True(t, manager.autoResumer.exhausted)
+
+	// A manual resume starts a new outage, and fatal errors are never resumed.
+	manager.PushAdminJob(&model.AdminJob{CfID: state.ID, Type: model.AdminResume})
+	tick()
+	require.Equal(t, model.StateNormal, state.Info.State)
+	require.False(t, manager.autoResumer.exhausted)
+	failWith("CDC:ErrSnapshotLostByGC", "fake error for test")
+	time.Sleep(150 * time.Millisecond)
+	tick()
+	require.Equal(t, model.StateFailed, state.Info.State)
+	require.True(t, manager.autoResumer.outageStart.IsZero())
+}
@@ -42,6 +42,13 @@ var (
 			Name:      "checkpoint_ts_lag",
 			Help:      "checkpoint ts lag of changefeeds in seconds",
 		}, []string{"namespace", "changefeed"})
+	changefeedCheckpointGCSafePointLagGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "owner",
+			Name:      "checkpoint_gc_safepoint_lag",
+			Help:      "The lag in seconds between checkpoint ts of changefeeds and the service gc safepoint",
+		}, []string{"namespace", "changefeed"})
 	currentPDTsGauge = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "ticdc",
@@ -134,12 +141,13 @@ const (
 )
 
 // InitMetrics registers all metrics used in owner
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(changefeedBarrierTsGauge)
 
 	registry.MustRegister(changefeedCheckpointTsGauge)
 	registry.MustRegister(changefeedCheckpointTsLagGauge)
 	registry.MustRegister(changefeedCheckpointLagDuration)
+	registry.MustRegister(changefeedCheckpointGCSafePointLagGauge)
 
 	registry.MustRegister(changefeedResolvedTsGauge)
 	registry.MustRegister(changefeedResolvedTsLagGauge)
@@ -140,6 +140,21 @@ func (mr *MockStatusProviderMockRecorder) GetProcessors(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessors", reflect.TypeOf((*MockStatusProvider)(nil).GetProcessors), ctx)
 }
 
+// GetTableStatuses mocks base method.
+func (m *MockStatusProvider) GetTableStatuses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableStatuses", ctx, changefeedID)
+	ret0, _ := ret[0].([]*model.TableReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableStatuses indicates an expected call of GetTableStatuses.
+func (mr *MockStatusProviderMockRecorder) GetTableStatuses(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableStatuses", reflect.TypeOf((*MockStatusProvider)(nil).GetTableStatuses), ctx, changefeedID)
+}
+
 // IsHealthy mocks base method.
 func (m *MockStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ package owner
 import (
 	"context"
 	"io"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	pkgmetrics "github.com/pingcap/tiflow/pkg/metrics"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/version"
@@ -210,6 +212,11 @@ func (o *ownerImpl) Tick(stdCtx context.Context, rawState orchestrator.ReactorSt
 			}
 			reactor.Close(ctx)
 			delete(o.changefeeds, changefeedID)
+			// Forget the retrying states of the removed changefeed.
+			reactor.upstream.GCManager.RemoveChangefeed(changefeedID)
+			// Delete the series left by the removed changefeed.
+			pkgmetrics.GetRegistry().DeleteChangefeedSeries(
+				changefeedID.Namespace, changefeedID.ID)
 		}
 	}
 
@@ -552,6 +559,21 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 			return errors.Trace(err)
 		}
 		query.Data = ret
+	case QueryTableStatuses:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok || cfReactor.state == nil {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		provider := cfReactor.GetInfoProvider()
+		if provider == nil {
+			// The scheduler has not been initialized yet.
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		ret, err := provider.GetTableStatuses()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		query.Data = ret
 	case QueryProcessors:
 		var ret []*model.ProcInfoSnap
 		for cfID, cfReactor := range o.changefeeds {
@@ -585,10 +607,65 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 		query.Data = ret
 	case QueryHealth:
 		query.Data = o.isHealthy()
+	case QueryDebugInfo:
+		ret, err := o.debugInfo()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		query.Data = ret
 	}
 	return nil
 }
 
+// debugInfo collects the states of the captures and changefeeds. It must be
+// called in the owner goroutine, so the states are consistent.
+func (o *ownerImpl) debugInfo() (*model.OwnerDebugInfo, error) {
+	ret := &model.OwnerDebugInfo{
+		Captures:    make([]*model.CaptureInfo, 0, len(o.captures)),
+		Changefeeds: make([]*model.ChangefeedDebugInfo, 0, len(o.changefeeds)),
+	}
+	for _, captureInfo := range o.captures {
+		ret.Captures = append(ret.Captures, &model.CaptureInfo{
+			ID:            captureInfo.ID,
+			AdvertiseAddr: captureInfo.AdvertiseAddr,
+			Version:       captureInfo.Version,
+		})
+	}
+	sort.Slice(ret.Captures, func(i, j int) bool {
+		return ret.Captures[i].ID < ret.Captures[j].ID
+	})
+
+	for cfID, cfReactor := range o.changefeeds {
+		info := &model.ChangefeedDebugInfo{ID: cfID}
+		if cfReactor.state != nil && cfReactor.state.Info != nil {
+			info.State = cfReactor.state.Info.State
+		}
+		if cfReactor.state != nil && cfReactor.state.Status != nil {
+			info.CheckpointTs = cfReactor.state.Status.CheckpointTs
+			info.ResolvedTs = cfReactor.state.Status.ResolvedTs
+		}
+		// The scheduler is created lazily, it is nil before initialization.
+		if provider := cfReactor.GetInfoProvider(); provider != nil {
+			var err error
+			info.SchedulerInitialized = provider.IsInitialized()
+			if info.Tables, err = provider.GetTableStatuses(); err != nil {
+				return nil, errors.Trace(err)
+			}
+			if info.RunningTasks, err = provider.GetRunningTasks(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		ret.Changefeeds = append(ret.Changefeeds, info)
+	}
+	sort.Slice(ret.Changefeeds, func(i, j int) bool {
+		if ret.Changefeeds[i].ID.Namespace != ret.Changefeeds[j].ID.Namespace {
+			return ret.Changefeeds[i].ID.Namespace < ret.Changefeeds[j].ID.Namespace
+		}
+		return ret.Changefeeds[i].ID.ID < ret.Changefeeds[j].ID.ID
+	})
+	return ret, nil
+}
+
 func (o *ownerImpl) isHealthy() bool {
 	if !o.changefeedTicked {
 		// Owner has not yet tick changefeeds, some changefeeds may be not
@@ -668,6 +745,7 @@ func (o *ownerImpl) updateGCSafepoint(
 	ctx context.Context, state *orchestrator.GlobalReactorState,
 ) error {
 	minChekpoinTsMap, forceUpdateMap := o.calculateGCSafepoint(state)
+	gcTTLMap := calculateGCTTL(state)
 
 	for upstreamID, minCheckpointTs := range minChekpoinTsMap {
 		up, ok := o.upstreamManager.Get(upstreamID)
@@ -692,6 +770,7 @@ func (o *ownerImpl) updateGCSafepoint(
 			forceUpdate = true
 		}
 
+		up.GCManager.SetChangefeedGCTTL(gcTTLMap[upstreamID])
 		err := up.GCManager.TryUpdateGCSafePoint(ctx, gcSafepointUpperBound, forceUpdate)
 		if err != nil {
 			return errors.Trace(err)
@@ -717,7 +796,24 @@ func (o *ownerImpl) ignoreFailedChangeFeedWhenGC(
 	if state.Status != nil {
 		ts = state.Status.CheckpointTs
 	}
-	return us.GCManager.IgnoreFailedChangeFeed(ts)
+	var retention time.Duration
+	if state.Info.Config != nil {
+		retention = state.Info.Config.FailedChangefeedRetention
+	}
+	return us.GCManager.IgnoreFailedChangeFeedByID(state.ID, ts, retention)
+}
+
+// setChangefeedRetryingWhenGC tells the gc manager of the associated upstream
+// whether a changefeed is retrying on errors, so that it keeps protecting the
+// changefeed for a while even if the changefeed fails later.
+func (o *ownerImpl) setChangefeedRetryingWhenGC(
+	state *orchestrator.ChangefeedReactorState, retrying bool,
+) {
+	us, exist := o.upstreamManager.Get(state.Info.UpstreamID)
+	if !exist {
+		return
+	}
+	us.GCManager.SetRetrying(state.ID, retrying)
 }
 
 // calculateGCSafepoint calculates GCSafepoint for different upstream.
@@ -736,7 +832,10 @@ func (o *ownerImpl) calculateGCSafepoint(state *orchestrator.GlobalReactorState)
 		}
 
 		switch changefeedState.Info.State {
-		case model.StateNormal, model.StateStopped, model.StateError:
+		case model.StateNormal, model.StateStopped:
+			o.setChangefeedRetryingWhenGC(changefeedState, false)
+		case model.StateError:
+			o.setChangefeedRetryingWhenGC(changefeedState, true)
 		case model.StateFailed:
 			if o.ignoreFailedChangeFeedWhenGC(changefeedState) {
 				continue
@@ -767,6 +866,27 @@ func (o *ownerImpl) calculateGCSafepoint(state *orchestrator.GlobalReactorState)
 	return minCheckpointTsMap, forceUpdateMap
 }
 
+// calculateGCTTL returns the largest gc ttl required by changefeeds that
+// block GC of each upstream.
+func calculateGCTTL(state *orchestrator.GlobalReactorState) map[uint64]int64 {
+	gcTTLMap := make(map[uint64]int64)
+	for _, changefeedState := range state.Changefeeds {
+		info := changefeedState.Info
+		if info == nil || info.Config == nil {
+			continue
+		}
+		switch info.State {
+		case model.StateNormal, model.StateStopped, model.StateError:
+		default:
+			continue
+		}
+		if info.Config.GCTTL > gcTTLMap[info.UpstreamID] {
+			gcTTLMap[info.UpstreamID] = info.Config.GCTTL
+		}
+	}
+	return gcTTLMap
+}
+
 // StatusProvider returns a StatusProvider
 func (o *ownerImpl) StatusProvider() StatusProvider {
 	return &ownerStatusProvider{owner: o}
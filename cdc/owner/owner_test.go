@@ -174,6 +174,12 @@ func TestCreateRemoveChangefeed(t *testing.T) {
 	require.Nil(t, err)
 	require.Contains(t, owner.changefeeds, changefeedID)
 
+	// The gc manager protects the changefeed while it's retrying.
+	up, _ := owner.upstreamManager.Get(changefeedInfo.UpstreamID)
+	staleTs := oracle.GoTimeToTS(time.Now().Add(-48 * time.Hour))
+	up.GCManager.SetRetrying(changefeedID, true)
+	require.False(t, up.GCManager.IgnoreFailedChangeFeedByID(changefeedID, staleTs, 0))
+
 	// delete changefeed info key to remove changefeed
 	tester.MustUpdate(cdcKey.String(), nil)
 	// this tick to clean the leak info of the removed changefeed
@@ -187,6 +193,8 @@ func TestCreateRemoveChangefeed(t *testing.T) {
 
 	require.NotContains(t, owner.changefeeds, changefeedID)
 	require.NotContains(t, state.Changefeeds, changefeedID)
+	// The retrying state of the removed changefeed is forgotten.
+	require.True(t, up.GCManager.IgnoreFailedChangeFeedByID(changefeedID, staleTs, 0))
 
 	tester.MustUpdate(cdcKey.String(), []byte(changefeedStr))
 	_, err = owner.Tick(ctx, state)
@@ -200,7 +208,6 @@ func TestCreateRemoveChangefeed(t *testing.T) {
 	}
 
 	// this will make changefeed always meet ErrStartTsBeforeGC
-	up, _ := owner.upstreamManager.Get(changefeedInfo.UpstreamID)
 	mockedManager := &mockManager{Manager: up.GCManager}
 	up.GCManager = mockedManager
 	err = up.GCManager.CheckStaleCheckpointTs(ctx, changefeedID, 0)
@@ -684,7 +691,10 @@ func TestCalculateGCSafepointTs(t *testing.T) {
 	state := orchestrator.NewGlobalState(etcd.DefaultCDCClusterID)
 	expectMinTsMap := make(map[uint64]uint64)
 	expectForceUpdateMap := make(map[uint64]interface{})
-	o := ownerImpl{changefeeds: make(map[model.ChangeFeedID]*changefeed)}
+	o := ownerImpl{
+		changefeeds:     make(map[model.ChangeFeedID]*changefeed),
+		upstreamManager: upstream.NewManager4Test(&gc.MockPDClient{}),
+	}
 
 	for i := 0; i < 100; i++ {
 		cfID := model.DefaultChangeFeedID(fmt.Sprintf("testChangefeed-%d", i))
@@ -718,6 +728,29 @@ func TestCalculateGCSafepointTs(t *testing.T) {
 	require.Equal(t, expectForceUpdateMap, forceUpdateMap)
 }
 
+func TestCalculateGCTTL(t *testing.T) {
+	state := orchestrator.NewGlobalState(etcd.DefaultCDCClusterID)
+	addChangefeed := func(id string, upstreamID uint64, feedState model.FeedState, ttl int64) {
+		cfID := model.DefaultChangeFeedID(id)
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.GCTTL = ttl
+		state.Changefeeds[cfID] = &orchestrator.ChangefeedReactorState{
+			ID: cfID,
+			Info: &model.ChangeFeedInfo{
+				UpstreamID: upstreamID, State: feedState, Config: cfg,
+			},
+		}
+	}
+	addChangefeed("normal", 1, model.StateNormal, 100)
+	addChangefeed("stopped", 1, model.StateStopped, 200)
+	addChangefeed("error", 2, model.StateError, 300)
+	// Failed and finished changefeeds do not block GC.
+	addChangefeed("failed", 2, model.StateFailed, 400)
+	addChangefeed("finished", 3, model.StateFinished, 500)
+
+	require.Equal(t, map[uint64]int64{1: 200, 2: 300}, calculateGCTTL(state))
+}
+
 // AsyncStop should cleanup jobs and reject.
 func TestAsyncStop(t *testing.T) {
 	t.Parallel()
@@ -927,3 +960,67 @@ func TestIsHealthy(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, query.Data.(bool))
 }
+
+type debugInfoScheduler struct {
+	healthScheduler
+	tables []*model.TableReplicationStatus
+	tasks  []*model.ScheduleTaskInfo
+}
+
+func (s *debugInfoScheduler) GetTableStatuses() ([]*model.TableReplicationStatus, error) {
+	return s.tables, nil
+}
+
+func (s *debugInfoScheduler) GetRunningTasks() ([]*model.ScheduleTaskInfo, error) {
+	return s.tasks, nil
+}
+
+func TestQueryDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	o := &ownerImpl{
+		changefeeds: make(map[model.ChangeFeedID]*changefeed),
+		captures: map[model.CaptureID]*model.CaptureInfo{
+			"2": {ID: "2", AdvertiseAddr: "127.0.0.1:8301"},
+			"1": {ID: "1", AdvertiseAddr: "127.0.0.1:8300"},
+		},
+	}
+	// The scheduler of changefeed 2 has not been created.
+	o.changefeeds[model.DefaultChangeFeedID("2")] = &changefeed{
+		state: &orchestrator.ChangefeedReactorState{
+			Info: &model.ChangeFeedInfo{State: model.StateNormal},
+		},
+	}
+	tables := []*model.TableReplicationStatus{{TableID: 1, State: "Replicating"}}
+	tasks := []*model.ScheduleTaskInfo{{Type: "moveTable", TableID: 1}}
+	o.changefeeds[model.DefaultChangeFeedID("1")] = &changefeed{
+		state: &orchestrator.ChangefeedReactorState{
+			Info:   &model.ChangeFeedInfo{State: model.StateNormal},
+			Status: &model.ChangeFeedStatus{CheckpointTs: 10, ResolvedTs: 20},
+		},
+		scheduler: &debugInfoScheduler{
+			healthScheduler: healthScheduler{init: true},
+			tables:          tables,
+			tasks:           tasks,
+		},
+	}
+
+	query := &Query{Tp: QueryDebugInfo}
+	require.NoError(t, o.handleQueries(query))
+	info := query.Data.(*model.OwnerDebugInfo)
+	require.Len(t, info.Captures, 2)
+	require.Equal(t, "1", info.Captures[0].ID)
+	require.Equal(t, "2", info.Captures[1].ID)
+	require.Equal(t, []*model.ChangefeedDebugInfo{{
+		ID:                   model.DefaultChangeFeedID("1"),
+		State:                model.StateNormal,
+		CheckpointTs:         10,
+		ResolvedTs:           20,
+		SchedulerInitialized: true,
+		Tables:               tables,
+		RunningTasks:         tasks,
+	}, {
+		ID:    model.DefaultChangeFeedID("2"),
+		State: model.StateNormal,
+	}}, info.Changefeeds)
+}
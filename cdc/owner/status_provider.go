@@ -39,6 +39,10 @@ type StatusProvider interface {
 	// GetAllTaskStatuses returns the task statuses for the specified changefeed.
 	GetAllTaskStatuses(ctx context.Context, changefeedID model.ChangeFeedID) (map[model.CaptureID]*model.TaskStatus, error)
 
+	// GetTableStatuses returns the replication statuses of all tables
+	// for the specified changefeed.
+	GetTableStatuses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableReplicationStatus, error)
+
 	// GetProcessors returns the statuses of all processors
 	GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error)
 
@@ -65,6 +69,10 @@ const (
 	QueryCaptures
 	// QueryHealth is the type of query cluster health info.
 	QueryHealth
+	// QueryTableStatuses is the type of query table replication statuses.
+	QueryTableStatuses
+	// QueryDebugInfo is the type of query the debug info of the owner.
+	QueryDebugInfo
 )
 
 // Query wraps query command and return results.
@@ -139,6 +147,17 @@ func (p *ownerStatusProvider) GetAllTaskStatuses(ctx context.Context, changefeed
 	return query.Data.(map[model.CaptureID]*model.TaskStatus), nil
 }
 
+func (p *ownerStatusProvider) GetTableStatuses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableReplicationStatus, error) {
+	query := &Query{
+		Tp:           QueryTableStatuses,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.([]*model.TableReplicationStatus), nil
+}
+
 func (p *ownerStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	query := &Query{
 		Tp: QueryProcessors,
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
+	pkgmetrics "github.com/pingcap/tiflow/pkg/metrics"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/prometheus/client_golang/prometheus"
@@ -36,6 +38,8 @@ type commandTp int
 const (
 	commandTpUnknown commandTp = iota
 	commandTpWriteDebugInfo
+	commandTpQueryTableCount
+	commandTpQueryDebugInfo
 	processorLogsWarnDuration = 1 * time.Second
 )
 
@@ -54,6 +58,16 @@ type Manager interface {
 	Close()
 
 	WriteDebugInfo(ctx context.Context, w io.Writer, done chan<- error)
+
+	// QueryTableCount sends the number of tables replicated by all
+	// processors to tableCh, which must be buffered.
+	QueryTableCount(ctx context.Context, tableCh chan<- int, done chan<- error)
+
+	// QueryDebugInfo sends the states of all processors to infoCh,
+	// which must be buffered.
+	QueryDebugInfo(
+		ctx context.Context, infoCh chan<- []*model.ProcessorDebugInfo, done chan<- error,
+	)
 }
 
 // managerImpl is a manager of processor, which maintains the state and behavior of processors
@@ -110,6 +124,11 @@ func (m *managerImpl) Tick(stdCtx context.Context, state orchestrator.ReactorSta
 		if !changefeedState.Active(m.captureInfo.ID) {
 			inactiveChangefeedCount++
 			m.closeProcessor(changefeedID)
+			if changefeedState.Info == nil {
+				// The changefeed is removed, delete all series left by it.
+				pkgmetrics.GetRegistry().DeleteChangefeedSeries(
+					changefeedID.Namespace, changefeedID.ID)
+			}
 			continue
 		}
 		currentChangefeedEpoch := changefeedState.Info.Epoch
@@ -203,6 +222,26 @@ func (m *managerImpl) WriteDebugInfo(
 	}
 }
 
+// QueryTableCount query the number of tables in all processors.
+func (m *managerImpl) QueryTableCount(
+	ctx context.Context, tableCh chan<- int, done chan<- error,
+) {
+	err := m.sendCommand(ctx, commandTpQueryTableCount, tableCh, done)
+	if err != nil {
+		log.Warn("send command commandTpQueryTableCount failed", zap.Error(err))
+	}
+}
+
+// QueryDebugInfo query the states of all processors.
+func (m *managerImpl) QueryDebugInfo(
+	ctx context.Context, infoCh chan<- []*model.ProcessorDebugInfo, done chan<- error,
+) {
+	err := m.sendCommand(ctx, commandTpQueryDebugInfo, infoCh, done)
+	if err != nil {
+		log.Warn("send command commandTpQueryDebugInfo failed", zap.Error(err))
+	}
+}
+
 // sendCommands sends command to manager.
 // `done` is closed upon command completion or sendCommand returns error.
 func (m *managerImpl) sendCommand(
@@ -234,6 +273,28 @@ func (m *managerImpl) handleCommand() {
 		if err != nil {
 			cmd.done <- err
 		}
+	case commandTpQueryTableCount:
+		tableCh := cmd.payload.(chan<- int)
+		count := 0
+		for _, p := range m.processors {
+			if p.initialized {
+				count += p.sinkManager.r.GetAllCurrentTableSpansCount()
+			}
+		}
+		tableCh <- count
+	case commandTpQueryDebugInfo:
+		infoCh := cmd.payload.(chan<- []*model.ProcessorDebugInfo)
+		infos := make([]*model.ProcessorDebugInfo, 0, len(m.processors))
+		for _, p := range m.processors {
+			infos = append(infos, p.debugInfo())
+		}
+		sort.Slice(infos, func(i, j int) bool {
+			if infos[i].ChangefeedID.Namespace != infos[j].ChangefeedID.Namespace {
+				return infos[i].ChangefeedID.Namespace < infos[j].ChangefeedID.Namespace
+			}
+			return infos[i].ChangefeedID.ID < infos[j].ChangefeedID.ID
+		})
+		infoCh <- infos
 	default:
 		log.Warn("Unknown command in processor manager", zap.Any("command", cmd))
 	}
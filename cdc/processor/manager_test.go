@@ -186,6 +186,14 @@ func TestDebugInfo(t *testing.T) {
 	<-doneM
 	require.Greater(t, len(buf.String()), 0)
 
+	doneM = make(chan error, 1)
+	infoCh := make(chan []*model.ProcessorDebugInfo, 1)
+	s.manager.QueryDebugInfo(ctx, infoCh, doneM)
+	require.Nil(t, <-doneM)
+	infos := <-infoCh
+	require.Len(t, infos, 1)
+	require.True(t, infos[0].Initialized)
+
 	// Stop tick so that we can close manager safely.
 	cancel()
 	<-done
@@ -38,7 +38,7 @@ type MemConsumeRecord struct {
 type MemQuota struct {
 	changefeedID model.ChangeFeedID
 	// totalBytes is the total memory quota for one changefeed.
-	totalBytes uint64
+	totalBytes atomic.Uint64
 
 	// usedBytes is the memory usage of one changefeed.
 	usedBytes atomic.Uint64
@@ -56,26 +56,83 @@ type MemQuota struct {
 	metricTotal prometheus.Gauge
 	metricUsed  prometheus.Gauge
 
+	// parent is the server memory quota, memory acquired from the quota is
+	// also acquired from the parent. It's nil if the server memory quota is
+	// not set.
+	parent *MemQuota
+
 	// mu protects the following fields.
 	mu sync.Mutex
 	// tableMemory is the memory usage of each table.
 	tableMemory *spanz.HashMap[[]*MemConsumeRecord]
+
+	// childrenMu protects children.
+	childrenMu sync.Mutex
+	// children is the changefeed memory quotas sharing the server memory
+	// quota, it's only used by the server memory quota.
+	children map[*MemQuota]struct{}
+}
+
+// serverMemQuota is the memory quota shared by all changefeeds on the capture.
+var serverMemQuota atomic.Pointer[MemQuota]
+
+// InitServerMemQuota sets the memory quota shared by all changefeeds on the
+// capture, 0 means unlimited. Changefeed memory quotas created after it are
+// limited by both their own quota and the server memory quota. It should be
+// called before any changefeed memory quota is created.
+func InitServerMemQuota(totalBytes uint64) {
+	if totalBytes == 0 {
+		if old := serverMemQuota.Swap(nil); old != nil {
+			old.Close()
+		}
+		return
+	}
+	if m := serverMemQuota.Load(); m != nil {
+		m.SetTotalBytes(totalBytes)
+		return
+	}
+	m := newMemQuota(model.ChangeFeedID{}, totalBytes,
+		ServerMemoryQuota.WithLabelValues("total"),
+		ServerMemoryQuota.WithLabelValues("used"), nil)
+	m.children = make(map[*MemQuota]struct{})
+	serverMemQuota.Store(m)
+}
+
+// GetServerMemQuota returns the memory quota shared by all changefeeds on the
+// capture, it returns nil if the server memory quota is not set.
+func GetServerMemQuota() *MemQuota {
+	return serverMemQuota.Load()
 }
 
 // NewMemQuota creates a MemQuota instance.
 func NewMemQuota(changefeedID model.ChangeFeedID, totalBytes uint64, comp string) *MemQuota {
+	m := newMemQuota(changefeedID, totalBytes,
+		MemoryQuota.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "total", comp),
+		MemoryQuota.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "used", comp),
+		serverMemQuota.Load())
+	if m.parent != nil {
+		m.parent.childrenMu.Lock()
+		m.parent.children[m] = struct{}{}
+		m.parent.childrenMu.Unlock()
+	}
+	return m
+}
+
+func newMemQuota(
+	changefeedID model.ChangeFeedID, totalBytes uint64,
+	metricTotal, metricUsed prometheus.Gauge, parent *MemQuota,
+) *MemQuota {
 	m := &MemQuota{
 		changefeedID:     changefeedID,
-		totalBytes:       totalBytes,
 		blockAcquireCond: sync.NewCond(&sync.Mutex{}),
-		metricTotal: MemoryQuota.WithLabelValues(changefeedID.Namespace,
-			changefeedID.ID, "total", comp),
-		metricUsed: MemoryQuota.WithLabelValues(changefeedID.Namespace,
-			changefeedID.ID, "used", comp),
-		closeBg: make(chan struct{}, 1),
+		metricTotal:      metricTotal,
+		metricUsed:       metricUsed,
+		closeBg:          make(chan struct{}, 1),
+		parent:           parent,
 
 		tableMemory: spanz.NewHashMap[[]*MemConsumeRecord](),
 	}
+	m.totalBytes.Store(totalBytes)
 	m.metricTotal.Set(float64(totalBytes))
 	m.metricUsed.Set(float64(0))
 
@@ -102,22 +159,44 @@ func NewMemQuota(changefeedID model.ChangeFeedID, totalBytes uint64, comp string
 	return m
 }
 
+// SetTotalBytes updates the total memory quota, blocked acquires are notified
+// if the quota is increased.
+func (m *MemQuota) SetTotalBytes(totalBytes uint64) {
+	if m.totalBytes.Swap(totalBytes) == totalBytes {
+		return
+	}
+	m.metricTotal.Set(float64(totalBytes))
+	m.blockAcquireCond.Broadcast()
+	log.Info("Memory quota is updated",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.Uint64("total", totalBytes))
+}
+
 // TryAcquire returns true if the memory quota is available, otherwise returns false.
 func (m *MemQuota) TryAcquire(nBytes uint64) bool {
 	for {
 		usedBytes := m.usedBytes.Load()
-		if usedBytes+nBytes > m.totalBytes {
+		if usedBytes+nBytes > m.totalBytes.Load() {
 			return false
 		}
 		if m.usedBytes.CompareAndSwap(usedBytes, usedBytes+nBytes) {
-			return true
+			break
 		}
 	}
+	if m.parent != nil && !m.isClosed.Load() && !m.parent.TryAcquire(nBytes) {
+		m.refundLocal(nBytes)
+		return false
+	}
+	return true
 }
 
 // ForceAcquire is used to force acquire the memory quota.
 func (m *MemQuota) ForceAcquire(nBytes uint64) {
 	m.usedBytes.Add(nBytes)
+	if m.parent != nil && !m.isClosed.Load() {
+		m.parent.ForceAcquire(nBytes)
+	}
 }
 
 // BlockAcquire is used to block the request when the memory quota is not available.
@@ -127,16 +206,23 @@ func (m *MemQuota) BlockAcquire(nBytes uint64) error {
 			return cerrors.ErrFlowControllerAborted.GenWithStackByArgs()
 		}
 		usedBytes := m.usedBytes.Load()
-		if usedBytes+nBytes > m.totalBytes {
+		if usedBytes+nBytes > m.totalBytes.Load() {
 			m.blockAcquireCond.L.Lock()
 			m.blockAcquireCond.Wait()
 			m.blockAcquireCond.L.Unlock()
 			continue
 		}
 		if m.usedBytes.CompareAndSwap(usedBytes, usedBytes+nBytes) {
-			return nil
+			break
+		}
+	}
+	if m.parent != nil {
+		if err := m.parent.BlockAcquire(nBytes); err != nil {
+			m.refundLocal(nBytes)
+			return err
 		}
 	}
+	return nil
 }
 
 // Refund directly release the memory quota.
@@ -149,11 +235,26 @@ func (m *MemQuota) Refund(nBytes uint64) {
 		log.Panic("MemQuota.refund fail",
 			zap.Uint64("used", usedBytes), zap.Uint64("refund", nBytes))
 	}
-	if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes {
+	m.refundLocal(nBytes)
+	m.refundParent(nBytes)
+}
+
+// refundLocal releases the memory quota without refunding the parent.
+func (m *MemQuota) refundLocal(nBytes uint64) {
+	if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes.Load() {
 		m.blockAcquireCond.Broadcast()
 	}
 }
 
+// refundParent refunds the memory released by the quota to the parent. The
+// memory is refunded to the parent when the quota is closed, so it's skipped
+// after that.
+func (m *MemQuota) refundParent(nBytes uint64) {
+	if m.parent != nil && nBytes > 0 && !m.isClosed.Load() {
+		m.parent.Refund(nBytes)
+	}
+}
+
 // AddTable adds a table into the quota.
 func (m *MemQuota) AddTable(span tablepb.Span) {
 	m.mu.Lock()
@@ -175,9 +276,8 @@ func (m *MemQuota) Record(span tablepb.Span, resolved model.ResolvedTs, nBytes u
 			log.Panic("MemQuota.refund fail",
 				zap.Uint64("used", usedBytes), zap.Uint64("refund", nBytes))
 		}
-		if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes {
-			m.blockAcquireCond.Broadcast()
-		}
+		m.refundLocal(nBytes)
+		m.refundParent(nBytes)
 		return
 	}
 	m.tableMemory.ReplaceOrInsert(span, append(m.tableMemory.GetV(span), &MemConsumeRecord{
@@ -216,9 +316,8 @@ func (m *MemQuota) Release(span tablepb.Span, resolved model.ResolvedTs) {
 		log.Panic("MemQuota.release fail",
 			zap.Uint64("used", usedBytes), zap.Uint64("release", toRelease))
 	}
-	if m.usedBytes.Add(^(toRelease - 1)) < m.totalBytes {
-		m.blockAcquireCond.Broadcast()
-	}
+	m.refundLocal(toRelease)
+	m.refundParent(toRelease)
 }
 
 // Clean all records of the table.
@@ -243,18 +342,29 @@ func (m *MemQuota) Clean(span tablepb.Span) uint64 {
 	}
 	m.tableMemory.Delete(span)
 
-	if m.usedBytes.Add(^(cleaned - 1)) < m.totalBytes {
-		m.blockAcquireCond.Broadcast()
+	if cleaned == 0 {
+		return 0
 	}
+	m.refundLocal(cleaned)
+	m.refundParent(cleaned)
 	return cleaned
 }
 
 // Close the mem quota and notify the blocked acquire.
+// The memory still used by the quota is refunded to the parent.
 func (m *MemQuota) Close() {
 	if m.isClosed.CompareAndSwap(false, true) {
 		m.blockAcquireCond.Broadcast()
 		close(m.closeBg)
 		m.wg.Wait()
+		if m.parent != nil {
+			m.parent.childrenMu.Lock()
+			delete(m.parent.children, m)
+			m.parent.childrenMu.Unlock()
+			if usedBytes := m.usedBytes.Load(); usedBytes > 0 {
+				m.parent.Refund(usedBytes)
+			}
+		}
 	}
 }
 
@@ -263,7 +373,63 @@ func (m *MemQuota) GetUsedBytes() uint64 {
 	return m.usedBytes.Load()
 }
 
+// GetTotalBytes returns the total memory quota.
+func (m *MemQuota) GetTotalBytes() uint64 {
+	return m.totalBytes.Load()
+}
+
+// IsExhausted returns true if all the memory quota is used.
+func (m *MemQuota) IsExhausted() bool {
+	return m.usedBytes.Load() >= m.totalBytes.Load()
+}
+
+// GetTableUsage returns the memory quota usage of the table. The quota is
+// exhausted if the table is blocked from acquiring more memory.
+func (m *MemQuota) GetTableUsage(span tablepb.Span) tablepb.MemoryQuota {
+	m.mu.Lock()
+	usedBytes := uint64(0)
+	for _, record := range m.tableMemory.GetV(span) {
+		usedBytes += record.Size
+	}
+	m.mu.Unlock()
+
+	return tablepb.MemoryQuota{
+		Exhausted:  m.IsExhausted(),
+		UsedBytes:  usedBytes,
+		QuotaBytes: m.GetTotalBytes(),
+	}
+}
+
+// ChangefeedUsage is the memory used by a changefeed.
+type ChangefeedUsage struct {
+	ChangefeedID model.ChangeFeedID
+	UsedBytes    uint64
+}
+
+// GetChangefeedUsages returns the memory used by each changefeed sharing the
+// server memory quota, the changefeeds are sorted by their IDs.
+func (m *MemQuota) GetChangefeedUsages() []ChangefeedUsage {
+	m.childrenMu.Lock()
+	usedBytes := make(map[model.ChangeFeedID]uint64, len(m.children))
+	for child := range m.children {
+		usedBytes[child.changefeedID] += child.usedBytes.Load()
+	}
+	m.childrenMu.Unlock()
+
+	usages := make([]ChangefeedUsage, 0, len(usedBytes))
+	for id, used := range usedBytes {
+		usages = append(usages, ChangefeedUsage{ChangefeedID: id, UsedBytes: used})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].ChangefeedID.Namespace != usages[j].ChangefeedID.Namespace {
+			return usages[i].ChangefeedID.Namespace < usages[j].ChangefeedID.Namespace
+		}
+		return usages[i].ChangefeedID.ID < usages[j].ChangefeedID.ID
+	})
+	return usages
+}
+
 // hasAvailable returns true if the memory quota is available, otherwise returns false.
 func (m *MemQuota) hasAvailable(nBytes uint64) bool {
-	return m.usedBytes.Load()+nBytes <= m.totalBytes
+	return m.usedBytes.Load()+nBytes <= m.totalBytes.Load()
 }
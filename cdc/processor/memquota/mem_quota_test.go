@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
@@ -76,6 +77,34 @@ func TestMemQuotaBlockAcquire(t *testing.T) {
 	wg.Wait()
 }
 
+func TestMemQuotaSetTotalBytes(t *testing.T) {
+	t.Parallel()
+
+	m := NewMemQuota(model.DefaultChangeFeedID("1"), 100, "")
+	defer m.Close()
+	require.True(t, m.TryAcquire(100))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := m.BlockAcquire(50)
+		require.NoError(t, err)
+	}()
+	// Raising the quota wakes up the blocked acquire.
+	m.SetTotalBytes(150)
+	wg.Wait()
+	require.Equal(t, uint64(150), m.GetUsedBytes())
+	require.False(t, m.TryAcquire(1))
+
+	// Lowering the quota doesn't affect the acquired memory.
+	m.SetTotalBytes(100)
+	require.Equal(t, uint64(150), m.GetUsedBytes())
+	m.Refund(100)
+	require.False(t, m.TryAcquire(51))
+	require.True(t, m.TryAcquire(50))
+}
+
 func TestMemQuotaClose(t *testing.T) {
 	t.Parallel()
 
@@ -187,6 +216,36 @@ func TestMemQuotaRecordAndRelease(t *testing.T) {
 	require.True(t, m.hasAvailable(300))
 }
 
+func TestMemQuotaGetTableUsage(t *testing.T) {
+	t.Parallel()
+
+	m := NewMemQuota(model.DefaultChangeFeedID("1"), 300, "")
+	defer m.Close()
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	m.AddTable(span1)
+	m.AddTable(span2)
+
+	require.True(t, m.TryAcquire(200))
+	m.Record(span1, model.NewResolvedTs(100), 200)
+	require.Equal(t, tablepb.MemoryQuota{UsedBytes: 200, QuotaBytes: 300},
+		m.GetTableUsage(span1))
+
+	// The quota is exhausted, all tables are blocked.
+	require.True(t, m.TryAcquire(100))
+	m.Record(span2, model.NewResolvedTs(100), 100)
+	require.Equal(t, tablepb.MemoryQuota{Exhausted: true, UsedBytes: 200, QuotaBytes: 300},
+		m.GetTableUsage(span1))
+	require.Equal(t, tablepb.MemoryQuota{Exhausted: true, UsedBytes: 100, QuotaBytes: 300},
+		m.GetTableUsage(span2))
+
+	// The exhaustion is cleared after the memory is released.
+	m.Release(span1, model.NewResolvedTs(101))
+	require.Equal(t, tablepb.MemoryQuota{QuotaBytes: 300}, m.GetTableUsage(span1))
+	require.Equal(t, tablepb.MemoryQuota{UsedBytes: 100, QuotaBytes: 300},
+		m.GetTableUsage(span2))
+}
+
 func TestMemQuotaRecordAndReleaseWithBatchID(t *testing.T) {
 	t.Parallel()
 
@@ -254,3 +313,52 @@ func TestMemQuotaRecordAndClean(t *testing.T) {
 	require.Equal(t, uint64(300), cleanedBytes)
 	require.True(t, m.hasAvailable(100))
 }
+
+func TestServerMemQuota(t *testing.T) {
+	InitServerMemQuota(100)
+	defer InitServerMemQuota(0)
+	server := GetServerMemQuota()
+	require.NotNil(t, server)
+
+	cf1 := model.DefaultChangeFeedID("1")
+	cf2 := model.DefaultChangeFeedID("2")
+	m1 := NewMemQuota(cf1, 80, "sink")
+	m2 := NewMemQuota(cf2, 80, "sink")
+	defer m2.Close()
+
+	require.True(t, m1.TryAcquire(60))
+	// The changefeed quota is available but the server quota isn't.
+	require.False(t, m2.TryAcquire(60))
+	require.Equal(t, uint64(0), m2.GetUsedBytes())
+	require.True(t, m2.TryAcquire(40))
+	require.True(t, server.IsExhausted())
+	require.Equal(t, []ChangefeedUsage{
+		{ChangefeedID: cf1, UsedBytes: 60},
+		{ChangefeedID: cf2, UsedBytes: 40},
+	}, server.GetChangefeedUsages())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := m2.BlockAcquire(30)
+		require.NoError(t, err)
+	}()
+	m1.Refund(30)
+	wg.Wait()
+	require.Equal(t, uint64(70), m2.GetUsedBytes())
+	require.Equal(t, uint64(100), server.GetUsedBytes())
+
+	// The memory used by a closed changefeed is refunded to the server.
+	m1.Close()
+	require.Equal(t, uint64(70), server.GetUsedBytes())
+	require.Equal(t, []ChangefeedUsage{
+		{ChangefeedID: cf2, UsedBytes: 70},
+	}, server.GetChangefeedUsages())
+
+	span := spanz.TableIDToComparableSpan(1)
+	m2.AddTable(span)
+	m2.Record(span, model.NewResolvedTs(1), 70)
+	m2.Release(span, model.NewResolvedTs(1))
+	require.Equal(t, uint64(0), server.GetUsedBytes())
+}
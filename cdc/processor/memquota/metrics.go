@@ -28,7 +28,19 @@ var MemoryQuota = prometheus.NewGaugeVec(
 	// type includes total, used, component includes sink and redo.
 	[]string{"namespace", "changefeed", "type", "component"})
 
+// ServerMemoryQuota indicates memory usage of all changefeeds on the capture.
+var ServerMemoryQuota = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "sinkmanager",
+		Name:      "server_memory_quota",
+		Help:      "memory quota shared by all changefeeds on the capture",
+	},
+	// type includes total, used.
+	[]string{"type"})
+
 // InitMetrics registers all metrics in this file.
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(MemoryQuota)
+	registry.MustRegister(ServerMemoryQuota)
 }
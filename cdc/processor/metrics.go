@@ -74,7 +74,7 @@ var (
 )
 
 // InitMetrics registers all metrics used in processor
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(syncTableNumGauge)
 	registry.MustRegister(processorErrorCounter)
 	registry.MustRegister(processorSchemaStorageGcTsGauge)
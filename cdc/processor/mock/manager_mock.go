@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	model "github.com/pingcap/tiflow/cdc/model"
 	orchestrator "github.com/pingcap/tiflow/pkg/orchestrator"
 )
 
@@ -48,6 +49,30 @@ func (mr *MockManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockManager)(nil).Close))
 }
 
+// QueryDebugInfo mocks base method.
+func (m *MockManager) QueryDebugInfo(ctx context.Context, infoCh chan<- []*model.ProcessorDebugInfo, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "QueryDebugInfo", ctx, infoCh, done)
+}
+
+// QueryDebugInfo indicates an expected call of QueryDebugInfo.
+func (mr *MockManagerMockRecorder) QueryDebugInfo(ctx, infoCh, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDebugInfo", reflect.TypeOf((*MockManager)(nil).QueryDebugInfo), ctx, infoCh, done)
+}
+
+// QueryTableCount mocks base method.
+func (m *MockManager) QueryTableCount(ctx context.Context, tableCh chan<- int, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "QueryTableCount", ctx, tableCh, done)
+}
+
+// QueryTableCount indicates an expected call of QueryTableCount.
+func (mr *MockManagerMockRecorder) QueryTableCount(ctx, tableCh, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTableCount", reflect.TypeOf((*MockManager)(nil).QueryTableCount), ctx, tableCh, done)
+}
+
 // Tick mocks base method.
 func (m *MockManager) Tick(ctx context.Context, state orchestrator.ReactorState) (orchestrator.ReactorState, error) {
 	m.ctrl.T.Helper()
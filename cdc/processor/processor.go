@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -35,10 +36,12 @@ import (
 	"github.com/pingcap/tiflow/cdc/redo"
 	"github.com/pingcap/tiflow/cdc/scheduler"
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/subscription"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
+	pkgmetrics "github.com/pingcap/tiflow/pkg/metrics"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/retry"
@@ -76,6 +79,9 @@ type processor struct {
 	sourceManager component[*sourcemanager.SourceManager]
 
 	sinkManager component[*sinkmanager.SinkManager]
+	// appliedInfo is the changefeed info whose replica config has been
+	// applied to the sink manager.
+	appliedInfo *model.ChangeFeedInfo
 
 	initialized bool
 
@@ -190,7 +196,8 @@ func (p *processor) AddTableSpan(
 	if p.redo.r.Enabled() {
 		p.redo.r.AddTable(span, startTs)
 	}
-	p.sourceManager.r.AddTable(span, p.getTableName(ctx, span.TableID), startTs)
+	p.sourceManager.r.AddTable(
+		span, p.getTableName(ctx, span.TableID), startTs, p.needOldValue(span.TableID))
 
 	return true, nil
 }
@@ -231,6 +238,7 @@ func (p *processor) IsAddTableSpanFinished(span tablepb.Span, isPrepare bool) bo
 			stats := p.sinkManager.r.GetTableStats(span)
 			tableResolvedTs = stats.ResolvedTs
 			tableCheckpointTs = stats.CheckpointTs
+			state = p.adjustPreparedState(state, stats)
 		} else {
 			log.Panic("table which was added is not found",
 				zap.String("captureID", p.captureInfo.ID),
@@ -315,6 +323,11 @@ func (p *processor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool
 	}
 	p.sinkManager.r.RemoveTable(span)
 	p.sourceManager.r.RemoveTable(span)
+	if !p.hasTableSpans(span.TableID) {
+		// Delete the series of the table which is moved out of the processor.
+		pkgmetrics.GetRegistry().DeleteTableSeries(p.changefeedID.Namespace,
+			p.changefeedID.ID, p.getTableName(context.Background(), span.TableID))
+	}
 	log.Info("table removed",
 		zap.String("captureID", p.captureInfo.ID),
 		zap.String("namespace", p.changefeedID.Namespace),
@@ -336,6 +349,7 @@ func (p *processor) GetTableSpanStatus(span tablepb.Span, collectStat bool) tabl
 		}
 	}
 	sinkStats := p.sinkManager.r.GetTableStats(span)
+	state = p.adjustPreparedState(state, sinkStats)
 	stats := tablepb.Stats{}
 	if collectStat {
 		stats = p.getStatsFromSourceManagerAndSinkManager(span, sinkStats)
@@ -347,9 +361,52 @@ func (p *processor) GetTableSpanStatus(span tablepb.Span, collectStat bool) tabl
 			CheckpointTs: sinkStats.CheckpointTs,
 			ResolvedTs:   sinkStats.ResolvedTs,
 		},
-		State: state,
-		Stats: stats,
+		State:           state,
+		Stats:           stats,
+		Error:           p.getTableError(span, sinkStats),
+		ReplicationMode: p.getReplicationMode(span),
+	}
+}
+
+// getTableError returns the error of the puller or the sink of the table.
+// The category is left unknown, the agent fills it by the table state.
+func (p *processor) getTableError(
+	span tablepb.Span, sinkStats sinkmanager.TableStats,
+) *tablepb.TableError {
+	err := p.sourceManager.r.GetTablePullerError(span)
+	if err == nil {
+		err = sinkStats.Error
+	}
+	if err == nil {
+		return nil
+	}
+	return &tablepb.TableError{Message: err.Error()}
+}
+
+// getReplicationMode returns the replication mode of the table, the table
+// is in the initial scan until its puller is initialized.
+func (p *processor) getReplicationMode(span tablepb.Span) tablepb.ReplicationMode {
+	if !p.sourceManager.r.GetTablePullerStats(span).Initialized {
+		return tablepb.ReplicationModeInitialScan
 	}
+	return tablepb.ReplicationModeIncremental
+}
+
+// adjustPreparedState reports a prepared table as preparing until the events
+// received from the sorter catch up with the checkpoint of the changefeed.
+// So when the table is moved to this capture, the replication can continue
+// right after the original capture stops the table, instead of waiting for
+// the incremental scan from the checkpoint.
+func (p *processor) adjustPreparedState(
+	state tablepb.TableState, stats sinkmanager.TableStats,
+) tablepb.TableState {
+	if state != tablepb.TableStatePrepared || p.changefeed.Status == nil {
+		return state
+	}
+	if stats.ReceivedMaxResolvedTs < p.changefeed.Status.CheckpointTs {
+		return tablepb.TableStatePreparing
+	}
+	return state
 }
 
 func (p *processor) getStatsFromSourceManagerAndSinkManager(
@@ -359,9 +416,29 @@ func (p *processor) getStatsFromSourceManagerAndSinkManager(
 	now, _ := p.upstream.PDClock.CurrentTime()
 
 	stats := tablepb.Stats{
-		RegionCount: pullerStats.RegionCount,
-		CurrentTs:   oracle.ComposeTS(oracle.GetPhysical(now), 0),
-		BarrierTs:   sinkStats.BarrierTs,
+		RegionCount:         pullerStats.RegionCount,
+		CurrentTs:           oracle.ComposeTS(oracle.GetPhysical(now), 0),
+		BarrierTs:           sinkStats.BarrierTs,
+		MQPositions:         sinkStats.MQPositions,
+		SinkWriteLatency:    sinkStats.WriteLatency,
+		BufferedEventCount:  sinkStats.BufferedEventCount,
+		WorkerHealth:        sinkStats.WorkerHealth,
+		ConflictCount:       sinkStats.ConflictCount,
+		RetryCount:          sinkStats.RetryCount,
+		LargeTxn:            sinkStats.LargeTxn,
+		EventTimeSkew:       pullerStats.EventTimeSkew,
+		BatchFlush:          sinkStats.BatchFlush,
+		UnavailableRegions:  pullerStats.UnavailableRegions,
+		MemoryQuota:         sinkStats.MemoryQuota,
+		DMLRowCount:         sinkStats.DMLRowCount,
+		DDLEventCount:       sinkStats.DDLEventCount,
+		CyclicFilteredCount: pullerStats.CyclicFilteredCount,
+		CheckpointSource:    sinkStats.CheckpointSource,
+		SinkConnectionPool:  sinkStats.ConnectionPool,
+		SinkIdempotency:     sinkStats.Idempotency,
+		SinkCausality:       sinkStats.Causality,
+		SchemaCompatibility: sinkStats.SchemaCompatibility,
+		ErrorRecovery:       sinkStats.ErrorRecovery,
 		StageCheckpoints: map[string]tablepb.Checkpoint{
 			"puller-ingress": {
 				CheckpointTs: pullerStats.CheckpointTsIngress,
@@ -379,6 +456,7 @@ func (p *processor) getStatsFromSourceManagerAndSinkManager(
 	}
 
 	sortStats := p.sourceManager.r.GetTableSorterStats(span)
+	stats.SorterSpill = sortStats.Spill
 	stats.StageCheckpoints["sorter-ingress"] = tablepb.Checkpoint{
 		CheckpointTs: sortStats.ReceivedMaxCommitTs,
 		ResolvedTs:   sortStats.ReceivedMaxResolvedTs,
@@ -565,10 +643,30 @@ func (p *processor) tick(ctx cdcContext.Context) error {
 		p.updateBarrierTs(barrier)
 	}
 	p.doGCSchemaStorage()
+	p.updateSubscriptionResolvedTs()
+	p.updateReplicaConfig()
 
 	return nil
 }
 
+// updateReplicaConfig applies the replica config to the sink manager when the
+// changefeed info is updated, so that settings like the memory quota can be
+// updated online.
+func (p *processor) updateReplicaConfig() {
+	if p.sinkManager.r == nil || p.appliedInfo == p.changefeed.Info {
+		return
+	}
+	p.sinkManager.r.UpdateReplicaConfig(p.changefeed.Info.Config)
+	p.appliedInfo = p.changefeed.Info
+}
+
+// kvClientConfig returns the kv client config of the server overridden by the
+// kv client config of the changefeed.
+func (p *processor) kvClientConfig() *config.KVClientConfig {
+	return config.GetGlobalServerConfig().KVClient.
+		WithReplicaConfig(p.changefeed.Info.Config.KVClient)
+}
+
 // checkChangefeedNormal checks if the changefeed is runnable.
 func (p *processor) checkChangefeedNormal() bool {
 	// check the state in this tick, make sure that the admin job type of the changefeed is not stopped
@@ -661,7 +759,7 @@ func (p *processor) lazyInitImpl(etcdCtx cdcContext.Context) (err error) {
 
 	p.sourceManager.r = sourcemanager.New(
 		p.changefeedID, p.upstream, p.mg.r,
-		sortEngine, p.changefeed.Info.Config.BDRMode)
+		sortEngine, p.changefeed.Info.Config.BDRMode, p.kvClientConfig())
 	p.sourceManager.name = "SourceManager"
 	p.sourceManager.spawn(stdCtx)
 
@@ -760,7 +858,7 @@ func (p *processor) initDDLHandler(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 
-	kvCfg := config.GetGlobalServerConfig().KVClient
+	kvCfg := p.kvClientConfig()
 	ctx = contextutil.PutTableInfoInCtx(ctx, -1, puller.DDLPullerTableName)
 	ddlPuller, err := puller.NewDDLJobPuller(
 		ctx,
@@ -830,6 +928,25 @@ func (p *processor) getTableName(ctx context.Context, tableID model.TableID) str
 	return tableName.QuoteString()
 }
 
+// hasTableSpans returns whether there are spans of the table in the processor.
+func (p *processor) hasTableSpans(tableID model.TableID) bool {
+	for _, span := range p.sinkManager.r.GetAllCurrentTableSpans() {
+		if span.TableID == tableID {
+			return true
+		}
+	}
+	return false
+}
+
+// needOldValue returns whether the old values of the table need to be pulled.
+func (p *processor) needOldValue(tableID model.TableID) bool {
+	tableInfo, ok := p.ddlHandler.r.schemaStorage.GetLastSnapshot().PhysicalTableByID(tableID)
+	if !ok {
+		return true
+	}
+	return entry.NeedOldValue(p.changefeed.Info.Config, tableInfo)
+}
+
 func (p *processor) removeTable(span tablepb.Span) {
 	if p.redo.r.Enabled() {
 		p.redo.r.RemoveTable(span)
@@ -866,6 +983,17 @@ func (p *processor) doGCSchemaStorage() {
 	p.metricSchemaStorageGcTsGauge.Set(float64(lastSchemaPhysicalTs))
 }
 
+// updateSubscriptionResolvedTs pushes the checkpoint ts of the changefeed to
+// the consumers of the subscription sink on this capture. All events before
+// the checkpoint ts must have been acknowledged by the consumers.
+func (p *processor) updateSubscriptionResolvedTs() {
+	if p.changefeed.Status == nil {
+		// This could happen if Etcd data is not complete.
+		return
+	}
+	subscription.UpdateResolvedTs(p.changefeedID, p.changefeed.Status.CheckpointTs)
+}
+
 func (p *processor) refreshMetrics() {
 	// Before the processor is initialized, we should not refresh metrics.
 	// Otherwise, it will cause panic.
@@ -953,6 +1081,37 @@ func (p *processor) WriteDebugInfo(w io.Writer) error {
 	return nil
 }
 
+// debugInfo returns the state of the processor.
+func (p *processor) debugInfo() *model.ProcessorDebugInfo {
+	info := &model.ProcessorDebugInfo{
+		ChangefeedID: p.changefeedID,
+		Initialized:  p.initialized,
+	}
+	if !p.initialized {
+		return info
+	}
+	spans := p.sinkManager.r.GetAllCurrentTableSpans()
+	sort.Slice(spans, func(i, j int) bool { return spans[i].Less(&spans[j]) })
+	info.Tables = make([]*model.TableSpanDebugInfo, 0, len(spans))
+	for i := range spans {
+		state, _ := p.sinkManager.r.GetTableState(spans[i])
+		stats := p.sinkManager.r.GetTableStats(spans[i])
+		info.Tables = append(info.Tables, &model.TableSpanDebugInfo{
+			TableID:               spans[i].TableID,
+			Span:                  spans[i].String(),
+			State:                 state.String(),
+			CheckpointTs:          stats.CheckpointTs,
+			ResolvedTs:            stats.ResolvedTs,
+			BarrierTs:             stats.BarrierTs,
+			ReceivedMaxCommitTs:   stats.ReceivedMaxCommitTs,
+			ReceivedMaxResolvedTs: stats.ReceivedMaxResolvedTs,
+		})
+	}
+	info.SinkBacklogEvents = p.sourceManager.r.ReceivedEvents() - p.sinkManager.r.ReceivedEvents()
+	info.SinkMemoryUsage, info.SinkMemoryQuota, info.RedoMemoryUsage = p.sinkManager.r.MemoryUsage()
+	return info
+}
+
 func (p *processor) calculateTableBarrierTs(
 	barrier *schedulepb.Barrier,
 ) map[model.TableID]model.Ts {
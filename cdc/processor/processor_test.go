@@ -20,6 +20,7 @@ import (
 	"math"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -253,6 +254,37 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, tablepb.TableStatePrepared, state)
 
+	// The table is not prepared until it catches up with the checkpoint
+	// of the changefeed.
+	p.changefeed.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+		status.CheckpointTs = 120
+		status.ResolvedTs = 120
+		return status, true, nil
+	})
+	tester.MustApplyPatches()
+	done = p.IsAddTableSpanFinished(span, true)
+	require.False(t, done)
+	require.Equal(t, tablepb.TableStatePreparing, p.GetTableSpanStatus(span, false).State)
+	// The puller for test never finishes the initial scan.
+	require.Equal(t, tablepb.ReplicationModeInitialScan,
+		p.GetTableSpanStatus(span, false).ReplicationMode)
+	p.sourceManager.r.Add(
+		span,
+		[]*model.PolymorphicEvent{{
+			CRTs: 121,
+			RawKV: &model.RawKVEntry{
+				OpType: model.OpTypeResolved,
+				CRTs:   121,
+			},
+		}}...,
+	)
+	err = p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+	done = p.IsAddTableSpanFinished(span, true)
+	require.True(t, done)
+	require.Equal(t, tablepb.TableStatePrepared, p.GetTableSpanStatus(span, false).State)
+
 	ok, err = p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(1), 30, true)
 	require.NoError(t, err)
 	require.True(t, ok)
@@ -260,7 +292,7 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	require.Equal(t, model.Ts(20), stats.CheckpointTs)
 	require.Equal(t, model.Ts(20), stats.BarrierTs)
 	require.Equal(t, model.Ts(0), stats.ReceivedMaxCommitTs)
-	require.Equal(t, model.Ts(101), stats.ReceivedMaxResolvedTs)
+	require.Equal(t, model.Ts(121), stats.ReceivedMaxResolvedTs)
 
 	// Start to replicate table-1.
 	ok, err = p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(1), 30, false)
@@ -276,6 +308,13 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, tablepb.TableStateReplicating, state)
 
+	info := p.debugInfo()
+	require.True(t, info.Initialized)
+	require.Len(t, info.Tables, 1)
+	require.Equal(t, model.TableID(1), info.Tables[0].TableID)
+	require.Equal(t, tablepb.TableStateReplicating.String(), info.Tables[0].State)
+	require.Equal(t, model.Ts(121), info.Tables[0].ReceivedMaxResolvedTs)
+
 	err = p.Close()
 	require.Nil(t, err)
 	require.Nil(t, p.agent)
@@ -587,6 +626,53 @@ func TestUpdateBarrierTs(t *testing.T) {
 	tester.MustApplyPatches()
 }
 
+func TestTableSpanStatusError(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	// Do a no operation tick to lazy init the processor.
+	err = p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	done, err := p.AddTableSpan(ctx, span, 5, false)
+	require.True(t, done)
+	require.Nil(t, err)
+	require.Nil(t, p.GetTableSpanStatus(span, false).Error)
+
+	// The memory sort engine doesn't collect stats, so the error recovery is
+	// checked in the table stats of the sink manager.
+	require.Equal(t, tablepb.ErrorRecovery{},
+		p.sinkManager.r.GetTableStats(span).ErrorRecovery)
+
+	errAt := time.Now()
+	p.sinkManager.r.SetTableSinkError4Test(span, errors.New("sink write failed"))
+	status := p.GetTableSpanStatus(span, false)
+	require.Equal(t, &tablepb.TableError{Message: "sink write failed"}, status.Error)
+	errorRecovery := p.sinkManager.r.GetTableStats(span).ErrorRecovery
+	require.GreaterOrEqual(t, errorRecovery.LastErrorMs, errAt.UnixMilli())
+	require.Zero(t, errorRecovery.RecoveryMs)
+
+	// The error is gone once the table sink recovers, and the time to
+	// recover is reported.
+	time.Sleep(20 * time.Millisecond)
+	p.sinkManager.r.SetTableSinkError4Test(span, nil)
+	require.Nil(t, p.GetTableSpanStatus(span, false).Error)
+	errorRecovery = p.sinkManager.r.GetTableStats(span).ErrorRecovery
+	require.GreaterOrEqual(t, errorRecovery.LastErrorMs, errAt.UnixMilli())
+	require.GreaterOrEqual(t, errorRecovery.RecoveryMs, int64(20))
+
+	require.Nil(t, p.Close())
+	tester.MustApplyPatches()
+}
+
 func TestProcessorLiveness(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(true)
 	liveness := model.LivenessCaptureAlive
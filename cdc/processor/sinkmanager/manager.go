@@ -28,8 +28,10 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/redo"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/factory"
 	"github.com/pingcap/tiflow/cdc/sink/metrics/tablesink"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/spanz"
@@ -54,9 +56,34 @@ type TableStats struct {
 	CheckpointTs model.Ts
 	ResolvedTs   model.Ts
 	BarrierTs    model.Ts
+	// WriteLatency is the latency of writing events to the backend sink.
+	WriteLatency tablepb.Latency
+	// BufferedEventCount is the number of events received from the sorter
+	// and not acknowledged by the backend sink yet.
+	BufferedEventCount uint64
+	// WorkerHealth is the health of the sink workers serving all tables.
+	WorkerHealth tablepb.WorkerHealth
+	// LargeTxn is the large transaction that holds back the checkpoint.
+	LargeTxn tablepb.LargeTxn
+	// MemoryQuota is the usage of the sink memory quota.
+	MemoryQuota tablepb.MemoryQuota
+	// DMLRowCount and DDLEventCount are the cumulative numbers of rows
+	// written to the table sink and DDLs of the table.
+	DMLRowCount   uint64
+	DDLEventCount uint64
+	// CheckpointSource is the mechanism that holds the checkpoint.
+	CheckpointSource tablepb.WatermarkSource
+	// Error is the latest error of writing the table sink, nil if the table
+	// sink has been advanced successfully since then.
+	Error error
+	// ErrorRecovery is the time of the last error of the table sink and the
+	// time it took to recover.
+	ErrorRecovery tablepb.ErrorRecovery
 	// From sorter.
 	ReceivedMaxCommitTs   model.Ts
 	ReceivedMaxResolvedTs model.Ts
+	// From the backend sink.
+	dmlsink.TableStats
 }
 
 // SinkManager is the implementation of SinkManager.
@@ -95,6 +122,8 @@ type SinkManager struct {
 	sinkWorkerAvailable chan struct{}
 	// sinkMemQuota is used to control the total memory usage of the table sink.
 	sinkMemQuota *memquota.MemQuota
+	// throttler limits the rows and bytes emitted by all sink workers.
+	throttler *sinkThrottler
 
 	// redoWorkers used to pull data from source manager.
 	redoWorkers []*redoWorker
@@ -142,6 +171,9 @@ func New(
 
 		metricsTableSinkTotalRows: tablesink.TotalRowsCountCounter.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		// All sink workers share the throttler, so that the limits are applied
+		// to the whole changefeed.
+		throttler: newSinkThrottler(changefeedInfo.Config.Sink),
 	}
 
 	if redoDMLMgr != nil && redoDMLMgr.Enabled() {
@@ -151,13 +183,13 @@ func New(
 		m.redoTaskChan = make(chan *redoTask)
 		m.redoWorkerAvailable = make(chan struct{}, 1)
 
-		// Use 3/4 memory quota as redo quota, and 1/2 again for redo cache.
-		m.sinkMemQuota = memquota.NewMemQuota(changefeedID, changefeedInfo.Config.MemoryQuota/4*1, "sink")
-		redoQuota := changefeedInfo.Config.MemoryQuota / 4 * 3
+		sinkQuota, redoQuota := splitMemoryQuota(changefeedInfo.Config.MemoryQuota, true)
+		m.sinkMemQuota = memquota.NewMemQuota(changefeedID, sinkQuota, "sink")
 		m.redoMemQuota = memquota.NewMemQuota(changefeedID, redoQuota, "redo")
 		m.eventCache = newRedoEventCache(changefeedID, redoQuota/2*1)
 	} else {
-		m.sinkMemQuota = memquota.NewMemQuota(changefeedID, changefeedInfo.Config.MemoryQuota, "sink")
+		sinkQuota, _ := splitMemoryQuota(changefeedInfo.Config.MemoryQuota, false)
+		m.sinkMemQuota = memquota.NewMemQuota(changefeedID, sinkQuota, "sink")
 		m.redoMemQuota = memquota.NewMemQuota(changefeedID, 0, "redo")
 	}
 
@@ -165,6 +197,29 @@ func New(
 	return m
 }
 
+// splitMemoryQuota splits the memory quota of the changefeed into the sink
+// quota and the redo quota.
+func splitMemoryQuota(memoryQuota uint64, redoEnabled bool) (sinkQuota, redoQuota uint64) {
+	if !redoEnabled {
+		return memoryQuota, 0
+	}
+	// Use 3/4 memory quota as redo quota, and 1/2 again for redo cache.
+	return memoryQuota / 4 * 1, memoryQuota / 4 * 3
+}
+
+// UpdateReplicaConfig applies the settings of the replica config which can be
+// updated without restarting the sink manager, i.e., the memory quota and the
+// rows and bytes limits of the sink. The size of the redo event cache is not
+// changed.
+func (m *SinkManager) UpdateReplicaConfig(cfg *config.ReplicaConfig) {
+	sinkQuota, redoQuota := splitMemoryQuota(cfg.MemoryQuota, m.redoDMLMgr != nil)
+	m.sinkMemQuota.SetTotalBytes(sinkQuota)
+	if m.redoDMLMgr != nil {
+		m.redoMemQuota.SetTotalBytes(redoQuota)
+	}
+	m.throttler.update(cfg.Sink)
+}
+
 // Run implements util.Runnable.
 func (m *SinkManager) Run(ctx context.Context) (err error) {
 	var managerCancel, taskCancel context.CancelFunc
@@ -269,7 +324,7 @@ func (m *SinkManager) startSinkWorkers(ctx context.Context, splitTxn bool, enabl
 	for i := 0; i < sinkWorkerNum; i++ {
 		w := newSinkWorker(m.changefeedID, m.sourceManager,
 			m.sinkMemQuota, m.redoMemQuota,
-			m.eventCache, splitTxn, enableOldValue)
+			m.eventCache, splitTxn, enableOldValue, m.throttler)
 		m.sinkWorkers = append(m.sinkWorkers, w)
 		eg.Go(func() error { return w.handleTasks(ctx, m.sinkTaskChan) })
 	}
@@ -666,6 +721,11 @@ func (m *SinkManager) UpdateBarrierTs(
 		// Other goroutines will only read the barrier ts.
 		// So it is safe to do not use compare and swap here, just Load and Store.
 		if tableBarrierTs, ok := tableBarrier[tableSink.span.TableID]; ok {
+			// A table barrier is a DDL of the table.
+			if tableBarrierTs > tableSink.tableBarrierTs.Load() {
+				tableSink.tableBarrierTs.Store(tableBarrierTs)
+				tableSink.ddlEventCount.Add(1)
+			}
 			barrierTs := tableBarrierTs
 			if barrierTs > globalBarrierTs {
 				barrierTs = globalBarrierTs
@@ -876,16 +936,66 @@ func (m *SinkManager) GetTableStats(span tablepb.Span) TableStats {
 	} else {
 		resolvedTs = m.sourceManager.GetTableResolvedTs(span)
 	}
+	barrierTs := tableSink.barrierTs.Load()
+	checkpointSource := getCheckpointSource(
+		checkpointTs.ResolvedMark(), resolvedTs, barrierTs)
+	tableSinkStats := tableSink.getTableSinkStats()
 
 	return TableStats{
 		CheckpointTs:          checkpointTs.ResolvedMark(),
 		ResolvedTs:            resolvedTs,
-		BarrierTs:             tableSink.barrierTs.Load(),
+		BarrierTs:             barrierTs,
+		WriteLatency:          tableSink.writeLatency.Summary(),
+		BufferedEventCount:    tableSinkStats.BufferedEvents,
+		WorkerHealth:          m.getWorkerHealth(),
+		LargeTxn:              tableSinkStats.LargeTxn,
+		MemoryQuota:           m.sinkMemQuota.GetTableUsage(span),
+		DMLRowCount:           tableSinkStats.Rows,
+		DDLEventCount:         tableSink.ddlEventCount.Load(),
+		CheckpointSource:      checkpointSource,
+		Error:                 tableSink.getError(),
+		ErrorRecovery:         tableSink.getErrorRecovery(),
 		ReceivedMaxCommitTs:   tableSink.getReceivedSorterCommitTs(),
 		ReceivedMaxResolvedTs: tableSink.getReceivedSorterResolvedTs(),
+		TableStats:            m.sinkFactory.GetTableStats(span),
+	}
+}
+
+// getCheckpointSource returns the mechanism that holds the checkpoint of a
+// table. The checkpoint is held by the barrier or the puller if it has caught
+// up with them, otherwise it's held by the sink acknowledgement. A zero
+// barrierTs means the barrier is not known yet, it holds nothing.
+func getCheckpointSource(
+	checkpointTs, resolvedTs, barrierTs model.Ts,
+) tablepb.WatermarkSource {
+	switch {
+	case checkpointTs == 0:
+		return tablepb.WatermarkSourceUnknown
+	case barrierTs != 0 && checkpointTs >= barrierTs:
+		return tablepb.WatermarkSourceBarrier
+	case checkpointTs >= resolvedTs:
+		return tablepb.WatermarkSourcePullerResolved
+	default:
+		return tablepb.WatermarkSourceSinkAcknowledged
 	}
 }
 
+// getWorkerHealth returns the health of the sink workers.
+func (m *SinkManager) getWorkerHealth() tablepb.WorkerHealth {
+	health := tablepb.WorkerHealth{}
+	now := time.Now()
+	for _, w := range m.sinkWorkers {
+		if !w.alive.Load() {
+			continue
+		}
+		health.AliveWorkers++
+		if w.isStuck(now) {
+			health.StuckWorkers++
+		}
+	}
+	return health
+}
+
 // ReceivedEvents returns the number of events received by all table sinks.
 func (m *SinkManager) ReceivedEvents() int64 {
 	totalReceivedEvents := int64(0)
@@ -896,6 +1006,13 @@ func (m *SinkManager) ReceivedEvents() int64 {
 	return totalReceivedEvents
 }
 
+// MemoryUsage returns the memory used by the sink and the redo, and the
+// memory quota of the sink.
+func (m *SinkManager) MemoryUsage() (sinkUsed, sinkQuota, redoUsed uint64) {
+	return m.sinkMemQuota.GetUsedBytes(), m.sinkMemQuota.GetTotalBytes(),
+		m.redoMemQuota.GetUsedBytes()
+}
+
 // WaitForReady implements pkg/util.Runnable.
 func (m *SinkManager) WaitForReady(ctx context.Context) {
 	select {
@@ -258,6 +258,151 @@ func TestGetTableStatsToReleaseMemQuota(t *testing.T) {
 	}, 5*time.Second, 10*time.Millisecond)
 }
 
+func TestGetTableStatsMemoryQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changefeedInfo := getChangefeedInfo()
+	manager, _, e := CreateManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"),
+		changefeedInfo, make(chan error, 1))
+	defer func() {
+		cancel()
+		manager.Close()
+	}()
+
+	span := spanz.TableIDToComparableSpan(1)
+	manager.AddTable(span, 1, 100)
+	addTableAndAddEventsToSortEngine(t, e, span)
+	quota := manager.sinkMemQuota.GetTotalBytes()
+	require.Equal(t, tablepb.MemoryQuota{QuotaBytes: quota},
+		manager.GetTableStats(span).MemoryQuota)
+
+	// The table uses up the quota.
+	manager.sinkMemQuota.ForceAcquire(quota)
+	manager.sinkMemQuota.Record(span, model.NewResolvedTs(100), quota)
+	require.Equal(t, tablepb.MemoryQuota{Exhausted: true, UsedBytes: quota, QuotaBytes: quota},
+		manager.GetTableStats(span).MemoryQuota)
+
+	// The exhaustion is cleared after the memory is released.
+	manager.sinkMemQuota.Release(span, model.NewResolvedTs(100))
+	require.Equal(t, tablepb.MemoryQuota{QuotaBytes: quota},
+		manager.GetTableStats(span).MemoryQuota)
+}
+
+func TestGetTableStatsEventCounts(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changefeedInfo := getChangefeedInfo()
+	manager, _, e := CreateManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"),
+		changefeedInfo, make(chan error, 1))
+	defer func() {
+		cancel()
+		manager.Close()
+	}()
+
+	span := spanz.TableIDToComparableSpan(1)
+	manager.AddTable(span, 1, 100)
+	addTableAndAddEventsToSortEngine(t, e, span)
+
+	// The DDL of the table blocks it at 4.
+	manager.UpdateBarrierTs(10, map[model.TableID]model.Ts{1: 4})
+	manager.UpdateReceivedSorterResolvedTs(span, 5)
+	manager.schemaStorage.AdvanceResolvedTs(5)
+	err := manager.StartTable(span, 0)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		s := manager.GetTableStats(span)
+		return s.CheckpointTs == 4 && s.DMLRowCount == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(1), manager.GetTableStats(span).DDLEventCount)
+
+	// The same DDL is not counted again.
+	manager.UpdateBarrierTs(10, map[model.TableID]model.Ts{1: 4})
+	require.Equal(t, uint64(1), manager.GetTableStats(span).DDLEventCount)
+	manager.UpdateBarrierTs(10, map[model.TableID]model.Ts{1: 8})
+	require.Equal(t, uint64(2), manager.GetTableStats(span).DDLEventCount)
+	manager.UpdateBarrierTs(10, map[model.TableID]model.Ts{2: 9})
+	require.Equal(t, uint64(2), manager.GetTableStats(span).DDLEventCount)
+}
+
+func TestGetTableStatsCheckpointSource(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changefeedInfo := getChangefeedInfo()
+	manager, _, e := CreateManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"),
+		changefeedInfo, make(chan error, 1))
+	defer func() {
+		cancel()
+		manager.Close()
+	}()
+
+	span := spanz.TableIDToComparableSpan(1)
+	manager.AddTable(span, 1, 100)
+	addTableAndAddEventsToSortEngine(t, e, span)
+
+	// The table is blocked by the barrier.
+	manager.UpdateBarrierTs(10, map[model.TableID]model.Ts{1: 4})
+	manager.UpdateReceivedSorterResolvedTs(span, 4)
+	manager.schemaStorage.AdvanceResolvedTs(5)
+	err := manager.StartTable(span, 0)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return manager.GetTableStats(span).CheckpointTs == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, tablepb.WatermarkSourceBarrier,
+		manager.GetTableStats(span).CheckpointSource)
+
+	// The barrier is lifted, the table waits for the puller.
+	manager.UpdateBarrierTs(10, map[model.TableID]model.Ts{1: 8})
+	require.Equal(t, tablepb.WatermarkSourcePullerResolved,
+		manager.GetTableStats(span).CheckpointSource)
+}
+
+func TestGetCheckpointSource(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, tablepb.WatermarkSourceUnknown, getCheckpointSource(0, 5, 10))
+	require.Equal(t, tablepb.WatermarkSourceBarrier, getCheckpointSource(5, 8, 5))
+	require.Equal(t, tablepb.WatermarkSourcePullerResolved, getCheckpointSource(5, 5, 10))
+	require.Equal(t, tablepb.WatermarkSourceSinkAcknowledged, getCheckpointSource(3, 5, 10))
+	// The barrier is not known yet.
+	require.Equal(t, tablepb.WatermarkSourcePullerResolved, getCheckpointSource(5, 5, 0))
+	require.Equal(t, tablepb.WatermarkSourceSinkAcknowledged, getCheckpointSource(3, 5, 0))
+}
+
+func TestGetTableStatsWorkerHealth(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changefeedInfo := getChangefeedInfo()
+	manager, _, e := CreateManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"),
+		changefeedInfo, make(chan error, 1))
+	defer manager.Close()
+
+	span := spanz.TableIDToComparableSpan(1)
+	manager.AddTable(span, 1, 100)
+	addTableAndAddEventsToSortEngine(t, e, span)
+	require.Eventually(t, func() bool {
+		s := manager.GetTableStats(span)
+		return s.WorkerHealth == tablepb.WorkerHealth{AliveWorkers: sinkWorkerNum}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A worker handles a task for too long.
+	manager.sinkWorkers[0].busySince.Store(
+		time.Now().Add(-stuckWorkerThreshold).UnixNano())
+	require.Equal(t, tablepb.WorkerHealth{AliveWorkers: sinkWorkerNum, StuckWorkers: 1},
+		manager.GetTableStats(span).WorkerHealth)
+
+	// All workers exit.
+	cancel()
+	require.Eventually(t, func() bool {
+		return manager.GetTableStats(span).WorkerHealth == tablepb.WorkerHealth{}
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestDoNotGenerateTableSinkTaskWhenTableIsNotReplicating(t *testing.T) {
 	t.Parallel()
 
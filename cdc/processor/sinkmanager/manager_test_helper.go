@@ -19,13 +19,16 @@ import (
 	"testing"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/memory"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
 )
 
 // MockPD only for test.
@@ -65,7 +68,7 @@ func CreateManagerWithMemEngine(
 	mg := &entry.MockMountGroup{}
 	schemaStorage := &entry.MockSchemaStorage{Resolved: math.MaxUint64}
 
-	sourceManager := sourcemanager.NewForTest(changefeedID, up, mg, sortEngine, false)
+	sourceManager := sourcemanager.NewForTest(changefeedID, up, mg, sortEngine, false, nil)
 	go func() { handleError(sourceManager.Run(ctx)) }()
 	sourceManager.WaitForReady(ctx)
 
@@ -87,7 +90,20 @@ func NewManagerWithMemEngine(
 	up := upstream.NewUpstream4Test(&MockPD{})
 	mg := &entry.MockMountGroup{}
 	schemaStorage := &entry.MockSchemaStorage{Resolved: math.MaxUint64}
-	sourceManager := sourcemanager.NewForTest(changefeedID, up, mg, sortEngine, false)
+	sourceManager := sourcemanager.NewForTest(changefeedID, up, mg, sortEngine, false, nil)
 	sinkManager := New(changefeedID, changefeedInfo, up, schemaStorage, nil, sourceManager)
 	return sinkManager, sourceManager, sortEngine
 }
+
+// SetTableSinkError4Test sets the error of the table sink as if it fails to
+// be written, a nil err means the table sink is advanced successfully.
+func (m *SinkManager) SetTableSinkError4Test(span tablepb.Span, err error) {
+	value, ok := m.tableSinks.Load(span)
+	if !ok {
+		log.Panic("Table sink not found when setting table sink error",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Stringer("span", &span))
+	}
+	value.(*tableSinkWrapper).setError(err)
+}
@@ -60,7 +60,7 @@ var (
 )
 
 // InitMetrics registers all metrics in this file.
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(RedoEventCache)
 	registry.MustRegister(RedoEventCacheAccess)
 	registry.MustRegister(outputEventCount)
@@ -0,0 +1,106 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinkmanager
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/config"
+	"golang.org/x/time/rate"
+)
+
+// sinkThrottler limits the rows and bytes emitted to table sinks of a
+// changefeed. It is shared by all sink workers of the changefeed.
+type sinkThrottler struct {
+	// limiters are replaced as a whole when the limits are updated, so that
+	// waiting workers are not affected by the change of the burst.
+	rowsLimiter  atomic.Pointer[rate.Limiter]
+	bytesLimiter atomic.Pointer[rate.Limiter]
+}
+
+// newSinkThrottler creates a sinkThrottler from the sink config. Rows and
+// bytes are not limited if they are not configured.
+func newSinkThrottler(cfg *config.SinkConfig) *sinkThrottler {
+	t := &sinkThrottler{}
+	t.update(cfg)
+	return t
+}
+
+// update applies the limits of the sink config, it can be called when the
+// sink workers are running.
+func (t *sinkThrottler) update(cfg *config.SinkConfig) {
+	var maxRows, maxBytes uint64
+	if cfg != nil && cfg.MaxRowsPerSecond != nil {
+		maxRows = *cfg.MaxRowsPerSecond
+	}
+	if cfg != nil && cfg.MaxBytesPerSecond != nil {
+		maxBytes = *cfg.MaxBytesPerSecond
+	}
+	updateLimiter(&t.rowsLimiter, maxRows)
+	updateLimiter(&t.bytesLimiter, maxBytes)
+}
+
+// updateLimiter replaces the limiter if its limit is changed.
+func updateLimiter(limiter *atomic.Pointer[rate.Limiter], limit uint64) {
+	newLimiter := newLimiter(limit)
+	if old := limiter.Load(); old != nil &&
+		old.Limit() == newLimiter.Limit() && old.Burst() == newLimiter.Burst() {
+		return
+	}
+	limiter.Store(newLimiter)
+}
+
+// newLimiter creates a limiter allowing limit tokens per second with a burst
+// of one second, 0 means unlimited.
+func newLimiter(limit uint64) *rate.Limiter {
+	if limit == 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	burst := int(math.Min(float64(limit), math.MaxInt32))
+	return rate.NewLimiter(rate.Limit(limit), burst)
+}
+
+// wait blocks until rows and bytes are allowed to be emitted.
+// It is safe to call wait on a nil sinkThrottler.
+func (t *sinkThrottler) wait(ctx context.Context, rows int, bytes uint64) error {
+	if t == nil {
+		return nil
+	}
+	if err := waitN(ctx, t.rowsLimiter.Load(), uint64(rows)); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(waitN(ctx, t.bytesLimiter.Load(), bytes))
+}
+
+// waitN is like rate.Limiter.WaitN, but n can be larger than the burst.
+func waitN(ctx context.Context, limiter *rate.Limiter, n uint64) error {
+	if limiter.Limit() == rate.Inf {
+		return nil
+	}
+	burst := uint64(limiter.Burst())
+	for n > 0 {
+		step := n
+		if step > burst {
+			step = burst
+		}
+		if err := limiter.WaitN(ctx, int(step)); err != nil {
+			return err
+		}
+		n -= step
+	}
+	return nil
+}
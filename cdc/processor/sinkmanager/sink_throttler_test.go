@@ -0,0 +1,93 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinkmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestNewSinkThrottler(t *testing.T) {
+	t.Parallel()
+
+	// A nil throttler never blocks.
+	var throttler *sinkThrottler
+	require.Nil(t, throttler.wait(context.Background(), 1000, 1000))
+
+	zero := uint64(0)
+	for _, cfg := range []*config.SinkConfig{
+		nil, {}, {MaxRowsPerSecond: &zero, MaxBytesPerSecond: &zero},
+	} {
+		throttler = newSinkThrottler(cfg)
+		require.Equal(t, rate.Inf, throttler.rowsLimiter.Load().Limit())
+		require.Equal(t, rate.Inf, throttler.bytesLimiter.Load().Limit())
+		require.Nil(t, throttler.wait(context.Background(), 1000000, 1000000))
+	}
+
+	maxBytes := uint64(1024)
+	throttler = newSinkThrottler(&config.SinkConfig{MaxBytesPerSecond: &maxBytes})
+	require.Equal(t, rate.Limit(1024), throttler.bytesLimiter.Load().Limit())
+	require.Equal(t, 1024, throttler.bytesLimiter.Load().Burst())
+	// Rows are not limited.
+	require.Nil(t, throttler.wait(context.Background(), 1000000, 0))
+}
+
+func TestSinkThrottlerUpdate(t *testing.T) {
+	t.Parallel()
+
+	throttler := newSinkThrottler(nil)
+	rowsLimiter := throttler.rowsLimiter.Load()
+
+	maxRows := uint64(100)
+	throttler.update(&config.SinkConfig{MaxRowsPerSecond: &maxRows})
+	require.Equal(t, rate.Limit(100), throttler.rowsLimiter.Load().Limit())
+	require.NotSame(t, rowsLimiter, throttler.rowsLimiter.Load())
+
+	// The limiter is kept if the limit is not changed.
+	rowsLimiter = throttler.rowsLimiter.Load()
+	bytesLimiter := throttler.bytesLimiter.Load()
+	throttler.update(&config.SinkConfig{MaxRowsPerSecond: &maxRows})
+	require.Same(t, rowsLimiter, throttler.rowsLimiter.Load())
+	require.Same(t, bytesLimiter, throttler.bytesLimiter.Load())
+
+	throttler.update(nil)
+	require.Equal(t, rate.Inf, throttler.rowsLimiter.Load().Limit())
+}
+
+func TestSinkThrottlerWait(t *testing.T) {
+	t.Parallel()
+
+	maxRows := uint64(100)
+	throttler := newSinkThrottler(&config.SinkConfig{MaxRowsPerSecond: &maxRows})
+
+	// The first second is allowed by the burst.
+	start := time.Now()
+	require.Nil(t, throttler.wait(context.Background(), 100, 0))
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// Waiting for more rows than the burst takes longer.
+	start = time.Now()
+	require.Nil(t, throttler.wait(context.Background(), 150, 0))
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	// The wait is interrupted by the canceled context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, throttler.wait(ctx, 100, 0))
+}
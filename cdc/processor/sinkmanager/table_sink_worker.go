@@ -16,6 +16,7 @@ package sinkmanager
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -53,6 +54,10 @@ import (
 // strictly increasing one by one.
 var batchID atomic.Uint64
 
+// stuckWorkerThreshold is the duration after which a sink worker handling
+// the same task is considered stuck.
+const stuckWorkerThreshold = time.Minute
+
 type sinkWorker struct {
 	changefeedID  model.ChangeFeedID
 	sourceManager *sourcemanager.SourceManager
@@ -64,6 +69,15 @@ type sinkWorker struct {
 	// enableOldValue indicates whether to enable the old value feature.
 	// If it is enabled, we need to deal with the compatibility of the data format.
 	enableOldValue bool
+	// throttler limits the rows and bytes emitted to table sinks, it is
+	// nil if the changefeed is not throttled.
+	throttler *sinkThrottler
+
+	// alive indicates the worker is handling tasks.
+	alive atomic.Bool
+	// busySince is the unix nano time when the worker starts to handle
+	// the current task, it is 0 if the worker is idle.
+	busySince atomic.Int64
 
 	// Metrics.
 	metricRedoEventCacheHit  prometheus.Counter
@@ -80,6 +94,7 @@ func newSinkWorker(
 	eventCache *redoEventCache,
 	splitTxn bool,
 	enableOldValue bool,
+	throttler *sinkThrottler,
 ) *sinkWorker {
 	return &sinkWorker{
 		changefeedID:   changefeedID,
@@ -89,6 +104,7 @@ func newSinkWorker(
 		eventCache:     eventCache,
 		splitTxn:       splitTxn,
 		enableOldValue: enableOldValue,
+		throttler:      throttler,
 
 		metricRedoEventCacheHit:  RedoEventCacheAccess.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "hit"),
 		metricRedoEventCacheMiss: RedoEventCacheAccess.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "miss"),
@@ -97,12 +113,16 @@ func newSinkWorker(
 }
 
 func (w *sinkWorker) handleTasks(ctx context.Context, taskChan <-chan *sinkTask) error {
+	w.alive.Store(true)
+	defer w.alive.Store(false)
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case task := <-taskChan:
+			w.busySince.Store(time.Now().UnixNano())
 			err := w.handleTask(ctx, task)
+			w.busySince.Store(0)
 			if err != nil {
 				return err
 			}
@@ -110,6 +130,14 @@ func (w *sinkWorker) handleTasks(ctx context.Context, taskChan <-chan *sinkTask)
 	}
 }
 
+// isStuck returns true if the worker has been handling the current task
+// for longer than stuckWorkerThreshold.
+func (w *sinkWorker) isStuck(now time.Time) bool {
+	busySince := w.busySince.Load()
+	return busySince != 0 &&
+		now.Sub(time.Unix(0, busySince)) >= stuckWorkerThreshold
+}
+
 func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr error) {
 	// We need to use a new batch ID for each task.
 	batchID.Add(1)
@@ -123,7 +151,7 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 		task.lowerBound,
 		task.getUpperBound(task.tableSink.getUpperBoundTs()))
 	if w.eventCache != nil {
-		drained, err := w.fetchFromCache(task, &lowerBound, &upperBound)
+		drained, err := w.fetchFromCache(ctx, task, &lowerBound, &upperBound)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -172,6 +200,8 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 		// Otherwise we can't ensure all events before `lastPos` are emitted.
 		if finalErr == nil {
 			task.callback(advancer.lastPos)
+		} else if errors.Cause(finalErr) != context.Canceled {
+			task.tableSink.setError(finalErr)
 		}
 	}()
 
@@ -208,6 +238,9 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 				return err
 			}
 
+			if err := w.throttler.wait(ctx, len(x), size); err != nil {
+				return errors.Trace(err)
+			}
 			advancer.appendEvents(x, size)
 			allEventSize += size
 		}
@@ -224,6 +257,7 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 }
 
 func (w *sinkWorker) fetchFromCache(
+	ctx context.Context,
 	task *sinkTask, // task is read-only here.
 	lowerBound *engine.Position,
 	upperBound *engine.Position,
@@ -239,6 +273,9 @@ func (w *sinkWorker) fetchFromCache(
 	if popRes.success {
 		newLowerBound = popRes.boundary.Next()
 		if len(popRes.events) > 0 {
+			if err = w.throttler.wait(ctx, len(popRes.events), popRes.size); err != nil {
+				return false, errors.Trace(err)
+			}
 			task.tableSink.receivedEventCount.Add(int64(popRes.pushCount))
 			w.metricOutputEventCountKV.Add(float64(popRes.pushCount))
 			w.metricRedoEventCacheHit.Add(float64(popRes.size))
@@ -31,6 +31,10 @@ import (
 
 var version uint64 = 0
 
+// writeLatencySamples is the number of the latest writes used to summarize
+// the write latency of a table sink.
+const writeLatencySamples = 64
+
 // tableSinkWrapper is a wrapper of TableSink, it is used in SinkManager to manage TableSink.
 // Because in the SinkManager, we write data to TableSink and RedoManager concurrently,
 // so current sink node can not be reused.
@@ -53,6 +57,10 @@ type tableSinkWrapper struct {
 	replicateTs model.Ts
 	// barrierTs is the barrier bound of the table sink.
 	barrierTs atomic.Uint64
+	// tableBarrierTs is the latest barrier of DDLs of the table, and
+	// ddlEventCount is the number of DDLs of the table.
+	tableBarrierTs atomic.Uint64
+	ddlEventCount  atomic.Uint64
 	// receivedSorterResolvedTs is the resolved ts received from the sorter.
 	// We use this to advance the redo log.
 	receivedSorterResolvedTs atomic.Uint64
@@ -71,6 +79,57 @@ type tableSinkWrapper struct {
 	// events in the range (rangeEventCounts[i-1].lastPos, rangeEventCounts[i].lastPos].
 	rangeEventCounts   []rangeEventCount
 	rangeEventCountsMu sync.Mutex
+
+	// eventsAppended indicates events are appended since the last resolved ts.
+	eventsAppended atomic.Bool
+	// pendingWrites are the writes that are not acknowledged by the sink yet,
+	// ordered by the resolved ts.
+	pendingWrites   []pendingWrite
+	pendingWritesMu sync.Mutex
+	// writeLatency is the latency from writing events to the sink to the
+	// checkpoint ts of the table sink passing them.
+	writeLatency *tablepb.LatencyWindow
+
+	// err is the latest error of writing the table sink, it's cleared once
+	// the table sink is advanced successfully.
+	err           error
+	errorRecovery errorRecovery
+	errMu         sync.Mutex
+}
+
+// errorRecovery tracks the last error of a table and the time it took to
+// recover.
+type errorRecovery struct {
+	failing   bool
+	lastError time.Time
+	recovery  time.Duration
+}
+
+func (r *errorRecovery) observe(now time.Time, failed bool) {
+	switch {
+	case failed && !r.failing:
+		r.failing = true
+		r.lastError = now
+		r.recovery = 0
+	case !failed && r.failing:
+		r.failing = false
+		r.recovery = now.Sub(r.lastError)
+	}
+}
+
+func (r *errorRecovery) stats() tablepb.ErrorRecovery {
+	if r.lastError.IsZero() {
+		return tablepb.ErrorRecovery{}
+	}
+	return tablepb.ErrorRecovery{
+		LastErrorMs: r.lastError.UnixMilli(),
+		RecoveryMs:  r.recovery.Milliseconds(),
+	}
+}
+
+type pendingWrite struct {
+	resolvedTs model.ResolvedTs
+	start      time.Time
 }
 
 type rangeEventCount struct {
@@ -104,6 +163,8 @@ func newTableSinkWrapper(
 		state:      &state,
 		startTs:    startTs,
 		targetTs:   targetTs,
+
+		writeLatency: tablepb.NewLatencyWindow(writeLatencySamples),
 	}
 	res.checkpointTs.Store(startTs)
 	res.receivedSorterResolvedTs.Store(startTs)
@@ -145,6 +206,9 @@ func (t *tableSinkWrapper) start(startTs model.Ts, replicateTs model.Ts) {
 
 func (t *tableSinkWrapper) appendRowChangedEvents(events ...*model.RowChangedEvent) {
 	t.tableSink.AppendRowChangedEvents(events...)
+	if len(events) > 0 {
+		t.eventsAppended.Store(true)
+	}
 }
 
 func (t *tableSinkWrapper) updateReceivedSorterResolvedTs(ts model.Ts) {
@@ -169,21 +233,65 @@ func (t *tableSinkWrapper) updateReceivedSorterCommitTs(ts model.Ts) {
 }
 
 func (t *tableSinkWrapper) updateResolvedTs(ts model.ResolvedTs) error {
+	start := time.Now()
 	if err := t.tableSink.UpdateResolvedTs(ts); err != nil {
+		t.setError(err)
 		return errors.Trace(err)
 	}
+	t.setError(nil)
+	// Only writes carrying events are tracked, otherwise the latency of
+	// an idle table is always zero.
+	if t.eventsAppended.Swap(false) {
+		t.pendingWritesMu.Lock()
+		t.pendingWrites = append(t.pendingWrites, pendingWrite{resolvedTs: ts, start: start})
+		t.pendingWritesMu.Unlock()
+	}
 	return nil
 }
 
+func (t *tableSinkWrapper) setError(err error) {
+	t.errMu.Lock()
+	defer t.errMu.Unlock()
+	t.err = err
+	t.errorRecovery.observe(time.Now(), err != nil)
+}
+
+func (t *tableSinkWrapper) getError() error {
+	t.errMu.Lock()
+	defer t.errMu.Unlock()
+	return t.err
+}
+
+func (t *tableSinkWrapper) getErrorRecovery() tablepb.ErrorRecovery {
+	t.errMu.Lock()
+	defer t.errMu.Unlock()
+	return t.errorRecovery.stats()
+}
+
 func (t *tableSinkWrapper) getCheckpointTs() model.ResolvedTs {
 	currentCheckpointTs := t.checkpointTs.Load()
 	newCheckpointTs := t.tableSink.GetCheckpointTs()
 	if currentCheckpointTs > newCheckpointTs.ResolvedMark() {
-		return model.NewResolvedTs(currentCheckpointTs)
+		newCheckpointTs = model.NewResolvedTs(currentCheckpointTs)
 	}
+	t.observeWriteLatency(newCheckpointTs)
 	return newCheckpointTs
 }
 
+// observeWriteLatency observes the latency of writes passed by the checkpoint.
+func (t *tableSinkWrapper) observeWriteLatency(checkpointTs model.ResolvedTs) {
+	t.pendingWritesMu.Lock()
+	defer t.pendingWritesMu.Unlock()
+	i := 0
+	for ; i < len(t.pendingWrites); i++ {
+		if !checkpointTs.EqualOrGreater(t.pendingWrites[i].resolvedTs) {
+			break
+		}
+		t.writeLatency.Observe(time.Since(t.pendingWrites[i].start))
+	}
+	t.pendingWrites = t.pendingWrites[i:]
+}
+
 func (t *tableSinkWrapper) getReceivedSorterResolvedTs() model.Ts {
 	return t.receivedSorterResolvedTs.Load()
 }
@@ -192,6 +300,10 @@ func (t *tableSinkWrapper) getReceivedSorterCommitTs() model.Ts {
 	return t.receivedSorterCommitTs.Load()
 }
 
+func (t *tableSinkWrapper) getTableSinkStats() tablesink.Stats {
+	return t.tableSink.GetStats()
+}
+
 func (t *tableSinkWrapper) getReceivedEventCount() int64 {
 	return t.receivedEventCount.Load()
 }
@@ -14,8 +14,10 @@
 package sinkmanager
 
 import (
+	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -30,6 +32,7 @@ type mockSink struct {
 	mu         sync.Mutex
 	events     []*dmlsink.CallbackableEvent[*model.RowChangedEvent]
 	writeTimes int
+	writeErr   error
 }
 
 func newMockSink() *mockSink {
@@ -41,11 +44,20 @@ func newMockSink() *mockSink {
 func (m *mockSink) WriteEvents(events ...*dmlsink.CallbackableEvent[*model.RowChangedEvent]) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	if m.writeErr != nil {
+		return m.writeErr
+	}
 	m.writeTimes++
 	m.events = append(m.events, events...)
 	return nil
 }
 
+func (m *mockSink) setWriteError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.writeErr = err
+}
+
 func (m *mockSink) GetEvents() []*dmlsink.CallbackableEvent[*model.RowChangedEvent] {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -326,3 +338,74 @@ func TestNewTableSinkWrapper(t *testing.T) {
 	require.Equal(t, uint64(10), wrapper.getReceivedSorterResolvedTs())
 	require.Equal(t, uint64(10), wrapper.checkpointTs.Load())
 }
+
+func TestTableSinkWrapperWriteLatency(t *testing.T) {
+	t.Parallel()
+
+	wrapper, sink := createTableSinkWrapper(
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1))
+	require.Equal(t, tablepb.Latency{}, wrapper.writeLatency.Summary())
+
+	wrapper.appendRowChangedEvents(&model.RowChangedEvent{CommitTs: 10})
+	require.Nil(t, wrapper.updateResolvedTs(model.NewResolvedTs(10)))
+	// The write is not acknowledged yet.
+	require.Equal(t, uint64(0), wrapper.getCheckpointTs().Ts)
+	require.Equal(t, tablepb.Latency{}, wrapper.writeLatency.Summary())
+
+	time.Sleep(20 * time.Millisecond)
+	sink.AckAllEvents()
+	require.Equal(t, uint64(10), wrapper.getCheckpointTs().Ts)
+	latency := wrapper.writeLatency.Summary()
+	require.GreaterOrEqual(t, latency.P50, float64(20))
+	require.Equal(t, latency.P50, latency.P99)
+
+	// Writes without events are not observed.
+	require.Nil(t, wrapper.updateResolvedTs(model.NewResolvedTs(20)))
+	require.Equal(t, uint64(20), wrapper.getCheckpointTs().Ts)
+	require.Equal(t, latency, wrapper.writeLatency.Summary())
+}
+
+func TestTableSinkWrapperError(t *testing.T) {
+	t.Parallel()
+
+	wrapper, sink := createTableSinkWrapper(
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1))
+	require.Nil(t, wrapper.getError())
+
+	sink.setWriteError(errors.New("write failed"))
+	wrapper.appendRowChangedEvents(&model.RowChangedEvent{CommitTs: 10})
+	require.Error(t, wrapper.updateResolvedTs(model.NewResolvedTs(10)))
+	require.EqualError(t, wrapper.getError(), "write failed")
+
+	// The error is cleared once the table sink is advanced successfully.
+	sink.setWriteError(nil)
+	wrapper.appendRowChangedEvents(&model.RowChangedEvent{CommitTs: 20})
+	require.Nil(t, wrapper.updateResolvedTs(model.NewResolvedTs(20)))
+	require.Nil(t, wrapper.getError())
+}
+
+func TestErrorRecovery(t *testing.T) {
+	t.Parallel()
+
+	var r errorRecovery
+	require.Equal(t, tablepb.ErrorRecovery{}, r.stats())
+
+	// The table sink fails, and keeps failing.
+	errAt := time.Unix(1000, 0)
+	r.observe(errAt, true)
+	r.observe(errAt.Add(10*time.Second), true)
+	require.Equal(t, tablepb.ErrorRecovery{LastErrorMs: errAt.UnixMilli()}, r.stats())
+
+	// The table sink recovers.
+	r.observe(errAt.Add(30*time.Second), false)
+	r.observe(errAt.Add(time.Minute), false)
+	require.Equal(t, tablepb.ErrorRecovery{
+		LastErrorMs: errAt.UnixMilli(),
+		RecoveryMs:  (30 * time.Second).Milliseconds(),
+	}, r.stats())
+
+	// It fails again, the recovery time is reset.
+	errAt = errAt.Add(2 * time.Minute)
+	r.observe(errAt, true)
+	require.Equal(t, tablepb.ErrorRecovery{LastErrorMs: errAt.UnixMilli()}, r.stats())
+}
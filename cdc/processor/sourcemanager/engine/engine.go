@@ -171,4 +171,7 @@ func (p Position) IsCommitFence() bool {
 type TableStats struct {
 	ReceivedMaxCommitTs   model.Ts
 	ReceivedMaxResolvedTs model.Ts
+	// Spill is the disk usage of the table, only collected by engines
+	// spilling events on disk.
+	Spill tablepb.SorterSpill
 }
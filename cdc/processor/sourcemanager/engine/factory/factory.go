@@ -128,6 +128,16 @@ func (f *SortEngineFactory) Close() (err error) {
 	return
 }
 
+// DiskUsage returns the on-disk data size of all sort engines in bytes.
+func (f *SortEngineFactory) DiskUsage() (usage uint64) {
+	if f.engineType == pebbleEngine && f.dbInitialized.Load() {
+		for _, db := range f.dbs {
+			usage += db.Metrics().DiskSpaceUsage()
+		}
+	}
+	return
+}
+
 // NewForPebble will create a SortEngineFactory for the pebble implementation.
 func NewForPebble(dir string, memQuotaInBytes uint64, cfg *config.DBConfig) *SortEngineFactory {
 	factoryMu.Lock()
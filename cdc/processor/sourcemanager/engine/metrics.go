@@ -156,7 +156,7 @@ func BlockCacheAccess() *prometheus.GaugeVec {
 }
 
 // InitMetrics registers all metrics in this file
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(mountWaitDuration)
 	registry.MustRegister(sorterWriteDurationHistogram)
 	registry.MustRegister(sorterCompactDurationHistogram)
@@ -126,6 +126,7 @@ func (s *EventSorter) AddTable(span tablepb.Span) {
 	s.tables.ReplaceOrInsert(span, &tableState{
 		uniqueID: genUniqueID(),
 		ch:       s.channs[getDB(span, len(s.dbs))],
+		since:    time.Now(),
 	})
 	s.mu.Unlock()
 }
@@ -161,7 +162,9 @@ func (s *EventSorter) Add(span tablepb.Span, events ...*model.PolymorphicEvent)
 	maxCommitTs := model.Ts(0)
 	maxResolvedTs := model.Ts(0)
 	for _, event := range events {
-		state.ch.In() <- eventWithTableID{uniqueID: state.uniqueID, span: span, event: event}
+		state.ch.In() <- eventWithTableID{
+			uniqueID: state.uniqueID, span: span, event: event, state: state,
+		}
 		if event.IsResolved() {
 			if event.CRTs > maxResolvedTs {
 				maxResolvedTs = event.CRTs
@@ -302,6 +305,32 @@ func (s *EventSorter) GetStatsByTable(span tablepb.Span) engine.TableStats {
 	return engine.TableStats{
 		ReceivedMaxCommitTs:   maxCommitTs,
 		ReceivedMaxResolvedTs: maxResolvedTs,
+		Spill:                 s.getSpill(state, span),
+	}
+}
+
+// getSpill returns the disk usage of the table. Events in memtables are not
+// counted as they haven't been flushed to disk yet.
+func (s *EventSorter) getSpill(state *tableState, span tablepb.Span) tablepb.SorterSpill {
+	db := s.dbs[getDB(span, len(s.dbs))]
+	start := encoding.EncodeTsKey(state.uniqueID, uint64(span.TableID), 0)
+	end := encoding.EncodeTsKey(state.uniqueID, uint64(span.TableID)+1, 0)
+	diskBytes, err := db.EstimateDiskUsage(start, end)
+	if err != nil {
+		log.Warn("failed to estimate disk usage of table",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.Stringer("span", &span),
+			zap.Error(err))
+	}
+	// Avoid a huge rate right after the table is added.
+	elapsed := time.Since(state.since)
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	return tablepb.SorterSpill{
+		DiskBytes: diskBytes,
+		SpillRate: float64(state.spilledBytes.Load()) / elapsed.Seconds(),
 	}
 }
 
@@ -389,6 +418,7 @@ type eventWithTableID struct {
 	uniqueID uint32
 	span     tablepb.Span
 	event    *model.PolymorphicEvent
+	state    *tableState
 }
 
 type tableState struct {
@@ -399,6 +429,10 @@ type tableState struct {
 	maxReceivedCommitTs   atomic.Uint64
 	maxReceivedResolvedTs atomic.Uint64
 	receivedEvents        atomic.Int64
+	// since is when the table is added, spilledBytes is the total size of
+	// events written to pebble.
+	since        time.Time
+	spilledBytes atomic.Uint64
 
 	// Following fields are protected by mu.
 	mu      sync.RWMutex
@@ -434,6 +468,7 @@ func (s *EventSorter) handleEvents(
 				zap.String("namespace", s.changefeedID.Namespace),
 				zap.String("changefeed", s.changefeedID.ID))
 		}
+		item.state.spilledBytes.Add(uint64(len(key) + len(value)))
 	}
 
 	for {
@@ -183,3 +183,38 @@ func TestCleanData(t *testing.T) {
 	})
 	require.Nil(t, s.CleanByTable(span, engine.Position{}))
 }
+
+func TestGetStatsByTableSpill(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), t.Name())
+	db, err := OpenPebble(1, dbPath, &config.DBConfig{Count: 1}, 1024*1024*10)
+	require.Nil(t, err)
+	defer func() { _ = db.Close() }()
+
+	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
+	s := New(cf, []*pebble.DB{db})
+	defer s.Close()
+
+	span := spanz.TableIDToComparableSpan(1)
+	s.AddTable(span)
+	resolvedTs := make(chan model.Ts, 1)
+	s.OnResolve(func(_ tablepb.Span, ts model.Ts) { resolvedTs <- ts })
+	require.Equal(t, tablepb.SorterSpill{}, s.GetStatsByTable(span).Spill)
+
+	s.Add(span, model.NewPolymorphicEvent(&model.RawKVEntry{
+		OpType:  model.OpTypePut,
+		Key:     []byte{1},
+		Value:   []byte("value"),
+		StartTs: 1,
+		CRTs:    2,
+	}))
+	s.Add(span, model.NewResolvedPolymorphicEvent(0, 2))
+	<-resolvedTs
+
+	// Events are written to pebble, but not flushed to disk yet.
+	spill := s.GetStatsByTable(span).Spill
+	require.Zero(t, spill.DiskBytes)
+	require.Greater(t, spill.SpillRate, float64(0))
+
+	require.Nil(t, db.Flush())
+	require.Greater(t, s.GetStatsByTable(span).Spill.DiskBytes, uint64(0))
+}
@@ -25,6 +25,7 @@ import (
 	pullerwrapper "github.com/pingcap/tiflow/cdc/processor/sourcemanager/puller"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"go.uber.org/zap"
@@ -52,6 +53,8 @@ type SourceManager struct {
 	errChan chan error
 	// Used to indicate whether the changefeed is in BDR mode.
 	bdrMode bool
+	// kvCfg is the kv client config of the pullers.
+	kvCfg *config.KVClientConfig
 
 	// pullerWrapperCreator is used to create a puller wrapper.
 	// Only used for testing.
@@ -60,6 +63,8 @@ type SourceManager struct {
 		tableName string,
 		startTs model.Ts,
 		bdrMode bool,
+		kvCfg *config.KVClientConfig,
+		readOldValue bool,
 	) pullerwrapper.Wrapper
 }
 
@@ -70,6 +75,7 @@ func New(
 	mg entry.MounterGroup,
 	engine engine.SortEngine,
 	bdrMode bool,
+	kvCfg *config.KVClientConfig,
 ) *SourceManager {
 	return &SourceManager{
 		ready:                make(chan struct{}),
@@ -79,6 +85,7 @@ func New(
 		engine:               engine,
 		errChan:              make(chan error, 16),
 		bdrMode:              bdrMode,
+		kvCfg:                kvCfg,
 		pullerWrapperCreator: pullerwrapper.NewPullerWrapper,
 	}
 }
@@ -90,6 +97,7 @@ func NewForTest(
 	mg entry.MounterGroup,
 	engine engine.SortEngine,
 	bdrMode bool,
+	kvCfg *config.KVClientConfig,
 ) *SourceManager {
 	return &SourceManager{
 		ready:                make(chan struct{}),
@@ -99,15 +107,20 @@ func NewForTest(
 		engine:               engine,
 		errChan:              make(chan error, 16),
 		bdrMode:              bdrMode,
+		kvCfg:                kvCfg,
 		pullerWrapperCreator: pullerwrapper.NewPullerWrapperForTest,
 	}
 }
 
 // AddTable adds a table to the source manager. Start puller and register table to the engine.
-func (m *SourceManager) AddTable(span tablepb.Span, tableName string, startTs model.Ts) {
+// readOldValue indicates whether the puller reads old values of the table.
+func (m *SourceManager) AddTable(
+	span tablepb.Span, tableName string, startTs model.Ts, readOldValue bool,
+) {
 	// Add table to the engine first, so that the engine can receive the events from the puller.
 	m.engine.AddTable(span)
-	p := m.pullerWrapperCreator(m.changefeedID, span, tableName, startTs, m.bdrMode)
+	p := m.pullerWrapperCreator(
+		m.changefeedID, span, tableName, startTs, m.bdrMode, m.kvCfg, readOldValue)
 	p.Start(m.ctx, m.up, m.engine, m.errChan)
 	m.pullers.Store(span, p)
 }
@@ -157,6 +170,18 @@ func (m *SourceManager) GetTablePullerStats(span tablepb.Span) puller.Stats {
 	return p.(pullerwrapper.Wrapper).GetStats()
 }
 
+// GetTablePullerError returns the error that stops the puller of the table.
+func (m *SourceManager) GetTablePullerError(span tablepb.Span) error {
+	p, ok := m.pullers.Load(span)
+	if !ok {
+		log.Panic("Table puller not found when getting table puller error",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Stringer("span", &span))
+	}
+	return p.(pullerwrapper.Wrapper).GetError()
+}
+
 // GetTableSorterStats returns the sorter stats of the table.
 func (m *SourceManager) GetTableSorterStats(span tablepb.Span) engine.TableStats {
 	return m.engine.GetStatsByTable(span)
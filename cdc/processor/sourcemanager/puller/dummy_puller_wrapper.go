@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/upstream"
 )
 
@@ -34,6 +35,8 @@ func NewPullerWrapperForTest(
 	tableName string,
 	startTs model.Ts,
 	bdrMode bool,
+	kvCfg *config.KVClientConfig,
+	readOldValue bool,
 ) Wrapper {
 	return &dummyPullerWrapper{}
 }
@@ -46,4 +49,8 @@ func (d *dummyPullerWrapper) GetStats() puller.Stats {
 	return puller.Stats{}
 }
 
+func (d *dummyPullerWrapper) GetError() error {
+	return nil
+}
+
 func (d *dummyPullerWrapper) Close() {}
@@ -15,9 +15,12 @@ package puller
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller"
@@ -27,6 +30,10 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// memQuotaCheckInterval is the interval to check whether the memory quota
+// shared by all changefeeds is available.
+const memQuotaCheckInterval = 100 * time.Millisecond
+
 // Wrapper is a wrapper of puller used by source manager.
 type Wrapper interface {
 	// Start the puller and send internal errors into `errChan`.
@@ -37,6 +44,8 @@ type Wrapper interface {
 		errChan chan<- error,
 	)
 	GetStats() puller.Stats
+	// GetError returns the error that stops the puller, nil if it's running.
+	GetError() error
 	Close()
 }
 
@@ -48,11 +57,17 @@ type WrapperImpl struct {
 	p          puller.Puller
 	startTs    model.Ts
 	bdrMode    bool
+	kvCfg      *config.KVClientConfig
+	// readOldValue indicates whether to read old values of the table.
+	readOldValue bool
 
 	// cancel is used to cancel the puller when remove or close the table.
 	cancel context.CancelFunc
 	// eg is used to wait the puller to exit.
 	eg *errgroup.Group
+
+	errMu sync.Mutex
+	err   error
 }
 
 // NewPullerWrapper creates a new puller wrapper.
@@ -62,13 +77,17 @@ func NewPullerWrapper(
 	tableName string,
 	startTs model.Ts,
 	bdrMode bool,
+	kvCfg *config.KVClientConfig,
+	readOldValue bool,
 ) Wrapper {
 	return &WrapperImpl{
-		changefeed: changefeed,
-		span:       span,
-		tableName:  tableName,
-		startTs:    startTs,
-		bdrMode:    bdrMode,
+		changefeed:   changefeed,
+		span:         span,
+		tableName:    tableName,
+		startTs:      startTs,
+		bdrMode:      bdrMode,
+		kvCfg:        kvCfg,
+		readOldValue: readOldValue,
 	}
 }
 
@@ -82,6 +101,11 @@ func (n *WrapperImpl) Start(
 ) {
 	ctx, n.cancel = context.WithCancel(ctx)
 	errorHandler := func(err error) {
+		if err != nil && !cerrors.Is(err, context.Canceled) {
+			n.errMu.Lock()
+			n.err = err
+			n.errMu.Unlock()
+		}
 		select {
 		case <-ctx.Done():
 		case errChan <- err:
@@ -92,7 +116,23 @@ func (n *WrapperImpl) Start(
 		errorHandler(cerrors.New("processor add table injected error"))
 	})
 
-	// NOTICE: always pull the old value internally
+	// The events are added into the sort engine in the goroutine of the
+	// puller, so no extra goroutine is spawned for each table to forward them.
+	serverQuota := memquota.GetServerMemQuota()
+	consume := func(ctx context.Context, rawKV *model.RawKVEntry) error {
+		if rawKV == nil {
+			return nil
+		}
+		if err := waitMemQuotaAvailable(ctx, serverQuota); err != nil {
+			return err
+		}
+		pEvent := model.NewPolymorphicEvent(rawKV)
+		eventSortEngine.Add(n.span, pEvent)
+		return nil
+	}
+
+	// NOTICE: the old value is pulled internally unless it's unnecessary
+	// for the table, see entry.NeedOldValue.
 	// See also: https://github.com/pingcap/tiflow/issues/2301.
 	n.p = puller.New(
 		ctx,
@@ -103,12 +143,14 @@ func (n *WrapperImpl) Start(
 		up.PDClock,
 		n.startTs,
 		[]tablepb.Span{n.span},
-		config.GetGlobalServerConfig().KVClient,
+		n.kvCfg,
 		n.changefeed,
 		n.span.TableID,
 		n.tableName,
 		n.bdrMode,
+		n.readOldValue,
 		false,
+		consume,
 	)
 
 	// Use errgroup to ensure all sub goroutines can exit without calling Close.
@@ -118,20 +160,25 @@ func (n *WrapperImpl) Start(
 		errorHandler(err)
 		return err
 	})
-	n.eg.Go(func() error {
-		for {
-			select {
-			case <-ctx.Done():
-				return nil
-			case rawKV := <-n.p.Output():
-				if rawKV == nil {
-					continue
-				}
-				pEvent := model.NewPolymorphicEvent(rawKV)
-				eventSortEngine.Add(n.span, pEvent)
-			}
+}
+
+// waitMemQuotaAvailable blocks until the memory quota shared by all
+// changefeeds is available, which applies backpressure to the puller when
+// the memory used by all changefeeds exceeds the quota.
+func waitMemQuotaAvailable(ctx context.Context, quota *memquota.MemQuota) error {
+	if quota == nil || !quota.IsExhausted() {
+		return nil
+	}
+	ticker := time.NewTicker(memQuotaCheckInterval)
+	defer ticker.Stop()
+	for quota.IsExhausted() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
 		}
-	})
+	}
+	return nil
 }
 
 // GetStats returns the puller stats.
@@ -139,6 +186,13 @@ func (n *WrapperImpl) GetStats() puller.Stats {
 	return n.p.Stats()
 }
 
+// GetError returns the error that stops the puller.
+func (n *WrapperImpl) GetError() error {
+	n.errMu.Lock()
+	defer n.errMu.Unlock()
+	return n.err
+}
+
 // Close the puller wrapper.
 func (n *WrapperImpl) Close() {
 	if n.cancel == nil {
@@ -19,9 +19,13 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // Load TableState with THREAD-SAFE
@@ -112,3 +116,49 @@ func (s *Span) Eq(b *Span) bool {
 		bytes.Equal(s.StartKey, b.StartKey) &&
 		bytes.Equal(s.EndKey, b.EndKey)
 }
+
+// LatencyWindow keeps the latest latencies and summarizes them.
+// It is thread-safe.
+type LatencyWindow struct {
+	mu sync.Mutex
+	// samples are in milliseconds, it is a ring buffer once it is full.
+	samples []float64
+	next    int
+}
+
+// NewLatencyWindow creates a LatencyWindow keeping the latest size latencies.
+func NewLatencyWindow(size int) *LatencyWindow {
+	return &LatencyWindow{samples: make([]float64, 0, size)}
+}
+
+// Observe adds a latency to the window.
+func (w *LatencyWindow) Observe(latency time.Duration) {
+	ms := float64(latency) / float64(time.Millisecond)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.samples) < cap(w.samples) {
+		w.samples = append(w.samples, ms)
+		return
+	}
+	w.samples[w.next] = ms
+	w.next = (w.next + 1) % len(w.samples)
+}
+
+// Summary returns the percentiles of latencies in the window by nearest rank.
+func (w *LatencyWindow) Summary() Latency {
+	w.mu.Lock()
+	sorted := append([]float64(nil), w.samples...)
+	w.mu.Unlock()
+	if len(sorted) == 0 {
+		return Latency{}
+	}
+	sort.Float64s(sorted)
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+	return Latency{P50: percentile(0.5), P99: percentile(0.99)}
+}
@@ -4,6 +4,7 @@
 package tablepb
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -74,6 +75,133 @@ func (TableState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ae83c9c6cf5ef75c, []int{0}
 }
 
+// SchemaCompatibility is the compatibility of a table schema with the schema
+// registry of the downstream, e.g. Avro.
+type SchemaCompatibility int32
+
+const (
+	SchemaCompatibilityCompatible          SchemaCompatibility = 0
+	SchemaCompatibilityPendingRegistration SchemaCompatibility = 1
+	SchemaCompatibilityIncompatible        SchemaCompatibility = 2
+)
+
+var SchemaCompatibility_name = map[int32]string{
+	0: "SchemaCompatible",
+	1: "SchemaPendingRegistration",
+	2: "SchemaIncompatible",
+}
+
+var SchemaCompatibility_value = map[string]int32{
+	"SchemaCompatible":          0,
+	"SchemaPendingRegistration": 1,
+	"SchemaIncompatible":        2,
+}
+
+func (x SchemaCompatibility) String() string {
+	return proto.EnumName(SchemaCompatibility_name, int32(x))
+}
+
+func (SchemaCompatibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{1}
+}
+
+// WatermarkSource is the mechanism that advances the watermark of a table.
+type WatermarkSource int32
+
+const (
+	WatermarkSourceUnknown WatermarkSource = 0
+	// The watermark is resolved from the puller.
+	WatermarkSourcePullerResolved WatermarkSource = 1
+	// The watermark is acknowledged by the sink.
+	WatermarkSourceSinkAcknowledged WatermarkSource = 2
+	// The watermark is held by a barrier, e.g. a DDL or a sync point.
+	WatermarkSourceBarrier WatermarkSource = 3
+)
+
+var WatermarkSource_name = map[int32]string{
+	0: "UnknownWatermarkSource",
+	1: "PullerResolved",
+	2: "SinkAcknowledged",
+	3: "Barrier",
+}
+
+var WatermarkSource_value = map[string]int32{
+	"UnknownWatermarkSource": 0,
+	"PullerResolved":         1,
+	"SinkAcknowledged":       2,
+	"Barrier":                3,
+}
+
+func (x WatermarkSource) String() string {
+	return proto.EnumName(WatermarkSource_name, int32(x))
+}
+
+func (WatermarkSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{2}
+}
+
+// ReplicationMode is the mode of table replication.
+type ReplicationMode int32
+
+const (
+	ReplicationModeUnknown ReplicationMode = 0
+	// The table is in the initial scan.
+	ReplicationModeInitialScan ReplicationMode = 1
+	// The table has switched to incremental replication.
+	ReplicationModeIncremental ReplicationMode = 2
+)
+
+var ReplicationMode_name = map[int32]string{
+	0: "UnknownReplicationMode",
+	1: "InitialScan",
+	2: "Incremental",
+}
+
+var ReplicationMode_value = map[string]int32{
+	"UnknownReplicationMode": 0,
+	"InitialScan":            1,
+	"Incremental":            2,
+}
+
+func (x ReplicationMode) String() string {
+	return proto.EnumName(ReplicationMode_name, int32(x))
+}
+
+func (ReplicationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{3}
+}
+
+// TableErrorCategory is the category of a table error.
+type TableErrorCategory int32
+
+const (
+	TableErrorCategoryUnknown TableErrorCategory = 0
+	// The table failed during initial setup, e.g. it couldn't read schema.
+	TableErrorCategoryInitialization TableErrorCategory = 1
+	// The table failed in the middle of replication.
+	TableErrorCategoryReplication TableErrorCategory = 2
+)
+
+var TableErrorCategory_name = map[int32]string{
+	0: "UnknownError",
+	1: "InitializationError",
+	2: "ReplicationError",
+}
+
+var TableErrorCategory_value = map[string]int32{
+	"UnknownError":        0,
+	"InitializationError": 1,
+	"ReplicationError":    2,
+}
+
+func (x TableErrorCategory) String() string {
+	return proto.EnumName(TableErrorCategory_name, int32(x))
+}
+
+func (TableErrorCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{4}
+}
+
 // Span is a full extent of key space from an inclusive start_key to
 // an exclusive end_key.
 type Span struct {
@@ -166,30 +294,25 @@ func (m *Checkpoint) GetResolvedTs() Ts {
 	return 0
 }
 
-// Stats holds a statistic for a table.
-type Stats struct {
-	// Number of captured regions.
-	RegionCount uint64 `protobuf:"varint,1,opt,name=region_count,json=regionCount,proto3" json:"region_count,omitempty"`
-	// The current timestamp from the table's point of view.
-	CurrentTs Ts `protobuf:"varint,2,opt,name=current_ts,json=currentTs,proto3,casttype=Ts" json:"current_ts,omitempty"`
-	// Checkponits at each stage.
-	StageCheckpoints map[string]Checkpoint `protobuf:"bytes,3,rep,name=stage_checkpoints,json=stageCheckpoints,proto3" json:"stage_checkpoints" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The barrier timestamp of the table.
-	BarrierTs Ts `protobuf:"varint,4,opt,name=barrier_ts,json=barrierTs,proto3,casttype=Ts" json:"barrier_ts,omitempty"`
+// MQPosition is the latest position produced to a partition of a MQ downstream.
+type MQPosition struct {
+	Topic     string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition int32  `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (m *Stats) Reset()         { *m = Stats{} }
-func (m *Stats) String() string { return proto.CompactTextString(m) }
-func (*Stats) ProtoMessage()    {}
-func (*Stats) Descriptor() ([]byte, []int) {
+func (m *MQPosition) Reset()         { *m = MQPosition{} }
+func (m *MQPosition) String() string { return proto.CompactTextString(m) }
+func (*MQPosition) ProtoMessage()    {}
+func (*MQPosition) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae83c9c6cf5ef75c, []int{2}
 }
-func (m *Stats) XXX_Unmarshal(b []byte) error {
+func (m *MQPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MQPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MQPosition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -199,68 +322,57 @@ func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Stats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stats.Merge(m, src)
+func (m *MQPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MQPosition.Merge(m, src)
 }
-func (m *Stats) XXX_Size() int {
+func (m *MQPosition) XXX_Size() int {
 	return m.Size()
 }
-func (m *Stats) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stats.DiscardUnknown(m)
+func (m *MQPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MQPosition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stats proto.InternalMessageInfo
+var xxx_messageInfo_MQPosition proto.InternalMessageInfo
 
-func (m *Stats) GetRegionCount() uint64 {
+func (m *MQPosition) GetTopic() string {
 	if m != nil {
-		return m.RegionCount
+		return m.Topic
 	}
-	return 0
+	return ""
 }
 
-func (m *Stats) GetCurrentTs() Ts {
+func (m *MQPosition) GetPartition() int32 {
 	if m != nil {
-		return m.CurrentTs
+		return m.Partition
 	}
 	return 0
 }
 
-func (m *Stats) GetStageCheckpoints() map[string]Checkpoint {
-	if m != nil {
-		return m.StageCheckpoints
-	}
-	return nil
-}
-
-func (m *Stats) GetBarrierTs() Ts {
+func (m *MQPosition) GetOffset() int64 {
 	if m != nil {
-		return m.BarrierTs
+		return m.Offset
 	}
 	return 0
 }
 
-// TableStatus is the running status of a table.
-// TODO rename to TableStatus.
-type TableStatus struct {
-	TableID    TableID    `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=TableID" json:"table_id,omitempty"`
-	Span       Span       `protobuf:"bytes,5,opt,name=span,proto3" json:"span"`
-	State      TableState `protobuf:"varint,2,opt,name=state,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.TableState" json:"state,omitempty"`
-	Checkpoint Checkpoint `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint"`
-	Stats      Stats      `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats"`
+// Latency is a summary of latencies, in milliseconds.
+type Latency struct {
+	P50 float64 `protobuf:"fixed64,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P99 float64 `protobuf:"fixed64,2,opt,name=p99,proto3" json:"p99,omitempty"`
 }
 
-func (m *TableStatus) Reset()         { *m = TableStatus{} }
-func (m *TableStatus) String() string { return proto.CompactTextString(m) }
-func (*TableStatus) ProtoMessage()    {}
-func (*TableStatus) Descriptor() ([]byte, []int) {
+func (m *Latency) Reset()         { *m = Latency{} }
+func (m *Latency) String() string { return proto.CompactTextString(m) }
+func (*Latency) ProtoMessage()    {}
+func (*Latency) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae83c9c6cf5ef75c, []int{3}
 }
-func (m *TableStatus) XXX_Unmarshal(b []byte) error {
+func (m *Latency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TableStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Latency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TableStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Latency.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -270,445 +382,4276 @@ func (m *TableStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TableStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TableStatus.Merge(m, src)
+func (m *Latency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Latency.Merge(m, src)
 }
-func (m *TableStatus) XXX_Size() int {
+func (m *Latency) XXX_Size() int {
 	return m.Size()
 }
-func (m *TableStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_TableStatus.DiscardUnknown(m)
+func (m *Latency) XXX_DiscardUnknown() {
+	xxx_messageInfo_Latency.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TableStatus proto.InternalMessageInfo
+var xxx_messageInfo_Latency proto.InternalMessageInfo
 
-func (m *TableStatus) GetTableID() TableID {
+func (m *Latency) GetP50() float64 {
 	if m != nil {
-		return m.TableID
+		return m.P50
 	}
 	return 0
 }
 
-func (m *TableStatus) GetSpan() Span {
+func (m *Latency) GetP99() float64 {
 	if m != nil {
-		return m.Span
+		return m.P99
 	}
-	return Span{}
+	return 0
 }
 
-func (m *TableStatus) GetState() TableState {
-	if m != nil {
-		return m.State
+// WorkerHealth is the health of workers of the executor.
+type WorkerHealth struct {
+	// Number of alive workers.
+	AliveWorkers uint32 `protobuf:"varint,1,opt,name=alive_workers,json=aliveWorkers,proto3" json:"alive_workers,omitempty"`
+	// Number of workers that make no progress for a while.
+	StuckWorkers uint32 `protobuf:"varint,2,opt,name=stuck_workers,json=stuckWorkers,proto3" json:"stuck_workers,omitempty"`
+}
+
+func (m *WorkerHealth) Reset()         { *m = WorkerHealth{} }
+func (m *WorkerHealth) String() string { return proto.CompactTextString(m) }
+func (*WorkerHealth) ProtoMessage()    {}
+func (*WorkerHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{4}
+}
+func (m *WorkerHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return TableStateUnknown
+}
+func (m *WorkerHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerHealth.Merge(m, src)
+}
+func (m *WorkerHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerHealth.DiscardUnknown(m)
 }
 
-func (m *TableStatus) GetCheckpoint() Checkpoint {
+var xxx_messageInfo_WorkerHealth proto.InternalMessageInfo
+
+func (m *WorkerHealth) GetAliveWorkers() uint32 {
 	if m != nil {
-		return m.Checkpoint
+		return m.AliveWorkers
 	}
-	return Checkpoint{}
+	return 0
 }
 
-func (m *TableStatus) GetStats() Stats {
+func (m *WorkerHealth) GetStuckWorkers() uint32 {
 	if m != nil {
-		return m.Stats
+		return m.StuckWorkers
 	}
-	return Stats{}
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.TableState", TableState_name, TableState_value)
-	proto.RegisterType((*Span)(nil), "pingcap.tiflow.cdc.processor.tablepb.Span")
-	proto.RegisterType((*Checkpoint)(nil), "pingcap.tiflow.cdc.processor.tablepb.Checkpoint")
-	proto.RegisterType((*Stats)(nil), "pingcap.tiflow.cdc.processor.tablepb.Stats")
-	proto.RegisterMapType((map[string]Checkpoint)(nil), "pingcap.tiflow.cdc.processor.tablepb.Stats.StageCheckpointsEntry")
-	proto.RegisterType((*TableStatus)(nil), "pingcap.tiflow.cdc.processor.tablepb.TableStatus")
+// LargeTxn is the status of a large transaction that holds back the checkpoint.
+type LargeTxn struct {
+	HoldingCheckpoint bool `protobuf:"varint,1,opt,name=holding_checkpoint,json=holdingCheckpoint,proto3" json:"holding_checkpoint,omitempty"`
+	StartTs           Ts   `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3,casttype=Ts" json:"start_ts,omitempty"`
 }
 
-func init() { proto.RegisterFile("processor/tablepb/table.proto", fileDescriptor_ae83c9c6cf5ef75c) }
-
-var fileDescriptor_ae83c9c6cf5ef75c = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xb5, 0x9d, 0x5f, 0xcd, 0x27, 0xf9, 0x7e, 0xe5, 0xde, 0xb7, 0xed, 0x37, 0x44, 0x22, 0x31,
-	0x51, 0x81, 0xaa, 0x95, 0x1c, 0x28, 0x0b, 0xea, 0xd6, 0xb4, 0x80, 0xaa, 0x0a, 0x09, 0xb9, 0x81,
-	0x81, 0x25, 0xf2, 0x8f, 0xc3, 0xb5, 0x1a, 0xce, 0x96, 0xef, 0xd2, 0x2a, 0x1b, 0x23, 0xca, 0x02,
-	0x13, 0x62, 0x89, 0xd4, 0x3f, 0xa7, 0x63, 0x47, 0x06, 0x14, 0x41, 0x2a, 0x66, 0xf6, 0x4e, 0xe8,
-	0xee, 0xdc, 0xb8, 0x09, 0x0c, 0xa1, 0x4b, 0x72, 0xbe, 0xf7, 0x3e, 0xcf, 0xef, 0xbd, 0x3b, 0x19,
-	0x6e, 0x47, 0x71, 0xe8, 0x62, 0x4a, 0xc3, 0xb8, 0xc9, 0x6c, 0xa7, 0x8b, 0x23, 0x47, 0xfe, 0x9b,
-	0x51, 0x1c, 0xb2, 0x10, 0xad, 0x46, 0x01, 0xf1, 0x5d, 0x3b, 0x32, 0x59, 0xf0, 0xa6, 0x1b, 0x9e,
-	0x98, 0xae, 0xe7, 0x9a, 0x93, 0x09, 0x33, 0x99, 0xa8, 0x2e, 0xf9, 0xa1, 0x1f, 0x8a, 0x81, 0x26,
-	0x5f, 0xc9, 0xd9, 0xc6, 0x07, 0x15, 0xb2, 0x07, 0x91, 0x4d, 0xd0, 0x43, 0x58, 0x10, 0xcc, 0x4e,
-	0xe0, 0x55, 0x54, 0x43, 0x5d, 0xcb, 0xb4, 0x56, 0xc6, 0xa3, 0x7a, 0xa1, 0xcd, 0xf7, 0xf6, 0x76,
-	0x2f, 0xd3, 0xa5, 0x55, 0x10, 0xbc, 0x3d, 0x0f, 0xad, 0x42, 0x91, 0x32, 0x3b, 0x66, 0x9d, 0x23,
-	0xdc, 0xaf, 0x68, 0x86, 0xba, 0x56, 0x6e, 0x15, 0x2e, 0x47, 0xf5, 0xcc, 0x3e, 0xee, 0x5b, 0x0b,
-	0x02, 0xd9, 0xc7, 0x7d, 0x64, 0x40, 0x01, 0x13, 0x4f, 0x70, 0x32, 0xd3, 0x9c, 0x3c, 0x26, 0xde,
-	0x3e, 0xee, 0x6f, 0x95, 0xdf, 0x9f, 0xd6, 0x95, 0xcf, 0xa7, 0x75, 0xe5, 0xdd, 0x57, 0x43, 0x69,
-	0x38, 0x00, 0x3b, 0x87, 0xd8, 0x3d, 0x8a, 0xc2, 0x80, 0x30, 0xb4, 0x01, 0xff, 0xb8, 0x93, 0xa7,
-	0x0e, 0xa3, 0xc2, 0x5b, 0xb6, 0x95, 0xbf, 0x1c, 0xd5, 0xb5, 0x36, 0xb5, 0xca, 0x29, 0xd8, 0xa6,
-	0xe8, 0x3e, 0x94, 0x62, 0x4c, 0xc3, 0xee, 0x31, 0xf6, 0x38, 0x55, 0x9b, 0xa2, 0xc2, 0x15, 0xd4,
-	0xa6, 0x8d, 0x1f, 0x1a, 0xe4, 0x0e, 0x98, 0xcd, 0x28, 0xba, 0x03, 0xe5, 0x18, 0xfb, 0x41, 0x48,
-	0x3a, 0x6e, 0xd8, 0x23, 0x4c, 0xca, 0x5b, 0x25, 0xb9, 0xb7, 0xc3, 0xb7, 0xd0, 0x5d, 0x00, 0xb7,
-	0x17, 0xc7, 0x58, 0xbe, 0x7f, 0x5a, 0xb4, 0x98, 0x20, 0x6d, 0x8a, 0x18, 0x2c, 0x52, 0x66, 0xfb,
-	0xb8, 0x93, 0x5a, 0xa2, 0x95, 0x8c, 0x91, 0x59, 0x2b, 0x6d, 0x6e, 0x9b, 0xf3, 0x9c, 0x90, 0x29,
-	0x1c, 0xf1, 0x5f, 0x1f, 0xa7, 0x0d, 0xd0, 0x27, 0x84, 0xc5, 0xfd, 0x56, 0xf6, 0x6c, 0x54, 0x57,
-	0x2c, 0x9d, 0xce, 0x80, 0xdc, 0x9c, 0x63, 0xc7, 0x71, 0x80, 0x63, 0x6e, 0x2e, 0x3b, 0x6d, 0x2e,
-	0x41, 0xda, 0xb4, 0xda, 0x83, 0xe5, 0x3f, 0xea, 0x22, 0x1d, 0x32, 0xfc, 0x64, 0x78, 0xec, 0xa2,
-	0xc5, 0x97, 0xe8, 0x29, 0xe4, 0x8e, 0xed, 0x6e, 0x0f, 0x8b, 0xa4, 0xa5, 0xcd, 0x07, 0xf3, 0x79,
-	0x4f, 0x85, 0x2d, 0x39, 0xbe, 0xa5, 0x3d, 0x56, 0x1b, 0x3f, 0x35, 0x28, 0x89, 0x6b, 0xc3, 0xa3,
-	0xf5, 0xe8, 0x4d, 0x2e, 0xd9, 0x2e, 0x64, 0x69, 0x64, 0x93, 0x4a, 0x4e, 0xb8, 0x59, 0x9f, 0xb3,
-	0xc9, 0xc8, 0x26, 0x49, 0x65, 0x62, 0x9a, 0x87, 0xa2, 0xcc, 0x66, 0x32, 0xd4, 0xbf, 0xf3, 0x86,
-	0x9a, 0x58, 0xc7, 0x96, 0x1c, 0x47, 0xaf, 0x00, 0xd2, 0xe3, 0x15, 0xf7, 0xf9, 0x06, 0x0d, 0x25,
-	0xce, 0xae, 0x29, 0xa1, 0x67, 0xd2, 0x9f, 0x3c, 0xc1, 0xd2, 0xe6, 0xc6, 0x5f, 0x5c, 0x98, 0x44,
-	0x4d, 0xce, 0xaf, 0x7f, 0xd2, 0x00, 0x52, 0xdb, 0xa8, 0x01, 0x85, 0x97, 0xe4, 0x88, 0x84, 0x27,
-	0x44, 0x57, 0xaa, 0xcb, 0x83, 0xa1, 0xb1, 0x98, 0x82, 0x09, 0x80, 0x0c, 0xc8, 0x6f, 0x3b, 0x14,
-	0x13, 0xa6, 0xab, 0xd5, 0xa5, 0xc1, 0xd0, 0xd0, 0x53, 0x8a, 0xdc, 0x47, 0xf7, 0xa0, 0xf8, 0x22,
-	0xc6, 0x91, 0x1d, 0x07, 0xc4, 0xd7, 0xb5, 0xea, 0xff, 0x83, 0xa1, 0xf1, 0x5f, 0x4a, 0x9a, 0x40,
-	0x68, 0x15, 0x16, 0xe4, 0x03, 0xf6, 0xf4, 0x4c, 0x75, 0x65, 0x30, 0x34, 0xd0, 0x2c, 0x0d, 0x7b,
-	0x68, 0x1d, 0x4a, 0x16, 0x8e, 0xba, 0x81, 0x6b, 0x33, 0xae, 0x97, 0xad, 0xde, 0x1a, 0x0c, 0x8d,
-	0xe5, 0x6b, 0x5d, 0xa7, 0x20, 0x57, 0x3c, 0x60, 0x61, 0xc4, 0xdb, 0xd0, 0x73, 0xb3, 0x8a, 0x57,
-	0x08, 0x4f, 0x29, 0xd6, 0xd8, 0xd3, 0xf3, 0xb3, 0x29, 0x13, 0xa0, 0xf5, 0xfc, 0xfc, 0x7b, 0x4d,
-	0x39, 0x1b, 0xd7, 0xd4, 0xf3, 0x71, 0x4d, 0xfd, 0x36, 0xae, 0xa9, 0x1f, 0x2f, 0x6a, 0xca, 0xf9,
-	0x45, 0x4d, 0xf9, 0x72, 0x51, 0x53, 0x5e, 0x37, 0xfd, 0x80, 0x1d, 0xf6, 0x1c, 0xd3, 0x0d, 0xdf,
-	0x36, 0x93, 0xea, 0x9b, 0xb2, 0xfa, 0xa6, 0xeb, 0xb9, 0xcd, 0xdf, 0xbe, 0xbf, 0x4e, 0x5e, 0x7c,
-	0x3e, 0x1f, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x80, 0x0e, 0x45, 0x99, 0x9b, 0x05, 0x00, 0x00,
+func (m *LargeTxn) Reset()         { *m = LargeTxn{} }
+func (m *LargeTxn) String() string { return proto.CompactTextString(m) }
+func (*LargeTxn) ProtoMessage()    {}
+func (*LargeTxn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{5}
 }
-
-func (m *Span) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LargeTxn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LargeTxn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LargeTxn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Span) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LargeTxn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LargeTxn.Merge(m, src)
+}
+func (m *LargeTxn) XXX_Size() int {
+	return m.Size()
+}
+func (m *LargeTxn) XXX_DiscardUnknown() {
+	xxx_messageInfo_LargeTxn.DiscardUnknown(m)
 }
 
-func (m *Span) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndKey) > 0 {
-		i -= len(m.EndKey)
-		copy(dAtA[i:], m.EndKey)
-		i = encodeVarintTable(dAtA, i, uint64(len(m.EndKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintTable(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TableID != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.TableID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_LargeTxn proto.InternalMessageInfo
+
+func (m *LargeTxn) GetHoldingCheckpoint() bool {
+	if m != nil {
+		return m.HoldingCheckpoint
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LargeTxn) GetStartTs() Ts {
+	if m != nil {
+		return m.StartTs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchFlush is the statistics of batch flushes of a sink.
+type BatchFlush struct {
+	// Average number of events in a batch.
+	AvgBatchSize float64 `protobuf:"fixed64,1,opt,name=avg_batch_size,json=avgBatchSize,proto3" json:"avg_batch_size,omitempty"`
+	// Number of flushes per second.
+	FlushesPerSecond float64 `protobuf:"fixed64,2,opt,name=flushes_per_second,json=flushesPerSecond,proto3" json:"flushes_per_second,omitempty"`
 }
 
-func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResolvedTs != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.ResolvedTs))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CheckpointTs != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.CheckpointTs))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *BatchFlush) Reset()         { *m = BatchFlush{} }
+func (m *BatchFlush) String() string { return proto.CompactTextString(m) }
+func (*BatchFlush) ProtoMessage()    {}
+func (*BatchFlush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{6}
 }
-
-func (m *Stats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchFlush) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchFlush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchFlush.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchFlush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFlush.Merge(m, src)
+}
+func (m *BatchFlush) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchFlush) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFlush.DiscardUnknown(m)
 }
 
-func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BarrierTs != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.BarrierTs))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.StageCheckpoints) > 0 {
-		for k := range m.StageCheckpoints {
-			v := m.StageCheckpoints[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTable(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintTable(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTable(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.CurrentTs != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.CurrentTs))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RegionCount != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.RegionCount))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_BatchFlush proto.InternalMessageInfo
+
+func (m *BatchFlush) GetAvgBatchSize() float64 {
+	if m != nil {
+		return m.AvgBatchSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TableStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchFlush) GetFlushesPerSecond() float64 {
+	if m != nil {
+		return m.FlushesPerSecond
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TableStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RegionUnavailable is the status of an unavailable upstream region that
+// blocks the table.
+type RegionUnavailable struct {
+	RegionID uint64 `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	// Unix timestamp in milliseconds since when the region is unavailable.
+	SinceMs int64 `protobuf:"varint,2,opt,name=since_ms,json=sinceMs,proto3" json:"since_ms,omitempty"`
 }
 
-func (m *TableStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+func (m *RegionUnavailable) Reset()         { *m = RegionUnavailable{} }
+func (m *RegionUnavailable) String() string { return proto.CompactTextString(m) }
+func (*RegionUnavailable) ProtoMessage()    {}
+func (*RegionUnavailable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{7}
+}
+func (m *RegionUnavailable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionUnavailable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionUnavailable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTable(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTable(dAtA, i, uint64(size))
+}
+func (m *RegionUnavailable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionUnavailable.Merge(m, src)
+}
+func (m *RegionUnavailable) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionUnavailable) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionUnavailable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionUnavailable proto.InternalMessageInfo
+
+func (m *RegionUnavailable) GetRegionID() uint64 {
+	if m != nil {
+		return m.RegionID
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *RegionUnavailable) GetSinceMs() int64 {
+	if m != nil {
+		return m.SinceMs
+	}
+	return 0
+}
+
+// MemoryQuota is the memory quota usage of a table.
+type MemoryQuota struct {
+	// True if the quota is exhausted and backpressure is applied.
+	Exhausted  bool   `protobuf:"varint,1,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	UsedBytes  uint64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes uint64 `protobuf:"varint,3,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+}
+
+func (m *MemoryQuota) Reset()         { *m = MemoryQuota{} }
+func (m *MemoryQuota) String() string { return proto.CompactTextString(m) }
+func (*MemoryQuota) ProtoMessage()    {}
+func (*MemoryQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{8}
+}
+func (m *MemoryQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoryQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoryQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTable(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.State != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TableID != 0 {
-		i = encodeVarintTable(dAtA, i, uint64(m.TableID))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MemoryQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoryQuota.Merge(m, src)
+}
+func (m *MemoryQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoryQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoryQuota.DiscardUnknown(m)
 }
 
-func encodeVarintTable(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTable(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MemoryQuota proto.InternalMessageInfo
+
+func (m *MemoryQuota) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *Span) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TableID != 0 {
-		n += 1 + sovTable(uint64(m.TableID))
-	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovTable(uint64(l))
-	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovTable(uint64(l))
+
+func (m *MemoryQuota) GetUsedBytes() uint64 {
+	if m != nil {
+		return m.UsedBytes
 	}
-	return n
+	return 0
 }
 
-func (m *Checkpoint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CheckpointTs != 0 {
-		n += 1 + sovTable(uint64(m.CheckpointTs))
-	}
-	if m.ResolvedTs != 0 {
-		n += 1 + sovTable(uint64(m.ResolvedTs))
+func (m *MemoryQuota) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
 	}
-	return n
+	return 0
 }
 
-func (m *Stats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RegionCount != 0 {
-		n += 1 + sovTable(uint64(m.RegionCount))
-	}
-	if m.CurrentTs != 0 {
-		n += 1 + sovTable(uint64(m.CurrentTs))
-	}
-	if len(m.StageCheckpoints) > 0 {
-		for k, v := range m.StageCheckpoints {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovTable(uint64(len(k))) + 1 + l + sovTable(uint64(l))
-			n += mapEntrySize + 1 + sovTable(uint64(mapEntrySize))
+// ConnectionPool is the status of a connection pool of a sink.
+type ConnectionPool struct {
+	Active uint32 `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Idle   uint32 `protobuf:"varint,2,opt,name=idle,proto3" json:"idle,omitempty"`
+	// Number of requests waiting for a connection.
+	Waiting uint32 `protobuf:"varint,3,opt,name=waiting,proto3" json:"waiting,omitempty"`
+}
+
+func (m *ConnectionPool) Reset()         { *m = ConnectionPool{} }
+func (m *ConnectionPool) String() string { return proto.CompactTextString(m) }
+func (*ConnectionPool) ProtoMessage()    {}
+func (*ConnectionPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{9}
+}
+func (m *ConnectionPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BarrierTs != 0 {
-		n += 1 + sovTable(uint64(m.BarrierTs))
-	}
-	return n
+}
+func (m *ConnectionPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionPool.Merge(m, src)
+}
+func (m *ConnectionPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionPool.DiscardUnknown(m)
 }
 
-func (m *TableStatus) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ConnectionPool proto.InternalMessageInfo
+
+func (m *ConnectionPool) GetActive() uint32 {
+	if m != nil {
+		return m.Active
 	}
-	var l int
-	_ = l
-	if m.TableID != 0 {
-		n += 1 + sovTable(uint64(m.TableID))
+	return 0
+}
+
+func (m *ConnectionPool) GetIdle() uint32 {
+	if m != nil {
+		return m.Idle
 	}
-	if m.State != 0 {
-		n += 1 + sovTable(uint64(m.State))
+	return 0
+}
+
+func (m *ConnectionPool) GetWaiting() uint32 {
+	if m != nil {
+		return m.Waiting
 	}
-	l = m.Checkpoint.Size()
-	n += 1 + l + sovTable(uint64(l))
-	l = m.Stats.Size()
-	n += 1 + l + sovTable(uint64(l))
-	l = m.Span.Size()
-	n += 1 + l + sovTable(uint64(l))
-	return n
+	return 0
 }
 
-func sovTable(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// Idempotency is the status of the idempotency mechanism of an exactly-once
+// sink.
+type Idempotency struct {
+	// True if the deduplication is active.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// The range of commit timestamps covered by idempotency keys.
+	CoveredStartTs Ts `protobuf:"varint,2,opt,name=covered_start_ts,json=coveredStartTs,proto3,casttype=Ts" json:"covered_start_ts,omitempty"`
+	CoveredEndTs   Ts `protobuf:"varint,3,opt,name=covered_end_ts,json=coveredEndTs,proto3,casttype=Ts" json:"covered_end_ts,omitempty"`
 }
-func sozTable(x uint64) (n int) {
-	return sovTable(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Idempotency) Reset()         { *m = Idempotency{} }
+func (m *Idempotency) String() string { return proto.CompactTextString(m) }
+func (*Idempotency) ProtoMessage()    {}
+func (*Idempotency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{10}
 }
-func (m *Span) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTable
+func (m *Idempotency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Idempotency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Idempotency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Idempotency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Idempotency.Merge(m, src)
+}
+func (m *Idempotency) XXX_Size() int {
+	return m.Size()
+}
+func (m *Idempotency) XXX_DiscardUnknown() {
+	xxx_messageInfo_Idempotency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Idempotency proto.InternalMessageInfo
+
+func (m *Idempotency) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Idempotency) GetCoveredStartTs() Ts {
+	if m != nil {
+		return m.CoveredStartTs
+	}
+	return 0
+}
+
+func (m *Idempotency) GetCoveredEndTs() Ts {
+	if m != nil {
+		return m.CoveredEndTs
+	}
+	return 0
+}
+
+// Causality is the causality grouping status of a sink that groups events
+// by transactions for causal ordering.
+type Causality struct {
+	// The number of causality groups in flight.
+	GroupsInFlight uint64 `protobuf:"varint,1,opt,name=groups_in_flight,json=groupsInFlight,proto3" json:"groups_in_flight,omitempty"`
+	// The number of events in the largest causality group in flight.
+	MaxGroupSize uint64 `protobuf:"varint,2,opt,name=max_group_size,json=maxGroupSize,proto3" json:"max_group_size,omitempty"`
+}
+
+func (m *Causality) Reset()         { *m = Causality{} }
+func (m *Causality) String() string { return proto.CompactTextString(m) }
+func (*Causality) ProtoMessage()    {}
+func (*Causality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{11}
+}
+func (m *Causality) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Causality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Causality.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Causality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Causality.Merge(m, src)
+}
+func (m *Causality) XXX_Size() int {
+	return m.Size()
+}
+func (m *Causality) XXX_DiscardUnknown() {
+	xxx_messageInfo_Causality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Causality proto.InternalMessageInfo
+
+func (m *Causality) GetGroupsInFlight() uint64 {
+	if m != nil {
+		return m.GroupsInFlight
+	}
+	return 0
+}
+
+func (m *Causality) GetMaxGroupSize() uint64 {
+	if m != nil {
+		return m.MaxGroupSize
+	}
+	return 0
+}
+
+// SorterSpill is the on-disk spill usage of the sorter of a table.
+type SorterSpill struct {
+	// The number of bytes spilled on disk.
+	DiskBytes uint64 `protobuf:"varint,1,opt,name=disk_bytes,json=diskBytes,proto3" json:"disk_bytes,omitempty"`
+	// The rate of spilling, in bytes per second.
+	SpillRate float64 `protobuf:"fixed64,2,opt,name=spill_rate,json=spillRate,proto3" json:"spill_rate,omitempty"`
+}
+
+func (m *SorterSpill) Reset()         { *m = SorterSpill{} }
+func (m *SorterSpill) String() string { return proto.CompactTextString(m) }
+func (*SorterSpill) ProtoMessage()    {}
+func (*SorterSpill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{12}
+}
+func (m *SorterSpill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SorterSpill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SorterSpill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SorterSpill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SorterSpill.Merge(m, src)
+}
+func (m *SorterSpill) XXX_Size() int {
+	return m.Size()
+}
+func (m *SorterSpill) XXX_DiscardUnknown() {
+	xxx_messageInfo_SorterSpill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SorterSpill proto.InternalMessageInfo
+
+func (m *SorterSpill) GetDiskBytes() uint64 {
+	if m != nil {
+		return m.DiskBytes
+	}
+	return 0
+}
+
+func (m *SorterSpill) GetSpillRate() float64 {
+	if m != nil {
+		return m.SpillRate
+	}
+	return 0
+}
+
+// ErrorRecovery is the error and recovery history of a table, it helps
+// compute the mean time to recovery.
+type ErrorRecovery struct {
+	// Unix timestamp in milliseconds of the last error, 0 if there is none.
+	LastErrorMs int64 `protobuf:"varint,1,opt,name=last_error_ms,json=lastErrorMs,proto3" json:"last_error_ms,omitempty"`
+	// Milliseconds it took to recover from the last error, 0 if the table
+	// has not recovered yet.
+	RecoveryMs int64 `protobuf:"varint,2,opt,name=recovery_ms,json=recoveryMs,proto3" json:"recovery_ms,omitempty"`
+}
+
+func (m *ErrorRecovery) Reset()         { *m = ErrorRecovery{} }
+func (m *ErrorRecovery) String() string { return proto.CompactTextString(m) }
+func (*ErrorRecovery) ProtoMessage()    {}
+func (*ErrorRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{13}
+}
+func (m *ErrorRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorRecovery.Merge(m, src)
+}
+func (m *ErrorRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorRecovery proto.InternalMessageInfo
+
+func (m *ErrorRecovery) GetLastErrorMs() int64 {
+	if m != nil {
+		return m.LastErrorMs
+	}
+	return 0
+}
+
+func (m *ErrorRecovery) GetRecoveryMs() int64 {
+	if m != nil {
+		return m.RecoveryMs
+	}
+	return 0
+}
+
+// Stats holds a statistic for a table.
+type Stats struct {
+	// Number of captured regions.
+	RegionCount uint64 `protobuf:"varint,1,opt,name=region_count,json=regionCount,proto3" json:"region_count,omitempty"`
+	// The current timestamp from the table's point of view.
+	CurrentTs Ts `protobuf:"varint,2,opt,name=current_ts,json=currentTs,proto3,casttype=Ts" json:"current_ts,omitempty"`
+	// Checkponits at each stage.
+	StageCheckpoints map[string]Checkpoint `protobuf:"bytes,3,rep,name=stage_checkpoints,json=stageCheckpoints,proto3" json:"stage_checkpoints" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The barrier timestamp of the table.
+	BarrierTs Ts `protobuf:"varint,4,opt,name=barrier_ts,json=barrierTs,proto3,casttype=Ts" json:"barrier_ts,omitempty"`
+	// Latest produced positions, only reported by MQ sinks.
+	MQPositions []MQPosition `protobuf:"bytes,5,rep,name=mq_positions,json=mqPositions,proto3" json:"mq_positions"`
+	// Latency of writing events of the table to the downstream.
+	SinkWriteLatency Latency `protobuf:"bytes,6,opt,name=sink_write_latency,json=sinkWriteLatency,proto3" json:"sink_write_latency"`
+	// Number of events buffered in the table pipeline and not yet written
+	// to the downstream.
+	BufferedEventCount uint64 `protobuf:"varint,7,opt,name=buffered_event_count,json=bufferedEventCount,proto3" json:"buffered_event_count,omitempty"`
+	// Health of executor workers serving the table.
+	WorkerHealth WorkerHealth `protobuf:"bytes,8,opt,name=worker_health,json=workerHealth,proto3" json:"worker_health"`
+	// Seconds of checkpoint advanced per second in a recent sliding window,
+	// it is computed by the scheduler agent.
+	CheckpointAdvanceRate float64 `protobuf:"fixed64,9,opt,name=checkpoint_advance_rate,json=checkpointAdvanceRate,proto3" json:"checkpoint_advance_rate,omitempty"`
+	// Number of write conflicts, e.g. unique key violations, in the downstream.
+	ConflictCount uint64 `protobuf:"varint,10,opt,name=conflict_count,json=conflictCount,proto3" json:"conflict_count,omitempty"`
+	// Number of retried writes to the downstream.
+	RetryCount uint64 `protobuf:"varint,11,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// Compatibility of the table schema with the downstream schema registry.
+	SchemaCompatibility SchemaCompatibility `protobuf:"varint,12,opt,name=schema_compatibility,json=schemaCompatibility,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.SchemaCompatibility" json:"schema_compatibility,omitempty"`
+	// The large transaction that is holding back the checkpoint, if any.
+	LargeTxn LargeTxn `protobuf:"bytes,13,opt,name=large_txn,json=largeTxn,proto3" json:"large_txn"`
+	// Skew between the commit time of events in the upstream and the time
+	// they are written to the downstream, in milliseconds.
+	EventTimeSkew Latency `protobuf:"bytes,14,opt,name=event_time_skew,json=eventTimeSkew,proto3" json:"event_time_skew"`
+	// Statistics of batch flushes of the sink.
+	BatchFlush BatchFlush `protobuf:"bytes,15,opt,name=batch_flush,json=batchFlush,proto3" json:"batch_flush"`
+	// Upstream regions that are unavailable and block the table.
+	UnavailableRegions []RegionUnavailable `protobuf:"bytes,16,rep,name=unavailable_regions,json=unavailableRegions,proto3" json:"unavailable_regions"`
+	// Memory quota usage of the table.
+	MemoryQuota MemoryQuota `protobuf:"bytes,17,opt,name=memory_quota,json=memoryQuota,proto3" json:"memory_quota"`
+	// Number of DML rows and DDL events processed. The executor reports
+	// cumulative counts, and the scheduler agent converts them to deltas
+	// since the previous detailed heartbeat.
+	DMLRowCount   uint64 `protobuf:"varint,18,opt,name=dml_row_count,json=dmlRowCount,proto3" json:"dml_row_count,omitempty"`
+	DDLEventCount uint64 `protobuf:"varint,19,opt,name=ddl_event_count,json=ddlEventCount,proto3" json:"ddl_event_count,omitempty"`
+	// Number of events filtered out as loopback in cyclic replication.
+	CyclicFilteredCount uint64 `protobuf:"varint,20,opt,name=cyclic_filtered_count,json=cyclicFilteredCount,proto3" json:"cyclic_filtered_count,omitempty"`
+	// The mechanism that advances the reported checkpoint of the table.
+	CheckpointSource WatermarkSource `protobuf:"varint,21,opt,name=checkpoint_source,json=checkpointSource,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.WatermarkSource" json:"checkpoint_source,omitempty"`
+	// Status of the connection pool of the sink, e.g. MySQL and TiDB.
+	SinkConnectionPool ConnectionPool `protobuf:"bytes,22,opt,name=sink_connection_pool,json=sinkConnectionPool,proto3" json:"sink_connection_pool"`
+	// Status of the idempotency mechanism of the sink.
+	SinkIdempotency Idempotency `protobuf:"bytes,23,opt,name=sink_idempotency,json=sinkIdempotency,proto3" json:"sink_idempotency"`
+	// Causality grouping status of the sink.
+	SinkCausality Causality `protobuf:"bytes,24,opt,name=sink_causality,json=sinkCausality,proto3" json:"sink_causality"`
+	// On-disk spill usage of the sorter.
+	SorterSpill SorterSpill `protobuf:"bytes,25,opt,name=sorter_spill,json=sorterSpill,proto3" json:"sorter_spill"`
+	// Error and recovery history of the table sink.
+	ErrorRecovery ErrorRecovery `protobuf:"bytes,26,opt,name=error_recovery,json=errorRecovery,proto3" json:"error_recovery"`
+}
+
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{14}
+}
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
+}
+func (m *Stats) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetRegionCount() uint64 {
+	if m != nil {
+		return m.RegionCount
+	}
+	return 0
+}
+
+func (m *Stats) GetCurrentTs() Ts {
+	if m != nil {
+		return m.CurrentTs
+	}
+	return 0
+}
+
+func (m *Stats) GetStageCheckpoints() map[string]Checkpoint {
+	if m != nil {
+		return m.StageCheckpoints
+	}
+	return nil
+}
+
+func (m *Stats) GetBarrierTs() Ts {
+	if m != nil {
+		return m.BarrierTs
+	}
+	return 0
+}
+
+func (m *Stats) GetMQPositions() []MQPosition {
+	if m != nil {
+		return m.MQPositions
+	}
+	return nil
+}
+
+func (m *Stats) GetSinkWriteLatency() Latency {
+	if m != nil {
+		return m.SinkWriteLatency
+	}
+	return Latency{}
+}
+
+func (m *Stats) GetBufferedEventCount() uint64 {
+	if m != nil {
+		return m.BufferedEventCount
+	}
+	return 0
+}
+
+func (m *Stats) GetWorkerHealth() WorkerHealth {
+	if m != nil {
+		return m.WorkerHealth
+	}
+	return WorkerHealth{}
+}
+
+func (m *Stats) GetCheckpointAdvanceRate() float64 {
+	if m != nil {
+		return m.CheckpointAdvanceRate
+	}
+	return 0
+}
+
+func (m *Stats) GetConflictCount() uint64 {
+	if m != nil {
+		return m.ConflictCount
+	}
+	return 0
+}
+
+func (m *Stats) GetRetryCount() uint64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *Stats) GetSchemaCompatibility() SchemaCompatibility {
+	if m != nil {
+		return m.SchemaCompatibility
+	}
+	return SchemaCompatibilityCompatible
+}
+
+func (m *Stats) GetLargeTxn() LargeTxn {
+	if m != nil {
+		return m.LargeTxn
+	}
+	return LargeTxn{}
+}
+
+func (m *Stats) GetEventTimeSkew() Latency {
+	if m != nil {
+		return m.EventTimeSkew
+	}
+	return Latency{}
+}
+
+func (m *Stats) GetBatchFlush() BatchFlush {
+	if m != nil {
+		return m.BatchFlush
+	}
+	return BatchFlush{}
+}
+
+func (m *Stats) GetUnavailableRegions() []RegionUnavailable {
+	if m != nil {
+		return m.UnavailableRegions
+	}
+	return nil
+}
+
+func (m *Stats) GetMemoryQuota() MemoryQuota {
+	if m != nil {
+		return m.MemoryQuota
+	}
+	return MemoryQuota{}
+}
+
+func (m *Stats) GetDMLRowCount() uint64 {
+	if m != nil {
+		return m.DMLRowCount
+	}
+	return 0
+}
+
+func (m *Stats) GetDDLEventCount() uint64 {
+	if m != nil {
+		return m.DDLEventCount
+	}
+	return 0
+}
+
+func (m *Stats) GetCyclicFilteredCount() uint64 {
+	if m != nil {
+		return m.CyclicFilteredCount
+	}
+	return 0
+}
+
+func (m *Stats) GetCheckpointSource() WatermarkSource {
+	if m != nil {
+		return m.CheckpointSource
+	}
+	return WatermarkSourceUnknown
+}
+
+func (m *Stats) GetSinkConnectionPool() ConnectionPool {
+	if m != nil {
+		return m.SinkConnectionPool
+	}
+	return ConnectionPool{}
+}
+
+func (m *Stats) GetSinkIdempotency() Idempotency {
+	if m != nil {
+		return m.SinkIdempotency
+	}
+	return Idempotency{}
+}
+
+func (m *Stats) GetSinkCausality() Causality {
+	if m != nil {
+		return m.SinkCausality
+	}
+	return Causality{}
+}
+
+func (m *Stats) GetSorterSpill() SorterSpill {
+	if m != nil {
+		return m.SorterSpill
+	}
+	return SorterSpill{}
+}
+
+func (m *Stats) GetErrorRecovery() ErrorRecovery {
+	if m != nil {
+		return m.ErrorRecovery
+	}
+	return ErrorRecovery{}
+}
+
+// TableError is an error of a table.
+type TableError struct {
+	Category TableErrorCategory `protobuf:"varint,1,opt,name=category,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.TableErrorCategory" json:"category,omitempty"`
+	Message  string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *TableError) Reset()         { *m = TableError{} }
+func (m *TableError) String() string { return proto.CompactTextString(m) }
+func (*TableError) ProtoMessage()    {}
+func (*TableError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{15}
+}
+func (m *TableError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableError.Merge(m, src)
+}
+func (m *TableError) XXX_Size() int {
+	return m.Size()
+}
+func (m *TableError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableError proto.InternalMessageInfo
+
+func (m *TableError) GetCategory() TableErrorCategory {
+	if m != nil {
+		return m.Category
+	}
+	return TableErrorCategoryUnknown
+}
+
+func (m *TableError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// TableStatus is the running status of a table.
+// TODO rename to TableStatus.
+type TableStatus struct {
+	TableID    TableID    `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=TableID" json:"table_id,omitempty"`
+	Span       Span       `protobuf:"bytes,5,opt,name=span,proto3" json:"span"`
+	State      TableState `protobuf:"varint,2,opt,name=state,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.TableState" json:"state,omitempty"`
+	Checkpoint Checkpoint `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint"`
+	Stats      Stats      `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats"`
+	// The error of the table, nil if the table is healthy.
+	Error           *TableError     `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ReplicationMode ReplicationMode `protobuf:"varint,7,opt,name=replication_mode,json=replicationMode,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.ReplicationMode" json:"replication_mode,omitempty"`
+}
+
+func (m *TableStatus) Reset()         { *m = TableStatus{} }
+func (m *TableStatus) String() string { return proto.CompactTextString(m) }
+func (*TableStatus) ProtoMessage()    {}
+func (*TableStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{16}
+}
+func (m *TableStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableStatus.Merge(m, src)
+}
+func (m *TableStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TableStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableStatus proto.InternalMessageInfo
+
+func (m *TableStatus) GetTableID() TableID {
+	if m != nil {
+		return m.TableID
+	}
+	return 0
+}
+
+func (m *TableStatus) GetSpan() Span {
+	if m != nil {
+		return m.Span
+	}
+	return Span{}
+}
+
+func (m *TableStatus) GetState() TableState {
+	if m != nil {
+		return m.State
+	}
+	return TableStateUnknown
+}
+
+func (m *TableStatus) GetCheckpoint() Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return Checkpoint{}
+}
+
+func (m *TableStatus) GetStats() Stats {
+	if m != nil {
+		return m.Stats
+	}
+	return Stats{}
+}
+
+func (m *TableStatus) GetError() *TableError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *TableStatus) GetReplicationMode() ReplicationMode {
+	if m != nil {
+		return m.ReplicationMode
+	}
+	return ReplicationModeUnknown
+}
+
+func init() {
+	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.TableState", TableState_name, TableState_value)
+	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.SchemaCompatibility", SchemaCompatibility_name, SchemaCompatibility_value)
+	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.WatermarkSource", WatermarkSource_name, WatermarkSource_value)
+	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.ReplicationMode", ReplicationMode_name, ReplicationMode_value)
+	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.TableErrorCategory", TableErrorCategory_name, TableErrorCategory_value)
+	proto.RegisterType((*Span)(nil), "pingcap.tiflow.cdc.processor.tablepb.Span")
+	proto.RegisterType((*Checkpoint)(nil), "pingcap.tiflow.cdc.processor.tablepb.Checkpoint")
+	proto.RegisterType((*MQPosition)(nil), "pingcap.tiflow.cdc.processor.tablepb.MQPosition")
+	proto.RegisterType((*Latency)(nil), "pingcap.tiflow.cdc.processor.tablepb.Latency")
+	proto.RegisterType((*WorkerHealth)(nil), "pingcap.tiflow.cdc.processor.tablepb.WorkerHealth")
+	proto.RegisterType((*LargeTxn)(nil), "pingcap.tiflow.cdc.processor.tablepb.LargeTxn")
+	proto.RegisterType((*BatchFlush)(nil), "pingcap.tiflow.cdc.processor.tablepb.BatchFlush")
+	proto.RegisterType((*RegionUnavailable)(nil), "pingcap.tiflow.cdc.processor.tablepb.RegionUnavailable")
+	proto.RegisterType((*MemoryQuota)(nil), "pingcap.tiflow.cdc.processor.tablepb.MemoryQuota")
+	proto.RegisterType((*ConnectionPool)(nil), "pingcap.tiflow.cdc.processor.tablepb.ConnectionPool")
+	proto.RegisterType((*Idempotency)(nil), "pingcap.tiflow.cdc.processor.tablepb.Idempotency")
+	proto.RegisterType((*Causality)(nil), "pingcap.tiflow.cdc.processor.tablepb.Causality")
+	proto.RegisterType((*SorterSpill)(nil), "pingcap.tiflow.cdc.processor.tablepb.SorterSpill")
+	proto.RegisterType((*ErrorRecovery)(nil), "pingcap.tiflow.cdc.processor.tablepb.ErrorRecovery")
+	proto.RegisterType((*Stats)(nil), "pingcap.tiflow.cdc.processor.tablepb.Stats")
+	proto.RegisterMapType((map[string]Checkpoint)(nil), "pingcap.tiflow.cdc.processor.tablepb.Stats.StageCheckpointsEntry")
+	proto.RegisterType((*TableError)(nil), "pingcap.tiflow.cdc.processor.tablepb.TableError")
+	proto.RegisterType((*TableStatus)(nil), "pingcap.tiflow.cdc.processor.tablepb.TableStatus")
+}
+
+func init() { proto.RegisterFile("processor/tablepb/table.proto", fileDescriptor_ae83c9c6cf5ef75c) }
+
+var fileDescriptor_ae83c9c6cf5ef75c = []byte{
+	// 2168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x0f, 0x49, 0x24, 0x1f, 0x7f, 0x68, 0x35, 0xfa, 0xe1, 0x35, 0xf1, 0xb5, 0x48, 0x33,
+	0x4e, 0xa2, 0xaf, 0x12, 0x4b, 0x8e, 0x5c, 0x27, 0x51, 0x8a, 0x1e, 0x2c, 0xc9, 0x4e, 0x05, 0x5b,
+	0x80, 0xbc, 0x64, 0xe2, 0x34, 0x49, 0xb1, 0x1e, 0xee, 0x8e, 0xc8, 0x05, 0x97, 0xbb, 0xeb, 0x9d,
+	0xa1, 0x64, 0x1a, 0x3d, 0xf4, 0x52, 0xa0, 0xe0, 0xa5, 0x3d, 0x15, 0xed, 0x81, 0x40, 0xee, 0xfd,
+	0x1b, 0x7a, 0xcf, 0xd1, 0xa7, 0xa2, 0x87, 0x42, 0x68, 0xe5, 0xfe, 0x15, 0x46, 0x0f, 0xc5, 0xfc,
+	0x58, 0x2e, 0x49, 0x29, 0x00, 0xed, 0x8b, 0xb4, 0xf3, 0x79, 0x9f, 0xf7, 0xf6, 0xbd, 0x79, 0x6f,
+	0xde, 0x9b, 0x25, 0xdc, 0x08, 0x42, 0xdf, 0x22, 0x94, 0xfa, 0xe1, 0x36, 0xc3, 0x4d, 0x97, 0x04,
+	0x4d, 0xf9, 0x7f, 0x2b, 0x08, 0x7d, 0xe6, 0xa3, 0x5b, 0x81, 0xe3, 0xb5, 0x2c, 0x1c, 0x6c, 0x31,
+	0xe7, 0xc4, 0xf5, 0xcf, 0xb6, 0x2c, 0xdb, 0xda, 0x1a, 0x69, 0x6c, 0x29, 0x8d, 0xf2, 0x4a, 0xcb,
+	0x6f, 0xf9, 0x42, 0x61, 0x9b, 0x3f, 0x49, 0xdd, 0xda, 0x1f, 0x92, 0x30, 0x57, 0x0f, 0xb0, 0x87,
+	0x3e, 0x81, 0xac, 0x60, 0x9a, 0x8e, 0xad, 0x27, 0xab, 0xc9, 0x8d, 0xf4, 0xde, 0xda, 0xc5, 0x79,
+	0x25, 0xd3, 0xe0, 0xd8, 0xe1, 0xc1, 0x9b, 0xf8, 0xd1, 0xc8, 0x08, 0xde, 0xa1, 0x8d, 0x6e, 0x41,
+	0x8e, 0x32, 0x1c, 0x32, 0xb3, 0x43, 0xfa, 0x7a, 0xaa, 0x9a, 0xdc, 0x28, 0xec, 0x65, 0xde, 0x9c,
+	0x57, 0xd2, 0x8f, 0x48, 0xdf, 0xc8, 0x0a, 0xc9, 0x23, 0xd2, 0x47, 0x55, 0xc8, 0x10, 0xcf, 0x16,
+	0x9c, 0xf4, 0x24, 0x67, 0x81, 0x78, 0xf6, 0x23, 0xd2, 0xff, 0xa2, 0xf0, 0xfb, 0x1f, 0x2a, 0x89,
+	0x3f, 0xff, 0x50, 0x49, 0xfc, 0xf6, 0x9f, 0xd5, 0x44, 0xad, 0x09, 0xb0, 0xdf, 0x26, 0x56, 0x27,
+	0xf0, 0x1d, 0x8f, 0xa1, 0x8f, 0xa0, 0x68, 0x8d, 0x56, 0x26, 0xa3, 0xc2, 0xb7, 0xb9, 0xbd, 0x85,
+	0x37, 0xe7, 0x95, 0x54, 0x83, 0x1a, 0x85, 0x58, 0xd8, 0xa0, 0xe8, 0x43, 0xc8, 0x87, 0x84, 0xfa,
+	0xee, 0x29, 0xb1, 0x39, 0x35, 0x35, 0x41, 0x85, 0x48, 0xd4, 0xa0, 0xb5, 0x6f, 0x00, 0x8e, 0x9e,
+	0x1c, 0xfb, 0xd4, 0x61, 0x8e, 0xef, 0xa1, 0x15, 0x98, 0x67, 0x7e, 0xe0, 0x58, 0xc2, 0x76, 0xce,
+	0x90, 0x0b, 0xf4, 0x7f, 0x90, 0x0b, 0x70, 0xc8, 0x04, 0x45, 0x98, 0x9a, 0x37, 0x62, 0x00, 0xad,
+	0xc1, 0x82, 0x7f, 0x72, 0x42, 0x09, 0x13, 0x41, 0xa5, 0x0d, 0xb5, 0xaa, 0xdd, 0x86, 0xcc, 0x63,
+	0xcc, 0x88, 0x67, 0xf5, 0x91, 0x06, 0xe9, 0xe0, 0xde, 0x1d, 0x61, 0x34, 0x69, 0xf0, 0x47, 0x81,
+	0xec, 0xee, 0xea, 0x29, 0x85, 0xec, 0xee, 0xd6, 0xbe, 0x81, 0xc2, 0x53, 0x3f, 0xec, 0x90, 0xf0,
+	0x97, 0x04, 0xbb, 0xac, 0x8d, 0xde, 0x83, 0x22, 0x76, 0x9d, 0x53, 0x62, 0x9e, 0x09, 0x54, 0x86,
+	0x5b, 0x34, 0x0a, 0x02, 0x94, 0x4c, 0xca, 0x49, 0x94, 0xf5, 0xac, 0xce, 0x88, 0x94, 0x92, 0x24,
+	0x01, 0x2a, 0x52, 0xed, 0x7b, 0xc8, 0x3e, 0xc6, 0x61, 0x8b, 0x34, 0x5e, 0x78, 0xe8, 0x36, 0xa0,
+	0xb6, 0xef, 0xda, 0x8e, 0xd7, 0x32, 0xe3, 0xfd, 0x12, 0xa6, 0xb3, 0xc6, 0x92, 0x92, 0x8c, 0xed,
+	0xf9, 0x4d, 0x90, 0xd9, 0xbb, 0xbc, 0x87, 0x19, 0x81, 0x37, 0x68, 0xed, 0x19, 0xc0, 0x1e, 0x66,
+	0x56, 0xfb, 0xa1, 0xdb, 0xa3, 0x6d, 0x74, 0x0b, 0x4a, 0xf8, 0xb4, 0x65, 0x36, 0x39, 0x62, 0x52,
+	0xe7, 0x25, 0x51, 0x41, 0x17, 0xf0, 0x69, 0x4b, 0xd0, 0xea, 0xce, 0x4b, 0x82, 0x3e, 0x06, 0x74,
+	0xc2, 0xe9, 0x84, 0x9a, 0x01, 0x09, 0x4d, 0x4a, 0x2c, 0xdf, 0xb3, 0xd5, 0x66, 0x68, 0x4a, 0x72,
+	0x4c, 0xc2, 0xba, 0xc0, 0x6b, 0xbf, 0x82, 0x25, 0x83, 0xb4, 0x1c, 0xdf, 0xfb, 0xca, 0xc3, 0xa7,
+	0xd8, 0x71, 0x79, 0xcd, 0xa1, 0xff, 0x87, 0x5c, 0x28, 0xc0, 0xa8, 0x4a, 0xe7, 0xf6, 0x0a, 0x17,
+	0xe7, 0x95, 0xac, 0x64, 0x1e, 0x1e, 0x18, 0x59, 0x29, 0x3e, 0xb4, 0xd1, 0x75, 0xc8, 0x52, 0xc7,
+	0xb3, 0x88, 0xd9, 0x95, 0x41, 0xa4, 0x8d, 0x8c, 0x58, 0x1f, 0xd1, 0x5a, 0x07, 0xf2, 0x47, 0xa4,
+	0xeb, 0x87, 0xfd, 0x27, 0x3d, 0x9f, 0x61, 0x9e, 0x68, 0xf2, 0xa2, 0x8d, 0x7b, 0x94, 0x11, 0x5b,
+	0x6d, 0x4a, 0x0c, 0xa0, 0x1b, 0x00, 0x3d, 0x4a, 0x6c, 0xb3, 0xd9, 0x67, 0x44, 0x6d, 0x87, 0x91,
+	0xe3, 0xc8, 0x1e, 0x07, 0x50, 0x05, 0xf2, 0xcf, 0xb9, 0x15, 0x25, 0x4f, 0x0b, 0x39, 0x08, 0x48,
+	0x10, 0x6a, 0x5f, 0x43, 0x69, 0xdf, 0xf7, 0x3c, 0x62, 0xf1, 0xb2, 0x39, 0xf6, 0x7d, 0x97, 0x97,
+	0x0e, 0xb6, 0x98, 0x73, 0x4a, 0x54, 0x72, 0xd5, 0x0a, 0x21, 0x98, 0x73, 0x6c, 0x97, 0xa8, 0x6c,
+	0x8a, 0x67, 0xa4, 0x43, 0xe6, 0x0c, 0x3b, 0xcc, 0xf1, 0x5a, 0xc2, 0x74, 0xd1, 0x88, 0x96, 0xb5,
+	0xdf, 0x25, 0x21, 0x7f, 0x68, 0x93, 0x6e, 0xe0, 0xcb, 0x6a, 0x9b, 0xb4, 0x9a, 0x1d, 0x59, 0xbd,
+	0x03, 0x9a, 0xe5, 0x9f, 0x92, 0x90, 0xd8, 0xe6, 0x4f, 0x24, 0xb5, 0xa4, 0xe4, 0x75, 0x99, 0x5b,
+	0xf4, 0x31, 0x44, 0x88, 0xc9, 0x0f, 0x2e, 0x53, 0x51, 0x8d, 0x9d, 0x39, 0x29, 0x7d, 0xe0, 0xf1,
+	0xa3, 0xf4, 0x1d, 0xe4, 0xf6, 0x71, 0x8f, 0x62, 0xd7, 0x61, 0x7d, 0xb4, 0x01, 0x5a, 0x2b, 0xf4,
+	0x7b, 0x01, 0x35, 0x1d, 0xcf, 0x3c, 0x71, 0x9d, 0x56, 0x5b, 0x96, 0xd9, 0x9c, 0x51, 0x92, 0xf8,
+	0xa1, 0xf7, 0x50, 0xa0, 0xbc, 0x64, 0xba, 0xf8, 0x85, 0x29, 0x50, 0x59, 0x32, 0x72, 0x6b, 0x0b,
+	0x5d, 0xfc, 0xe2, 0x4b, 0x0e, 0xf2, 0x92, 0xa9, 0x3d, 0x82, 0x7c, 0xdd, 0x0f, 0x19, 0x09, 0xeb,
+	0x81, 0xe3, 0xba, 0x3c, 0x17, 0xb6, 0x43, 0x3b, 0x6a, 0xaf, 0xa5, 0xe1, 0x1c, 0x47, 0x64, 0x2e,
+	0x6e, 0x00, 0x50, 0xce, 0x33, 0x43, 0xcc, 0x88, 0x2a, 0xac, 0x9c, 0x40, 0x0c, 0xcc, 0x48, 0xad,
+	0x01, 0xc5, 0x07, 0x61, 0xe8, 0x87, 0x06, 0x11, 0x01, 0xf4, 0x51, 0x0d, 0x8a, 0x2e, 0xa6, 0xcc,
+	0x24, 0x1c, 0x35, 0xbb, 0xd2, 0x62, 0xda, 0xc8, 0x73, 0x50, 0x30, 0x8f, 0x44, 0x7e, 0x43, 0xc5,
+	0x8f, 0x2b, 0x09, 0x22, 0xe8, 0x88, 0xd6, 0xfe, 0xab, 0xc1, 0x7c, 0x9d, 0x61, 0x46, 0xd1, 0x4d,
+	0x28, 0xa8, 0xe2, 0xb4, 0xfc, 0x9e, 0x17, 0x05, 0x9e, 0x97, 0xd8, 0x3e, 0x87, 0xd0, 0xfb, 0x00,
+	0x56, 0x2f, 0x0c, 0x89, 0x77, 0x45, 0x1a, 0x72, 0x4a, 0xd2, 0xa0, 0x88, 0xc1, 0x12, 0x65, 0xb8,
+	0x45, 0xc6, 0x4e, 0x2b, 0x4f, 0x42, 0x7a, 0x23, 0xbf, 0x73, 0x7f, 0x6b, 0x96, 0x66, 0xbf, 0x25,
+	0x3c, 0xe2, 0x7f, 0x5b, 0x24, 0x3e, 0xd8, 0xf4, 0x81, 0xc7, 0xc2, 0xfe, 0xde, 0xdc, 0x8f, 0xe7,
+	0x95, 0x84, 0xa1, 0xd1, 0x29, 0x21, 0x77, 0xae, 0x89, 0xc3, 0xd0, 0x21, 0x21, 0x77, 0x6e, 0x6e,
+	0xd2, 0x39, 0x25, 0x69, 0x50, 0xd4, 0x86, 0x42, 0xf7, 0xb9, 0x19, 0xa8, 0xe6, 0x49, 0xf5, 0x79,
+	0xe1, 0xd7, 0x9d, 0xd9, 0xfc, 0x8a, 0xbb, 0xee, 0xde, 0x32, 0x77, 0xe3, 0xe2, 0xbc, 0x92, 0x8f,
+	0x31, 0x6a, 0xe4, 0xbb, 0xcf, 0x47, 0x0b, 0x84, 0x01, 0x51, 0xc7, 0xeb, 0x98, 0x67, 0xa1, 0xc3,
+	0x88, 0xe9, 0xca, 0xb6, 0xaa, 0x2f, 0x54, 0x93, 0x1b, 0xf9, 0x9d, 0xdb, 0xb3, 0xbd, 0x4f, 0xf5,
+	0xe2, 0x51, 0xcc, 0x8e, 0xd7, 0x79, 0xca, 0xad, 0x29, 0x1c, 0xdd, 0x81, 0x95, 0x66, 0xef, 0xe4,
+	0x44, 0x16, 0xfb, 0x29, 0xcf, 0x8b, 0xcc, 0x5d, 0x46, 0xe4, 0x0e, 0x45, 0xb2, 0x07, 0x5c, 0x24,
+	0x53, 0xf8, 0x6b, 0x28, 0xca, 0xb6, 0x6b, 0xb6, 0x45, 0xcb, 0xd6, 0xb3, 0xc2, 0x9f, 0x9d, 0xd9,
+	0xfc, 0x19, 0x6f, 0xf6, 0xca, 0xa9, 0xc2, 0xd9, 0x18, 0x86, 0x3e, 0x85, 0x6b, 0x63, 0xf3, 0x0e,
+	0xdb, 0xa7, 0x98, 0xf7, 0x30, 0x51, 0xd0, 0x39, 0x51, 0xd0, 0xab, 0xb1, 0xf8, 0xbe, 0x94, 0xf2,
+	0xe2, 0x46, 0xef, 0xf3, 0x43, 0xeb, 0x9d, 0xb8, 0x8e, 0x15, 0x85, 0x00, 0x22, 0x84, 0x62, 0x84,
+	0x4a, 0xef, 0x45, 0x39, 0xb3, 0xb0, 0xaf, 0x38, 0x79, 0xc1, 0x01, 0x01, 0x49, 0x82, 0x0b, 0x2b,
+	0xd4, 0x6a, 0x93, 0x2e, 0x36, 0x2d, 0xbf, 0x1b, 0x60, 0xe6, 0x34, 0x1d, 0x7e, 0xb2, 0xf5, 0x42,
+	0x35, 0xb9, 0x51, 0xda, 0xd9, 0x9d, 0xb1, 0xfa, 0x84, 0x85, 0xfd, 0x71, 0x03, 0xc6, 0x32, 0xbd,
+	0x0c, 0xa2, 0x27, 0x90, 0x73, 0xf9, 0x90, 0x32, 0xd9, 0x0b, 0x4f, 0x2f, 0x8a, 0x8d, 0xdc, 0x9a,
+	0x35, 0xb1, 0x72, 0xb6, 0xa9, 0x4d, 0xcc, 0xba, 0x6a, 0x8d, 0xbe, 0x83, 0x45, 0x99, 0x48, 0xe6,
+	0x74, 0x89, 0x49, 0x3b, 0xe4, 0x4c, 0x2f, 0xbd, 0x7b, 0xc5, 0x14, 0x85, 0xad, 0x86, 0xd3, 0x25,
+	0xf5, 0x0e, 0x39, 0x43, 0x4f, 0x21, 0x2f, 0x87, 0x9c, 0x18, 0x57, 0xfa, 0x62, 0x35, 0x39, 0x7b,
+	0xe9, 0xc7, 0xf3, 0x52, 0xd9, 0x86, 0xe6, 0x08, 0x41, 0x1e, 0x2c, 0xf7, 0xe2, 0x39, 0x67, 0xca,
+	0x9e, 0x41, 0x75, 0x4d, 0x9c, 0xad, 0xcf, 0x66, 0x7b, 0xc1, 0xa5, 0x71, 0xa9, 0xde, 0x83, 0xc6,
+	0x2c, 0x4b, 0x0e, 0x45, 0xdf, 0x42, 0xa1, 0x2b, 0x46, 0xa0, 0x29, 0x46, 0x95, 0xbe, 0x24, 0x22,
+	0xf9, 0x64, 0xc6, 0x43, 0x1c, 0x0f, 0x4f, 0xf5, 0x8a, 0x7c, 0x37, 0x86, 0xd0, 0x5d, 0x28, 0xda,
+	0x5d, 0xd7, 0x0c, 0xfd, 0x33, 0x55, 0x65, 0x48, 0xb4, 0x92, 0x45, 0x7e, 0xd6, 0x0f, 0x8e, 0x1e,
+	0x1b, 0xfe, 0x99, 0x28, 0x35, 0x23, 0x6f, 0x77, 0xdd, 0x68, 0x81, 0x76, 0x61, 0xd1, 0xb6, 0xdd,
+	0x89, 0x33, 0xb8, 0x2c, 0xd4, 0x96, 0x2e, 0xce, 0x2b, 0xc5, 0x83, 0x83, 0xc7, 0xf1, 0x11, 0x34,
+	0x8a, 0xb6, 0xed, 0xc6, 0x4b, 0xb4, 0x03, 0xab, 0x56, 0xdf, 0x72, 0x1d, 0xcb, 0x3c, 0x71, 0x5c,
+	0x26, 0x8e, 0xb2, 0x34, 0xb0, 0x22, 0xaa, 0x7b, 0x59, 0x0a, 0x1f, 0x2a, 0x99, 0xd4, 0x69, 0xc2,
+	0xd2, 0xd8, 0x31, 0xa3, 0x7e, 0x2f, 0xb4, 0x88, 0xbe, 0x2a, 0x6a, 0xfc, 0xde, 0x8c, 0x27, 0x19,
+	0x33, 0x12, 0x76, 0x71, 0xd8, 0xa9, 0x0b, 0x65, 0x43, 0x8b, 0xed, 0x49, 0x44, 0x1c, 0x25, 0xde,
+	0xbe, 0xac, 0xd1, 0xf8, 0x37, 0x03, 0xdf, 0x77, 0xf5, 0x35, 0xb1, 0xd7, 0x3f, 0x9b, 0xed, 0x35,
+	0x93, 0x77, 0x87, 0x28, 0xa3, 0xdc, 0xee, 0xa4, 0x04, 0x35, 0x41, 0x74, 0x37, 0xd3, 0x89, 0xef,
+	0x04, 0xfa, 0xb5, 0xb7, 0xc9, 0xea, 0xd8, 0x65, 0x42, 0xbd, 0x66, 0x91, 0x1b, 0x1c, 0x83, 0xd1,
+	0xf7, 0x50, 0x92, 0x11, 0x45, 0x03, 0x5f, 0xd7, 0xc5, 0x1b, 0xb6, 0x67, 0x8c, 0x25, 0x52, 0x8b,
+	0x0e, 0x97, 0x08, 0x23, 0x02, 0x79, 0x4d, 0x52, 0x31, 0xec, 0x4d, 0x31, 0xb3, 0xf5, 0xeb, 0x6f,
+	0xe3, 0xfd, 0xd8, 0x35, 0x21, 0xaa, 0x49, 0x1a, 0x43, 0xe8, 0x19, 0x94, 0xe4, 0x94, 0x8f, 0x26,
+	0xb7, 0x5e, 0x16, 0xd6, 0xef, 0xce, 0x66, 0x7d, 0xe2, 0xde, 0x30, 0x6a, 0x0d, 0xe3, 0x60, 0xb9,
+	0x07, 0xab, 0x57, 0x8e, 0x5b, 0x7e, 0xe9, 0xe7, 0xdf, 0x3e, 0xf2, 0xdb, 0x82, 0x3f, 0xa2, 0x87,
+	0x30, 0x7f, 0x8a, 0xdd, 0x9e, 0xbc, 0xa2, 0xcc, 0xdc, 0x3f, 0x62, 0xc3, 0x86, 0x54, 0xff, 0x22,
+	0xf5, 0x79, 0xb2, 0xf6, 0x1b, 0x00, 0xf1, 0x5d, 0x26, 0x3c, 0x44, 0x0d, 0xc8, 0x5a, 0x98, 0x91,
+	0x96, 0x1f, 0xca, 0x17, 0x96, 0x76, 0x3e, 0x9f, 0xcd, 0x78, 0x6c, 0x63, 0x5f, 0xe9, 0x1b, 0x23,
+	0x4b, 0xfc, 0x12, 0xda, 0x25, 0x94, 0xe2, 0x96, 0xf4, 0x38, 0x67, 0x44, 0xcb, 0xda, 0x5f, 0xe6,
+	0x20, 0x2f, 0x54, 0xf9, 0x7d, 0xa3, 0x47, 0xdf, 0xe5, 0x23, 0xf2, 0x00, 0xe6, 0x68, 0x80, 0x3d,
+	0x7d, 0x5e, 0xec, 0xc5, 0xe6, 0x8c, 0xd9, 0x0e, 0x70, 0xd4, 0xf9, 0x85, 0x36, 0xdf, 0x52, 0xca,
+	0xa2, 0x5b, 0x5f, 0x69, 0xd6, 0x2d, 0x1d, 0xb9, 0x4e, 0x0c, 0xa9, 0x8e, 0xbe, 0x06, 0x18, 0xfb,
+	0x42, 0x4a, 0xbf, 0x5b, 0x7e, 0xa2, 0xfe, 0x1e, 0x5b, 0x42, 0x5f, 0x4a, 0xff, 0xe4, 0xb5, 0x2a,
+	0xbf, 0xf3, 0xd1, 0x5b, 0xdc, 0xe2, 0x94, 0x35, 0xa9, 0xcf, 0x03, 0x15, 0x75, 0xa7, 0x2f, 0xbc,
+	0x8d, 0x6f, 0x71, 0x7a, 0x0d, 0xa9, 0x8e, 0x9e, 0x81, 0x16, 0x92, 0xc0, 0x75, 0x2c, 0x2c, 0x1a,
+	0x53, 0xd7, 0xb7, 0x89, 0x9e, 0x79, 0x9b, 0xfe, 0x67, 0xc4, 0xda, 0x47, 0xbe, 0x4d, 0x8c, 0xc5,
+	0x70, 0x12, 0xd8, 0xfc, 0x53, 0x4a, 0x95, 0xa6, 0xd8, 0x60, 0x54, 0x83, 0xcc, 0x57, 0x5e, 0xc7,
+	0xf3, 0xcf, 0x3c, 0x2d, 0x51, 0x5e, 0x1d, 0x0c, 0xab, 0x4b, 0xb1, 0x50, 0x09, 0x50, 0x15, 0x16,
+	0xee, 0x37, 0x29, 0xf1, 0x98, 0x96, 0x2c, 0xaf, 0x0c, 0x86, 0x55, 0x2d, 0xa6, 0x48, 0x1c, 0x7d,
+	0x00, 0xb9, 0xe3, 0x90, 0x04, 0x38, 0x74, 0xbc, 0x96, 0x96, 0x2a, 0x5f, 0x1b, 0x0c, 0xab, 0xcb,
+	0x31, 0x69, 0x24, 0x42, 0xb7, 0x20, 0x2b, 0x17, 0xc4, 0xd6, 0xd2, 0xe5, 0xb5, 0xc1, 0xb0, 0x8a,
+	0xa6, 0x69, 0xc4, 0x46, 0x9b, 0x90, 0x1f, 0x85, 0xe1, 0xb5, 0xb4, 0xb9, 0xf2, 0xf5, 0xc1, 0xb0,
+	0xba, 0x3a, 0x56, 0x15, 0xb1, 0x90, 0x5b, 0xac, 0x33, 0x3f, 0xe0, 0x7b, 0xa3, 0xcd, 0x4f, 0x5b,
+	0x8c, 0x24, 0x3c, 0x4a, 0xf1, 0x4c, 0x6c, 0x6d, 0x61, 0x3a, 0x4a, 0x25, 0xd8, 0xfc, 0x7b, 0x12,
+	0x96, 0xaf, 0xb8, 0x21, 0xa1, 0xcf, 0x40, 0x9b, 0x84, 0x5d, 0xa2, 0x25, 0xca, 0x37, 0x07, 0xc3,
+	0xea, 0x8d, 0x2b, 0xe8, 0x31, 0x09, 0x1d, 0xc2, 0x75, 0x49, 0x38, 0x26, 0x1e, 0xff, 0x94, 0xe7,
+	0x43, 0x9e, 0xb2, 0x50, 0xa4, 0x42, 0x4b, 0x96, 0x37, 0x07, 0xc3, 0xea, 0x07, 0x57, 0x58, 0xb8,
+	0x82, 0x8d, 0x7e, 0x0e, 0x48, 0x32, 0x0f, 0x3d, 0x2b, 0xf6, 0x22, 0x55, 0x7e, 0x6f, 0x30, 0xac,
+	0x56, 0xae, 0xb0, 0x31, 0x4e, 0xdb, 0xfc, 0x4f, 0x12, 0x16, 0xa7, 0xc6, 0x22, 0xfa, 0x14, 0xd6,
+	0x54, 0x76, 0xa7, 0x24, 0x5a, 0xa2, 0x5c, 0x1e, 0x0c, 0xab, 0x6b, 0x53, 0x70, 0x54, 0x0a, 0xf7,
+	0xa0, 0x74, 0xdc, 0x73, 0x5d, 0x12, 0x1a, 0xea, 0x57, 0x1b, 0x2d, 0x29, 0xb7, 0x62, 0x8a, 0x3f,
+	0x49, 0x42, 0xbb, 0xa0, 0xd5, 0x1d, 0xaf, 0x73, 0xdf, 0xe2, 0x56, 0x5c, 0x62, 0xb7, 0x88, 0x1d,
+	0x79, 0x3f, 0xa5, 0x38, 0x4d, 0x43, 0x1f, 0x42, 0x66, 0x4f, 0x7e, 0xe4, 0x68, 0xe9, 0x2b, 0x5d,
+	0x53, 0xd2, 0xcd, 0xbf, 0x26, 0x61, 0x71, 0xaa, 0xfa, 0xc7, 0xc2, 0x9c, 0x92, 0x44, 0x61, 0x4e,
+	0xc1, 0x51, 0x98, 0xdb, 0x90, 0x3f, 0xf4, 0x1c, 0xe6, 0x60, 0xb7, 0x6e, 0x61, 0x9e, 0xac, 0xf5,
+	0xc1, 0xb0, 0x5a, 0x9e, 0x22, 0x8f, 0x31, 0xa4, 0x82, 0x15, 0x92, 0x2e, 0xf1, 0x18, 0x76, 0xb5,
+	0xd4, 0x4f, 0x28, 0x8c, 0x18, 0x9b, 0x7f, 0x4b, 0x02, 0xba, 0xdc, 0xdd, 0xd1, 0x36, 0x14, 0x94,
+	0x0f, 0x02, 0xd7, 0x12, 0xe5, 0x1b, 0x83, 0x61, 0xf5, 0xfa, 0x65, 0x66, 0xe4, 0xe9, 0x2f, 0x60,
+	0x59, 0xf9, 0xe1, 0xbc, 0x14, 0x2f, 0x92, 0x7a, 0xc9, 0xf2, 0xad, 0xc1, 0xb0, 0x5a, 0xbd, 0xac,
+	0x37, 0x49, 0xe6, 0xc5, 0x3d, 0xe6, 0xa4, 0xd4, 0x4d, 0xc9, 0x8c, 0x5e, 0xd6, 0x1d, 0x63, 0xee,
+	0x1d, 0xbd, 0xfa, 0xf7, 0x7a, 0xe2, 0xc7, 0x8b, 0xf5, 0xe4, 0xab, 0x8b, 0xf5, 0xe4, 0xbf, 0x2e,
+	0xd6, 0x93, 0x7f, 0x7c, 0xbd, 0x9e, 0x78, 0xf5, 0x7a, 0x3d, 0xf1, 0x8f, 0xd7, 0xeb, 0x89, 0x6f,
+	0xb7, 0x5b, 0x0e, 0x6b, 0xf7, 0x9a, 0x5b, 0x96, 0xdf, 0xdd, 0x56, 0x6d, 0x6b, 0x5b, 0xb6, 0xad,
+	0x6d, 0xcb, 0xb6, 0xb6, 0x2f, 0xfd, 0x6e, 0xda, 0x5c, 0x10, 0x3f, 0x7b, 0xde, 0xfd, 0xdf, 0x00,
+	0xaf, 0x64, 0x47, 0x6e, 0x53, 0x15, 0x00, 0x00,
+}
+
+func (m *Span) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Span) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Span) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintTable(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintTable(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TableID != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.TableID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.ResolvedTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CheckpointTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.CheckpointTs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MQPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MQPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MQPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintTable(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Latency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Latency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Latency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.P99 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P99))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.P50 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P50))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StuckWorkers != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.StuckWorkers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AliveWorkers != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.AliveWorkers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LargeTxn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LargeTxn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LargeTxn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HoldingCheckpoint {
+		i--
+		if m.HoldingCheckpoint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchFlush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchFlush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchFlush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlushesPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FlushesPerSecond))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.AvgBatchSize != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AvgBatchSize))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegionUnavailable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionUnavailable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionUnavailable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceMs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.SinceMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RegionID != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.RegionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoryQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoryQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuotaBytes != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.QuotaBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UsedBytes != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.UsedBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Exhausted {
+		i--
+		if m.Exhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Waiting != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.Waiting))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Idle != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.Idle))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Active != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.Active))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Idempotency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Idempotency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Idempotency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CoveredEndTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.CoveredEndTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CoveredStartTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.CoveredStartTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Causality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Causality) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Causality) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGroupSize != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.MaxGroupSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupsInFlight != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.GroupsInFlight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SorterSpill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SorterSpill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SorterSpill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpillRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SpillRate))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.DiskBytes != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.DiskBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryMs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.RecoveryMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastErrorMs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.LastErrorMs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ErrorRecovery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size, err := m.SorterSpill.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size, err := m.SinkCausality.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size, err := m.SinkIdempotency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size, err := m.SinkConnectionPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.CheckpointSource != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.CheckpointSource))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.CyclicFilteredCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.CyclicFilteredCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DDLEventCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.DDLEventCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DMLRowCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.DMLRowCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.MemoryQuota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.UnavailableRegions) > 0 {
+		for iNdEx := len(m.UnavailableRegions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnavailableRegions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTable(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.BatchFlush.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.EventTimeSkew.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.LargeTxn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.SchemaCompatibility != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.SchemaCompatibility))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RetryCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ConflictCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.ConflictCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CheckpointAdvanceRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CheckpointAdvanceRate))))
+		i--
+		dAtA[i] = 0x49
+	}
+	{
+		size, err := m.WorkerHealth.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BufferedEventCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.BufferedEventCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.SinkWriteLatency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.MQPositions) > 0 {
+		for iNdEx := len(m.MQPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MQPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTable(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BarrierTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.BarrierTs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StageCheckpoints) > 0 {
+		for k := range m.StageCheckpoints {
+			v := m.StageCheckpoints[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTable(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTable(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTable(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CurrentTs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.CurrentTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RegionCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.RegionCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTable(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Category != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReplicationMode != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.ReplicationMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTable(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.State != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TableID != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.TableID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTable(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTable(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Span) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TableID != 0 {
+		n += 1 + sovTable(uint64(m.TableID))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovTable(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovTable(uint64(l))
+	}
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckpointTs != 0 {
+		n += 1 + sovTable(uint64(m.CheckpointTs))
+	}
+	if m.ResolvedTs != 0 {
+		n += 1 + sovTable(uint64(m.ResolvedTs))
+	}
+	return n
+}
+
+func (m *MQPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovTable(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovTable(uint64(m.Partition))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTable(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *Latency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.P50 != 0 {
+		n += 9
+	}
+	if m.P99 != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *WorkerHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AliveWorkers != 0 {
+		n += 1 + sovTable(uint64(m.AliveWorkers))
+	}
+	if m.StuckWorkers != 0 {
+		n += 1 + sovTable(uint64(m.StuckWorkers))
+	}
+	return n
+}
+
+func (m *LargeTxn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldingCheckpoint {
+		n += 2
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovTable(uint64(m.StartTs))
+	}
+	return n
+}
+
+func (m *BatchFlush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AvgBatchSize != 0 {
+		n += 9
+	}
+	if m.FlushesPerSecond != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *RegionUnavailable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegionID != 0 {
+		n += 1 + sovTable(uint64(m.RegionID))
+	}
+	if m.SinceMs != 0 {
+		n += 1 + sovTable(uint64(m.SinceMs))
+	}
+	return n
+}
+
+func (m *MemoryQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exhausted {
+		n += 2
+	}
+	if m.UsedBytes != 0 {
+		n += 1 + sovTable(uint64(m.UsedBytes))
+	}
+	if m.QuotaBytes != 0 {
+		n += 1 + sovTable(uint64(m.QuotaBytes))
+	}
+	return n
+}
+
+func (m *ConnectionPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active != 0 {
+		n += 1 + sovTable(uint64(m.Active))
+	}
+	if m.Idle != 0 {
+		n += 1 + sovTable(uint64(m.Idle))
+	}
+	if m.Waiting != 0 {
+		n += 1 + sovTable(uint64(m.Waiting))
+	}
+	return n
+}
+
+func (m *Idempotency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	if m.CoveredStartTs != 0 {
+		n += 1 + sovTable(uint64(m.CoveredStartTs))
+	}
+	if m.CoveredEndTs != 0 {
+		n += 1 + sovTable(uint64(m.CoveredEndTs))
+	}
+	return n
+}
+
+func (m *Causality) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupsInFlight != 0 {
+		n += 1 + sovTable(uint64(m.GroupsInFlight))
+	}
+	if m.MaxGroupSize != 0 {
+		n += 1 + sovTable(uint64(m.MaxGroupSize))
+	}
+	return n
+}
+
+func (m *SorterSpill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DiskBytes != 0 {
+		n += 1 + sovTable(uint64(m.DiskBytes))
+	}
+	if m.SpillRate != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ErrorRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastErrorMs != 0 {
+		n += 1 + sovTable(uint64(m.LastErrorMs))
+	}
+	if m.RecoveryMs != 0 {
+		n += 1 + sovTable(uint64(m.RecoveryMs))
+	}
+	return n
+}
+
+func (m *Stats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegionCount != 0 {
+		n += 1 + sovTable(uint64(m.RegionCount))
+	}
+	if m.CurrentTs != 0 {
+		n += 1 + sovTable(uint64(m.CurrentTs))
+	}
+	if len(m.StageCheckpoints) > 0 {
+		for k, v := range m.StageCheckpoints {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovTable(uint64(len(k))) + 1 + l + sovTable(uint64(l))
+			n += mapEntrySize + 1 + sovTable(uint64(mapEntrySize))
+		}
+	}
+	if m.BarrierTs != 0 {
+		n += 1 + sovTable(uint64(m.BarrierTs))
+	}
+	if len(m.MQPositions) > 0 {
+		for _, e := range m.MQPositions {
+			l = e.Size()
+			n += 1 + l + sovTable(uint64(l))
+		}
+	}
+	l = m.SinkWriteLatency.Size()
+	n += 1 + l + sovTable(uint64(l))
+	if m.BufferedEventCount != 0 {
+		n += 1 + sovTable(uint64(m.BufferedEventCount))
+	}
+	l = m.WorkerHealth.Size()
+	n += 1 + l + sovTable(uint64(l))
+	if m.CheckpointAdvanceRate != 0 {
+		n += 9
+	}
+	if m.ConflictCount != 0 {
+		n += 1 + sovTable(uint64(m.ConflictCount))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovTable(uint64(m.RetryCount))
+	}
+	if m.SchemaCompatibility != 0 {
+		n += 1 + sovTable(uint64(m.SchemaCompatibility))
+	}
+	l = m.LargeTxn.Size()
+	n += 1 + l + sovTable(uint64(l))
+	l = m.EventTimeSkew.Size()
+	n += 1 + l + sovTable(uint64(l))
+	l = m.BatchFlush.Size()
+	n += 1 + l + sovTable(uint64(l))
+	if len(m.UnavailableRegions) > 0 {
+		for _, e := range m.UnavailableRegions {
+			l = e.Size()
+			n += 2 + l + sovTable(uint64(l))
+		}
+	}
+	l = m.MemoryQuota.Size()
+	n += 2 + l + sovTable(uint64(l))
+	if m.DMLRowCount != 0 {
+		n += 2 + sovTable(uint64(m.DMLRowCount))
+	}
+	if m.DDLEventCount != 0 {
+		n += 2 + sovTable(uint64(m.DDLEventCount))
+	}
+	if m.CyclicFilteredCount != 0 {
+		n += 2 + sovTable(uint64(m.CyclicFilteredCount))
+	}
+	if m.CheckpointSource != 0 {
+		n += 2 + sovTable(uint64(m.CheckpointSource))
+	}
+	l = m.SinkConnectionPool.Size()
+	n += 2 + l + sovTable(uint64(l))
+	l = m.SinkIdempotency.Size()
+	n += 2 + l + sovTable(uint64(l))
+	l = m.SinkCausality.Size()
+	n += 2 + l + sovTable(uint64(l))
+	l = m.SorterSpill.Size()
+	n += 2 + l + sovTable(uint64(l))
+	l = m.ErrorRecovery.Size()
+	n += 2 + l + sovTable(uint64(l))
+	return n
+}
+
+func (m *TableError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Category != 0 {
+		n += 1 + sovTable(uint64(m.Category))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTable(uint64(l))
+	}
+	return n
+}
+
+func (m *TableStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TableID != 0 {
+		n += 1 + sovTable(uint64(m.TableID))
+	}
+	if m.State != 0 {
+		n += 1 + sovTable(uint64(m.State))
+	}
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovTable(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovTable(uint64(l))
+	l = m.Span.Size()
+	n += 1 + l + sovTable(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTable(uint64(l))
+	}
+	if m.ReplicationMode != 0 {
+		n += 1 + sovTable(uint64(m.ReplicationMode))
+	}
+	return n
+}
+
+func sovTable(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTable(x uint64) (n int) {
+	return sovTable(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Span) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Span: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Span: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			}
+			m.TableID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableID |= TableID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+			}
+			m.CheckpointTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			}
+			m.ResolvedTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MQPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MQPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MQPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Latency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Latency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Latency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P50 = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P99 = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AliveWorkers", wireType)
+			}
+			m.AliveWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AliveWorkers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StuckWorkers", wireType)
+			}
+			m.StuckWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StuckWorkers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LargeTxn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LargeTxn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LargeTxn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingCheckpoint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HoldingCheckpoint = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchFlush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchFlush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchFlush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgBatchSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AvgBatchSize = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushesPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FlushesPerSecond = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionUnavailable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionUnavailable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionUnavailable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionID", wireType)
+			}
+			m.RegionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceMs", wireType)
+			}
+			m.SinceMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhausted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
+			}
+			m.UsedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBytes", wireType)
+			}
+			m.QuotaBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			m.Active = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Active |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idle", wireType)
+			}
+			m.Idle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Idle |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			m.Waiting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Waiting |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Idempotency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Idempotency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Idempotency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredStartTs", wireType)
+			}
+			m.CoveredStartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoveredStartTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredEndTs", wireType)
+			}
+			m.CoveredEndTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoveredEndTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Causality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Causality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Causality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsInFlight", wireType)
+			}
+			m.GroupsInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupsInFlight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGroupSize", wireType)
+			}
+			m.MaxGroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGroupSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SorterSpill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SorterSpill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SorterSpill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskBytes", wireType)
+			}
+			m.DiskBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SpillRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorMs", wireType)
+			}
+			m.LastErrorMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastErrorMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryMs", wireType)
+			}
+			m.RecoveryMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			}
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTs", wireType)
+			}
+			m.CurrentTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StageCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StageCheckpoints == nil {
+				m.StageCheckpoints = make(map[string]Checkpoint)
+			}
+			var mapkey string
+			mapvalue := &Checkpoint{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTable
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTable
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTable
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTable
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTable
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTable
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTable
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Checkpoint{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTable(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTable
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StageCheckpoints[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BarrierTs", wireType)
+			}
+			m.BarrierTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BarrierTs |= Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MQPositions = append(m.MQPositions, MQPosition{})
+			if err := m.MQPositions[len(m.MQPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkWriteLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SinkWriteLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedEventCount", wireType)
+			}
+			m.BufferedEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferedEventCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkerHealth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointAdvanceRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CheckpointAdvanceRate = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictCount", wireType)
+			}
+			m.ConflictCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaCompatibility", wireType)
+			}
+			m.SchemaCompatibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaCompatibility |= SchemaCompatibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if iNdEx >= l {
+			if err := m.LargeTxn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeSkew", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EventTimeSkew.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Span: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Span: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchFlush", wireType)
 			}
-			m.TableID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -718,16 +4661,30 @@ func (m *Span) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableID |= TableID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchFlush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableRegions", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -737,31 +4694,31 @@ func (m *Span) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTable
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTable
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
+			m.UnavailableRegions = append(m.UnavailableRegions, RegionUnavailable{})
+			if err := m.UnavailableRegions[len(m.UnavailableRegions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryQuota", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -771,81 +4728,205 @@ func (m *Span) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTable
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTable
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			if err := m.MemoryQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTable(dAtA[iNdEx:])
-			if err != nil {
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DMLRowCount", wireType)
+			}
+			m.DMLRowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DMLRowCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLEventCount", wireType)
+			}
+			m.DDLEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DDLEventCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CyclicFilteredCount", wireType)
+			}
+			m.CyclicFilteredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CyclicFilteredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointSource", wireType)
+			}
+			m.CheckpointSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointSource |= WatermarkSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkConnectionPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SinkConnectionPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkIdempotency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTable
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Checkpoint) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTable
+			if err := m.SinkIdempotency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkCausality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SinkCausality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SorterSpill", wireType)
 			}
-			m.CheckpointTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -855,16 +4936,30 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckpointTs |= Ts(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTable
 			}
-			m.ResolvedTs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SorterSpill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRecovery", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -874,11 +4969,25 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolvedTs |= Ts(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrorRecovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTable(dAtA[iNdEx:])
@@ -900,7 +5009,7 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Stats) Unmarshal(dAtA []byte) error {
+func (m *TableError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -923,17 +5032,17 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+			return fmt.Errorf("proto: TableError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TableError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			m.RegionCount = 0
+			m.Category = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -943,35 +5052,16 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionCount |= uint64(b&0x7F) << shift
+				m.Category |= TableErrorCategory(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTs", wireType)
-			}
-			m.CurrentTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTable
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTs |= Ts(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StageCheckpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTable
@@ -981,140 +5071,24 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTable
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTable
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StageCheckpoints == nil {
-				m.StageCheckpoints = make(map[string]Checkpoint)
-			}
-			var mapkey string
-			mapvalue := &Checkpoint{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTable
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTable
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTable
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTable
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTable
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTable
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTable
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Checkpoint{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTable(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTable
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.StageCheckpoints[mapkey] = *mapvalue
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BarrierTs", wireType)
-			}
-			m.BarrierTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTable
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BarrierTs |= Ts(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTable(dAtA[iNdEx:])
@@ -1302,6 +5276,61 @@ func (m *TableStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &TableError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationMode", wireType)
+			}
+			m.ReplicationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationMode |= ReplicationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTable(dAtA[iNdEx:])
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
@@ -106,3 +107,23 @@ func TestSpanEq(t *testing.T) {
 	require.False(t, a.Eq(d))
 	require.True(t, d.Eq(d))
 }
+
+func TestLatencyWindow(t *testing.T) {
+	t.Parallel()
+
+	w := NewLatencyWindow(4)
+	require.Equal(t, Latency{}, w.Summary())
+
+	w.Observe(10 * time.Millisecond)
+	require.Equal(t, Latency{P50: 10, P99: 10}, w.Summary())
+
+	w.Observe(40 * time.Millisecond)
+	w.Observe(20 * time.Millisecond)
+	w.Observe(30 * time.Millisecond)
+	require.Equal(t, Latency{P50: 20, P99: 40}, w.Summary())
+
+	// The oldest latencies are replaced.
+	w.Observe(time.Millisecond)
+	w.Observe(2 * time.Millisecond)
+	require.Equal(t, Latency{P50: 2, P99: 30}, w.Summary())
+}
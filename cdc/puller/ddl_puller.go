@@ -491,6 +491,8 @@ func NewDDLJobPuller(
 			-1, DDLPullerTableName,
 			ddLPullerFilterLoop,
 			true,
+			true,
+			nil,
 		),
 		kvStorage: kvStorage,
 		outputCh:  make(chan *model.DDLJobEntry, defaultPullerOutputChanSize),
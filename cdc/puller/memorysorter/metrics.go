@@ -64,7 +64,7 @@ var (
 )
 
 // InitMetrics registers all metrics in this file
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(entrySorterResolvedChanSizeGauge)
 	registry.MustRegister(entrySorterOutputChanSizeGauge)
 	registry.MustRegister(entrySorterUnsortedSizeGauge)
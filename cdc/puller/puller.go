@@ -40,6 +40,8 @@ import (
 const (
 	defaultPullerEventChanSize  = 128
 	defaultPullerOutputChanSize = 128
+	// The number of recent events to summarize the event time skew.
+	eventTimeSkewSamples = 64
 )
 
 // Stats of a puller.
@@ -49,16 +51,33 @@ type Stats struct {
 	ResolvedTsIngress   model.Ts
 	CheckpointTsEgress  model.Ts
 	ResolvedTsEgress    model.Ts
+	// EventTimeSkew is the skew between the commit time of events and the
+	// time they are output by the puller.
+	EventTimeSkew tablepb.Latency
+	// UnavailableRegions are the upstream regions that block the puller.
+	UnavailableRegions []tablepb.RegionUnavailable
+	// CyclicFilteredCount is the number of rows written by another TiCDC
+	// and filtered in BDR mode.
+	CyclicFilteredCount uint64
+	// Initialized is true if the initial scan of all regions is done.
+	Initialized bool
 }
 
 // Puller pull data from tikv and push changes into a buffer.
 type Puller interface {
 	// Run the puller, continually fetch event from TiKV and add event into buffer.
 	Run(ctx context.Context) error
+	// Output returns the channel of the pulled events, nothing is sent to the
+	// channel if the puller is created with a ConsumeFunc.
 	Output() <-chan *model.RawKVEntry
 	Stats() Stats
 }
 
+// ConsumeFunc consumes the events pulled by the puller. It's called in the
+// goroutine of the puller, so the events are consumed without a goroutine
+// reading from the output channel for each table.
+type ConsumeFunc func(ctx context.Context, raw *model.RawKVEntry) error
+
 type pullerImpl struct {
 	kvCli     kv.CDCKVClient
 	kvStorage tikv.Storage
@@ -69,10 +88,18 @@ type pullerImpl struct {
 	checkpointTs uint64
 	// The latest resolved ts that puller has sent.
 	resolvedTs uint64
+	// initialized is set once all regions of the spans are initialized.
+	initialized atomic.Bool
+
+	pdClock       pdutil.Clock
+	eventTimeSkew *tablepb.LatencyWindow
 
 	changefeed model.ChangeFeedID
 	tableID    model.TableID
 	tableName  string
+
+	// consume is used to consume the events instead of outputCh if it's set.
+	consume ConsumeFunc
 }
 
 // New create a new Puller fetch event start from checkpointTs and put into buf.
@@ -89,7 +116,9 @@ func New(ctx context.Context,
 	tableID model.TableID,
 	tableName string,
 	filterLoop bool,
+	readOldValue bool,
 	isDDLPuller bool,
+	consume ConsumeFunc,
 ) Puller {
 	tikvStorage, ok := kvStorage.(tikv.Storage)
 	if !ok {
@@ -106,18 +135,22 @@ func New(ctx context.Context,
 	// initialized, the ts should advance to a non-zero value.
 	tsTracker := frontier.NewFrontier(0, metricMissedRegionCollectCounter, spans...)
 	kvCli := kv.NewCDCKVClient(
-		ctx, pdCli, grpcPool, regionCache, pdClock, cfg, changefeed, tableID, tableName, filterLoop)
+		ctx, pdCli, grpcPool, regionCache, pdClock, cfg, changefeed, tableID, tableName,
+		filterLoop, readOldValue)
 	p := &pullerImpl{
-		kvCli:        kvCli,
-		kvStorage:    tikvStorage,
-		checkpointTs: checkpointTs,
-		spans:        spans,
-		outputCh:     make(chan *model.RawKVEntry, defaultPullerOutputChanSize),
-		tsTracker:    tsTracker,
-		resolvedTs:   checkpointTs,
-		changefeed:   changefeed,
-		tableID:      tableID,
-		tableName:    tableName,
+		kvCli:         kvCli,
+		kvStorage:     tikvStorage,
+		checkpointTs:  checkpointTs,
+		spans:         spans,
+		outputCh:      make(chan *model.RawKVEntry, defaultPullerOutputChanSize),
+		tsTracker:     tsTracker,
+		eventTimeSkew: tablepb.NewLatencyWindow(eventTimeSkewSamples),
+		resolvedTs:    checkpointTs,
+		pdClock:       pdClock,
+		changefeed:    changefeed,
+		tableID:       tableID,
+		tableName:     tableName,
+		consume:       consume,
 	}
 	return p
 }
@@ -180,13 +213,22 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 				return nil
 			}
 			commitTs := raw.CRTs
-			select {
-			case <-ctx.Done():
-				return errors.Trace(ctx.Err())
-			case p.outputCh <- raw:
-				if atomic.LoadUint64(&p.checkpointTs) < commitTs {
-					atomic.StoreUint64(&p.checkpointTs, commitTs)
+			if p.consume != nil {
+				if err := p.consume(ctx, raw); err != nil {
+					return errors.Trace(err)
 				}
+			} else {
+				select {
+				case <-ctx.Done():
+					return errors.Trace(ctx.Err())
+				case p.outputCh <- raw:
+				}
+			}
+			if atomic.LoadUint64(&p.checkpointTs) < commitTs {
+				atomic.StoreUint64(&p.checkpointTs, commitTs)
+			}
+			if raw.OpType != model.OpTypeResolved {
+				p.observeEventTimeSkew(commitTs)
 			}
 			return nil
 		}
@@ -233,6 +275,7 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 				resolvedTs := p.tsTracker.Frontier()
 				if resolvedTs > 0 && !initialized {
 					initialized = true
+					p.initialized.Store(true)
 
 					spans := make([]string, 0, len(p.spans))
 					for i := range p.spans {
@@ -262,6 +305,14 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 	return g.Wait()
 }
 
+func (p *pullerImpl) observeEventTimeSkew(commitTs uint64) {
+	now, err := p.pdClock.CurrentTime()
+	if err != nil {
+		return
+	}
+	p.eventTimeSkew.Observe(now.Sub(oracle.GetTimeFromTS(commitTs)))
+}
+
 func (p *pullerImpl) Output() <-chan *model.RawKVEntry {
 	return p.outputCh
 }
@@ -273,5 +324,9 @@ func (p *pullerImpl) Stats() Stats {
 		CheckpointTsIngress: p.kvCli.CommitTs(),
 		ResolvedTsEgress:    atomic.LoadUint64(&p.resolvedTs),
 		CheckpointTsEgress:  atomic.LoadUint64(&p.checkpointTs),
+		EventTimeSkew:       p.eventTimeSkew.Summary(),
+		UnavailableRegions:  p.kvCli.UnavailableRegions(),
+		CyclicFilteredCount: p.kvCli.CyclicFilteredCount(),
+		Initialized:         p.initialized.Load(),
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	tidbkv "github.com/pingcap/tidb/kv"
@@ -35,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/txnutil"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/tikv"
 	pd "github.com/tikv/pd/client"
 )
@@ -69,6 +71,7 @@ func newMockCDCKVClient(
 	tableID model.TableID,
 	tableName string,
 	filterloop bool,
+	readOldValue bool,
 ) kv.CDCKVClient {
 	return &mockCDCKVClient{
 		expectations: make(chan model.RegionFeedEvent, 1024),
@@ -115,6 +118,7 @@ func newPullerForTest(
 	t *testing.T,
 	spans []tablepb.Span,
 	checkpointTs uint64,
+	consume ConsumeFunc,
 ) (*mockInjectedPuller, context.CancelFunc, *sync.WaitGroup, tidbkv.Storage) {
 	var wg sync.WaitGroup
 	ctx, cancel := context.WithCancel(context.Background())
@@ -134,7 +138,7 @@ func newPullerForTest(
 		ctx, pdCli, grpcPool, regionCache, store, pdutil.NewClock4Test(),
 		checkpointTs, spans, config.GetDefaultServerConfig().KVClient,
 		model.DefaultChangeFeedID("changefeed-id-test"), 0,
-		"table-test", false, false)
+		"table-test", false, true, false, consume)
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -159,7 +163,7 @@ func TestPullerResolvedForward(t *testing.T) {
 		},
 	}
 	checkpointTs := uint64(996)
-	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs)
+	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs, nil)
 
 	plr.cli.Returns(model.RegionFeedEvent{
 		Resolved: &model.ResolvedSpans{
@@ -200,6 +204,41 @@ func TestPullerResolvedForward(t *testing.T) {
 	wg.Wait()
 }
 
+func TestPullerInitialized(t *testing.T) {
+	spans := []tablepb.Span{
+		{
+			StartKey: spanz.ToComparableKey([]byte("t_a")),
+			EndKey:   spanz.ToComparableKey([]byte("t_e")),
+		},
+	}
+	plr, cancel, wg, store := newPullerForTest(t, spans, 996, nil)
+	defer func() {
+		store.Close()
+		cancel()
+		wg.Wait()
+	}()
+	require.False(t, plr.Puller.(*pullerImpl).initialized.Load())
+
+	// The puller is initialized after all regions are resolved.
+	plr.cli.Returns(model.RegionFeedEvent{
+		Resolved: &model.ResolvedSpans{
+			Spans: []model.RegionComparableSpan{{
+				Span: spanz.ToSpan([]byte("t_a"), []byte("t_c")),
+			}}, ResolvedTs: uint64(1001),
+		},
+	})
+	plr.cli.Returns(model.RegionFeedEvent{
+		Resolved: &model.ResolvedSpans{
+			Spans: []model.RegionComparableSpan{{
+				Span: spanz.ToSpan([]byte("t_c"), []byte("t_e")),
+			}}, ResolvedTs: uint64(1000),
+		},
+	})
+	ev := <-plr.Output()
+	require.Equal(t, model.OpTypeResolved, ev.OpType)
+	require.True(t, plr.Puller.(*pullerImpl).initialized.Load())
+}
+
 func TestPullerRawKV(t *testing.T) {
 	spans := []tablepb.Span{
 		{
@@ -208,7 +247,7 @@ func TestPullerRawKV(t *testing.T) {
 		},
 	}
 	checkpointTs := uint64(996)
-	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs)
+	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs, nil)
 
 	plr.cli.Returns(model.RegionFeedEvent{
 		Val: &model.RawKVEntry{
@@ -238,3 +277,71 @@ func TestPullerRawKV(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+func TestPullerEventTimeSkew(t *testing.T) {
+	spans := []tablepb.Span{
+		{
+			StartKey: spanz.ToComparableKey([]byte("c")),
+			EndKey:   spanz.ToComparableKey([]byte("e")),
+		},
+	}
+	commitTime := time.Now().Add(-10 * time.Second)
+	commitTs := oracle.GoTimeToTS(commitTime)
+	plr, cancel, wg, store := newPullerForTest(t, spans, commitTs-1, nil)
+
+	plr.cli.Returns(model.RegionFeedEvent{
+		Val: &model.RawKVEntry{
+			OpType: model.OpTypePut,
+			Key:    []byte("d"),
+			Value:  []byte("test-value"),
+			CRTs:   commitTs,
+		},
+	})
+	ev := <-plr.Output()
+	require.Equal(t, commitTs, ev.CRTs)
+	skew := plr.Puller.(*pullerImpl).eventTimeSkew
+	require.Eventually(t, func() bool {
+		return skew.Summary().P50 >= 10*1000
+	}, 5*time.Second, 10*time.Millisecond)
+
+	store.Close()
+	cancel()
+	wg.Wait()
+}
+
+func TestPullerConsumeFunc(t *testing.T) {
+	spans := []tablepb.Span{
+		{
+			StartKey: spanz.ToComparableKey([]byte("c")),
+			EndKey:   spanz.ToComparableKey([]byte("e")),
+		},
+	}
+	checkpointTs := uint64(996)
+	consumed := make(chan *model.RawKVEntry, 2)
+	consume := func(ctx context.Context, raw *model.RawKVEntry) error {
+		consumed <- raw
+		return nil
+	}
+	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs, consume)
+
+	plr.cli.Returns(model.RegionFeedEvent{
+		Val: &model.RawKVEntry{
+			OpType: model.OpTypePut,
+			Key:    []byte("d"),
+			Value:  []byte("test-value"),
+			CRTs:   uint64(1002),
+		},
+	})
+	ev := <-consumed
+	require.Equal(t, model.OpTypePut, ev.OpType)
+	require.Equal(t, []byte("d"), ev.Key)
+	// Nothing is sent to the output channel.
+	require.Len(t, plr.Output(), 0)
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint64(&(plr.Puller.(*pullerImpl).checkpointTs)) == uint64(1002)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	store.Close()
+	cancel()
+	wg.Wait()
+}
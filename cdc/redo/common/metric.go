@@ -87,7 +87,7 @@ var (
 )
 
 // InitMetrics registers all metrics in this file
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(RedoFsyncDurationHistogram)
 	registry.MustRegister(RedoTotalRowsCountGauge)
 	registry.MustRegister(RedoWriteBytesGauge)
@@ -26,4 +26,12 @@ type InfoProvider interface {
 
 	// GetTaskStatuses returns the task statuses.
 	GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus, error)
+
+	// GetTableStatuses returns the replication statuses of all tables,
+	// which are sorted by table ID.
+	GetTableStatuses() ([]*model.TableReplicationStatus, error)
+
+	// GetRunningTasks returns the running schedule tasks, which are sorted
+	// by span.
+	GetRunningTasks() ([]*model.ScheduleTaskInfo, error)
 }
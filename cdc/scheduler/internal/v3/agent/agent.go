@@ -15,6 +15,8 @@ package agent
 
 import (
 	"context"
+	"math/bits"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -51,6 +53,13 @@ type agent struct {
 	// 1. The capture receives a SIGTERM signal.
 	// 2. The agent receives a stopping heartbeat.
 	liveness *model.Liveness
+
+	// affinity is the placement of the capture, reported in heartbeat responses.
+	affinity schedulepb.Affinity
+
+	// maxHeartbeatResponseBytes caps the size of a heartbeat response,
+	// 0 means no limit.
+	maxHeartbeatResponseBytes int
 }
 
 type agentInfo struct {
@@ -100,6 +109,11 @@ func newAgent(
 		tableM:    newTableSpanManager(changeFeedID, tableExecutor),
 		liveness:  liveness,
 		compat:    compat.New(cfg, map[model.CaptureID]*model.CaptureInfo{}),
+		affinity: schedulepb.Affinity{
+			Zone: cfg.Affinity.Zone,
+			Rack: cfg.Affinity.Rack,
+		},
+		maxHeartbeatResponseBytes: cfg.MaxHeartbeatResponseBytes,
 	}
 
 	etcdCliCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -273,28 +287,51 @@ func (a *agent) handleMessageHeartbeat(request *schedulepb.Heartbeat) (
 	*schedulepb.Message, *schedulepb.Barrier,
 ) {
 	allTables := a.tableM.getAllTableSpans()
-	result := make([]tablepb.TableStatus, 0, allTables.Len())
+	spans := make([]tablepb.Span, 0, allTables.Len())
 	allTables.Ascend(func(span tablepb.Span, table *tableSpan) bool {
-		status := table.getTableSpanStatus(request.CollectStats)
-		if table.task != nil && table.task.IsRemove {
-			status.State = tablepb.TableStateStopping
-		}
-		result = append(result, status)
+		spans = append(spans, span)
 		return true
 	})
 	for _, span := range request.GetSpans() {
 		if _, ok := allTables.Get(span); !ok {
-			status := a.tableM.getTableSpanStatus(span, request.CollectStats)
-			result = append(result, status)
+			spans = append(spans, span)
 		}
 	}
+	getStatus := func(span tablepb.Span) tablepb.TableStatus {
+		table, ok := allTables.Get(span)
+		if !ok {
+			return a.tableM.getTableSpanStatus(span, request.CollectStats)
+		}
+		status := table.getTableSpanStatus(request.CollectStats)
+		if table.task != nil && table.task.IsRemove {
+			status.State = tablepb.TableStateStopping
+		}
+		return status
+	}
 
 	if request.IsStopping {
 		a.handleLivenessUpdate(model.LivenessCaptureStopping)
 	}
 	response := &schedulepb.HeartbeatResponse{
-		Tables:   result,
 		Liveness: a.liveness.Load(),
+		Affinity: a.affinity,
+	}
+	if request.ResumeSpan != nil || a.maxHeartbeatResponseBytes > 0 {
+		a.paginateHeartbeatResponse(response, spans, request.ResumeSpan, getStatus)
+	} else {
+		response.Tables = make([]tablepb.TableStatus, 0, len(spans))
+		for _, span := range spans {
+			response.Tables = append(response.Tables, getStatus(span))
+		}
+	}
+	if request.CollectStats {
+		// Event counts are deltas since the previous report, so they are
+		// only counted for tables in the response.
+		for i := range response.Tables {
+			if table, ok := allTables.Get(response.Tables[i].Span); ok {
+				table.countEvents(&response.Tables[i].Stats)
+			}
+		}
 	}
 
 	message := &schedulepb.Message{
@@ -311,6 +348,56 @@ func (a *agent) handleMessageHeartbeat(request *schedulepb.Heartbeat) (
 	return message, request.GetBarrier()
 }
 
+// paginateHeartbeatResponse fills the response with statuses of spans from
+// resumeSpan on, and stops before the status that makes the response exceed
+// maxHeartbeatResponseBytes. It always includes at least one status so that
+// pagination makes progress. Statuses are only collected for spans that are
+// considered for the page.
+func (a *agent) paginateHeartbeatResponse(
+	response *schedulepb.HeartbeatResponse, spans []tablepb.Span,
+	resumeSpan *tablepb.Span, getStatus func(tablepb.Span) tablepb.TableStatus,
+) {
+	// Spans requested by the owner are appended after others, sort them so
+	// that pages are ordered by span.
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].Less(&spans[j])
+	})
+	if resumeSpan != nil {
+		i := sort.Search(len(spans), func(i int) bool {
+			return !spans[i].Less(resumeSpan)
+		})
+		spans = spans[i:]
+	}
+
+	tables := make([]tablepb.TableStatus, 0, len(spans))
+	size := response.Size()
+	for i := range spans {
+		status := getStatus(spans[i])
+		size += fieldSize(status.Size())
+		total := size
+		if i+1 < len(spans) {
+			// Reserve room for the overflow flag and the next span, in case
+			// the following status does not fit.
+			total += 2 + fieldSize(spans[i+1].Size())
+		}
+		if a.maxHeartbeatResponseBytes > 0 &&
+			total > a.maxHeartbeatResponseBytes && i > 0 {
+			next := spans[i]
+			response.Overflow = true
+			response.NextSpan = &next
+			break
+		}
+		tables = append(tables, status)
+	}
+	response.Tables = tables
+}
+
+// fieldSize returns the encoded size of a length-delimited field with n
+// bytes of data and a 1 byte tag.
+func fieldSize(n int) int {
+	return 1 + (bits.Len64(uint64(n)|1)+6)/7 + n
+}
+
 type dispatchTableTaskStatus int32
 
 const (
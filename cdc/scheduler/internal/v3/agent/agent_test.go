@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -61,6 +62,30 @@ func iterPermutation(sequence []int, fn func(sequence []int)) {
 	}
 }
 
+// heartbeatTableStatus4Test sends a heartbeat about the given span to the agent,
+// and returns the reported status of the span.
+func heartbeatTableStatus4Test(
+	t *testing.T, a *agent, collectStats bool, span tablepb.Span,
+) tablepb.TableStatus {
+	heartbeat := &schedulepb.Message{
+		Header: &schedulepb.Message_Header{
+			Version:       "version-1",
+			OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+		},
+		MsgType: schedulepb.MsgHeartbeat,
+		From:    "owner-1",
+		Heartbeat: &schedulepb.Heartbeat{
+			Spans:        []tablepb.Span{span},
+			CollectStats: collectStats,
+		},
+	}
+	response, _ := a.handleMessage([]*schedulepb.Message{heartbeat})
+	require.Len(t, response, 1)
+	result := response[0].GetHeartbeatResponse().Tables
+	require.Len(t, result, 1)
+	return result[0]
+}
+
 func newAgent4Test() *agent {
 	cfg := config.GetDefaultServerConfig().Debug.Scheduler
 	cfg.ChangefeedSettings = config.GetDefaultReplicaConfig().Scheduler
@@ -395,6 +420,473 @@ func TestAgentHandleMessageHeartbeat(t *testing.T) {
 	require.Equal(t, model.LivenessCaptureStopping, a.liveness.Load())
 }
 
+func TestAgentHandleMessageHeartbeatStats(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		stats tablepb.Stats
+	}{
+		{name: "mq positions", stats: tablepb.Stats{MQPositions: []tablepb.MQPosition{
+			{Topic: "topic", Partition: 0, Offset: 10},
+			{Topic: "topic", Partition: 1, Offset: 20},
+		}}},
+		{name: "sink write latency", stats: tablepb.Stats{
+			SinkWriteLatency: tablepb.Latency{P50: 12.5, P99: 230},
+		}},
+		{name: "buffered event count", stats: tablepb.Stats{BufferedEventCount: 1024}},
+		{name: "worker health", stats: tablepb.Stats{
+			WorkerHealth: tablepb.WorkerHealth{AliveWorkers: 3, StuckWorkers: 1},
+		}},
+		{name: "conflict and retry count", stats: tablepb.Stats{ConflictCount: 7, RetryCount: 9}},
+		{name: "event time skew", stats: tablepb.Stats{
+			EventTimeSkew: tablepb.Latency{P50: 800, P99: 2500},
+		}},
+		{name: "batch flush", stats: tablepb.Stats{
+			BatchFlush: tablepb.BatchFlush{AvgBatchSize: 128, FlushesPerSecond: 20},
+		}},
+		{name: "unavailable regions", stats: tablepb.Stats{
+			UnavailableRegions: []tablepb.RegionUnavailable{{RegionID: 7, SinceMs: 1000}},
+		}},
+		{name: "cyclic filtered count", stats: tablepb.Stats{CyclicFilteredCount: 42}},
+		{name: "checkpoint source", stats: tablepb.Stats{
+			CheckpointSource: tablepb.WatermarkSourceSinkAcknowledged,
+		}},
+		{name: "sink connection pool", stats: tablepb.Stats{
+			SinkConnectionPool: tablepb.ConnectionPool{Active: 16, Idle: 0, Waiting: 5},
+		}},
+		{name: "sink idempotency", stats: tablepb.Stats{
+			SinkIdempotency: tablepb.Idempotency{Active: true, CoveredStartTs: 10, CoveredEndTs: 20},
+		}},
+		{name: "sink causality", stats: tablepb.Stats{
+			SinkCausality: tablepb.Causality{GroupsInFlight: 3, MaxGroupSize: 10000},
+		}},
+		{name: "sorter spill", stats: tablepb.Stats{
+			SorterSpill: tablepb.SorterSpill{DiskBytes: 1 << 30, SpillRate: 1 << 20},
+		}},
+		{name: "large txn", stats: tablepb.Stats{
+			LargeTxn: tablepb.LargeTxn{HoldingCheckpoint: true, StartTs: 100},
+		}},
+		{name: "memory quota", stats: tablepb.Stats{
+			MemoryQuota: tablepb.MemoryQuota{Exhausted: true, UsedBytes: 1024, QuotaBytes: 1024},
+		}},
+		{name: "schema pending registration", stats: tablepb.Stats{
+			SchemaCompatibility: tablepb.SchemaCompatibilityPendingRegistration,
+		}},
+		// The incompatibility is only reported, it is up to the owner to act.
+		{name: "schema incompatible", stats: tablepb.Stats{
+			SchemaCompatibility: tablepb.SchemaCompatibilityIncompatible,
+		}},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := newAgent4Test()
+			mockTableExecutor := newMockTableExecutor()
+			a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+
+			span := spanz.TableIDToComparableSpan(1)
+			a.tableM.addTableSpan(span)
+			mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+
+			// Stats are only reported if the owner asks for them.
+			mockTableExecutor.stats.ReplaceOrInsert(span, tc.stats)
+			status := heartbeatTableStatus4Test(t, a, false, span)
+			require.Equal(t, tablepb.Stats{}, status.Stats)
+
+			status = heartbeatTableStatus4Test(t, a, true, span)
+			require.Equal(t, tc.stats, status.Stats)
+			require.Equal(t, tablepb.TableStateReplicating, status.State)
+
+			// The stats are cleared, e.g. the large transaction is done.
+			mockTableExecutor.stats.ReplaceOrInsert(span, tablepb.Stats{})
+			status = heartbeatTableStatus4Test(t, a, true, span)
+			require.Equal(t, tablepb.Stats{}, status.Stats)
+			mockTableExecutor.AssertNotCalled(t, "RemoveTableSpan", mock.Anything)
+		})
+	}
+}
+
+func TestAgentHeartbeatResponseAffinity(t *testing.T) {
+	t.Parallel()
+
+	liveness := model.LivenessCaptureAlive
+	me := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	me.EXPECT().GetOwnerID(gomock.Any()).
+		Return("", concurrency.ErrElectionNoLeader).Times(1)
+	cfg := config.NewDefaultSchedulerConfig()
+	cfg.ChangefeedSettings = config.GetDefaultReplicaConfig().Scheduler
+	cfg.Affinity = config.AffinityConfig{Zone: "zone-1", Rack: "rack-1"}
+	mockTableExecutor := newMockTableExecutor()
+	i, err := newAgent(context.Background(), "capture-test", &liveness,
+		model.DefaultChangeFeedID("changefeed-test"), me, mockTableExecutor, 0, cfg)
+	require.NoError(t, err)
+	a := i.(*agent)
+
+	heartbeat := &schedulepb.Message{
+		Header: &schedulepb.Message_Header{
+			Version:       "version-1",
+			OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+		},
+		MsgType:   schedulepb.MsgHeartbeat,
+		From:      "owner-1",
+		Heartbeat: &schedulepb.Heartbeat{},
+	}
+	response, _ := a.handleMessage([]*schedulepb.Message{heartbeat})
+	require.Len(t, response, 1)
+	require.Equal(t, schedulepb.Affinity{Zone: "zone-1", Rack: "rack-1"},
+		response[0].GetHeartbeatResponse().Affinity)
+}
+
+func TestAgentTableInitializationError(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+
+	span := spanz.TableIDToComparableSpan(1)
+	addTableRequest := &schedulepb.Message{
+		Header: &schedulepb.Message_Header{
+			Version:        "version-1",
+			OwnerRevision:  schedulepb.OwnerRevision{Revision: 1},
+			ProcessorEpoch: a.Epoch,
+		},
+		MsgType: schedulepb.MsgDispatchTableRequest,
+		From:    "owner-1",
+		DispatchTableRequest: &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_AddTable{
+				AddTable: &schedulepb.AddTableRequest{
+					Span:        span,
+					IsSecondary: true,
+					Checkpoint:  tablepb.Checkpoint{},
+				},
+			},
+		},
+	}
+	_, _ = a.handleMessage([]*schedulepb.Message{addTableRequest})
+
+	mockTableExecutor.On("AddTableSpan", mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(false, errors.New("schema not found"))
+	ctx := context.Background()
+	responses, _ := a.tableM.poll(ctx)
+	require.Len(t, responses, 1)
+	status := responses[0].DispatchTableResponse.GetAddTable().Status
+	require.NotNil(t, status.Error)
+	require.Equal(t, tablepb.TableErrorCategoryInitialization, status.Error.Category)
+	require.Equal(t, "schema not found", status.Error.Message)
+
+	// The executor reports an error while the table is preparing.
+	a.tableM.addTableSpan(span)
+	mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStatePreparing)
+	mockTableExecutor.errs.ReplaceOrInsert(span, &tablepb.TableError{Message: "init"})
+	heartbeatStatus := heartbeatTableStatus4Test(t, a, false, span)
+	require.Equal(t, tablepb.TableErrorCategoryInitialization,
+		heartbeatStatus.Error.Category)
+	require.Equal(t, "init", heartbeatStatus.Error.Message)
+}
+
+func TestAgentTableReplicationError(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+
+	span := spanz.TableIDToComparableSpan(1)
+	a.tableM.addTableSpan(span)
+	mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+
+	status := heartbeatTableStatus4Test(t, a, false, span)
+	require.Nil(t, status.Error)
+
+	tableErr := &tablepb.TableError{Message: "sink write failed"}
+	mockTableExecutor.errs.ReplaceOrInsert(span, tableErr)
+	status = heartbeatTableStatus4Test(t, a, false, span)
+	require.Equal(t, tablepb.TableErrorCategoryReplication, status.Error.Category)
+	require.Equal(t, "sink write failed", status.Error.Message)
+	// The error reported by the executor is not modified.
+	require.Equal(t, tablepb.TableErrorCategoryUnknown, tableErr.Category)
+
+	// The category reported by the executor is respected.
+	mockTableExecutor.errs.ReplaceOrInsert(span, &tablepb.TableError{
+		Category: tablepb.TableErrorCategoryInitialization,
+	})
+	status = heartbeatTableStatus4Test(t, a, false, span)
+	require.Equal(t, tablepb.TableErrorCategoryInitialization, status.Error.Category)
+}
+
+func TestAgentTableErrorMidReplication(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+	ctx := context.Background()
+
+	span := spanz.TableIDToComparableSpan(1)
+	checkpoint := tablepb.Checkpoint{CheckpointTs: 10, ResolvedTs: 20}
+	a.tableM.addTableSpan(span)
+	mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+	mockTableExecutor.checkpoints.ReplaceOrInsert(span, checkpoint)
+	responses, err := a.tableM.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, responses, 0)
+
+	// The table fails, the owner is told once.
+	mockTableExecutor.errs.ReplaceOrInsert(span, &tablepb.TableError{Message: "sink write failed"})
+	responses, err = a.tableM.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	status := responses[0].DispatchTableResponse.GetAddTable().Status
+	require.Equal(t, tablepb.TableStateReplicating, status.State)
+	require.Equal(t, tablepb.TableErrorCategoryReplication, status.Error.Category)
+	require.Equal(t, tablepb.Checkpoint{}, status.Checkpoint)
+	responses, err = a.tableM.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, responses, 0)
+
+	// The checkpoint of the failed table is not reported.
+	heartbeatStatus := heartbeatTableStatus4Test(t, a, false, span)
+	require.Equal(t, "sink write failed", heartbeatStatus.Error.Message)
+	require.Equal(t, tablepb.Checkpoint{}, heartbeatStatus.Checkpoint)
+
+	// The table recovers.
+	mockTableExecutor.errs.Delete(span)
+	responses, err = a.tableM.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, responses, 0)
+	heartbeatStatus = heartbeatTableStatus4Test(t, a, false, span)
+	require.Nil(t, heartbeatStatus.Error)
+	require.Equal(t, checkpoint, heartbeatStatus.Checkpoint)
+
+	// A table that fails while it's being added drops the add table task.
+	span2 := spanz.TableIDToComparableSpan(2)
+	table := a.tableM.addTableSpan(span2)
+	table.injectDispatchTableTask(&dispatchTableTask{Span: span2, IsPrepare: true})
+	mockTableExecutor.tables.ReplaceOrInsert(span2, tablepb.TableStatePreparing)
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything, mock.Anything).Return(false)
+	responses, err = a.tableM.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, responses, 0)
+	require.NotNil(t, table.task)
+
+	mockTableExecutor.errs.ReplaceOrInsert(span2, &tablepb.TableError{Message: "schema not found"})
+	responses, err = a.tableM.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	status = responses[0].DispatchTableResponse.GetAddTable().Status
+	require.Equal(t, tablepb.TableStatePreparing, status.State)
+	require.Equal(t, tablepb.TableErrorCategoryInitialization, status.Error.Category)
+	require.Nil(t, table.task)
+}
+
+func TestAgentHandleMessageHeartbeatReplicationMode(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+
+	span := spanz.TableIDToComparableSpan(1)
+	a.tableM.addTableSpan(span)
+	mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+	mockTableExecutor.modes.ReplaceOrInsert(span, tablepb.ReplicationModeInitialScan)
+
+	// The mode is reported even if stats are not collected.
+	status := heartbeatTableStatus4Test(t, a, false, span)
+	require.Equal(t, tablepb.ReplicationModeInitialScan, status.ReplicationMode)
+
+	// The initial scan is done.
+	mockTableExecutor.modes.ReplaceOrInsert(span, tablepb.ReplicationModeIncremental)
+	status = heartbeatTableStatus4Test(t, a, false, span)
+	require.Equal(t, tablepb.ReplicationModeIncremental, status.ReplicationMode)
+}
+
+func TestAgentHandleMessageHeartbeatSizeCap(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+	a.maxHeartbeatResponseBytes = 1024
+
+	tableCount := 100
+	for i := 0; i < tableCount; i++ {
+		span := spanz.TableIDToComparableSpan(int64(i))
+		a.tableM.addTableSpan(span)
+		mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+	}
+
+	var reported []tablepb.Span
+	var resumeSpan *tablepb.Span
+	for pages := 1; ; pages++ {
+		require.Less(t, pages, tableCount)
+		heartbeat := &schedulepb.Message{
+			Header: &schedulepb.Message_Header{
+				Version:       "version-1",
+				OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+			},
+			MsgType: schedulepb.MsgHeartbeat,
+			From:    "owner-1",
+			Heartbeat: &schedulepb.Heartbeat{
+				CollectStats: true,
+				ResumeSpan:   resumeSpan,
+			},
+		}
+		response, _ := a.handleMessage([]*schedulepb.Message{heartbeat})
+		require.Len(t, response, 1)
+		resp := response[0].GetHeartbeatResponse()
+		require.LessOrEqual(t, resp.Size(), a.maxHeartbeatResponseBytes)
+		require.NotEmpty(t, resp.Tables)
+		for _, status := range resp.Tables {
+			reported = append(reported, status.Span)
+		}
+		if !resp.Overflow {
+			require.Nil(t, resp.NextSpan)
+			require.Greater(t, pages, 1)
+			break
+		}
+		require.NotNil(t, resp.NextSpan)
+		resumeSpan = resp.NextSpan
+	}
+
+	require.Len(t, reported, tableCount)
+	for i, span := range reported {
+		require.Equal(t, spanz.TableIDToComparableSpan(int64(i)), span)
+	}
+
+	// No limit.
+	a.maxHeartbeatResponseBytes = 0
+	response, _ := a.handleMessage([]*schedulepb.Message{{
+		Header: &schedulepb.Message_Header{
+			Version:       "version-1",
+			OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+		},
+		MsgType:   schedulepb.MsgHeartbeat,
+		From:      "owner-1",
+		Heartbeat: &schedulepb.Heartbeat{},
+	}})
+	require.Len(t, response, 1)
+	require.Len(t, response[0].GetHeartbeatResponse().Tables, tableCount)
+	require.False(t, response[0].GetHeartbeatResponse().Overflow)
+}
+
+func TestAgentHandleMessageHeartbeatPaginatedEventCounts(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+	a.maxHeartbeatResponseBytes = 256
+
+	tableCount := 20
+	for i := 0; i < tableCount; i++ {
+		span := spanz.TableIDToComparableSpan(int64(i))
+		a.tableM.addTableSpan(span)
+		mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+		mockTableExecutor.stats.ReplaceOrInsert(span, tablepb.Stats{DMLRowCount: 5})
+	}
+
+	// heartbeatRound pages through the statuses of all tables.
+	heartbeatRound := func(collectStats bool) []tablepb.TableStatus {
+		var resumeSpan *tablepb.Span
+		var tables []tablepb.TableStatus
+		for pages := 1; ; pages++ {
+			require.Less(t, pages, tableCount)
+			response, _ := a.handleMessage([]*schedulepb.Message{{
+				Header: &schedulepb.Message_Header{
+					Version:       "version-1",
+					OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+				},
+				MsgType: schedulepb.MsgHeartbeat,
+				From:    "owner-1",
+				Heartbeat: &schedulepb.Heartbeat{
+					CollectStats: collectStats,
+					ResumeSpan:   resumeSpan,
+				},
+			}})
+			require.Len(t, response, 1)
+			resp := response[0].GetHeartbeatResponse()
+			tables = append(tables, resp.Tables...)
+			if !resp.Overflow {
+				require.Greater(t, pages, 1)
+				return tables
+			}
+			resumeSpan = resp.NextSpan
+		}
+	}
+
+	// Tables on later pages report the events since their previous report,
+	// collecting a page does not count events of other tables.
+	tables := heartbeatRound(true)
+	require.Len(t, tables, tableCount)
+	for _, status := range tables {
+		require.Equal(t, uint64(5), status.Stats.DMLRowCount)
+	}
+
+	// Heartbeats without stats do not consume counts.
+	for i := 0; i < tableCount; i++ {
+		span := spanz.TableIDToComparableSpan(int64(i))
+		mockTableExecutor.stats.ReplaceOrInsert(span, tablepb.Stats{DMLRowCount: 12})
+	}
+	require.Len(t, heartbeatRound(false), tableCount)
+	tables = heartbeatRound(true)
+	require.Len(t, tables, tableCount)
+	for _, status := range tables {
+		require.Equal(t, uint64(7), status.Stats.DMLRowCount)
+	}
+}
+
+func TestPaginateHeartbeatResponse(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	a.maxHeartbeatResponseBytes = 256
+	var spans []tablepb.Span
+	for i := 9; i >= 0; i-- {
+		spans = append(spans, spanz.TableIDToComparableSpan(int64(i)))
+	}
+	var collected []tablepb.Span
+	getStatus := func(span tablepb.Span) tablepb.TableStatus {
+		collected = append(collected, span)
+		return tablepb.TableStatus{Span: span, State: tablepb.TableStateReplicating}
+	}
+
+	// Pages are ordered by span and start from the resume span, statuses
+	// are only collected for spans considered for the page.
+	resumeSpan := spanz.TableIDToComparableSpan(3)
+	response := &schedulepb.HeartbeatResponse{}
+	a.paginateHeartbeatResponse(response, spans, &resumeSpan, getStatus)
+	require.True(t, response.Overflow)
+	require.NotEmpty(t, response.Tables)
+	require.LessOrEqual(t, response.Size(), a.maxHeartbeatResponseBytes)
+	require.Equal(t, resumeSpan, response.Tables[0].Span)
+	for i, status := range response.Tables {
+		require.Equal(t, spanz.TableIDToComparableSpan(int64(3+i)), status.Span)
+	}
+	require.Equal(t, spanz.TableIDToComparableSpan(int64(3+len(response.Tables))),
+		*response.NextSpan)
+	require.Len(t, collected, len(response.Tables)+1)
+
+	// A status is always included even if it exceeds the limit.
+	a.maxHeartbeatResponseBytes = 1
+	response = &schedulepb.HeartbeatResponse{}
+	a.paginateHeartbeatResponse(response, spans, nil, getStatus)
+	require.Len(t, response.Tables, 1)
+	require.Equal(t, spanz.TableIDToComparableSpan(0), response.Tables[0].Span)
+	require.True(t, response.Overflow)
+
+	// The last page.
+	a.maxHeartbeatResponseBytes = 0
+	response = &schedulepb.HeartbeatResponse{}
+	a.paginateHeartbeatResponse(response, spans, &resumeSpan, getStatus)
+	require.Len(t, response.Tables, 7)
+	require.False(t, response.Overflow)
+	require.Nil(t, response.NextSpan)
+}
+
 func TestAgentPermuteMessages(t *testing.T) {
 	t.Parallel()
 
@@ -1056,6 +1548,11 @@ type MockTableExecutor struct {
 
 	// it's preferred to use `pipeline.MockPipeline` here to make the test more vivid.
 	tables *spanz.BtreeMap[tablepb.TableState]
+	// stats is reported only if `collectStat` is requested.
+	stats       *spanz.BtreeMap[tablepb.Stats]
+	checkpoints *spanz.BtreeMap[tablepb.Checkpoint]
+	errs        *spanz.BtreeMap[*tablepb.TableError]
+	modes       *spanz.BtreeMap[tablepb.ReplicationMode]
 }
 
 var _ internal.TableExecutor = (*MockTableExecutor)(nil)
@@ -1063,7 +1560,11 @@ var _ internal.TableExecutor = (*MockTableExecutor)(nil)
 // newMockTableExecutor creates a new mock table executor.
 func newMockTableExecutor() *MockTableExecutor {
 	return &MockTableExecutor{
-		tables: spanz.NewBtreeMap[tablepb.TableState](),
+		tables:      spanz.NewBtreeMap[tablepb.TableState](),
+		stats:       spanz.NewBtreeMap[tablepb.Stats](),
+		checkpoints: spanz.NewBtreeMap[tablepb.Checkpoint](),
+		errs:        spanz.NewBtreeMap[*tablepb.TableError](),
+		modes:       spanz.NewBtreeMap[tablepb.ReplicationMode](),
 	}
 }
 
@@ -1189,8 +1690,19 @@ func (e *MockTableExecutor) GetTableSpanStatus(
 	if !ok {
 		state = tablepb.TableStateAbsent
 	}
+	var stats tablepb.Stats
+	if collectStat {
+		stats, _ = e.stats.Get(span)
+	}
+	checkpoint, _ := e.checkpoints.Get(span)
+	tableErr, _ := e.errs.Get(span)
+	mode, _ := e.modes.Get(span)
 	return tablepb.TableStatus{
-		Span:  span,
-		State: state,
+		Span:            span,
+		State:           state,
+		Checkpoint:      checkpoint,
+		Stats:           stats,
+		Error:           tableErr,
+		ReplicationMode: mode,
 	}
 }
@@ -15,7 +15,9 @@ package agent
 
 import (
 	"context"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -23,9 +25,13 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
 	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 )
 
+// checkpointRateWindow is the sliding window of checkpoint advance rate.
+const checkpointRateWindow = time.Minute
+
 // tableSpan is a state machine that manage the tableSpan's state,
 // also tracking its progress by utilize the `TableExecutor`
 type tableSpan struct {
@@ -36,17 +42,28 @@ type tableSpan struct {
 	executor internal.TableExecutor
 
 	task *dispatchTableTask
+	// failed is true if the executor reports an error of the table span, and
+	// errorReported is true if the error has been sent to the owner.
+	failed        bool
+	errorReported bool
+
+	clock          clock.Clock
+	checkpointRate checkpointRate
+	eventCounts    eventCounts
 }
 
 func newTableSpan(
-	changefeed model.ChangeFeedID, span tablepb.Span, executor internal.TableExecutor,
+	changefeed model.ChangeFeedID, span tablepb.Span,
+	executor internal.TableExecutor, clock clock.Clock,
 ) *tableSpan {
 	return &tableSpan{
-		changefeedID: changefeed,
-		span:         span,
-		state:        tablepb.TableStateAbsent, // use `absent` as the default state.
-		executor:     executor,
-		task:         nil,
+		changefeedID:   changefeed,
+		span:           span,
+		state:          tablepb.TableStateAbsent, // use `absent` as the default state.
+		executor:       executor,
+		task:           nil,
+		clock:          clock,
+		checkpointRate: checkpointRate{window: checkpointRateWindow},
 	}
 }
 
@@ -56,6 +73,10 @@ func (t *tableSpan) getAndUpdateTableSpanState() (tablepb.TableState, bool) {
 
 	meta := t.executor.GetTableSpanStatus(t.span, false)
 	t.state = meta.State
+	t.failed = meta.Error != nil
+	if t.state == tablepb.TableStateReplicating {
+		t.checkpointRate.observe(t.clock.Now(), meta.Checkpoint.CheckpointTs)
+	}
 
 	if oldState != t.state {
 		log.Debug("schedulerv3: table state changed",
@@ -70,7 +91,142 @@ func (t *tableSpan) getAndUpdateTableSpanState() (tablepb.TableState, bool) {
 }
 
 func (t *tableSpan) getTableSpanStatus(collectStat bool) tablepb.TableStatus {
-	return t.executor.GetTableSpanStatus(t.span, collectStat)
+	status := t.executor.GetTableSpanStatus(t.span, collectStat)
+	if collectStat {
+		status.Stats.CheckpointAdvanceRate = t.checkpointRate.rate()
+	}
+	if status.Error != nil &&
+		status.Error.Category == tablepb.TableErrorCategoryUnknown {
+		tableErr := *status.Error
+		tableErr.Category = categorizeTableError(status.State)
+		status.Error = &tableErr
+	}
+	if status.Error != nil {
+		// The checkpoint of a failed table span is not reported, so it does
+		// not count in the checkpoint of the changefeed until it recovers.
+		status.Checkpoint = tablepb.Checkpoint{}
+	}
+	return status
+}
+
+// handleTableError sends the status of the table span to the owner once the
+// executor reports an error of it, so the owner can decide whether to
+// reschedule the table or fail the changefeed. The unfinished add table task
+// is dropped, since the table span can not make progress.
+func (t *tableSpan) handleTableError() *schedulepb.Message {
+	if !t.failed {
+		t.errorReported = false
+		return nil
+	}
+	if t.errorReported {
+		return nil
+	}
+	t.errorReported = true
+	if t.task != nil && !t.task.IsRemove {
+		t.task = nil
+	}
+	status := t.getTableSpanStatus(false)
+	log.Warn("schedulerv3: table failed",
+		zap.String("namespace", t.changefeedID.Namespace),
+		zap.String("changefeed", t.changefeedID.ID),
+		zap.Int64("tableID", t.span.TableID),
+		zap.Stringer("state", t.state),
+		zap.Any("error", status.Error))
+	return newAddTableResponseMessage(status)
+}
+
+// countEvents converts the cumulative event counts in stats to the counts
+// since the previous call.
+func (t *tableSpan) countEvents(stats *tablepb.Stats) {
+	stats.DMLRowCount, stats.DDLEventCount = t.eventCounts.delta(
+		stats.DMLRowCount, stats.DDLEventCount)
+}
+
+// categorizeTableError returns the category of an error by the state of the
+// table span. A table span that is not replicating yet failed during
+// initial setup, the owner may retry it instead of escalating.
+func categorizeTableError(state tablepb.TableState) tablepb.TableErrorCategory {
+	switch state {
+	case tablepb.TableStateAbsent,
+		tablepb.TableStatePreparing,
+		tablepb.TableStatePrepared:
+		return tablepb.TableErrorCategoryInitialization
+	case tablepb.TableStateReplicating,
+		tablepb.TableStateStopping,
+		tablepb.TableStateStopped:
+		return tablepb.TableErrorCategoryReplication
+	default:
+		return tablepb.TableErrorCategoryUnknown
+	}
+}
+
+// newAddTableFailedResponseMessage returns the response of a failed add table
+// task, err is reported as an initialization error.
+func (t *tableSpan) newAddTableFailedResponseMessage(err error) *schedulepb.Message {
+	status := t.getTableSpanStatus(false)
+	if err != nil {
+		status.Error = &tablepb.TableError{
+			Category: tablepb.TableErrorCategoryInitialization,
+			Message:  err.Error(),
+		}
+	}
+	return newAddTableResponseMessage(status)
+}
+
+// eventCounts converts cumulative event counts to deltas.
+type eventCounts struct {
+	dmlRows   uint64
+	ddlEvents uint64
+}
+
+// delta returns counts since the previous call. A counter that is smaller
+// than the previous one is reset, the count itself is the delta.
+func (c *eventCounts) delta(dmlRows, ddlEvents uint64) (uint64, uint64) {
+	counterDelta := func(prev, current uint64) uint64 {
+		if current < prev {
+			return current
+		}
+		return current - prev
+	}
+	dmlDelta := counterDelta(c.dmlRows, dmlRows)
+	ddlDelta := counterDelta(c.ddlEvents, ddlEvents)
+	c.dmlRows, c.ddlEvents = dmlRows, ddlEvents
+	return dmlDelta, ddlDelta
+}
+
+type checkpointSample struct {
+	time         time.Time
+	checkpointTs model.Ts
+}
+
+// checkpointRate computes the advance rate of checkpoint in a sliding window.
+type checkpointRate struct {
+	window  time.Duration
+	samples []checkpointSample
+}
+
+func (r *checkpointRate) observe(now time.Time, checkpointTs model.Ts) {
+	r.samples = append(r.samples, checkpointSample{time: now, checkpointTs: checkpointTs})
+	i := 0
+	for i < len(r.samples)-1 && now.Sub(r.samples[i].time) > r.window {
+		i++
+	}
+	r.samples = r.samples[i:]
+}
+
+// rate returns seconds of checkpoint advanced per second in the window.
+func (r *checkpointRate) rate() float64 {
+	if len(r.samples) < 2 {
+		return 0
+	}
+	first, last := r.samples[0], r.samples[len(r.samples)-1]
+	elapsed := last.time.Sub(first.time)
+	if elapsed <= 0 || last.checkpointTs <= first.checkpointTs {
+		return 0
+	}
+	advance := oracle.GetTimeFromTS(last.checkpointTs).Sub(
+		oracle.GetTimeFromTS(first.checkpointTs))
+	return advance.Seconds() / elapsed.Seconds()
 }
 
 func newAddTableResponseMessage(status tablepb.TableStatus) *schedulepb.Message {
@@ -166,8 +322,7 @@ func (t *tableSpan) handleAddTableTask(
 					zap.String("changefeed", t.changefeedID.ID),
 					zap.Int64("tableID", t.span.TableID), zap.Any("task", t.task),
 					zap.Error(err))
-				status := t.getTableSpanStatus(false)
-				return newAddTableResponseMessage(status), errors.Trace(err)
+				return t.newAddTableFailedResponseMessage(err), errors.Trace(err)
 			}
 			state, changed = t.getAndUpdateTableSpanState()
 		case tablepb.TableStateReplicating:
@@ -197,8 +352,7 @@ func (t *tableSpan) handleAddTableTask(
 						zap.String("changefeed", t.changefeedID.ID),
 						zap.Int64("tableID", t.span.TableID), zap.Stringer("state", state),
 						zap.Error(err))
-					status := t.getTableSpanStatus(false)
-					return newAddTableResponseMessage(status), errors.Trace(err)
+					return t.newAddTableFailedResponseMessage(err), errors.Trace(err)
 				}
 				t.task.status = dispatchTableTaskProcessed
 			}
@@ -278,6 +432,7 @@ func (t *tableSpan) poll(ctx context.Context) (*schedulepb.Message, error) {
 type tableSpanManager struct {
 	tables   *spanz.BtreeMap[*tableSpan]
 	executor internal.TableExecutor
+	clock    clock.Clock
 
 	changefeedID model.ChangeFeedID
 }
@@ -288,6 +443,7 @@ func newTableSpanManager(
 	return &tableSpanManager{
 		tables:       spanz.NewBtreeMap[*tableSpan](),
 		executor:     executor,
+		clock:        clock.New(),
 		changefeedID: changefeed,
 	}
 }
@@ -308,6 +464,9 @@ func (tm *tableSpanManager) poll(ctx context.Context) ([]*schedulepb.Message, er
 			toBeDropped = append(toBeDropped, span)
 		}
 
+		if message == nil {
+			message = table.handleTableError()
+		}
 		if message == nil {
 			return true
 		}
@@ -328,7 +487,7 @@ func (tm *tableSpanManager) getAllTableSpans() *spanz.BtreeMap[*tableSpan] {
 func (tm *tableSpanManager) addTableSpan(span tablepb.Span) *tableSpan {
 	table, ok := tm.tables.Get(span)
 	if !ok {
-		table = newTableSpan(tm.changefeedID, span, tm.executor)
+		table = newTableSpan(tm.changefeedID, span, tm.executor, tm.clock)
 		tm.tables.ReplaceOrInsert(span, table)
 	}
 	return table
@@ -14,12 +14,16 @@
 package agent
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 func TestTableManager(t *testing.T) {
@@ -37,3 +41,89 @@ func TestTableManager(t *testing.T) {
 	tableM.dropTableSpan(span1)
 	require.False(t, tableM.tables.Has(span1))
 }
+
+func TestTableSpanCheckpointAdvanceRate(t *testing.T) {
+	t.Parallel()
+
+	mockTableExecutor := newMockTableExecutor()
+	tableM := newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+	mockClock := clock.NewMock()
+	tableM.clock = mockClock
+
+	span := spanz.TableIDToComparableSpan(1)
+	table := tableM.addTableSpan(span)
+	mockTableExecutor.tables.ReplaceOrInsert(span, tablepb.TableStateReplicating)
+	start := time.Now()
+	setCheckpoint := func(ts time.Time) {
+		mockTableExecutor.checkpoints.ReplaceOrInsert(span, tablepb.Checkpoint{
+			CheckpointTs: oracle.GoTimeToTS(ts),
+		})
+	}
+
+	// Not enough samples.
+	setCheckpoint(start)
+	_, err := tableM.poll(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, table.getTableSpanStatus(true).Stats.CheckpointAdvanceRate)
+
+	// Checkpoint advances 20s in 10s.
+	mockClock.Add(10 * time.Second)
+	setCheckpoint(start.Add(20 * time.Second))
+	_, err = tableM.poll(context.Background())
+	require.NoError(t, err)
+	require.InDelta(t, 2, table.getTableSpanStatus(true).Stats.CheckpointAdvanceRate, 0.01)
+	require.Zero(t, table.getTableSpanStatus(false).Stats.CheckpointAdvanceRate)
+
+	// Checkpoint stalls, old samples slide out of the window.
+	mockClock.Add(checkpointRateWindow)
+	_, err = tableM.poll(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, table.getTableSpanStatus(true).Stats.CheckpointAdvanceRate)
+
+	// Checkpoint advances 30s in 60s.
+	mockClock.Add(checkpointRateWindow)
+	setCheckpoint(start.Add(50 * time.Second))
+	_, err = tableM.poll(context.Background())
+	require.NoError(t, err)
+	require.InDelta(t, 0.5, table.getTableSpanStatus(true).Stats.CheckpointAdvanceRate, 0.01)
+}
+
+func TestTableSpanCountEvents(t *testing.T) {
+	t.Parallel()
+
+	table := newTableSpan(model.ChangeFeedID{}, spanz.TableIDToComparableSpan(1),
+		newMockTableExecutor(), clock.New())
+
+	// The first count is the count since the table started.
+	stats := tablepb.Stats{DMLRowCount: 100, DDLEventCount: 2}
+	table.countEvents(&stats)
+	require.Equal(t, uint64(100), stats.DMLRowCount)
+	require.Equal(t, uint64(2), stats.DDLEventCount)
+
+	stats = tablepb.Stats{DMLRowCount: 180, DDLEventCount: 3}
+	table.countEvents(&stats)
+	require.Equal(t, uint64(80), stats.DMLRowCount)
+	require.Equal(t, uint64(1), stats.DDLEventCount)
+
+	// Counters are reset, e.g. the table is restarted in the executor.
+	stats = tablepb.Stats{DMLRowCount: 10}
+	table.countEvents(&stats)
+	require.Equal(t, uint64(10), stats.DMLRowCount)
+	require.Equal(t, uint64(0), stats.DDLEventCount)
+}
+
+func TestCategorizeTableError(t *testing.T) {
+	t.Parallel()
+
+	for state, category := range map[tablepb.TableState]tablepb.TableErrorCategory{
+		tablepb.TableStateAbsent:      tablepb.TableErrorCategoryInitialization,
+		tablepb.TableStatePreparing:   tablepb.TableErrorCategoryInitialization,
+		tablepb.TableStatePrepared:    tablepb.TableErrorCategoryInitialization,
+		tablepb.TableStateReplicating: tablepb.TableErrorCategoryReplication,
+		tablepb.TableStateStopping:    tablepb.TableErrorCategoryReplication,
+		tablepb.TableStateStopped:     tablepb.TableErrorCategoryReplication,
+		tablepb.TableStateUnknown:     tablepb.TableErrorCategoryUnknown,
+	} {
+		require.Equal(t, category, categorizeTableError(state), state.String())
+	}
+}
@@ -14,8 +14,12 @@
 package v3
 
 import (
+	"sort"
+
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 )
 
 var _ internal.InfoProvider = (*coordinator)(nil)
@@ -48,3 +52,71 @@ func (c *coordinator) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus,
 	}
 	return tasks, nil
 }
+
+// GetTableStatuses returns the replication statuses of all tables.
+func (c *coordinator) GetTableStatuses() ([]*model.TableReplicationStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var statuses []*model.TableReplicationStatus
+	var last *model.TableReplicationStatus
+	c.replicationM.ReplicationSets().Ascend(
+		func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+			// Spans are sorted by table ID, so spans of a table are adjacent.
+			if last == nil || last.TableID != span.TableID {
+				last = &model.TableReplicationStatus{
+					TableID:      span.TableID,
+					CheckpointTs: rep.Checkpoint.CheckpointTs,
+					ResolvedTs:   rep.Checkpoint.ResolvedTs,
+					State:        rep.State.String(),
+				}
+				statuses = append(statuses, last)
+			}
+			if rep.Checkpoint.CheckpointTs < last.CheckpointTs {
+				last.CheckpointTs = rep.Checkpoint.CheckpointTs
+			}
+			if rep.Checkpoint.ResolvedTs < last.ResolvedTs {
+				last.ResolvedTs = rep.Checkpoint.ResolvedTs
+			}
+			if last.State == replication.ReplicationSetStateReplicating.String() {
+				last.State = rep.State.String()
+			}
+			if rep.Primary != "" {
+				idx := sort.SearchStrings(last.Captures, rep.Primary)
+				if idx == len(last.Captures) || last.Captures[idx] != rep.Primary {
+					last.Captures = append(last.Captures, "")
+					copy(last.Captures[idx+1:], last.Captures[idx:])
+					last.Captures[idx] = rep.Primary
+				}
+			}
+			last.SpanCount++
+			return true
+		})
+	return statuses, nil
+}
+
+// GetRunningTasks returns the running schedule tasks.
+func (c *coordinator) GetRunningTasks() ([]*model.ScheduleTaskInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var tasks []*model.ScheduleTaskInfo
+	c.replicationM.RunningTasks().Ascend(
+		func(span tablepb.Span, task *replication.ScheduleTask) bool {
+			info := &model.ScheduleTaskInfo{
+				Type:    task.Name(),
+				TableID: span.TableID,
+				Span:    span.String(),
+			}
+			if rep, ok := c.replicationM.ReplicationSets().Get(span); ok {
+				info.State = rep.State.String()
+				for captureID := range rep.Captures {
+					info.Captures = append(info.Captures, captureID)
+				}
+				sort.Strings(info.Captures)
+			}
+			tasks = append(tasks, info)
+			return true
+		})
+	return tasks, nil
+}
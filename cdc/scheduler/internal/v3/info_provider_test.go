@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/keyspan"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
 )
@@ -89,3 +90,108 @@ func TestInfoProviderIsInitialized(t *testing.T) {
 	coord.captureM.SetInitializedForTests(true)
 	require.True(t, ip.IsInitialized())
 }
+
+func TestInfoProviderTableStatuses(t *testing.T) {
+	t.Parallel()
+
+	coord := newCoordinator("a", model.ChangeFeedID{}, 1, &config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		MaxTaskConcurrency: 1,
+		ChangefeedSettings: config.GetDefaultReplicaConfig().Scheduler,
+	})
+	spans := coord.replicationM.GetReplicationSetForTests()
+	spans.ReplaceOrInsert(tablepb.Span{TableID: 1}, &replication.ReplicationSet{
+		State:      replication.ReplicationSetStateReplicating,
+		Primary:    "a",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 10, ResolvedTs: 12},
+	})
+	// Table 2 is split into 3 spans.
+	spans.ReplaceOrInsert(tablepb.Span{
+		TableID: 2, StartKey: []byte{1}, EndKey: []byte{2},
+	}, &replication.ReplicationSet{
+		State:      replication.ReplicationSetStateReplicating,
+		Primary:    "b",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 8, ResolvedTs: 12},
+	})
+	spans.ReplaceOrInsert(tablepb.Span{
+		TableID: 2, StartKey: []byte{2}, EndKey: []byte{3},
+	}, &replication.ReplicationSet{
+		State:      replication.ReplicationSetStatePrepare,
+		Primary:    "a",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 9, ResolvedTs: 11},
+	})
+	spans.ReplaceOrInsert(tablepb.Span{
+		TableID: 2, StartKey: []byte{3}, EndKey: []byte{4},
+	}, &replication.ReplicationSet{
+		State:      replication.ReplicationSetStateReplicating,
+		Primary:    "b",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 10, ResolvedTs: 10},
+	})
+
+	var ip internal.InfoProvider = coord
+	statuses, err := ip.GetTableStatuses()
+	require.Nil(t, err)
+	require.Equal(t, []*model.TableReplicationStatus{{
+		TableID:      1,
+		CheckpointTs: 10,
+		ResolvedTs:   12,
+		Captures:     []model.CaptureID{"a"},
+		State:        "Replicating",
+		SpanCount:    1,
+	}, {
+		TableID:      2,
+		CheckpointTs: 8,
+		ResolvedTs:   10,
+		Captures:     []model.CaptureID{"a", "b"},
+		State:        "Prepare",
+		SpanCount:    3,
+	}}, statuses)
+}
+
+func TestInfoProviderRunningTasks(t *testing.T) {
+	t.Parallel()
+
+	coord := newCoordinator("a", model.ChangeFeedID{}, 1, &config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		MaxTaskConcurrency: 2,
+		ChangefeedSettings: config.GetDefaultReplicaConfig().Scheduler,
+	})
+	spans := coord.replicationM.GetReplicationSetForTests()
+	spans.ReplaceOrInsert(tablepb.Span{TableID: 1}, &replication.ReplicationSet{
+		State:   replication.ReplicationSetStateReplicating,
+		Primary: "a",
+		Captures: map[model.CaptureID]replication.Role{
+			"a": replication.RolePrimary,
+		},
+	})
+	_, err := coord.replicationM.HandleTasks([]*replication.ScheduleTask{{
+		MoveTable: &replication.MoveTable{
+			Span:        tablepb.Span{TableID: 1},
+			DestCapture: "b",
+		},
+	}, {
+		AddTable: &replication.AddTable{
+			Span:         tablepb.Span{TableID: 2},
+			CaptureID:    "b",
+			CheckpointTs: 1,
+		},
+	}})
+	require.Nil(t, err)
+
+	var ip internal.InfoProvider = coord
+	tasks, err := ip.GetRunningTasks()
+	require.Nil(t, err)
+	require.Equal(t, []*model.ScheduleTaskInfo{{
+		Type:     "moveTable",
+		TableID:  1,
+		Span:     "{table_id:1}",
+		State:    "Prepare",
+		Captures: []model.CaptureID{"a", "b"},
+	}, {
+		Type:     "addTable",
+		TableID:  2,
+		Span:     "{table_id:2}",
+		State:    "Prepare",
+		Captures: []model.CaptureID{"b"},
+	}}, tasks)
+}
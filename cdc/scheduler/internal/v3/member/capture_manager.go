@@ -64,6 +64,15 @@ type CaptureStatus struct {
 	ID       model.CaptureID
 	Addr     string
 	IsOwner  bool
+
+	// pendingTables are the tables collected from pages of a paginated
+	// heartbeat response, resumeSpan is the span to request the next page
+	// from, see HeartbeatResponse.Overflow. collectStats is true if the pages
+	// are requested to collect stats.
+	pendingTables []tablepb.TableStatus
+	pendingEpoch  schedulepb.ProcessorEpoch
+	resumeSpan    *tablepb.Span
+	collectStats  bool
 }
 
 func newCaptureStatus(
@@ -92,6 +101,12 @@ func (c *CaptureStatus) handleHeartbeatResponse(
 		return
 	}
 
+	if !c.collectTables(resp, epoch) {
+		// Wait for the rest of pages, otherwise tables on following pages
+		// are considered absent and get replicated twice.
+		return
+	}
+
 	if c.State == CaptureStateUninitialized {
 		c.Epoch = epoch
 		c.State = CaptureStateInitialized
@@ -105,7 +120,42 @@ func (c *CaptureStatus) handleHeartbeatResponse(
 			zap.String("capture", c.ID),
 			zap.String("captureAddr", c.Addr))
 	}
-	c.Tables = resp.Tables
+}
+
+// collectTables collects tables reported by the heartbeat response, it
+// returns false if the response is a page and the rest of pages are pending.
+func (c *CaptureStatus) collectTables(
+	resp *schedulepb.HeartbeatResponse, epoch schedulepb.ProcessorEpoch,
+) bool {
+	if c.pendingEpoch.Epoch != epoch.Epoch {
+		// The capture has restarted, drop pages of the previous epoch.
+		c.pendingTables, c.resumeSpan = nil, nil
+	}
+	tables := resp.Tables
+	if len(c.pendingTables) > 0 {
+		// Pages are ordered by span, skip tables that have been collected
+		// in case the response is for a stale heartbeat.
+		last := c.pendingTables[len(c.pendingTables)-1].Span
+		tables = c.pendingTables
+		for i := range resp.Tables {
+			if last.Less(&resp.Tables[i].Span) {
+				tables = append(tables, resp.Tables[i])
+			}
+		}
+	}
+	if resp.Overflow && resp.NextSpan != nil {
+		next := *resp.NextSpan
+		c.pendingTables, c.pendingEpoch, c.resumeSpan = tables, epoch, &next
+		log.Info("schedulerv3: heartbeat response overflows, collect the rest",
+			zap.String("capture", c.ID),
+			zap.String("captureAddr", c.Addr),
+			zap.Int("collected", len(tables)),
+			zap.Stringer("nextSpan", &next))
+		return false
+	}
+	c.Tables = tables
+	c.pendingTables, c.resumeSpan = nil, nil
+	return true
 }
 
 // CaptureChanges wraps changes of captures.
@@ -187,7 +237,12 @@ func (c *CaptureManager) Tick(
 		return true
 	})
 	msgs := make([]*schedulepb.Message, 0, len(c.Captures))
-	for to := range c.Captures {
+	for to, capture := range c.Captures {
+		if capture.resumeSpan == nil {
+			// Stats are collected on every page of a paginated response,
+			// so they are decided when the first page is requested.
+			capture.collectStats = c.pendingCollect
+		}
 		msgs = append(msgs, &schedulepb.Message{
 			To:      to,
 			MsgType: schedulepb.MsgHeartbeat,
@@ -196,8 +251,10 @@ func (c *CaptureManager) Tick(
 				// IsStopping let the receiver capture know that it should be stopping now.
 				// At the moment, this is triggered by `DrainCapture` scheduler.
 				IsStopping:   drainingCapture == to,
-				CollectStats: c.pendingCollect,
+				CollectStats: capture.collectStats,
 				Barrier:      barrier,
+				// Request the next page of a paginated heartbeat response.
+				ResumeSpan: capture.resumeSpan,
 			},
 		})
 	}
@@ -56,6 +56,50 @@ func TestCaptureStatusHandleHeartbeatResponse(t *testing.T) {
 	require.Equal(t, epoch, c.Epoch)
 }
 
+func TestCaptureStatusHandlePaginatedHeartbeatResponse(t *testing.T) {
+	t.Parallel()
+
+	status := func(tableID tablepb.TableID) tablepb.TableStatus {
+		return tablepb.TableStatus{Span: tablepb.Span{TableID: tableID}}
+	}
+	rev := schedulepb.OwnerRevision{Revision: 1}
+	epoch := schedulepb.ProcessorEpoch{Epoch: "test"}
+	c := newCaptureStatus(rev, "", "", false)
+
+	// The capture stays uninitialized until all pages are collected.
+	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{
+		Tables:   []tablepb.TableStatus{status(1), status(2)},
+		Overflow: true,
+		NextSpan: &tablepb.Span{TableID: 3},
+	}, epoch)
+	require.Equal(t, CaptureStateUninitialized, c.State)
+	require.Nil(t, c.Tables)
+	require.Equal(t, &tablepb.Span{TableID: 3}, c.resumeSpan)
+
+	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{
+		Tables: []tablepb.TableStatus{status(3), status(4)},
+	}, epoch)
+	require.Equal(t, CaptureStateInitialized, c.State)
+	require.Equal(t, []tablepb.TableStatus{status(1), status(2), status(3), status(4)}, c.Tables)
+	require.Nil(t, c.resumeSpan)
+
+	// Tables are kept until all pages are collected.
+	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{
+		Tables:   []tablepb.TableStatus{status(1)},
+		Overflow: true,
+		NextSpan: &tablepb.Span{TableID: 2},
+	}, epoch)
+	require.Len(t, c.Tables, 4)
+
+	// A response to a stale heartbeat starts from the first table, the
+	// collected tables are not duplicated.
+	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{
+		Tables: []tablepb.TableStatus{status(1), status(2), status(3)},
+	}, epoch)
+	require.Equal(t, []tablepb.TableStatus{status(1), status(2), status(3)}, c.Tables)
+	require.Nil(t, c.resumeSpan)
+}
+
 func TestCaptureManagerHandleAliveCaptureUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -287,3 +331,101 @@ func TestCaptureManagerCollectStatsTick(t *testing.T) {
 		}
 	}
 }
+
+func TestCaptureManagerPaginatedHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	rev := schedulepb.OwnerRevision{}
+	cfg := config.NewDefaultSchedulerConfig()
+	cm := NewCaptureManager("1", model.ChangeFeedID{}, rev, cfg)
+	ms := map[model.CaptureID]*model.CaptureInfo{"1": {}}
+	cm.HandleAliveCaptureUpdate(ms)
+
+	page1 := []tablepb.TableStatus{{Span: tablepb.Span{TableID: 1}}}
+	page2 := []tablepb.TableStatus{{Span: tablepb.Span{TableID: 2}}}
+	cm.HandleMessage([]*schedulepb.Message{{
+		Header: &schedulepb.Message_Header{}, From: "1",
+		MsgType: schedulepb.MsgHeartbeatResponse,
+		HeartbeatResponse: &schedulepb.HeartbeatResponse{
+			Tables: page1, Overflow: true, NextSpan: &tablepb.Span{TableID: 2},
+		},
+	}})
+	cm.HandleAliveCaptureUpdate(ms)
+	require.False(t, cm.CheckAllCaptureInitialized())
+
+	// The next heartbeat requests the rest of tables.
+	var msgs []*schedulepb.Message
+	for i := 0; i < cfg.HeartbeatTick; i++ {
+		msgs = cm.Tick(spanz.NewBtreeMap[*replication.ReplicationSet](), captureIDNotDraining, nil)
+	}
+	require.Len(t, msgs, 1)
+	require.Equal(t, &tablepb.Span{TableID: 2}, msgs[0].Heartbeat.ResumeSpan)
+
+	// The capture is initialized with tables of all pages.
+	cm.HandleMessage([]*schedulepb.Message{{
+		Header: &schedulepb.Message_Header{}, From: "1",
+		MsgType:           schedulepb.MsgHeartbeatResponse,
+		HeartbeatResponse: &schedulepb.HeartbeatResponse{Tables: page2},
+	}})
+	cm.HandleAliveCaptureUpdate(ms)
+	require.True(t, cm.CheckAllCaptureInitialized())
+	require.Equal(t, &CaptureChanges{
+		Init: map[model.CaptureID][]tablepb.TableStatus{"1": append(page1, page2...)},
+	}, cm.TakeChanges())
+	for i := 0; i < cfg.HeartbeatTick; i++ {
+		msgs = cm.Tick(spanz.NewBtreeMap[*replication.ReplicationSet](), captureIDNotDraining, nil)
+	}
+	require.Len(t, msgs, 1)
+	require.Nil(t, msgs[0].Heartbeat.ResumeSpan)
+}
+
+func TestCaptureManagerPaginatedHeartbeatCollectStats(t *testing.T) {
+	t.Parallel()
+
+	rev := schedulepb.OwnerRevision{}
+	cfg := config.NewDefaultSchedulerConfig()
+	cfg.HeartbeatTick = 1
+	cfg.CollectStatsTick = 3
+	cm := NewCaptureManager("1", model.ChangeFeedID{}, rev, cfg)
+	cm.HandleAliveCaptureUpdate(map[model.CaptureID]*model.CaptureInfo{"1": {}})
+
+	tick := func() *schedulepb.Heartbeat {
+		msgs := cm.Tick(spanz.NewBtreeMap[*replication.ReplicationSet](), captureIDNotDraining, nil)
+		require.Len(t, msgs, 1)
+		return msgs[0].Heartbeat
+	}
+	respond := func(overflow bool) {
+		resp := &schedulepb.HeartbeatResponse{}
+		if overflow {
+			resp.Overflow = true
+			resp.NextSpan = &tablepb.Span{TableID: 2}
+		}
+		cm.HandleMessage([]*schedulepb.Message{{
+			Header: &schedulepb.Message_Header{}, From: "1",
+			MsgType:           schedulepb.MsgHeartbeatResponse,
+			HeartbeatResponse: resp,
+		}})
+	}
+
+	// A round without stats.
+	require.False(t, tick().CollectStats)
+	respond(true)
+	heartbeat := tick()
+	require.NotNil(t, heartbeat.ResumeSpan)
+	require.False(t, heartbeat.CollectStats)
+	respond(false)
+
+	// All pages of a round collect stats.
+	heartbeat = tick()
+	require.Nil(t, heartbeat.ResumeSpan)
+	require.True(t, heartbeat.CollectStats)
+	respond(true)
+	heartbeat = tick()
+	require.NotNil(t, heartbeat.ResumeSpan)
+	require.True(t, heartbeat.CollectStats)
+	respond(false)
+
+	heartbeat = tick()
+	require.Nil(t, heartbeat.ResumeSpan)
+	require.False(t, heartbeat.CollectStats)
+}
@@ -26,6 +26,6 @@ var captureTableGauge = prometheus.NewGaugeVec(
 	}, []string{"namespace", "changefeed", "addr"})
 
 // InitMetrics registers all metrics used in scheduler
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(captureTableGauge)
 }
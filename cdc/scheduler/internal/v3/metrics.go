@@ -17,12 +17,14 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/scheduler"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/transport"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 // InitMetrics registers all metrics used in scheduler
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	member.InitMetrics(registry)
 	replication.InitMetrics(registry)
 	scheduler.InitMetrics(registry)
+	transport.InitMetrics(registry)
 }
@@ -128,7 +128,7 @@ var (
 )
 
 // InitMetrics registers all metrics used in scheduler
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(tableGauge)
 	registry.MustRegister(tableStateGauge)
 	registry.MustRegister(acceptScheduleTaskCounter)
@@ -99,6 +99,9 @@ type Manager struct { //nolint:revive
 
 	runningTasks       *spanz.BtreeMap[*ScheduleTask]
 	maxTaskConcurrency int
+	// haltedSpans are the spans whose schemas are incompatible with the
+	// downstream, they are removed and never added back.
+	haltedSpans *spanz.Set
 
 	changefeedID           model.ChangeFeedID
 	slowestTableID         tablepb.Span
@@ -124,6 +127,7 @@ func NewReplicationManager(
 		spans:              spanz.NewBtreeMapWithDegree[*ReplicationSet](degreeReadHeavy),
 		runningTasks:       spanz.NewBtreeMap[*ScheduleTask](),
 		maxTaskConcurrency: maxTaskConcurrency,
+		haltedSpans:        spanz.NewSet(),
 		changefeedID:       changefeedID,
 	}
 }
@@ -238,6 +242,13 @@ func (r *Manager) handleMessageHeartbeatResponse(
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		if status.Stats.SchemaCompatibility == tablepb.SchemaCompatibilityIncompatible {
+			haltMsgs, err := r.haltTable(from, table)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			msgs = append(msgs, haltMsgs...)
+		}
 		if table.hasRemoved() {
 			log.Info("schedulerv3: table has removed",
 				zap.String("namespace", r.changefeedID.Namespace),
@@ -250,6 +261,28 @@ func (r *Manager) handleMessageHeartbeatResponse(
 	return sentMsgs, nil
 }
 
+// haltTable removes the table whose schema is incompatible with the
+// downstream, and the table is never added back. Other tables keep
+// replicating.
+func (r *Manager) haltTable(
+	from model.CaptureID, table *ReplicationSet,
+) ([]*schedulepb.Message, error) {
+	if !r.haltedSpans.Contain(table.Span) {
+		log.Warn("schedulerv3: table schema is incompatible with the downstream, halt it",
+			zap.String("namespace", r.changefeedID.Namespace),
+			zap.String("changefeed", r.changefeedID.ID),
+			zap.String("capture", from),
+			zap.String("span", table.Span.String()))
+		r.haltedSpans.Add(table.Span)
+	}
+	// The table may be in the middle of another task, it's removed once the
+	// task finishes and the incompatibility is reported again.
+	if table.State != ReplicationSetStateReplicating {
+		return nil, nil
+	}
+	return table.handleRemoveTable()
+}
+
 func (r *Manager) handleMessageDispatchTableResponse(
 	from model.CaptureID, msg *schedulepb.DispatchTableResponse,
 ) ([]*schedulepb.Message, error) {
@@ -346,6 +379,13 @@ func (r *Manager) HandleTasks(
 			span = task.MoveTable.Span
 		}
 
+		if task.AddTable != nil && r.haltedSpans.Contain(span) {
+			log.Info("schedulerv3: ignore add table task, table is halted",
+				zap.String("namespace", r.changefeedID.Namespace),
+				zap.String("changefeed", r.changefeedID.ID),
+				zap.Any("task", task))
+			continue
+		}
 		// Skip task if the table is already running a task,
 		// or the table has removed.
 		if _, ok := r.runningTasks.Get(span); ok {
@@ -453,6 +493,10 @@ func (r *Manager) handleBurstBalanceTasks(
 			// Skip add table if the table is already running a task.
 			continue
 		}
+		if r.haltedSpans.Contain(addTable.Span) {
+			// Skip add table if the table is halted.
+			continue
+		}
 		msgs, err := r.handleAddTableTask(&addTable)
 		if err != nil {
 			return nil, errors.Trace(err)
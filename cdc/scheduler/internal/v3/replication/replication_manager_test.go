@@ -792,6 +792,82 @@ func TestReplicationManagerHandleCaptureChangesDuringAddTable(t *testing.T) {
 	require.Equal(t, 1, <-addTableCh)
 }
 
+func TestReplicationManagerHandleIncompatibleSchema(t *testing.T) {
+	t.Parallel()
+
+	r := NewReplicationManager(10, model.ChangeFeedID{})
+	span := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	init := map[model.CaptureID][]tablepb.TableStatus{
+		"1": {
+			{Span: span, State: tablepb.TableStateReplicating},
+			{Span: span2, State: tablepb.TableStateReplicating},
+		},
+	}
+	_, err := r.HandleCaptureChanges(init, nil, 0)
+	require.Nil(t, err)
+
+	// Only the incompatible table is removed.
+	msgs, err := r.HandleMessage([]*schedulepb.Message{{
+		From:    "1",
+		MsgType: schedulepb.MsgHeartbeatResponse,
+		HeartbeatResponse: &schedulepb.HeartbeatResponse{
+			Tables: []tablepb.TableStatus{{
+				Span:  span,
+				State: tablepb.TableStateReplicating,
+				Stats: tablepb.Stats{
+					SchemaCompatibility: tablepb.SchemaCompatibilityIncompatible,
+				},
+			}, {
+				Span:  span2,
+				State: tablepb.TableStateReplicating,
+			}},
+		},
+	}})
+	require.Nil(t, err)
+	require.Len(t, msgs, 1)
+	require.EqualValues(t, &schedulepb.Message{
+		To:      "1",
+		MsgType: schedulepb.MsgDispatchTableRequest,
+		DispatchTableRequest: &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_RemoveTable{
+				RemoveTable: &schedulepb.RemoveTableRequest{Span: span},
+			},
+		},
+	}, msgs[0])
+	require.Equal(t, ReplicationSetStateRemoving, r.spans.GetV(span).State)
+	require.Equal(t, ReplicationSetStateReplicating, r.spans.GetV(span2).State)
+
+	// The halted table is not added back once it's removed.
+	msgs, err = r.HandleMessage([]*schedulepb.Message{{
+		From:    "1",
+		MsgType: schedulepb.MsgDispatchTableResponse,
+		DispatchTableResponse: &schedulepb.DispatchTableResponse{
+			Response: &schedulepb.DispatchTableResponse_RemoveTable{
+				RemoveTable: &schedulepb.RemoveTableResponse{
+					Status: &tablepb.TableStatus{
+						Span:  span,
+						State: tablepb.TableStateStopped,
+					},
+				},
+			},
+		},
+	}})
+	require.Nil(t, err)
+	require.Len(t, msgs, 0)
+	require.False(t, r.spans.Has(span))
+	msgs, err = r.HandleTasks([]*ScheduleTask{{
+		AddTable: &AddTable{Span: span, CaptureID: "1"},
+	}, {
+		BurstBalance: &BurstBalance{
+			AddTables: []AddTable{{Span: span, CaptureID: "1"}},
+		},
+	}})
+	require.Nil(t, err)
+	require.Len(t, msgs, 0)
+	require.False(t, r.spans.Has(span))
+}
+
 func TestLogSlowTableInfo(t *testing.T) {
 	t.Parallel()
 	r := NewReplicationManager(1, model.ChangeFeedID{})
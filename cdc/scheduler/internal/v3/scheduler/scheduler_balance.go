@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/spanz"
 )
 
@@ -41,6 +42,9 @@ type balanceScheduler struct {
 	forceBalance bool
 
 	maxTaskConcurrency int
+	// strategy is either config.BalanceStrategyTableCount or
+	// config.BalanceStrategyWorkload, empty means the former.
+	strategy string
 }
 
 func newBalanceScheduler(interval time.Duration, concurrency int) *balanceScheduler {
@@ -77,8 +81,14 @@ func (b *balanceScheduler) Schedule(
 		}
 	}
 
-	tasks := buildBalanceMoveTables(
-		b.random, captures, replications, b.maxTaskConcurrency)
+	var tasks []*replication.ScheduleTask
+	if b.strategy == config.BalanceStrategyWorkload {
+		tasks = buildWorkloadBalanceMoveTables(
+			captures, replications, b.maxTaskConcurrency)
+	} else {
+		tasks = buildBalanceMoveTables(
+			b.random, captures, replications, b.maxTaskConcurrency)
+	}
 	b.forceBalance = len(tasks) != 0
 	return tasks
 }
@@ -98,3 +108,17 @@ func buildBalanceMoveTables(
 	}
 	return tasks
 }
+
+func buildWorkloadBalanceMoveTables(
+	captures map[model.CaptureID]*member.CaptureStatus,
+	replications *spanz.BtreeMap[*replication.ReplicationSet],
+	maxTaskConcurrency int,
+) []*replication.ScheduleTask {
+	moves := newWorkloadBalanceMoveTables(captures, replications, maxTaskConcurrency)
+	tasks := make([]*replication.ScheduleTask, 0, len(moves))
+	for i := 0; i < len(moves); i++ {
+		// No need for accept callback here.
+		tasks = append(tasks, &replication.ScheduleTask{MoveTable: &moves[i]})
+	}
+	return tasks
+}
@@ -18,8 +18,10 @@ import (
 	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
 )
@@ -94,3 +96,71 @@ func TestSchedulerBalanceTaskLimit(t *testing.T) {
 	tasks = sched.Schedule(0, currentTables, captures, replications)
 	require.Len(t, tasks, 1)
 }
+
+func TestSchedulerBalanceWorkload(t *testing.T) {
+	t.Parallel()
+
+	newReplicating := func(primary model.CaptureID, regions uint64) *replication.ReplicationSet {
+		return &replication.ReplicationSet{
+			State:   replication.ReplicationSetStateReplicating,
+			Primary: primary,
+			Stats:   tablepb.Stats{RegionCount: regions},
+		}
+	}
+	captures := map[model.CaptureID]*member.CaptureStatus{"a": {}, "b": {}}
+	currentTables := spanz.ArrayToSpan([]model.TableID{1, 2, 3, 4, 5, 6})
+	replications := mapToSpanMap(map[model.TableID]*replication.ReplicationSet{
+		1: newReplicating("a", 60),
+		2: newReplicating("a", 40),
+		3: newReplicating("b", 1),
+		4: newReplicating("b", 1),
+		5: newReplicating("b", 1),
+		6: newReplicating("b", 1),
+	})
+
+	// Balancing by table count moves an idle table to the busy capture.
+	sched := newBalanceScheduler(time.Duration(0), 10)
+	sched.random = nil
+	tasks := sched.Schedule(0, currentTables, captures, replications)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "a", tasks[0].MoveTable.DestCapture)
+
+	// Balancing by workload moves the table that narrows the gap the most.
+	sched = newBalanceScheduler(time.Duration(0), 10)
+	sched.strategy = config.BalanceStrategyWorkload
+	tasks = sched.Schedule(0, currentTables, captures, replications)
+	require.Len(t, tasks, 1)
+	require.Equal(t, model.TableID(2), tasks[0].MoveTable.Span.TableID)
+	require.Equal(t, "b", tasks[0].MoveTable.DestCapture)
+
+	// A table larger than the gap is never moved.
+	replications = mapToSpanMap(map[model.TableID]*replication.ReplicationSet{
+		1: newReplicating("a", 100),
+		3: newReplicating("b", 1),
+	})
+	tasks = sched.Schedule(0, currentTables, captures, replications)
+	require.Len(t, tasks, 0)
+
+	// Idle tables are balanced by count, within the task limit.
+	captures = map[model.CaptureID]*member.CaptureStatus{"a": {}, "b": {}, "c": {}}
+	replications = mapToSpanMap(map[model.TableID]*replication.ReplicationSet{
+		1: newReplicating("a", 0),
+		2: newReplicating("a", 0),
+		3: newReplicating("a", 0),
+		4: newReplicating("a", 0),
+		5: newReplicating("a", 0),
+		6: newReplicating("a", 0),
+	})
+	tasks = sched.Schedule(0, currentTables, captures, replications)
+	require.Len(t, tasks, 4)
+	dest := map[model.CaptureID]int{}
+	for _, task := range tasks {
+		dest[task.MoveTable.DestCapture]++
+	}
+	require.Equal(t, map[model.CaptureID]int{"b": 2, "c": 2}, dest)
+
+	sched = newBalanceScheduler(time.Duration(0), 1)
+	sched.strategy = config.BalanceStrategyWorkload
+	tasks = sched.Schedule(0, currentTables, captures, replications)
+	require.Len(t, tasks, 1)
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"sort"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/spanz"
+)
+
+// workloadBalanceTolerance is how much the workload of a capture may exceed
+// the average before tables are moved off it, it prevents moving tables
+// back and forth for small fluctuations of workload.
+const workloadBalanceTolerance = 0.2
+
+type tableWorkload struct {
+	span     tablepb.Span
+	workload uint64
+	moved    bool
+}
+
+// spanWorkload returns the workload of a table, which is the number of its
+// captured regions. A table without regions still counts as 1, so that
+// idle tables are balanced by count.
+func spanWorkload(rep *replication.ReplicationSet) uint64 {
+	if rep.Stats.RegionCount == 0 {
+		return 1
+	}
+	return rep.Stats.RegionCount
+}
+
+// newWorkloadBalanceMoveTables moves tables from the capture with the
+// highest workload to the one with the lowest, until the highest workload
+// is within workloadBalanceTolerance of the average or no move narrows the
+// gap. A table is moved at most once.
+func newWorkloadBalanceMoveTables(
+	captures map[model.CaptureID]*member.CaptureStatus,
+	replications *spanz.BtreeMap[*replication.ReplicationSet],
+	maxTaskLimit int,
+) []replication.MoveTable {
+	if len(captures) == 0 {
+		return nil
+	}
+	tablesPerCapture := make(map[model.CaptureID][]*tableWorkload, len(captures))
+	captureWorkload := make(map[model.CaptureID]uint64, len(captures))
+	captureIDs := make([]model.CaptureID, 0, len(captures))
+	for captureID := range captures {
+		captureWorkload[captureID] = 0
+		captureIDs = append(captureIDs, captureID)
+	}
+	// Sort captures so that the result is deterministic on ties.
+	sort.Strings(captureIDs)
+
+	var totalWorkload uint64
+	replications.Ascend(func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+		if rep.State != replication.ReplicationSetStateReplicating {
+			return true
+		}
+		if _, ok := captures[rep.Primary]; !ok {
+			return true
+		}
+		workload := spanWorkload(rep)
+		tablesPerCapture[rep.Primary] = append(tablesPerCapture[rep.Primary],
+			&tableWorkload{span: span, workload: workload})
+		captureWorkload[rep.Primary] += workload
+		totalWorkload += workload
+		return true
+	})
+	avgWorkload := float64(totalWorkload) / float64(len(captures))
+
+	moveTables := make([]replication.MoveTable, 0)
+	for len(moveTables) < maxTaskLimit {
+		source, target := captureIDs[0], captureIDs[0]
+		for _, captureID := range captureIDs {
+			if captureWorkload[captureID] > captureWorkload[source] {
+				source = captureID
+			}
+			if captureWorkload[captureID] < captureWorkload[target] {
+				target = captureID
+			}
+		}
+		if float64(captureWorkload[source]) <= avgWorkload*(1+workloadBalanceTolerance) {
+			break
+		}
+
+		// Moving a table narrows the gap only if its workload is less than
+		// the gap, and the best one is closest to half of the gap.
+		gap := captureWorkload[source] - captureWorkload[target]
+		var victim *tableWorkload
+		victimIdx := -1
+		for idx, table := range tablesPerCapture[source] {
+			if table.moved || table.workload >= gap {
+				continue
+			}
+			if victim == nil ||
+				absDiff(2*table.workload, gap) < absDiff(2*victim.workload, gap) {
+				victim, victimIdx = table, idx
+			}
+		}
+		if victim == nil {
+			break
+		}
+
+		victim.moved = true
+		sourceTables := tablesPerCapture[source]
+		tablesPerCapture[source] = append(sourceTables[:victimIdx], sourceTables[victimIdx+1:]...)
+		tablesPerCapture[target] = append(tablesPerCapture[target], victim)
+		captureWorkload[source] -= victim.workload
+		captureWorkload[target] += victim.workload
+		moveTables = append(moveTables, replication.MoveTable{
+			Span:        victim.span,
+			DestCapture: target,
+		})
+	}
+	return moveTables
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
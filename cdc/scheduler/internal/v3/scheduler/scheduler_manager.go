@@ -54,8 +54,12 @@ func NewSchedulerManager(
 		cfg.AddTableBatchSize, changefeedID)
 	sm.schedulers[schedulerPriorityDrainCapture] = newDrainCaptureScheduler(
 		cfg.MaxTaskConcurrency, changefeedID)
-	sm.schedulers[schedulerPriorityBalance] = newBalanceScheduler(
+	balanceScheduler := newBalanceScheduler(
 		time.Duration(cfg.CheckBalanceInterval), cfg.MaxTaskConcurrency)
+	if cfg.ChangefeedSettings != nil {
+		balanceScheduler.strategy = cfg.ChangefeedSettings.BalanceStrategy
+	}
+	sm.schedulers[schedulerPriorityBalance] = balanceScheduler
 	sm.schedulers[schedulerPriorityMoveTable] = newMoveTableScheduler(changefeedID)
 	sm.schedulers[schedulerPriorityRebalance] = newRebalanceScheduler(changefeedID)
 
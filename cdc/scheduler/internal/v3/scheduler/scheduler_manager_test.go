@@ -38,6 +38,21 @@ func TestNewSchedulerManager(t *testing.T) {
 	require.NotNil(t, m.schedulers[schedulerPriorityDrainCapture])
 }
 
+func TestNewSchedulerManagerBalanceStrategy(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultSchedulerConfig()
+	m := NewSchedulerManager(model.DefaultChangeFeedID("test-changefeed"), cfg)
+	require.Empty(t, m.schedulers[schedulerPriorityBalance].(*balanceScheduler).strategy)
+
+	cfg.ChangefeedSettings = &config.ChangefeedSchedulerConfig{
+		BalanceStrategy: config.BalanceStrategyWorkload,
+	}
+	m = NewSchedulerManager(model.DefaultChangeFeedID("test-changefeed"), cfg)
+	require.Equal(t, config.BalanceStrategyWorkload,
+		m.schedulers[schedulerPriorityBalance].(*balanceScheduler).strategy)
+}
+
 func TestSchedulerManagerScheduler(t *testing.T) {
 	t.Parallel()
 
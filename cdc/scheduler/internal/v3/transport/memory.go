@@ -0,0 +1,148 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// MemoryOption configures in-memory transports, see NewMemoryTransportPair.
+type MemoryOption func(l *memoryLink)
+
+// WithDropHook drops every sent message for which drop returns true, it
+// simulates a lossy network.
+func WithDropHook(drop func(msg *schedulepb.Message) bool) MemoryOption {
+	return func(l *memoryLink) {
+		l.drop = drop
+	}
+}
+
+// WithReorderHook reorders every batch of sent messages with reorder before
+// they are delivered, it simulates a network which reorders messages.
+func WithReorderHook(
+	reorder func(msgs []*schedulepb.Message) []*schedulepb.Message,
+) MemoryOption {
+	return func(l *memoryLink) {
+		l.reorder = reorder
+	}
+}
+
+// memoryLink is shared by the two ends of an in-memory transport pair.
+// Hooks may be called concurrently by both ends.
+type memoryLink struct {
+	drop    func(msg *schedulepb.Message) bool
+	reorder func(msgs []*schedulepb.Message) []*schedulepb.Message
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func (l *memoryLink) close() {
+	l.closeOnce.Do(func() { close(l.closed) })
+}
+
+// memoryTransport is an in-memory Transport, messages sent by one end are
+// received by the other end.
+type memoryTransport struct {
+	link  *memoryLink
+	inbox chan *schedulepb.Message
+	peer  chan *schedulepb.Message
+}
+
+var _ Transport = (*memoryTransport)(nil)
+
+// NewMemoryTransportPair returns two connected in-memory transports, e.g. one
+// for the scheduler and one for the agent, each buffers at most bufferSize
+// received messages. Send blocks while the buffer of the peer is full.
+// Closing either end closes both.
+func NewMemoryTransportPair(
+	bufferSize int, opts ...MemoryOption,
+) (Transport, Transport) {
+	link := &memoryLink{closed: make(chan struct{})}
+	for _, opt := range opts {
+		opt(link)
+	}
+	a, b := make(chan *schedulepb.Message, bufferSize),
+		make(chan *schedulepb.Message, bufferSize)
+	return &memoryTransport{link: link, inbox: a, peer: b},
+		&memoryTransport{link: link, inbox: b, peer: a}
+}
+
+// Send implements Transport.Send.
+func (t *memoryTransport) Send(
+	ctx context.Context, msgs []*schedulepb.Message,
+) error {
+	if t.link.reorder != nil {
+		msgs = t.link.reorder(append([]*schedulepb.Message(nil), msgs...))
+	}
+	for _, msg := range msgs {
+		if t.link.drop != nil && t.link.drop(msg) {
+			continue
+		}
+		// Check closed first, select picks a random ready case.
+		select {
+		case <-t.link.closed:
+			return cerror.ErrSchedulerTransportClosed.GenWithStackByArgs()
+		default:
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-t.link.closed:
+			return cerror.ErrSchedulerTransportClosed.GenWithStackByArgs()
+		case t.peer <- msg:
+		}
+	}
+	return nil
+}
+
+// Recv implements Transport.Recv. It never blocks, it returns all buffered
+// messages, or an error if there is none and the transport is closed.
+// Messages are kept in the buffer if ctx is canceled.
+func (t *memoryTransport) Recv(ctx context.Context) ([]*schedulepb.Message, error) {
+	select {
+	case <-ctx.Done():
+		return nil, errors.Trace(ctx.Err())
+	default:
+	}
+	msgs := make([]*schedulepb.Message, 0)
+	for {
+		select {
+		case msg := <-t.inbox:
+			msgs = append(msgs, msg)
+			continue
+		default:
+		}
+		break
+	}
+	if len(msgs) == 0 {
+		select {
+		case <-t.link.closed:
+			return nil, cerror.ErrSchedulerTransportClosed.GenWithStackByArgs()
+		default:
+		}
+	}
+	return msgs, nil
+}
+
+// Close implements Transport.Close. It is idempotent.
+func (t *memoryTransport) Close() error {
+	t.link.close()
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMessages(n int) []*schedulepb.Message {
+	msgs := make([]*schedulepb.Message, 0, n)
+	for i := 0; i < n; i++ {
+		msgs = append(msgs, &schedulepb.Message{
+			Header: &schedulepb.Message_Header{OwnerRevision: schedulepb.OwnerRevision{
+				Revision: int64(i),
+			}},
+		})
+	}
+	return msgs
+}
+
+func TestMemoryTransportSendRecv(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scheduler, agent := NewMemoryTransportPair(8)
+
+	msgs, err := agent.Recv(ctx)
+	require.Nil(t, err)
+	require.Empty(t, msgs)
+
+	// Messages are received in order, in both directions.
+	sent := newTestMessages(4)
+	require.Nil(t, scheduler.Send(ctx, sent[:2]))
+	require.Nil(t, scheduler.Send(ctx, sent[2:]))
+	msgs, err = agent.Recv(ctx)
+	require.Nil(t, err)
+	require.Equal(t, sent, msgs)
+	require.Nil(t, agent.Send(ctx, sent[:1]))
+	msgs, err = scheduler.Recv(ctx)
+	require.Nil(t, err)
+	require.Equal(t, sent[:1], msgs)
+
+	// Concurrent senders.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Nil(t, scheduler.Send(ctx, newTestMessages(2)))
+		}()
+	}
+	wg.Wait()
+	msgs, err = agent.Recv(ctx)
+	require.Nil(t, err)
+	require.Len(t, msgs, 8)
+}
+
+func TestMemoryTransportBackpressure(t *testing.T) {
+	t.Parallel()
+
+	scheduler, agent := NewMemoryTransportPair(1)
+	sent := newTestMessages(2)
+
+	// The buffer is full, Send blocks until the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := scheduler.Send(ctx, sent)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Send unblocks once the peer receives.
+	done := make(chan error, 1)
+	go func() { done <- scheduler.Send(context.Background(), sent[1:]) }()
+	msgs, err := agent.Recv(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, sent[:1], msgs)
+	require.Nil(t, <-done)
+	msgs, err = agent.Recv(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, sent[1:], msgs)
+}
+
+func TestMemoryTransportHooks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scheduler, agent := NewMemoryTransportPair(8,
+		WithDropHook(func(msg *schedulepb.Message) bool {
+			return msg.Header.OwnerRevision.Revision == 1
+		}),
+		WithReorderHook(func(msgs []*schedulepb.Message) []*schedulepb.Message {
+			for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+				msgs[i], msgs[j] = msgs[j], msgs[i]
+			}
+			return msgs
+		}))
+
+	sent := newTestMessages(3)
+	require.Nil(t, scheduler.Send(ctx, sent))
+	// The batch passed to Send is not modified.
+	require.Equal(t, newTestMessages(3), sent)
+	msgs, err := agent.Recv(ctx)
+	require.Nil(t, err)
+	require.Equal(t, []*schedulepb.Message{sent[2], sent[0]}, msgs)
+}
+
+func TestMemoryTransportClose(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scheduler, agent := NewMemoryTransportPair(1)
+	sent := newTestMessages(2)
+	require.Nil(t, scheduler.Send(ctx, sent[:1]))
+
+	// A blocked Send returns once the transport is closed.
+	done := make(chan error, 1)
+	go func() { done <- scheduler.Send(ctx, sent[1:]) }()
+	require.Nil(t, agent.Close())
+	require.Nil(t, agent.Close())
+	require.True(t, cerror.ErrSchedulerTransportClosed.Equal(<-done))
+
+	// Buffered messages are still delivered, then Recv returns an error.
+	msgs, err := agent.Recv(ctx)
+	require.Nil(t, err)
+	require.Equal(t, sent[:1], msgs)
+	_, err = agent.Recv(ctx)
+	require.True(t, cerror.ErrSchedulerTransportClosed.Equal(err))
+	_, err = scheduler.Recv(ctx)
+	require.True(t, cerror.ErrSchedulerTransportClosed.Equal(err))
+	err = scheduler.Send(ctx, sent)
+	require.True(t, cerror.ErrSchedulerTransportClosed.Equal(err))
+}
+
+func TestMemoryTransportRecvCanceled(t *testing.T) {
+	t.Parallel()
+
+	scheduler, agent := NewMemoryTransportPair(8)
+	sent := newTestMessages(2)
+	require.Nil(t, scheduler.Send(context.Background(), sent))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	msgs, err := agent.Recv(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, msgs)
+
+	// Messages are not lost.
+	msgs, err = agent.Recv(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, sent, msgs)
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	messageSizeHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "scheduler",
+			Name:      "transport_message_size_bytes",
+			Help:      "Bucketed histogram of the size of sent schedule messages (bytes)",
+			Buckets:   prometheus.ExponentialBuckets(64, 4, 12), // 64B ~ 256MB
+		}, []string{"namespace", "changefeed", "role"})
+	sendDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "scheduler",
+			Name:      "transport_send_duration_seconds",
+			Help:      "Bucketed histogram of the duration of sending a batch of schedule messages (s)",
+			Buckets:   prometheus.ExponentialBuckets(0.0001 /* 0.1 ms */, 2, 18),
+		}, []string{"namespace", "changefeed", "role"})
+)
+
+// InitMetrics registers all metrics used in transport
+func InitMetrics(registry prometheus.Registerer) {
+	registry.MustRegister(messageSizeHistogram)
+	registry.MustRegister(sendDurationHistogram)
+}
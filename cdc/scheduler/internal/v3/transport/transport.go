@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/p2p"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
@@ -54,12 +55,16 @@ var _ Transport = (*p2pTransport)(nil)
 
 type p2pTransport struct {
 	changefeed    model.ChangeFeedID
+	role          Role
 	selfTopic     p2p.Topic
 	peerTopic     p2p.Topic
 	messageServer *p2p.MessageServer
 	messageRouter p2p.MessageRouter
 	errCh         <-chan error
 
+	metricMessageSize  prometheus.Observer
+	metricSendDuration prometheus.Observer
+
 	mu struct {
 		sync.Mutex
 		// FIXME it's an unbounded buffer, and may cause OOM!
@@ -85,10 +90,15 @@ func NewTransport(
 	selfTopic, peerTopic := p2pTopic(changefeed, role)
 	trans := &p2pTransport{
 		changefeed:    changefeed,
+		role:          role,
 		selfTopic:     selfTopic,
 		peerTopic:     peerTopic,
 		messageServer: server,
 		messageRouter: router,
+		metricMessageSize: messageSizeHistogram.
+			WithLabelValues(changefeed.Namespace, changefeed.ID, string(role)),
+		metricSendDuration: sendDurationHistogram.
+			WithLabelValues(changefeed.Namespace, changefeed.ID, string(role)),
 	}
 	var err error
 	trans.errCh, err = trans.messageServer.SyncAddHandler(
@@ -112,6 +122,10 @@ func NewTransport(
 func (t *p2pTransport) Send(
 	ctx context.Context, msgs []*schedulepb.Message,
 ) error {
+	start := time.Now()
+	defer func() {
+		t.metricSendDuration.Observe(time.Since(start).Seconds())
+	}()
 	for i := range msgs {
 		value := msgs[i]
 		to := value.To
@@ -124,6 +138,7 @@ func (t *p2pTransport) Send(
 			continue
 		}
 
+		t.metricMessageSize.Observe(float64(value.Size()))
 		_, err := client.TrySendMessage(ctx, t.peerTopic, value)
 		if err != nil {
 			if cerror.ErrPeerMessageSendTryAgain.Equal(err) {
@@ -167,5 +182,9 @@ func (t *p2pTransport) Close() error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	messageSizeHistogram.DeleteLabelValues(
+		t.changefeed.Namespace, t.changefeed.ID, string(t.role))
+	sendDurationHistogram.DeleteLabelValues(
+		t.changefeed.Namespace, t.changefeed.ID, string(t.role))
 	return nil
 }
@@ -95,6 +95,6 @@ func NewScheduler(
 }
 
 // InitMetrics registers all metrics used in scheduler
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	v3.InitMetrics(registry)
 }
@@ -371,7 +371,6 @@ func (m *RemoveTableResponse) GetCheckpoint() tablepb.Checkpoint {
 
 type DispatchTableResponse struct {
 	// Types that are valid to be assigned to Response:
-	//
 	//	*DispatchTableResponse_AddTable
 	//	*DispatchTableResponse_RemoveTable
 	Response isDispatchTableResponse_Response `protobuf_oneof:"response"`
@@ -569,6 +568,9 @@ type Heartbeat struct {
 	Spans        []tablepb.Span                                `protobuf:"bytes,3,rep,name=spans,proto3" json:"spans"`
 	CollectStats bool                                          `protobuf:"varint,4,opt,name=collect_stats,json=collectStats,proto3" json:"collect_stats,omitempty"`
 	Barrier      *Barrier                                      `protobuf:"bytes,5,opt,name=barrier,proto3" json:"barrier,omitempty"`
+	// The span to resume a paginated heartbeat response from, the statuses of
+	// spans before it are not reported. See HeartbeatResponse.overflow.
+	ResumeSpan *tablepb.Span `protobuf:"bytes,6,opt,name=resume_span,json=resumeSpan,proto3" json:"resume_span,omitempty"`
 }
 
 func (m *Heartbeat) Reset()         { *m = Heartbeat{} }
@@ -639,16 +641,82 @@ func (m *Heartbeat) GetBarrier() *Barrier {
 	return nil
 }
 
+func (m *Heartbeat) GetResumeSpan() *tablepb.Span {
+	if m != nil {
+		return m.ResumeSpan
+	}
+	return nil
+}
+
+// Affinity is the placement metadata of a capture.
+type Affinity struct {
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Rack string `protobuf:"bytes,2,opt,name=rack,proto3" json:"rack,omitempty"`
+}
+
+func (m *Affinity) Reset()         { *m = Affinity{} }
+func (m *Affinity) String() string { return proto.CompactTextString(m) }
+func (*Affinity) ProtoMessage()    {}
+func (*Affinity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86eeacbf6ca5b996, []int{9}
+}
+func (m *Affinity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Affinity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Affinity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Affinity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Affinity.Merge(m, src)
+}
+func (m *Affinity) XXX_Size() int {
+	return m.Size()
+}
+func (m *Affinity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Affinity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Affinity proto.InternalMessageInfo
+
+func (m *Affinity) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *Affinity) GetRack() string {
+	if m != nil {
+		return m.Rack
+	}
+	return ""
+}
+
 type HeartbeatResponse struct {
 	Tables   []tablepb.TableStatus                        `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables"`
 	Liveness github_com_pingcap_tiflow_cdc_model.Liveness `protobuf:"varint,2,opt,name=liveness,proto3,casttype=github.com/pingcap/tiflow/cdc/model.Liveness" json:"liveness,omitempty"`
+	Affinity Affinity                                     `protobuf:"bytes,3,opt,name=affinity,proto3" json:"affinity"`
+	// True if statuses of some tables are not reported because the response
+	// would exceed the size cap, the owner should heartbeat again with
+	// resume_span set to next_span to get the rest.
+	Overflow bool          `protobuf:"varint,4,opt,name=overflow,proto3" json:"overflow,omitempty"`
+	NextSpan *tablepb.Span `protobuf:"bytes,5,opt,name=next_span,json=nextSpan,proto3" json:"next_span,omitempty"`
 }
 
 func (m *HeartbeatResponse) Reset()         { *m = HeartbeatResponse{} }
 func (m *HeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*HeartbeatResponse) ProtoMessage()    {}
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86eeacbf6ca5b996, []int{9}
+	return fileDescriptor_86eeacbf6ca5b996, []int{10}
 }
 func (m *HeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,6 +759,27 @@ func (m *HeartbeatResponse) GetLiveness() github_com_pingcap_tiflow_cdc_model.Li
 	return 0
 }
 
+func (m *HeartbeatResponse) GetAffinity() Affinity {
+	if m != nil {
+		return m.Affinity
+	}
+	return Affinity{}
+}
+
+func (m *HeartbeatResponse) GetOverflow() bool {
+	if m != nil {
+		return m.Overflow
+	}
+	return false
+}
+
+func (m *HeartbeatResponse) GetNextSpan() *tablepb.Span {
+	if m != nil {
+		return m.NextSpan
+	}
+	return nil
+}
+
 type OwnerRevision struct {
 	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
 }
@@ -699,7 +788,7 @@ func (m *OwnerRevision) Reset()         { *m = OwnerRevision{} }
 func (m *OwnerRevision) String() string { return proto.CompactTextString(m) }
 func (*OwnerRevision) ProtoMessage()    {}
 func (*OwnerRevision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86eeacbf6ca5b996, []int{10}
+	return fileDescriptor_86eeacbf6ca5b996, []int{11}
 }
 func (m *OwnerRevision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,7 +832,7 @@ func (m *ProcessorEpoch) Reset()         { *m = ProcessorEpoch{} }
 func (m *ProcessorEpoch) String() string { return proto.CompactTextString(m) }
 func (*ProcessorEpoch) ProtoMessage()    {}
 func (*ProcessorEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86eeacbf6ca5b996, []int{11}
+	return fileDescriptor_86eeacbf6ca5b996, []int{12}
 }
 func (m *ProcessorEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +876,7 @@ func (m *ChangefeedEpoch) Reset()         { *m = ChangefeedEpoch{} }
 func (m *ChangefeedEpoch) String() string { return proto.CompactTextString(m) }
 func (*ChangefeedEpoch) ProtoMessage()    {}
 func (*ChangefeedEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86eeacbf6ca5b996, []int{12}
+	return fileDescriptor_86eeacbf6ca5b996, []int{13}
 }
 func (m *ChangefeedEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +927,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86eeacbf6ca5b996, []int{13}
+	return fileDescriptor_86eeacbf6ca5b996, []int{14}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1024,7 @@ func (m *Message_Header) Reset()         { *m = Message_Header{} }
 func (m *Message_Header) String() string { return proto.CompactTextString(m) }
 func (*Message_Header) ProtoMessage()    {}
 func (*Message_Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86eeacbf6ca5b996, []int{13, 0}
+	return fileDescriptor_86eeacbf6ca5b996, []int{14, 0}
 }
 func (m *Message_Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,6 +1092,7 @@ func init() {
 	proto.RegisterType((*TableBarrier)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.TableBarrier")
 	proto.RegisterType((*Barrier)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.Barrier")
 	proto.RegisterType((*Heartbeat)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.Heartbeat")
+	proto.RegisterType((*Affinity)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.Affinity")
 	proto.RegisterType((*HeartbeatResponse)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.HeartbeatResponse")
 	proto.RegisterType((*OwnerRevision)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.OwnerRevision")
 	proto.RegisterType((*ProcessorEpoch)(nil), "pingcap.tiflow.cdc.scheduler.schedulepb.ProcessorEpoch")
@@ -1016,81 +1106,86 @@ func init() {
 }
 
 var fileDescriptor_86eeacbf6ca5b996 = []byte{
-	// 1175 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0x8f, 0x93, 0xb4, 0x71, 0x4e, 0xda, 0x34, 0xbb, 0x74, 0xcc, 0x0a, 0x90, 0x04, 0x23, 0xb1,
-	0xb2, 0x81, 0xb3, 0x05, 0x18, 0x63, 0x03, 0xa4, 0xa5, 0x1d, 0x6a, 0xd1, 0xaa, 0x56, 0x6e, 0x0b,
-	0x08, 0x21, 0x05, 0xc7, 0xbe, 0x75, 0xac, 0x25, 0xbe, 0xc6, 0xd7, 0x6d, 0xd5, 0xaf, 0xd0, 0x27,
-	0xbe, 0x40, 0x3f, 0x00, 0x8f, 0x20, 0x21, 0xf1, 0x30, 0x89, 0xd7, 0x49, 0xbc, 0xf4, 0x11, 0x21,
-	0x14, 0x8d, 0xf6, 0x5b, 0x94, 0x17, 0xe4, 0x7b, 0xaf, 0x9d, 0xa4, 0x4d, 0xc1, 0x29, 0x03, 0x89,
-	0x37, 0xdf, 0x73, 0x7d, 0x7e, 0xe7, 0xcf, 0xfd, 0xfd, 0xce, 0xb5, 0xe1, 0x0d, 0x6a, 0x76, 0xb0,
-	0xb5, 0xd3, 0xc5, 0x7e, 0x3d, 0x7a, 0xf2, 0xda, 0xf5, 0xc0, 0x68, 0x77, 0x71, 0x2b, 0x32, 0x68,
-	0x9e, 0x4f, 0x02, 0x82, 0xae, 0x7b, 0x8e, 0x6b, 0x9b, 0x86, 0xa7, 0x05, 0xce, 0x76, 0x97, 0xec,
-	0x69, 0xa6, 0x65, 0x6a, 0xb1, 0xb7, 0x36, 0xf0, 0x2e, 0xcf, 0xdb, 0xc4, 0x26, 0xcc, 0xa7, 0x1e,
-	0x3e, 0x71, 0xf7, 0xf2, 0x2b, 0x9e, 0x4f, 0x4c, 0x4c, 0x29, 0xf1, 0x39, 0x7c, 0x14, 0x86, 0x6f,
-	0xab, 0xdf, 0xa6, 0x61, 0xee, 0x81, 0x65, 0x6d, 0x86, 0x26, 0x1d, 0x7f, 0xbd, 0x83, 0x69, 0x80,
-	0xb6, 0x40, 0xe6, 0x99, 0x38, 0x96, 0x22, 0xd5, 0xa4, 0x85, 0x4c, 0xf3, 0xde, 0x71, 0xbf, 0x9a,
-	0x63, 0xef, 0xac, 0x2c, 0x9d, 0xf6, 0xab, 0x37, 0x6d, 0x27, 0xe8, 0xec, 0xb4, 0x35, 0x93, 0xf4,
-	0xea, 0x22, 0xbb, 0x3a, 0xcf, 0xae, 0x6e, 0x5a, 0x66, 0xbd, 0x47, 0x2c, 0xdc, 0xd5, 0xc4, 0xeb,
-	0x7a, 0x8e, 0x61, 0xad, 0x58, 0x68, 0x09, 0xb2, 0xd4, 0x33, 0x5c, 0x25, 0x5b, 0x93, 0x16, 0x0a,
-	0x8d, 0x1b, 0xda, 0x98, 0xba, 0xe2, 0x5c, 0x35, 0x91, 0xab, 0xb6, 0xe1, 0x19, 0x6e, 0x33, 0xfb,
-	0xb4, 0x5f, 0x4d, 0xe9, 0xcc, 0x1b, 0xbd, 0x0a, 0x33, 0x0e, 0x6d, 0x51, 0x6c, 0x12, 0xd7, 0x32,
-	0xfc, 0x7d, 0x25, 0x5d, 0x93, 0x16, 0x64, 0xbd, 0xe0, 0xd0, 0x8d, 0xc8, 0x84, 0x3e, 0x05, 0x30,
-	0x3b, 0xd8, 0x7c, 0xec, 0x11, 0xc7, 0x0d, 0x94, 0x0c, 0x0b, 0x77, 0x2b, 0x59, 0xb8, 0xc5, 0xd8,
-	0x4f, 0x04, 0x1d, 0x42, 0x52, 0xbf, 0x93, 0x00, 0xe9, 0xb8, 0x47, 0x76, 0xf1, 0x7f, 0xd9, 0xae,
-	0xf4, 0x3f, 0x69, 0x97, 0xfa, 0x9b, 0x04, 0xf3, 0x4b, 0x0e, 0xf5, 0x8c, 0xc0, 0xec, 0x8c, 0x64,
-	0xfd, 0x19, 0xe4, 0x0d, 0xcb, 0x6a, 0x31, 0x47, 0x96, 0x76, 0xa1, 0x71, 0x57, 0x4b, 0x48, 0x35,
-	0xed, 0x0c, 0x63, 0x96, 0x53, 0xba, 0x6c, 0x08, 0x13, 0xfa, 0x0a, 0x66, 0x7c, 0xd6, 0x24, 0x81,
-	0xcd, 0xf3, 0xbf, 0x9f, 0x18, 0xfb, 0x7c, 0x87, 0x97, 0x53, 0x7a, 0xc1, 0x1f, 0x58, 0x9b, 0x79,
-	0xc8, 0xf9, 0x7c, 0x47, 0xfd, 0x41, 0x82, 0xd2, 0x20, 0x19, 0xea, 0x11, 0x97, 0x62, 0xb4, 0x02,
-	0xd3, 0x34, 0x30, 0x82, 0x1d, 0x2a, 0xea, 0xba, 0x9d, 0xac, 0x77, 0x0c, 0x64, 0x83, 0x39, 0xea,
-	0x02, 0xe0, 0x0c, 0x95, 0xd2, 0xcf, 0x8d, 0x4a, 0x3f, 0x4a, 0xf0, 0xc2, 0x48, 0xa1, 0xff, 0x9f,
-	0xd4, 0x9f, 0x49, 0x70, 0xf5, 0x0c, 0xa3, 0x44, 0xf2, 0x9f, 0x9f, 0xa7, 0xd4, 0xfb, 0x97, 0xa0,
-	0x14, 0x47, 0x1b, 0xe1, 0x94, 0x31, 0x96, 0x53, 0x1f, 0x5c, 0x8e, 0x53, 0x31, 0xfe, 0x08, 0xa9,
-	0x00, 0x64, 0x5f, 0x6c, 0xa9, 0x4f, 0x24, 0x98, 0xe1, 0x56, 0xc3, 0xf7, 0x1d, 0xec, 0xff, 0x5b,
-	0x12, 0xdf, 0x02, 0x68, 0xf3, 0x08, 0xad, 0x80, 0xb2, 0xa2, 0xb2, 0xcd, 0x3b, 0xa7, 0xfd, 0x6a,
-	0xe3, 0xaf, 0xd1, 0xce, 0x4d, 0x74, 0x6d, 0x93, 0xea, 0x79, 0x81, 0xb4, 0x49, 0xd5, 0x9f, 0x25,
-	0xc8, 0x45, 0x99, 0x7f, 0x09, 0x45, 0x9e, 0xb9, 0xd8, 0x0e, 0x89, 0x95, 0x59, 0x28, 0x34, 0xde,
-	0x4d, 0xdc, 0xbb, 0xe1, 0x46, 0xe8, 0xb3, 0xc1, 0xd0, 0x8a, 0xa2, 0x36, 0x5c, 0xb1, 0xbb, 0xa4,
-	0x6d, 0x74, 0x5b, 0xcf, 0xad, 0x8e, 0x39, 0x0e, 0xd8, 0x8c, 0xab, 0xf9, 0x29, 0x0d, 0xf9, 0x65,
-	0x6c, 0xf8, 0x41, 0x1b, 0x1b, 0x41, 0xc8, 0xb1, 0xe8, 0x24, 0x78, 0x29, 0x99, 0xe6, 0xfd, 0xe3,
-	0x7e, 0x55, 0x16, 0xbd, 0xa5, 0x93, 0x9e, 0x85, 0x2c, 0xce, 0x82, 0xa2, 0x2a, 0x14, 0xc2, 0x8b,
-	0x25, 0x20, 0x5e, 0xe8, 0x24, 0xee, 0x15, 0x70, 0xe8, 0x86, 0xb0, 0xa0, 0x8f, 0x61, 0x2a, 0x1c,
-	0xa9, 0x54, 0xc9, 0xb0, 0x0e, 0x4e, 0x3e, 0x91, 0xb9, 0x3b, 0x7a, 0x0d, 0x66, 0x4d, 0xd2, 0xed,
-	0x62, 0x33, 0x68, 0x85, 0x52, 0xa5, 0xec, 0x42, 0x94, 0xf5, 0x19, 0x61, 0x0c, 0x65, 0x4c, 0xd1,
-	0x27, 0x90, 0x13, 0x2d, 0x55, 0xa6, 0x2e, 0x96, 0xee, 0xd8, 0x03, 0x8b, 0xce, 0x2a, 0x02, 0x50,
-	0xbf, 0x97, 0xe0, 0x4a, 0xdc, 0xc1, 0x58, 0xad, 0x6b, 0x30, 0xcd, 0x72, 0x8c, 0x18, 0x31, 0xf9,
-	0xa8, 0x11, 0x65, 0x09, 0x18, 0xf4, 0x08, 0xe4, 0xae, 0xb3, 0x8b, 0x5d, 0x4c, 0x39, 0x07, 0xa6,
-	0x9a, 0xb7, 0x4e, 0xfb, 0xd5, 0x37, 0x93, 0x9c, 0xc6, 0x23, 0xe1, 0xa7, 0xc7, 0x08, 0xea, 0x4d,
-	0x98, 0x5d, 0xdb, 0x73, 0xb1, 0xaf, 0xe3, 0x5d, 0x87, 0x3a, 0xc4, 0x45, 0xe5, 0x50, 0xa0, 0xfc,
-	0x99, 0x6b, 0x50, 0x8f, 0xd7, 0xea, 0xeb, 0x50, 0x5c, 0x8f, 0x32, 0x7d, 0xe8, 0x11, 0xb3, 0x83,
-	0xe6, 0x61, 0x0a, 0x87, 0x0f, 0xec, 0xd5, 0xbc, 0xce, 0x17, 0xea, 0x75, 0x98, 0x5b, 0xec, 0x18,
-	0xae, 0x8d, 0xb7, 0x31, 0xb6, 0xc6, 0xbc, 0x98, 0x8d, 0x5e, 0x7c, 0x22, 0x43, 0x6e, 0x15, 0x53,
-	0x6a, 0xd8, 0xac, 0x51, 0x1d, 0x6c, 0x58, 0xd8, 0x17, 0x33, 0xed, 0xbd, 0xc4, 0x27, 0x21, 0x10,
-	0xb4, 0x65, 0xe6, 0xae, 0x0b, 0x18, 0xb4, 0x06, 0x72, 0x8f, 0xda, 0xad, 0x60, 0xdf, 0xe3, 0x93,
-	0xac, 0xd8, 0x78, 0x67, 0x52, 0xc8, 0xcd, 0x7d, 0x0f, 0xeb, 0xb9, 0x1e, 0xb5, 0xc3, 0x07, 0xf4,
-	0x10, 0xb2, 0xdb, 0x3e, 0xe9, 0xb1, 0x4f, 0x9d, 0x7c, 0xf3, 0xf6, 0x69, 0xbf, 0xfa, 0x56, 0x92,
-	0xae, 0x2f, 0x1a, 0x5e, 0xb0, 0xe3, 0x87, 0x2a, 0x60, 0xee, 0xe8, 0x01, 0xa4, 0x03, 0xc2, 0xd8,
-	0x78, 0x29, 0x90, 0x74, 0x40, 0x10, 0x85, 0x17, 0x2d, 0x71, 0x37, 0xf0, 0x51, 0xdd, 0x12, 0x37,
-	0xb5, 0x60, 0xf1, 0x87, 0x89, 0x0b, 0x1d, 0xf7, 0xd1, 0xa2, 0xcf, 0x5b, 0xe3, 0x3e, 0x65, 0x76,
-	0xe1, 0xda, 0xb9, 0xa0, 0x9c, 0xe4, 0xca, 0x34, 0x8b, 0xfa, 0xd1, 0x65, 0xa3, 0x72, 0x14, 0xfd,
-	0xaa, 0x35, 0xf6, 0xbe, 0x5b, 0x87, 0x7c, 0x27, 0x92, 0x95, 0x92, 0x63, 0x91, 0x1a, 0x89, 0x23,
-	0x0d, 0x04, 0x39, 0x00, 0x41, 0x0e, 0xa0, 0x78, 0x31, 0x28, 0x42, 0x66, 0xd0, 0xf7, 0x2e, 0x01,
-	0x1d, 0x15, 0x70, 0xa5, 0x73, 0xd6, 0x54, 0xfe, 0x35, 0x0d, 0xd3, 0x9c, 0x97, 0x48, 0x81, 0xdc,
-	0x2e, 0xf6, 0x63, 0x61, 0xe5, 0xf5, 0x68, 0x89, 0x4c, 0x28, 0x92, 0x50, 0x84, 0xad, 0x58, 0x79,
-	0xfc, 0xe6, 0xbd, 0x93, 0x38, 0x97, 0x11, 0x0d, 0x8b, 0x81, 0x31, 0x4b, 0x46, 0x84, 0xbd, 0x0d,
-	0x73, 0xf1, 0x98, 0x69, 0x71, 0x2d, 0x66, 0x26, 0x14, 0xda, 0xa8, 0xf8, 0x45, 0x98, 0xa2, 0x37,
-	0x3a, 0x12, 0x1c, 0x28, 0x99, 0xb1, 0xf8, 0x45, 0xa0, 0xec, 0x84, 0x1f, 0xbe, 0x67, 0xa6, 0x87,
-	0x88, 0x34, 0x67, 0x8e, 0x9a, 0x6f, 0xfc, 0x21, 0x41, 0x61, 0x48, 0xa9, 0xa8, 0x02, 0xb0, 0x4a,
-	0xed, 0x2d, 0xf7, 0xb1, 0x4b, 0xf6, 0xdc, 0x52, 0xaa, 0x5c, 0x3c, 0x38, 0xac, 0x0d, 0x59, 0xd0,
-	0x5d, 0xb8, 0xb6, 0x4a, 0xed, 0x71, 0x94, 0x2f, 0x49, 0xe5, 0x97, 0x0e, 0x0e, 0x6b, 0x17, 0x6d,
-	0xa3, 0x7b, 0xa0, 0x9c, 0xdf, 0xe2, 0x47, 0x5c, 0x4a, 0x97, 0x5f, 0x3e, 0x38, 0xac, 0x5d, 0xb8,
-	0x8f, 0x54, 0x98, 0x59, 0xa5, 0x76, 0xcc, 0x96, 0x52, 0xa6, 0x5c, 0x3a, 0x38, 0xac, 0x8d, 0xd8,
-	0x50, 0x03, 0xe6, 0x87, 0xd7, 0x31, 0x76, 0xb6, 0xac, 0x1c, 0x1c, 0xd6, 0xc6, 0xee, 0x35, 0xd7,
-	0x8f, 0x7e, 0xaf, 0xa4, 0x9e, 0x1e, 0x57, 0xa4, 0xa3, 0xe3, 0x8a, 0xf4, 0xec, 0xb8, 0x22, 0x7d,
-	0x73, 0x52, 0x49, 0x1d, 0x9d, 0x54, 0x52, 0xbf, 0x9c, 0x54, 0x52, 0x5f, 0xfc, 0xcd, 0x47, 0xc1,
-	0xb8, 0x1f, 0xe3, 0xf6, 0x34, 0xfb, 0x59, 0x7d, 0xfb, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba,
-	0x96, 0xe2, 0x0e, 0x37, 0x0f, 0x00, 0x00,
+	// 1264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x4e, 0xbc, 0x7e, 0x4e, 0x1c, 0x77, 0x48, 0xa9, 0x65, 0xc0, 0x36, 0x46, 0xa2,
+	0xa1, 0x85, 0x75, 0x6b, 0xa0, 0x94, 0x16, 0x90, 0xea, 0xb6, 0x90, 0x42, 0xa3, 0x44, 0x9b, 0x04,
+	0x10, 0x42, 0x32, 0xeb, 0xdd, 0xf1, 0x7a, 0x15, 0x7b, 0x67, 0xd9, 0x59, 0x3b, 0x84, 0x8f, 0x10,
+	0x71, 0xe0, 0x0b, 0xe4, 0x03, 0x70, 0xe4, 0x80, 0xc4, 0xa1, 0x1f, 0xa0, 0x12, 0x97, 0x1c, 0x11,
+	0x42, 0x56, 0x49, 0xae, 0x7c, 0x82, 0x70, 0x41, 0x3b, 0x33, 0xbb, 0xb6, 0x13, 0x07, 0xd6, 0xa6,
+	0x20, 0x71, 0x9b, 0x79, 0xb3, 0xef, 0xf7, 0xfe, 0xfd, 0xde, 0x9b, 0xb1, 0xe1, 0x15, 0xaa, 0xb7,
+	0xb1, 0xd1, 0xeb, 0x60, 0xb7, 0x1a, 0xac, 0x9c, 0x66, 0xd5, 0xd3, 0x9a, 0x1d, 0xdc, 0x08, 0x04,
+	0x8a, 0xe3, 0x12, 0x8f, 0xa0, 0xcb, 0x8e, 0x65, 0x9b, 0xba, 0xe6, 0x28, 0x9e, 0xd5, 0xea, 0x90,
+	0x5d, 0x45, 0x37, 0x74, 0x25, 0xd4, 0x56, 0x86, 0xda, 0x85, 0x65, 0x93, 0x98, 0x84, 0xe9, 0x54,
+	0xfd, 0x15, 0x57, 0x2f, 0xbc, 0xe0, 0xb8, 0x44, 0xc7, 0x94, 0x12, 0x97, 0xc3, 0x07, 0x66, 0xf8,
+	0x71, 0xe5, 0xbb, 0x38, 0x2c, 0xdd, 0x31, 0x8c, 0x2d, 0x5f, 0xa4, 0xe2, 0x2f, 0x7b, 0x98, 0x7a,
+	0x68, 0x1b, 0x64, 0xee, 0x89, 0x65, 0xe4, 0xa5, 0xb2, 0xb4, 0x92, 0xa8, 0xdf, 0x3a, 0x1a, 0x94,
+	0x52, 0xec, 0x9b, 0x07, 0xf7, 0x4e, 0x06, 0xa5, 0xab, 0xa6, 0xe5, 0xb5, 0x7b, 0x4d, 0x45, 0x27,
+	0xdd, 0xaa, 0xf0, 0xae, 0xca, 0xbd, 0xab, 0xea, 0x86, 0x5e, 0xed, 0x12, 0x03, 0x77, 0x14, 0xf1,
+	0xb9, 0x9a, 0x62, 0x58, 0x0f, 0x0c, 0x74, 0x0f, 0x92, 0xd4, 0xd1, 0xec, 0x7c, 0xb2, 0x2c, 0xad,
+	0x64, 0x6a, 0x57, 0x94, 0x09, 0x71, 0x85, 0xbe, 0x2a, 0xc2, 0x57, 0x65, 0xd3, 0xd1, 0xec, 0x7a,
+	0xf2, 0xf1, 0xa0, 0x14, 0x53, 0x99, 0x36, 0x7a, 0x11, 0x16, 0x2c, 0xda, 0xa0, 0x58, 0x27, 0xb6,
+	0xa1, 0xb9, 0x7b, 0xf9, 0x78, 0x59, 0x5a, 0x91, 0xd5, 0x8c, 0x45, 0x37, 0x03, 0x11, 0xfa, 0x18,
+	0x40, 0x6f, 0x63, 0x7d, 0xc7, 0x21, 0x96, 0xed, 0xe5, 0x13, 0xcc, 0xdc, 0xb5, 0x68, 0xe6, 0xee,
+	0x86, 0x7a, 0xc2, 0xe8, 0x08, 0x52, 0xe5, 0x7b, 0x09, 0x90, 0x8a, 0xbb, 0xa4, 0x8f, 0xff, 0xcb,
+	0x74, 0xc5, 0xff, 0x49, 0xba, 0x2a, 0xbf, 0x4a, 0xb0, 0x7c, 0xcf, 0xa2, 0x8e, 0xe6, 0xe9, 0xed,
+	0x31, 0xaf, 0x3f, 0x81, 0xb4, 0x66, 0x18, 0x0d, 0xa6, 0xc8, 0xdc, 0xce, 0xd4, 0x6e, 0x2a, 0x11,
+	0xa9, 0xa6, 0x9c, 0x62, 0xcc, 0x6a, 0x4c, 0x95, 0x35, 0x21, 0x42, 0x5f, 0xc0, 0x82, 0xcb, 0x92,
+	0x24, 0xb0, 0xb9, 0xff, 0xb7, 0x23, 0x63, 0x9f, 0xcd, 0xf0, 0x6a, 0x4c, 0xcd, 0xb8, 0x43, 0x69,
+	0x3d, 0x0d, 0x29, 0x97, 0x9f, 0x54, 0x7e, 0x90, 0x20, 0x37, 0x74, 0x86, 0x3a, 0xc4, 0xa6, 0x18,
+	0x3d, 0x80, 0x79, 0xea, 0x69, 0x5e, 0x8f, 0x8a, 0xb8, 0xae, 0x47, 0xcb, 0x1d, 0x03, 0xd9, 0x64,
+	0x8a, 0xaa, 0x00, 0x38, 0x45, 0xa5, 0xf8, 0x53, 0xa3, 0xd2, 0x8f, 0x12, 0x3c, 0x33, 0x16, 0xe8,
+	0xff, 0xc7, 0xf5, 0x27, 0x12, 0x5c, 0x3c, 0xc5, 0x28, 0xe1, 0xfc, 0xa7, 0x67, 0x29, 0xf5, 0xf6,
+	0x0c, 0x94, 0xe2, 0x68, 0x63, 0x9c, 0xd2, 0x26, 0x72, 0xea, 0x9d, 0xd9, 0x38, 0x15, 0xe2, 0x8f,
+	0x91, 0x0a, 0x40, 0x76, 0xc5, 0x51, 0xe5, 0x91, 0x04, 0x0b, 0x5c, 0xaa, 0xb9, 0xae, 0x85, 0xdd,
+	0x7f, 0xab, 0xc5, 0xb7, 0x01, 0x9a, 0xdc, 0x42, 0xc3, 0xa3, 0x2c, 0xa8, 0x64, 0xfd, 0xc6, 0xc9,
+	0xa0, 0x54, 0xfb, 0x6b, 0xb4, 0x33, 0x13, 0x5d, 0xd9, 0xa2, 0x6a, 0x5a, 0x20, 0x6d, 0xd1, 0xca,
+	0x4f, 0x12, 0xa4, 0x02, 0xcf, 0x3f, 0x87, 0x2c, 0xf7, 0x5c, 0x1c, 0xfb, 0xc4, 0x4a, 0xac, 0x64,
+	0x6a, 0x6f, 0x46, 0xce, 0xdd, 0x68, 0x22, 0xd4, 0x45, 0x6f, 0x64, 0x47, 0x51, 0x13, 0x2e, 0x98,
+	0x1d, 0xd2, 0xd4, 0x3a, 0x8d, 0xa7, 0x16, 0xc7, 0x12, 0x07, 0xac, 0x87, 0xd1, 0x7c, 0x93, 0x80,
+	0xf4, 0x2a, 0xd6, 0x5c, 0xaf, 0x89, 0x35, 0xcf, 0xe7, 0x58, 0x50, 0x09, 0x1e, 0x4a, 0xa2, 0x7e,
+	0xfb, 0x68, 0x50, 0x92, 0x45, 0x6e, 0xe9, 0xb4, 0xb5, 0x90, 0x45, 0x2d, 0x28, 0x2a, 0x41, 0xc6,
+	0xbf, 0x58, 0x3c, 0xe2, 0xf8, 0x4a, 0xe2, 0x5e, 0x01, 0x8b, 0x6e, 0x0a, 0x09, 0x7a, 0x1f, 0xe6,
+	0xfc, 0x91, 0x4a, 0xf3, 0x89, 0x72, 0x62, 0xa6, 0x89, 0xcc, 0xd5, 0xd1, 0x4b, 0xb0, 0xa8, 0x93,
+	0x4e, 0x07, 0xeb, 0x5e, 0xc3, 0x6f, 0x55, 0xca, 0x2e, 0x44, 0x59, 0x5d, 0x10, 0x42, 0xbf, 0x8d,
+	0x29, 0xfa, 0x10, 0x52, 0x22, 0xa5, 0xf9, 0xb9, 0xf3, 0x5b, 0x77, 0x62, 0xc1, 0x82, 0x5a, 0x05,
+	0x00, 0xe8, 0x23, 0xc8, 0xb8, 0x98, 0xf6, 0xba, 0xb8, 0xc1, 0x2e, 0x94, 0xf9, 0x69, 0x2f, 0x14,
+	0x15, 0xb8, 0xba, 0xbf, 0xae, 0xd4, 0x40, 0xbe, 0xd3, 0x6a, 0x59, 0xb6, 0xe5, 0xed, 0x21, 0x04,
+	0xc9, 0xaf, 0x89, 0xcd, 0x7b, 0x3d, 0xad, 0xb2, 0xb5, 0x2f, 0x73, 0x35, 0x7d, 0x87, 0xe5, 0x2f,
+	0xad, 0xb2, 0x75, 0xe5, 0xf7, 0x38, 0x5c, 0x08, 0x4b, 0x18, 0x8e, 0x8b, 0x75, 0x98, 0x67, 0x56,
+	0x02, 0x4a, 0x4e, 0x3f, 0xeb, 0x44, 0x5e, 0x05, 0x0c, 0x7a, 0x08, 0x72, 0xc7, 0xea, 0x63, 0x1b,
+	0x53, 0x4e, 0xc2, 0xb9, 0xfa, 0xb5, 0x93, 0x41, 0xe9, 0xd5, 0x28, 0x74, 0x78, 0x28, 0xf4, 0xd4,
+	0x10, 0x01, 0x6d, 0x82, 0xac, 0x89, 0x40, 0xc5, 0x1b, 0xe2, 0x7a, 0xf4, 0x61, 0x26, 0x14, 0x85,
+	0x83, 0x21, 0x10, 0x2a, 0x80, 0x4c, 0xfa, 0xd8, 0xf5, 0xb5, 0x45, 0xd9, 0xc3, 0x3d, 0xfa, 0x00,
+	0xd2, 0x36, 0xfe, 0xca, 0xe3, 0x45, 0x9a, 0x9b, 0xba, 0x48, 0xb2, 0xaf, 0xcc, 0x4a, 0x74, 0x15,
+	0x16, 0xd7, 0x77, 0x6d, 0xec, 0xaa, 0xb8, 0x6f, 0x51, 0x8b, 0xd8, 0xbe, 0x55, 0x57, 0xac, 0xf9,
+	0xf8, 0x52, 0xc3, 0x7d, 0xe5, 0x65, 0xc8, 0x6e, 0x04, 0x80, 0xf7, 0x1d, 0xa2, 0xb7, 0xd1, 0x32,
+	0xcc, 0x61, 0x7f, 0x21, 0xca, 0xca, 0x37, 0x95, 0xcb, 0xb0, 0x74, 0xb7, 0xad, 0xd9, 0x26, 0x6e,
+	0x61, 0x6c, 0x4c, 0xf8, 0x30, 0x19, 0x7c, 0xf8, 0x48, 0x86, 0xd4, 0x1a, 0xa6, 0x54, 0x33, 0x59,
+	0x89, 0xdb, 0x58, 0x33, 0xb0, 0x2b, 0xae, 0x83, 0xb7, 0x22, 0x67, 0x50, 0x20, 0x28, 0xab, 0x4c,
+	0x5d, 0x15, 0x30, 0x68, 0x1d, 0xe4, 0x2e, 0x35, 0x1b, 0xde, 0x9e, 0xc3, 0x2f, 0x81, 0x6c, 0xed,
+	0x8d, 0x69, 0x21, 0xb7, 0xf6, 0x1c, 0xac, 0xa6, 0xba, 0xd4, 0xf4, 0x17, 0xe8, 0x3e, 0x24, 0x5b,
+	0x2e, 0xe9, 0xb2, 0x0a, 0xa7, 0xeb, 0xd7, 0x4f, 0x06, 0xa5, 0xd7, 0xa2, 0xf0, 0xe5, 0xae, 0xe6,
+	0x78, 0x3d, 0xd7, 0x1f, 0x20, 0x4c, 0x1d, 0xdd, 0x81, 0xb8, 0x47, 0xf2, 0xc9, 0x59, 0x41, 0xe2,
+	0x1e, 0x41, 0x14, 0x9e, 0x35, 0xc4, 0xb5, 0xca, 0x6f, 0xb9, 0x86, 0x78, 0xe4, 0x08, 0x2e, 0xbc,
+	0x1b, 0x39, 0xd0, 0x49, 0xef, 0x3d, 0x75, 0xd9, 0x98, 0x20, 0x45, 0x7d, 0xb8, 0x74, 0xc6, 0x28,
+	0x6f, 0x4f, 0x31, 0x26, 0xde, 0x9b, 0xd5, 0x2a, 0x47, 0x51, 0x2f, 0x1a, 0x93, 0xc4, 0x68, 0x03,
+	0xd2, 0xed, 0x60, 0x20, 0xe4, 0x53, 0xcc, 0x52, 0x2d, 0xb2, 0xa5, 0xe1, 0x28, 0x19, 0x82, 0x20,
+	0x0b, 0x50, 0xb8, 0x19, 0x06, 0x21, 0x33, 0xe8, 0x5b, 0x33, 0x40, 0x07, 0x01, 0x5c, 0x68, 0x9f,
+	0x16, 0x15, 0x7e, 0x89, 0xc3, 0x3c, 0xe7, 0x25, 0xca, 0x43, 0xaa, 0x8f, 0xdd, 0xb0, 0xb1, 0xd2,
+	0x6a, 0xb0, 0x45, 0x3a, 0x64, 0x89, 0xdf, 0x84, 0x8d, 0xb0, 0xf3, 0xf8, 0xa3, 0xe5, 0x46, 0x64,
+	0x5f, 0xc6, 0x7a, 0x58, 0x4c, 0x92, 0x45, 0x32, 0xd6, 0xd8, 0x2d, 0x58, 0x0a, 0xa7, 0x41, 0x83,
+	0xf7, 0x62, 0x62, 0xca, 0x46, 0x1b, 0x6f, 0x7e, 0x61, 0x26, 0xeb, 0x8c, 0x49, 0x91, 0x05, 0x39,
+	0x3d, 0x6c, 0x7e, 0x61, 0x28, 0x39, 0xe5, 0x6f, 0x86, 0x53, 0xd3, 0x43, 0x58, 0x5a, 0xd2, 0xc7,
+	0xc5, 0x57, 0xfe, 0x90, 0x20, 0x33, 0xd2, 0xa9, 0xa8, 0x08, 0xb0, 0x46, 0xcd, 0x6d, 0x7b, 0xc7,
+	0x26, 0xbb, 0x76, 0x2e, 0x56, 0xc8, 0xee, 0x1f, 0x94, 0x47, 0x24, 0xe8, 0x26, 0x5c, 0x5a, 0xa3,
+	0xe6, 0x24, 0xca, 0xe7, 0xa4, 0xc2, 0x73, 0xfb, 0x07, 0xe5, 0xf3, 0x8e, 0xd1, 0x2d, 0xc8, 0x9f,
+	0x3d, 0xe2, 0x25, 0xce, 0xc5, 0x0b, 0xcf, 0xef, 0x1f, 0x94, 0xcf, 0x3d, 0x47, 0x15, 0x58, 0x58,
+	0xa3, 0x66, 0xc8, 0x96, 0x5c, 0xa2, 0x90, 0xdb, 0x3f, 0x28, 0x8f, 0xc9, 0x50, 0x0d, 0x96, 0x47,
+	0xf7, 0x21, 0x76, 0xb2, 0x90, 0xdf, 0x3f, 0x28, 0x4f, 0x3c, 0xab, 0x6f, 0x1c, 0xfe, 0x56, 0x8c,
+	0x3d, 0x3e, 0x2a, 0x4a, 0x87, 0x47, 0x45, 0xe9, 0xc9, 0x51, 0x51, 0xfa, 0xf6, 0xb8, 0x18, 0x3b,
+	0x3c, 0x2e, 0xc6, 0x7e, 0x3e, 0x2e, 0xc6, 0x3e, 0xfb, 0x9b, 0xf7, 0xd4, 0xa4, 0xff, 0x14, 0x9a,
+	0xf3, 0xec, 0x77, 0xfe, 0xeb, 0x7f, 0x0e, 0x00, 0xe6, 0x87, 0x14, 0xd2, 0x72, 0x10, 0x00, 0x00,
 }
 
 func (m *AddTableRequest) Marshal() (dAtA []byte, err error) {
@@ -1522,6 +1617,18 @@ func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResumeSpan != nil {
+		{
+			size, err := m.ResumeSpan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTableSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Barrier != nil {
 		{
 			size, err := m.Barrier.MarshalToSizedBuffer(dAtA[:i])
@@ -1569,21 +1676,58 @@ func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.TableIDs) > 0 {
-		dAtA14 := make([]byte, len(m.TableIDs)*10)
-		var j13 int
+		dAtA15 := make([]byte, len(m.TableIDs)*10)
+		var j14 int
 		for _, num1 := range m.TableIDs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j14++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTableSchedule(dAtA, i, uint64(j13))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTableSchedule(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Affinity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Affinity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Affinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rack) > 0 {
+		i -= len(m.Rack)
+		copy(dAtA[i:], m.Rack)
+		i = encodeVarintTableSchedule(dAtA, i, uint64(len(m.Rack)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintTableSchedule(dAtA, i, uint64(len(m.Zone)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1610,6 +1754,38 @@ func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextSpan != nil {
+		{
+			size, err := m.NextSpan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTableSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Overflow {
+		i--
+		if m.Overflow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTableSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Liveness != 0 {
 		i = encodeVarintTableSchedule(dAtA, i, uint64(m.Liveness))
 		i--
@@ -2088,6 +2264,27 @@ func (m *Heartbeat) Size() (n int) {
 		l = m.Barrier.Size()
 		n += 1 + l + sovTableSchedule(uint64(l))
 	}
+	if m.ResumeSpan != nil {
+		l = m.ResumeSpan.Size()
+		n += 1 + l + sovTableSchedule(uint64(l))
+	}
+	return n
+}
+
+func (m *Affinity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovTableSchedule(uint64(l))
+	}
+	l = len(m.Rack)
+	if l > 0 {
+		n += 1 + l + sovTableSchedule(uint64(l))
+	}
 	return n
 }
 
@@ -2106,6 +2303,15 @@ func (m *HeartbeatResponse) Size() (n int) {
 	if m.Liveness != 0 {
 		n += 1 + sovTableSchedule(uint64(m.Liveness))
 	}
+	l = m.Affinity.Size()
+	n += 1 + l + sovTableSchedule(uint64(l))
+	if m.Overflow {
+		n += 2
+	}
+	if m.NextSpan != nil {
+		l = m.NextSpan.Size()
+		n += 1 + l + sovTableSchedule(uint64(l))
+	}
 	return n
 }
 
@@ -3352,6 +3558,156 @@ func (m *Heartbeat) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeSpan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeSpan == nil {
+				m.ResumeSpan = &tablepb.Span{}
+			}
+			if err := m.ResumeSpan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTableSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Affinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTableSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Affinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Affinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rack = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -3455,6 +3811,95 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overflow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overflow = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSpan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextSpan == nil {
+				m.NextSpan = &tablepb.Span{}
+			}
+			if err := m.NextSpan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
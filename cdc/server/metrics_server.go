@@ -62,7 +62,7 @@ func RecordGoRuntimeSettings() {
 }
 
 // initServerMetrics registers all metrics used in processor
-func initServerMetrics(registry *prometheus.Registry) {
+func initServerMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(etcdHealthCheckDuration)
 	registry.MustRegister(goGC)
 	registry.MustRegister(goMaxProcs)
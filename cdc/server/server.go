@@ -31,7 +31,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/factory"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/subscription"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/etcd"
@@ -42,6 +44,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/tcpserver"
 	"github.com/pingcap/tiflow/pkg/util"
 	p2pProto "github.com/pingcap/tiflow/proto/p2p"
+	subscriptionProto "github.com/pingcap/tiflow/proto/subscription"
 	pd "github.com/tikv/pd/client"
 	"go.etcd.io/etcd/client/pkg/v3/logutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -189,6 +192,11 @@ func (s *server) prepare(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 
+	if maxSeries := config.GetGlobalServerConfig().MaxMetricSeries; maxSeries > 0 {
+		registry.SetMaxSeries(maxSeries)
+		log.Info("set the max number of series of a metric", zap.Int("maxSeries", maxSeries))
+	}
+
 	s.capture = capture.NewCapture(
 		s.pdEndpoints, cdcEtcdClient, s.grpcService, s.sortEngineFactory)
 
@@ -210,6 +218,13 @@ func (s *server) setMemoryLimit() error {
 			zap.String("memory", humanize.IBytes(goMemLimit)),
 		)
 	}
+	if conf.MemoryQuota > 0 {
+		memquota.InitServerMemQuota(conf.MemoryQuota)
+		log.Info("set memory quota of all changefeeds",
+			zap.Uint64("bytes", conf.MemoryQuota),
+			zap.String("memory", humanize.IBytes(conf.MemoryQuota)),
+		)
+	}
 	return nil
 }
 
@@ -361,6 +376,7 @@ func (s *server) run(ctx context.Context) (err error) {
 
 	grpcServer := grpc.NewServer(s.grpcService.ServerOptions()...)
 	p2pProto.RegisterCDCPeerToPeerServer(grpcServer, s.grpcService)
+	subscriptionProto.RegisterChangefeedSubscriptionServer(grpcServer, subscription.NewServer())
 
 	wg.Go(func() error {
 		return grpcServer.Serve(s.tcpServer.GrpcListener())
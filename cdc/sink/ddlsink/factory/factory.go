@@ -24,11 +24,13 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mysql"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/oracle"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
 	kafkav2 "github.com/pingcap/tiflow/pkg/sink/kafka/v2"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 )
 
 // New creates a new ddlsink.Sink by scheme.
@@ -50,10 +52,18 @@ func New(
 		}
 		return mq.NewKafkaDDLSink(ctx, sinkURI, cfg,
 			factoryCreator, ddlproducer.NewKafkaDDLProducer)
+	case sink.PulsarScheme, sink.PulsarSSLScheme:
+		return mq.NewPulsarDDLSink(ctx, sinkURI, cfg,
+			pulsar.NewCreatorFactory, ddlproducer.NewPulsarDDLProducer)
 	case sink.BlackHoleScheme:
 		return blackhole.NewDDLSink(), nil
+	case sink.SubscriptionScheme:
+		// DDL events are not delivered to the consumers of the subscription sink.
+		return blackhole.NewDDLSink(), nil
 	case sink.MySQLSSLScheme, sink.MySQLScheme, sink.TiDBScheme, sink.TiDBSSLScheme:
 		return mysql.NewDDLSink(ctx, sinkURI, cfg)
+	case sink.OracleScheme:
+		return oracle.NewDDLSink(ctx, sinkURI)
 	case sink.S3Scheme, sink.FileScheme, sink.GCSScheme, sink.GSScheme, sink.AzblobScheme, sink.AzureScheme, sink.CloudStorageNoopScheme:
 		return cloudstorage.NewDDLSink(ctx, sinkURI)
 	default:
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
 )
 
 // DDLProducer is the interface for DDL message producer.
@@ -36,3 +37,6 @@ type DDLProducer interface {
 
 // Factory is a function to create a producer.
 type Factory func(ctx context.Context, factory kafka.Factory) (DDLProducer, error)
+
+// PulsarFactory is a function to create a pulsar producer.
+type PulsarFactory func(ctx context.Context, producers *pulsarsink.Producers) (DDLProducer, error)
@@ -0,0 +1,124 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddlproducer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+// Assert DDLEventSink implementation
+var _ DDLProducer = (*pulsarDDLProducer)(nil)
+
+// pulsarDDLProducer is used to send messages to pulsar synchronously.
+type pulsarDDLProducer struct {
+	// id indicates this sink belongs to which processor(changefeed).
+	id model.ChangeFeedID
+	// producers holds a pulsar producer for each partition.
+	producers *pulsarsink.Producers
+	// closedMu is used to protect `closed`.
+	// We need to ensure that closed producers are never written to.
+	closedMu sync.RWMutex
+	// closed is used to indicate whether the producer is closed.
+	// We also use it to guard against double closes.
+	closed bool
+}
+
+// NewPulsarDDLProducer creates a new pulsar producer for replicating DDL.
+func NewPulsarDDLProducer(
+	ctx context.Context, producers *pulsarsink.Producers,
+) (DDLProducer, error) {
+	return &pulsarDDLProducer{
+		id:        contextutil.ChangefeedIDFromCtx(ctx),
+		producers: producers,
+		closed:    false,
+	}, nil
+}
+
+func (p *pulsarDDLProducer) SyncBroadcastMessage(ctx context.Context, topic string,
+	totalPartitionsNum int32, message *common.Message,
+) error {
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	for i := int32(0); i < totalPartitionsNum; i++ {
+		if err := p.send(ctx, topic, i, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pulsarDDLProducer) SyncSendMessage(ctx context.Context, topic string,
+	partitionNum int32, message *common.Message,
+) error {
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	return p.send(ctx, topic, partitionNum, message)
+}
+
+func (p *pulsarDDLProducer) send(ctx context.Context, topic string,
+	partition int32, message *common.Message,
+) error {
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	default:
+	}
+
+	producer, err := p.producers.GetProducer(topic, partition)
+	if err != nil {
+		return err
+	}
+	_, err = producer.Send(ctx, &pulsar.ProducerMessage{
+		Key:     string(message.Key),
+		Payload: message.Value,
+	})
+	return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+}
+
+func (p *pulsarDDLProducer) Close() {
+	// We have to hold the lock to prevent write to closed producer.
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+	// If the producer was already closed, we should skip the close operation.
+	if p.closed {
+		log.Warn("Pulsar DDL producer already closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+		return
+	}
+	p.closed = true
+
+	p.producers.Close()
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddlproducer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarDDLProducer(t *testing.T) {
+	t.Parallel()
+
+	changefeed := model.DefaultChangeFeedID("changefeed-test")
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(), changefeed)
+	client := pulsarsink.NewMockClient()
+	client.SetPartitionNum("test", 3)
+	producers := pulsarsink.NewProducers(changefeed, client, pulsarsink.NewConfig())
+
+	producer, err := NewPulsarDDLProducer(ctx, producers)
+	require.NoError(t, err)
+
+	message := &common.Message{Key: []byte("key"), Value: []byte("value")}
+	require.NoError(t, producer.SyncBroadcastMessage(ctx, "test", 3, message))
+	require.NoError(t, producer.SyncSendMessage(ctx, "test", 1, message))
+	require.Len(t, client.GetMessages("test-partition-0"), 1)
+	require.Len(t, client.GetMessages("test-partition-1"), 2)
+	require.Len(t, client.GetMessages("test-partition-2"), 1)
+
+	// The partition does not exist.
+	require.Error(t, producer.SyncSendMessage(ctx, "test", 3, message))
+
+	producer.Close()
+	require.True(t, client.IsClosed())
+	err = producer.SyncSendMessage(ctx, "test", 0, message)
+	require.True(t, cerror.ErrPulsarProducerClosed.Equal(err))
+}
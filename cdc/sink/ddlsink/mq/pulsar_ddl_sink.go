@@ -0,0 +1,103 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/manager"
+	"github.com/pingcap/tiflow/cdc/sink/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+// NewPulsarDDLSink will verify the config and create a pulsar DDL sink.
+func NewPulsarDDLSink(
+	ctx context.Context,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	factoryCreator pulsarsink.FactoryCreator,
+	producerCreator ddlproducer.PulsarFactory,
+) (_ *DDLSink, err error) {
+	topic, err := util.GetTopic(sinkURI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	pulsarConfig := pulsarsink.NewConfig()
+	if err := pulsarConfig.Apply(sinkURI); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	protocol, err := util.GetProtocol(replicaConfig.Sink.Protocol)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := pulsarsink.CheckProtocol(protocol); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	changefeed := contextutil.ChangefeedIDFromCtx(ctx)
+	client, err := factoryCreator(pulsarConfig, changefeed)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewClient, err)
+	}
+	producers := pulsarsink.NewProducers(changefeed, client, pulsarConfig)
+
+	log.Info("Try to create a DDL sink producer",
+		zap.String("brokerURL", pulsarConfig.BrokerURL))
+	p, err := producerCreator(ctx, producers)
+	if err != nil {
+		producers.Close()
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	// Preventing leaks when error occurs.
+	// This also closes the client in p.Close().
+	defer func() {
+		if err != nil && p != nil {
+			p.Close()
+		}
+	}()
+
+	topicManager := manager.NewPulsarTopicManager(producers)
+	if _, err := topicManager.CreateTopicAndWaitUntilVisible(ctx, topic); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	eventRouter, err := dispatcher.NewEventRouter(replicaConfig, topic)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	encoderConfig, err := util.GetEncoderConfig(sinkURI, protocol, replicaConfig,
+		pulsarConfig.MaxMessageBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	s, err := newDDLSink(ctx, p, nil, topicManager, eventRouter, encoderConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// asyncDDLCheckInterval is the interval of checking whether the DDL jobs are
+// finished in the downstream. It's a variable for tests.
+var asyncDDLCheckInterval = 5 * time.Second
+
+// asyncDDL is a DDL which is executing asynchronously in the downstream.
+type asyncDDL struct {
+	ddl    *model.DDLEvent
+	doneCh chan struct{}
+	// err is the error of executing the DDL, it's readable after doneCh is closed.
+	err error
+}
+
+// isAsyncDDL returns true if the DDL should be executed asynchronously.
+// Only adding indexes is executed asynchronously, as it needs to backfill
+// the whole table and does not change the schema of rows, DMLs of the table
+// can be written to the downstream while the index is being added.
+func (m *DDLSink) isAsyncDDL(ddl *model.DDLEvent) bool {
+	return m.cfg.AsyncDDLEnable && m.cfg.IsTiDB && ddl.Type == timodel.ActionAddIndex
+}
+
+// execDDLAsync executes the DDL in background, the DDLs touching the same
+// table are blocked until it's finished.
+func (m *DDLSink) execDDLAsync(ddl *model.DDLEvent) {
+	a := &asyncDDL{ddl: ddl, doneCh: make(chan struct{})}
+	m.mu.Lock()
+	m.mu.asyncDDLs = append(m.mu.asyncDDLs, a)
+	m.mu.Unlock()
+
+	log.Info("Start exec DDL asynchronously", zap.String("sql", ddl.Query),
+		zap.String("namespace", m.id.Namespace),
+		zap.String("changefeed", m.id.ID))
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		a.err = m.execDDLWithMaxRetries(m.asyncCtx, ddl)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for i, running := range m.mu.asyncDDLs {
+			if running == a {
+				m.mu.asyncDDLs = append(m.mu.asyncDDLs[:i], m.mu.asyncDDLs[i+1:]...)
+				break
+			}
+		}
+		if a.err != nil && m.mu.asyncErr == nil &&
+			errors.Cause(a.err) != context.Canceled {
+			m.mu.asyncErr = cerror.WrapChangefeedUnretryableErr(a.err)
+		}
+		close(a.doneCh)
+	}()
+}
+
+// asyncError returns the error of the async DDLs which are finished.
+func (m *DDLSink) asyncError() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mu.asyncErr
+}
+
+// waitAsyncDDLs waits the async DDLs touching the same tables as the DDL to
+// finish, including the DDL jobs which are started before the changefeed is
+// restarted.
+func (m *DDLSink) waitAsyncDDLs(ctx context.Context, ddl *model.DDLEvent) error {
+	var running []*asyncDDL
+	m.mu.Lock()
+	for _, a := range m.mu.asyncDDLs {
+		if isDDLConflicted(a.ddl, ddl) {
+			running = append(running, a)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, a := range running {
+		log.Info("Wait the async DDL to finish",
+			zap.String("sql", ddl.Query), zap.String("running", a.ddl.Query),
+			zap.String("namespace", m.id.Namespace),
+			zap.String("changefeed", m.id.ID))
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-a.doneCh:
+		}
+		if a.err != nil {
+			return cerror.WrapChangefeedUnretryableErr(a.err)
+		}
+	}
+	return m.waitDownstreamDDLs(ctx, ddl)
+}
+
+// waitDownstreamDDLs waits the running DDL jobs of the tables touched by the
+// DDL to finish in the downstream.
+func (m *DDLSink) waitDownstreamDDLs(ctx context.Context, ddl *model.DDLEvent) error {
+	ticker := time.NewTicker(asyncDDLCheckInterval)
+	defer ticker.Stop()
+	for _, tableName := range ddlTableNames(ddl) {
+		for {
+			running, err := m.hasRunningDDLJobs(ctx, tableName)
+			if err != nil {
+				return err
+			}
+			if !running {
+				break
+			}
+			log.Info("Wait the running DDL jobs in the downstream to finish",
+				zap.String("sql", ddl.Query), zap.Stringer("table", tableName),
+				zap.String("namespace", m.id.Namespace),
+				zap.String("changefeed", m.id.ID))
+			select {
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			case <-ticker.C:
+			}
+		}
+	}
+	return nil
+}
+
+// hasRunningDDLJobs returns true if there are running DDL jobs of the table
+// in the downstream, all DDL jobs of the schema are checked if the table
+// name is empty.
+func (m *DDLSink) hasRunningDDLJobs(
+	ctx context.Context, tableName model.TableName,
+) (bool, error) {
+	query := fmt.Sprintf("ADMIN SHOW DDL JOBS 1 WHERE db_name = '%s'",
+		escapeString(tableName.Schema))
+	if tableName.Table != "" {
+		query += fmt.Sprintf(" AND table_name = '%s'", escapeString(tableName.Table))
+	}
+	query += " AND state NOT IN ('synced', 'cancelled', 'rollback done')"
+	rows, err := m.db.QueryContext(ctx, query)
+	if err != nil {
+		return false, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+	running := rows.Next()
+	if err := rows.Err(); err != nil {
+		return false, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return running, nil
+}
+
+// ddlTableNames returns the tables touched by the DDL, the table name is
+// empty for the DDLs of a whole schema.
+func ddlTableNames(ddl *model.DDLEvent) []model.TableName {
+	var names []model.TableName
+	if ddl.TableInfo != nil && ddl.TableInfo.TableName.Schema != "" {
+		name := ddl.TableInfo.TableName
+		if ddl.Type == timodel.ActionCreateSchema ||
+			ddl.Type == timodel.ActionDropSchema ||
+			ddl.Type == timodel.ActionModifySchemaCharsetAndCollate {
+			name.Table = ""
+		}
+		names = append(names, model.TableName{Schema: name.Schema, Table: name.Table})
+	}
+	if ddl.PreTableInfo != nil && ddl.PreTableInfo.TableName.Schema != "" {
+		name := ddl.PreTableInfo.TableName
+		names = append(names, model.TableName{Schema: name.Schema, Table: name.Table})
+	}
+	return names
+}
+
+// isDDLConflicted returns true if the two DDLs touch the same table.
+func isDDLConflicted(ddl1, ddl2 *model.DDLEvent) bool {
+	for _, name1 := range ddlTableNames(ddl1) {
+		for _, name2 := range ddlTableNames(ddl2) {
+			if name1.Schema != name2.Schema {
+				continue
+			}
+			if name1.Table == "" || name2.Table == "" || name1.Table == name2.Table {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func escapeString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `'`, `''`)
+}
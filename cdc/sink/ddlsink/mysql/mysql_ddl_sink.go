@@ -17,6 +17,7 @@ import (
 	"context"
 	"database/sql"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -61,6 +62,18 @@ type DDLSink struct {
 	// statistics is the statistics of this sink.
 	// We use it to record the DDL count.
 	statistics *metrics.Statistics
+
+	// asyncCtx is the context of the async DDLs, which is canceled on close.
+	asyncCtx    context.Context
+	asyncCancel context.CancelFunc
+	wg          sync.WaitGroup
+	mu          struct {
+		sync.Mutex
+		// asyncDDLs are the DDLs executing asynchronously.
+		asyncDDLs []*asyncDDL
+		// asyncErr is the first error of the async DDLs.
+		asyncErr error
+	}
 }
 
 // NewDDLSink creates a new DDLSink.
@@ -86,12 +99,25 @@ func NewDDLSink(
 		return nil, err
 	}
 
+	if cfg.AsyncDDLEnable {
+		cfg.IsTiDB, err = pmysql.CheckIsTiDB(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		if !cfg.IsTiDB {
+			log.Warn("Async DDL is only supported by TiDB, DDLs are executed synchronously",
+				zap.String("namespace", changefeedID.Namespace),
+				zap.String("changefeed", changefeedID.ID))
+		}
+	}
+
 	m := &DDLSink{
 		id:         changefeedID,
 		db:         db,
 		cfg:        cfg,
 		statistics: metrics.NewStatistics(ctx, sink.TxnSink),
 	}
+	m.asyncCtx, m.asyncCancel = context.WithCancel(context.Background())
 
 	log.Info("MySQL DDL sink is created",
 		zap.String("namespace", m.id.Namespace),
@@ -101,6 +127,19 @@ func NewDDLSink(
 
 // WriteDDLEvent writes a DDL event to the mysql database.
 func (m *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	if m.cfg.AsyncDDLEnable && m.cfg.IsTiDB {
+		if err := m.asyncError(); err != nil {
+			return err
+		}
+		if err := m.waitAsyncDDLs(ctx, ddl); err != nil {
+			return err
+		}
+		if m.isAsyncDDL(ddl) {
+			m.execDDLAsync(ddl)
+			return nil
+		}
+	}
+
 	err := m.execDDLWithMaxRetries(ctx, ddl)
 	// we should not retry changefeed if DDL failed by return an unretryable error.
 	if !errorutil.IsRetryableDDLError(err) {
@@ -138,10 +177,21 @@ func (m *DDLSink) execDDLWithMaxRetries(ctx context.Context, ddl *model.DDLEvent
 }
 
 func (m *DDLSink) execDDL(pctx context.Context, ddl *model.DDLEvent) error {
-	writeTimeout, _ := time.ParseDuration(m.cfg.WriteTimeout)
-	writeTimeout += networkDriftDuration
-	ctx, cancelFunc := context.WithTimeout(pctx, writeTimeout)
-	defer cancelFunc()
+	ctx := pctx
+	if m.isAsyncDDL(ddl) {
+		// The async DDL is executed without the write timeout, as it may take
+		// a long time. The DDL job started by the last try may be still
+		// running in the downstream, wait it to finish before retrying.
+		if err := m.waitDownstreamDDLs(ctx, ddl); err != nil {
+			return err
+		}
+	} else {
+		writeTimeout, _ := time.ParseDuration(m.cfg.WriteTimeout)
+		writeTimeout += networkDriftDuration
+		var cancelFunc context.CancelFunc
+		ctx, cancelFunc = context.WithTimeout(pctx, writeTimeout)
+		defer cancelFunc()
+	}
 
 	shouldSwitchDB := needSwitchDB(ddl)
 
@@ -207,14 +257,20 @@ func needSwitchDB(ddl *model.DDLEvent) bool {
 	return true
 }
 
-// WriteCheckpointTs does nothing.
+// WriteCheckpointTs reports the error of the async DDLs if there is any.
 func (m *DDLSink) WriteCheckpointTs(_ context.Context, _ uint64, _ []*model.TableInfo) error {
 	// Only for RowSink for now.
-	return nil
+	return m.asyncError()
 }
 
 // Close closes the database connection.
 func (m *DDLSink) Close() {
+	if m.asyncCancel != nil {
+		// The async DDL jobs keep running in the downstream TiDB, and they are
+		// waited by the DDLs of the same tables after the changefeed restarts.
+		m.asyncCancel()
+		m.wg.Wait()
+	}
 	if m.statistics != nil {
 		m.statistics.Close()
 	}
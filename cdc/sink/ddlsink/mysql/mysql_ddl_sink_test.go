@@ -18,11 +18,13 @@ import (
 	"database/sql"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	dmysql "github.com/go-sql-driver/mysql"
 	"github.com/pingcap/tidb/infoschema"
 	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -93,6 +95,129 @@ func TestWriteDDLEvent(t *testing.T) {
 	sink.Close()
 }
 
+func TestWriteDDLEventAsync(t *testing.T) {
+	asyncDDLCheckInterval = 10 * time.Millisecond
+	adminQuery := "ADMIN SHOW DDL JOBS 1 WHERE db_name = 'test' AND table_name = 't1'" +
+		" AND state NOT IN ('synced', 'cancelled', 'rollback done')"
+	jobColumns := []string{"JOB_ID", "DB_NAME", "TABLE_NAME", "STATE"}
+
+	dbIndex := 0
+	GetDBConnImpl = func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() {
+			dbIndex++
+		}()
+		if dbIndex == 0 {
+			// test db
+			db, err := pmysql.MockTestDB(true)
+			require.Nil(t, err)
+			return db, nil
+		}
+		// normal db
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.Nil(t, err)
+		mock.ExpectQuery("select tidb_version()").
+			WillReturnRows(sqlmock.NewRows([]string{"tidb_version()"}).AddRow("5.7.25-TiDB-v7.1.0"))
+		// The DDL job of the last run is still running in the downstream.
+		mock.ExpectQuery(adminQuery).
+			WillReturnRows(sqlmock.NewRows(jobColumns).AddRow(1, "test", "t1", "running"))
+		mock.ExpectQuery(adminQuery).WillReturnRows(sqlmock.NewRows(jobColumns))
+		// Add index asynchronously.
+		mock.ExpectQuery(adminQuery).WillReturnRows(sqlmock.NewRows(jobColumns))
+		mock.ExpectBegin()
+		mock.ExpectExec("USE `test`;").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("ALTER TABLE test.t1 ADD INDEX idx(a)").
+			WillDelayFor(100 * time.Millisecond).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		// The next DDL of the table waits the async DDL to finish.
+		mock.ExpectQuery(adminQuery).WillReturnRows(sqlmock.NewRows(jobColumns))
+		mock.ExpectBegin()
+		mock.ExpectExec("USE `test`;").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("ALTER TABLE test.t1 ADD COLUMN b int").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		// Add index fails.
+		mock.ExpectQuery(adminQuery).WillReturnRows(sqlmock.NewRows(jobColumns))
+		mock.ExpectQuery(adminQuery).WillReturnRows(sqlmock.NewRows(jobColumns))
+		mock.ExpectBegin()
+		mock.ExpectExec("USE `test`;").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("ALTER TABLE test.t1 ADD INDEX idx(a)").
+			WillReturnError(&dmysql.MySQLError{Number: mysql.ErrKeyColumnDoesNotExits})
+		mock.ExpectRollback()
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changefeed := "test-changefeed"
+	contextutil.PutChangefeedIDInCtx(ctx, model.DefaultChangeFeedID(changefeed))
+	sinkURI, err := url.Parse("mysql://127.0.0.1:4000?async-ddl-enable=true")
+	require.Nil(t, err)
+	rc := config.GetDefaultReplicaConfig()
+	sink, err := NewDDLSink(ctx, sinkURI, rc)
+	require.Nil(t, err)
+	require.True(t, sink.cfg.IsTiDB)
+
+	addIndex := &model.DDLEvent{
+		StartTs:  1000,
+		CommitTs: 1010,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "test", Table: "t1"},
+		},
+		Type:  timodel.ActionAddIndex,
+		Query: "ALTER TABLE test.t1 ADD INDEX idx(a)",
+	}
+	addColumn := &model.DDLEvent{
+		StartTs:  1020,
+		CommitTs: 1030,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "test", Table: "t1"},
+		},
+		Type:  timodel.ActionAddColumn,
+		Query: "ALTER TABLE test.t1 ADD COLUMN b int",
+	}
+	start := time.Now()
+	require.Nil(t, sink.WriteDDLEvent(ctx, addIndex))
+	// The add index DDL is still running.
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+	require.Nil(t, sink.WriteDDLEvent(ctx, addColumn))
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	require.Nil(t, sink.WriteCheckpointTs(ctx, 1030, nil))
+
+	// The error of the async DDL is reported.
+	require.Nil(t, sink.WriteDDLEvent(ctx, addIndex))
+	require.Eventually(t, func() bool {
+		return sink.WriteCheckpointTs(ctx, 1030, nil) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotNil(t, sink.WriteDDLEvent(ctx, addColumn))
+
+	sink.Close()
+}
+
+func TestIsDDLConflicted(t *testing.T) {
+	t.Parallel()
+
+	newDDL := func(tp timodel.ActionType, schema, table string) *model.DDLEvent {
+		return &model.DDLEvent{
+			Type: tp,
+			TableInfo: &model.TableInfo{
+				TableName: model.TableName{Schema: schema, Table: table},
+			},
+		}
+	}
+	addIndex := newDDL(timodel.ActionAddIndex, "test", "t1")
+	require.True(t, isDDLConflicted(addIndex, newDDL(timodel.ActionAddColumn, "test", "t1")))
+	require.False(t, isDDLConflicted(addIndex, newDDL(timodel.ActionAddColumn, "test", "t2")))
+	require.False(t, isDDLConflicted(addIndex, newDDL(timodel.ActionAddColumn, "test1", "t1")))
+	require.True(t, isDDLConflicted(addIndex, newDDL(timodel.ActionDropSchema, "test", "")))
+
+	rename := newDDL(timodel.ActionRenameTable, "test", "t3")
+	rename.PreTableInfo = &model.TableInfo{
+		TableName: model.TableName{Schema: "test", Table: "t1"},
+	}
+	require.True(t, isDDLConflicted(addIndex, rename))
+}
+
 func TestNeedSwitchDB(t *testing.T) {
 	t.Parallel()
 
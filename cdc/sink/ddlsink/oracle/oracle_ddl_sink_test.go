@@ -0,0 +1,63 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDDLEvent(t *testing.T) {
+	dbConnFactory := GetDBConnImpl
+	defer func() { GetDBConnImpl = dbConnFactory }()
+	GetDBConnImpl = func(ctx context.Context, dsn string) (*sql.DB, error) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.Nil(t, err)
+		mock.ExpectExec(`TRUNCATE TABLE "TEST"."T1"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	ctx := context.Background()
+	sinkURI, err := url.Parse("oracle://127.0.0.1:1521/ORCL")
+	require.Nil(t, err)
+	sink, err := NewDDLSink(ctx, sinkURI)
+	require.Nil(t, err)
+
+	tableInfo := model.WrapTableInfo(1, "test", 1, &timodel.TableInfo{
+		ID:   100,
+		Name: timodel.NewCIStr("t1"),
+	})
+	err = sink.WriteDDLEvent(ctx, &model.DDLEvent{
+		Type:      timodel.ActionTruncateTable,
+		Query:     "truncate table test.t1",
+		TableInfo: tableInfo,
+	})
+	require.Nil(t, err)
+	// The unsupported DDLs are skipped.
+	err = sink.WriteDDLEvent(ctx, &model.DDLEvent{
+		Type:  timodel.ActionCreateSchema,
+		Query: "create database test",
+	})
+	require.Nil(t, err)
+	sink.Close()
+}
@@ -125,12 +125,16 @@ func NewDMLSink(ctx context.Context,
 	}
 
 	wgCtx, wgCancel := context.WithCancel(ctx)
+	statistics := metrics.NewStatistics(wgCtx, sink.TxnSink)
+	if replicaConfig.Sink.TableMetricsEnabled() {
+		statistics.EnableTableMetrics()
+	}
 	s := &DMLSink{
 		changefeedID:    contextutil.ChangefeedIDFromCtx(wgCtx),
 		msgCh:           make(chan eventFragment, defaultChannelSize),
 		encodingWorkers: make([]*encodingWorker, defaultEncodingConcurrency),
 		workers:         make([]*dmlWorker, cfg.WorkerCount),
-		statistics:      metrics.NewStatistics(wgCtx, sink.TxnSink),
+		statistics:      statistics,
 		cancel:          wgCancel,
 		dead:            make(chan struct{}),
 	}
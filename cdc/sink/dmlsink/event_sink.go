@@ -13,6 +13,8 @@
 
 package dmlsink
 
+import "github.com/pingcap/tiflow/cdc/processor/tablepb"
+
 // EventSink is the interface for event sink.
 type EventSink[E TableEvent] interface {
 	// WriteEvents writes events to the sink.
@@ -23,3 +25,38 @@ type EventSink[E TableEvent] interface {
 	// The EventSink meets internal errors and has been dead already.
 	Dead() <-chan struct{}
 }
+
+// TableStatsCollector is implemented by event sinks that collect statistics
+// of the tables written to them.
+type TableStatsCollector interface {
+	// GetTableStats returns the statistics of the table of the span.
+	// This is a thread-safe method.
+	GetTableStats(span tablepb.Span) TableStats
+}
+
+// TableStats is the statistics of a table collected by an event sink.
+type TableStats struct {
+	// MQPositions are the latest positions produced to the downstream,
+	// only collected by MQ sinks.
+	MQPositions []tablepb.MQPosition
+	// ConflictCount is the number of transactions that conflict with
+	// unfinished transactions, only collected by transaction sinks.
+	ConflictCount uint64
+	// RetryCount is the number of retried writes to the downstream.
+	RetryCount uint64
+	// BatchFlush is the statistics of batch flushes to the downstream.
+	BatchFlush tablepb.BatchFlush
+	// ConnectionPool is the status of the connection pool shared by all
+	// tables, only collected by sinks backed by a connection pool.
+	ConnectionPool tablepb.ConnectionPool
+	// Idempotency is the status of writing the table idempotently, only
+	// collected by sinks supporting safe mode.
+	Idempotency tablepb.Idempotency
+	// Causality is the status of the unfinished transactions of the table
+	// in the conflict detector, only collected by transaction sinks.
+	Causality tablepb.Causality
+	// SchemaCompatibility is the compatibility of the schema of the table
+	// with the schema registry, only collected by sinks with schema
+	// registries, e.g. Avro.
+	SchemaCompatibility tablepb.SchemaCompatibility
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/cloudstorage"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/subscription"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -33,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
 	v2 "github.com/pingcap/tiflow/pkg/sink/kafka/v2"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -67,6 +69,12 @@ func New(
 			return nil, err
 		}
 		s.txnSink = txnSink
+	case sink.OracleScheme:
+		txnSink, err := txn.NewOracleSink(ctx, sinkURI, cfg, errCh, txn.DefaultConflictDetectorSlots)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = txnSink
 	case sink.KafkaScheme, sink.KafkaSSLScheme:
 		factoryCreator := kafka.NewSaramaFactory
 		if cfg.Sink.EnableKafkaSinkV2 {
@@ -78,6 +86,13 @@ func New(
 			return nil, err
 		}
 		s.rowSink = mqs
+	case sink.PulsarScheme, sink.PulsarSSLScheme:
+		mqs, err := mq.NewPulsarDMLSink(ctx, sinkURI, cfg, errCh,
+			pulsar.NewCreatorFactory, dmlproducer.NewPulsarDMLProducer)
+		if err != nil {
+			return nil, err
+		}
+		s.rowSink = mqs
 	case sink.S3Scheme, sink.FileScheme, sink.GCSScheme, sink.GSScheme, sink.AzblobScheme, sink.AzureScheme, sink.CloudStorageNoopScheme:
 		storageSink, err := cloudstorage.NewDMLSink(ctx, sinkURI, cfg, errCh)
 		if err != nil {
@@ -87,6 +102,12 @@ func New(
 	case sink.BlackHoleScheme:
 		bs := blackhole.NewDMLSink()
 		s.rowSink = bs
+	case sink.SubscriptionScheme:
+		subscriptionSink, err := subscription.NewDMLSink(ctx, sinkURI, cfg)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = subscriptionSink
 	default:
 		return nil,
 			cerror.ErrSinkURIInvalid.GenWithStack("the sink scheme (%s) is not supported", schema)
@@ -131,6 +152,21 @@ func (s *SinkFactory) CreateTableSinkForConsumer(
 		&dmlsink.RowChangeEventAppender{}, totalRowsCounter)
 }
 
+// GetTableStats returns the statistics of the table collected by the sink,
+// it is empty if the sink doesn't collect statistics.
+func (s *SinkFactory) GetTableStats(span tablepb.Span) dmlsink.TableStats {
+	var collector dmlsink.TableStatsCollector
+	if s.txnSink != nil {
+		collector, _ = s.txnSink.(dmlsink.TableStatsCollector)
+	} else {
+		collector, _ = s.rowSink.(dmlsink.TableStatsCollector)
+	}
+	if collector == nil {
+		return dmlsink.TableStats{}
+	}
+	return collector.GetTableStats(span)
+}
+
 // Close closes the sink.
 func (s *SinkFactory) Close() {
 	if s.rowSink != nil && s.txnSink != nil {
@@ -0,0 +1,135 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnselector
+
+import (
+	"github.com/pingcap/tidb/util/rowcodec"
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+type selector struct {
+	tableF  filter.Filter
+	columnM filter.ColumnFilter
+}
+
+func newSelector(rule *config.ColumnSelector, caseSensitive bool) (*selector, error) {
+	tableM, err := filter.Parse(rule.Matcher)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, rule.Matcher)
+	}
+	if !caseSensitive {
+		tableM = filter.CaseInsensitive(tableM)
+	}
+	columnM, err := filter.ParseColumnFilter(rule.Columns)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, rule.Columns)
+	}
+	return &selector{tableF: tableM, columnM: columnM}, nil
+}
+
+// match returns whether the selector is applied to the table.
+func (s *selector) match(schema, table string) bool {
+	return s.tableF.MatchTable(schema, table)
+}
+
+// apply removes the columns which are not selected from the event, the
+// handle key columns must be selected to keep the event identifiable.
+func (s *selector) apply(event *model.RowChangedEvent) error {
+	var err error
+	// ColInfos is aligned with Columns, or with PreColumns for delete events.
+	if len(event.Columns) == 0 {
+		event.PreColumns, event.ColInfos, err = s.selectColumns(event.PreColumns, event.ColInfos)
+		return err
+	}
+	event.Columns, event.ColInfos, err = s.selectColumns(event.Columns, event.ColInfos)
+	if err != nil {
+		return err
+	}
+	event.PreColumns, _, err = s.selectColumns(event.PreColumns, nil)
+	return err
+}
+
+func (s *selector) selectColumns(
+	columns []*model.Column, colInfos []rowcodec.ColInfo,
+) ([]*model.Column, []rowcodec.ColInfo, error) {
+	if len(columns) == 0 {
+		return columns, colInfos, nil
+	}
+	alignedColInfos := len(colInfos) == len(columns)
+	selectedColumns := make([]*model.Column, 0, len(columns))
+	var selectedColInfos []rowcodec.ColInfo
+	if alignedColInfos {
+		selectedColInfos = make([]rowcodec.ColInfo, 0, len(colInfos))
+	}
+	for i, col := range columns {
+		if col == nil {
+			continue
+		}
+		if !s.columnM.MatchColumn(col.Name) {
+			if col.Flag.IsHandleKey() {
+				return nil, nil, cerror.ErrColumnSelectorFailed.GenWithStackByArgs(col.Name)
+			}
+			continue
+		}
+		selectedColumns = append(selectedColumns, col)
+		if alignedColInfos {
+			selectedColInfos = append(selectedColInfos, colInfos[i])
+		}
+	}
+	if !alignedColInfos {
+		selectedColInfos = colInfos
+	}
+	return selectedColumns, selectedColInfos, nil
+}
+
+// ColumnSelector selects the columns of row changed events which are sent to
+// the downstream, by the column selectors of the sink config.
+type ColumnSelector struct {
+	selectors []*selector
+}
+
+// New creates a ColumnSelector, it returns nil if no column selector is
+// configured.
+func New(cfg *config.ReplicaConfig) (*ColumnSelector, error) {
+	if cfg.Sink == nil || len(cfg.Sink.ColumnSelectors) == 0 {
+		return nil, nil
+	}
+	selectors := make([]*selector, 0, len(cfg.Sink.ColumnSelectors))
+	for _, rule := range cfg.Sink.ColumnSelectors {
+		s, err := newSelector(rule, cfg.CaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, s)
+	}
+	return &ColumnSelector{selectors: selectors}, nil
+}
+
+// Apply applies the first selector matching the table of the event, events
+// of tables matched by no selector are kept as is.
+// It is safe to call Apply on a nil ColumnSelector.
+func (c *ColumnSelector) Apply(event *model.RowChangedEvent) error {
+	if c == nil {
+		return nil
+	}
+	for _, s := range c.selectors {
+		if s.match(event.Table.Schema, event.Table.Table) {
+			return s.apply(event)
+		}
+	}
+	return nil
+}
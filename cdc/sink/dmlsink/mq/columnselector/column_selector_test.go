@@ -0,0 +1,129 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnselector
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestColumns() []*model.Column {
+	return []*model.Column{
+		{Name: "id", Value: 1, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "name", Value: "a"},
+		{Name: "phone", Value: "123"},
+		{Name: "email", Value: "a@b.c"},
+	}
+}
+
+func newTestColInfos() []rowcodec.ColInfo {
+	return []rowcodec.ColInfo{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+}
+
+func columnNames(columns []*model.Column) []string {
+	names := make([]string, 0, len(columns))
+	for _, col := range columns {
+		names = append(names, col.Name)
+	}
+	return names
+}
+
+func TestNewColumnSelector(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	selector, err := New(replicaConfig)
+	require.NoError(t, err)
+	require.Nil(t, selector)
+	// A nil selector keeps all columns.
+	event := &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t"},
+		Columns: newTestColumns(),
+	}
+	require.NoError(t, selector.Apply(event))
+	require.Len(t, event.Columns, 4)
+
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{
+		{Matcher: []string{"[test.*"}, Columns: []string{"a"}},
+	}
+	_, err = New(replicaConfig)
+	require.ErrorContains(t, err, "ErrFilterRuleInvalid")
+}
+
+func TestColumnSelectorApply(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{
+		{Matcher: []string{"test.whitelist"}, Columns: []string{"id", "name"}},
+		{Matcher: []string{"test.*"}, Columns: []string{"*", "!phone", "!email"}},
+		{Matcher: []string{"test1.*"}, Columns: []string{"name"}},
+	}
+	selector, err := New(replicaConfig)
+	require.NoError(t, err)
+
+	// Only the first matching selector is applied.
+	event := &model.RowChangedEvent{
+		Table:    &model.TableName{Schema: "test", Table: "whitelist"},
+		Columns:  newTestColumns(),
+		ColInfos: newTestColInfos(),
+	}
+	require.NoError(t, selector.Apply(event))
+	require.Equal(t, []string{"id", "name"}, columnNames(event.Columns))
+	require.Equal(t, []rowcodec.ColInfo{{ID: 1}, {ID: 2}}, event.ColInfos)
+
+	// Both columns and pre-columns of an update event are selected.
+	event = &model.RowChangedEvent{
+		Table:      &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: newTestColumns(),
+		Columns:    newTestColumns(),
+		ColInfos:   newTestColInfos(),
+	}
+	require.NoError(t, selector.Apply(event))
+	require.Equal(t, []string{"id", "name"}, columnNames(event.Columns))
+	require.Equal(t, []string{"id", "name"}, columnNames(event.PreColumns))
+	require.Equal(t, []rowcodec.ColInfo{{ID: 1}, {ID: 2}}, event.ColInfos)
+
+	// ColInfos is aligned with pre-columns for a delete event.
+	event = &model.RowChangedEvent{
+		Table:      &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: newTestColumns(),
+		ColInfos:   newTestColInfos(),
+	}
+	require.NoError(t, selector.Apply(event))
+	require.Equal(t, []string{"id", "name"}, columnNames(event.PreColumns))
+	require.Equal(t, []rowcodec.ColInfo{{ID: 1}, {ID: 2}}, event.ColInfos)
+
+	// Tables matched by no selector are kept as is.
+	event = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test2", Table: "t"},
+		Columns: newTestColumns(),
+	}
+	require.NoError(t, selector.Apply(event))
+	require.Len(t, event.Columns, 4)
+
+	// The handle key column must be selected.
+	event = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test1", Table: "t"},
+		Columns: newTestColumns(),
+	}
+	err = selector.Apply(event)
+	require.True(t, cerror.ErrColumnSelectorFailed.Equal(err))
+	require.ErrorContains(t, err, "id")
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
 )
 
 // DMLProducer is the interface for message producer.
@@ -39,3 +40,8 @@ type DMLProducer interface {
 // It's usually a buffered channel.
 type Factory func(ctx context.Context, factory kafka.Factory,
 	adminClient kafka.ClusterAdminClient, errCh chan error) (DMLProducer, error)
+
+// PulsarFactory is a function to create a pulsar producer.
+// errCh is used to report the errors in the same way as Factory.
+type PulsarFactory func(ctx context.Context, producers *pulsarsink.Producers,
+	errCh chan error) (DMLProducer, error)
@@ -50,6 +50,7 @@ func (m *MockDMLProducer) AsyncSendMessage(_ context.Context, topic string,
 	if _, ok := m.events[key]; !ok {
 		m.events[key] = make([]*common.Message, 0)
 	}
+	message.Offset = int64(len(m.events[key]))
 	m.events[key] = append(m.events[key], message)
 
 	message.Callback()
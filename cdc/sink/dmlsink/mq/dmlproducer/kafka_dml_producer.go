@@ -130,7 +130,12 @@ func (k *kafkaDMLProducer) AsyncSendMessage(
 		failpoint.Return(nil)
 	})
 	return k.asyncProducer.AsyncSend(ctx, topic, partition,
-		message.Key, message.Value, message.Callback)
+		message.Key, message.Value, func(offset int64) {
+			message.Offset = offset
+			if message.Callback != nil {
+				message.Callback()
+			}
+		})
 }
 
 func (k *kafkaDMLProducer) Close() {
@@ -0,0 +1,133 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+var _ DMLProducer = (*pulsarDMLProducer)(nil)
+
+// pulsarDMLProducer is used to send messages to pulsar.
+type pulsarDMLProducer struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// producers holds a pulsar producer for each partition.
+	producers *pulsarsink.Producers
+	// errCh is used to report the errors of the acknowledgements.
+	errCh chan error
+	// closedMu is used to protect `closed`.
+	// We need to ensure that closed producers are never written to.
+	closedMu sync.RWMutex
+	// closed is used to indicate whether the producer is closed.
+	// We also use it to guard against double closes.
+	closed bool
+}
+
+// NewPulsarDMLProducer creates a new pulsar producer.
+func NewPulsarDMLProducer(
+	ctx context.Context,
+	producers *pulsarsink.Producers,
+	errCh chan error,
+) (DMLProducer, error) {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	log.Info("Starting pulsar DML producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID))
+
+	return &pulsarDMLProducer{
+		id:        changefeedID,
+		producers: producers,
+		errCh:     errCh,
+		closed:    false,
+	}, nil
+}
+
+// AsyncSendMessage sends the message to the partition of the topic. The
+// callback of the message is called only after the brokers acknowledge it,
+// so a message is never lost even if the changefeed restarts.
+func (p *pulsarDMLProducer) AsyncSendMessage(
+	ctx context.Context, topic string,
+	partition int32, message *common.Message,
+) error {
+	// We have to hold the lock to avoid writing to a closed producer.
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	// If the producer is closed, we should skip the message and return an error.
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	producer, err := p.producers.GetProducer(topic, partition)
+	if err != nil {
+		return err
+	}
+	producer.SendAsync(ctx, &pulsar.ProducerMessage{
+		Key:     string(message.Key),
+		Payload: message.Value,
+	}, func(id pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+		if err != nil {
+			p.reportError(ctx, cerror.WrapError(cerror.ErrPulsarSendMessage, err))
+			return
+		}
+		message.Offset = id.EntryID()
+		if message.Callback != nil {
+			message.Callback()
+		}
+	})
+	return nil
+}
+
+func (p *pulsarDMLProducer) reportError(ctx context.Context, err error) {
+	select {
+	case <-ctx.Done():
+	case p.errCh <- err:
+		log.Error("Pulsar DML producer send error",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID),
+			zap.Error(err))
+	default:
+		log.Error("Error channel is full in pulsar DML producer",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID),
+			zap.Error(err))
+	}
+}
+
+func (p *pulsarDMLProducer) Close() {
+	// We have to hold the lock to synchronize closing with writing.
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+	// If the producer has already been closed, we should skip this close operation.
+	if p.closed {
+		log.Warn("Pulsar DML producer already closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+		return
+	}
+	p.closed = true
+
+	p.producers.Close()
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarProducerAck(t *testing.T) {
+	t.Parallel()
+
+	changefeed := model.DefaultChangeFeedID("changefeed-test")
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(), changefeed)
+	client := pulsarsink.NewMockClient()
+	client.SetPartitionNum("test", 2)
+	producers := pulsarsink.NewProducers(changefeed, client, pulsarsink.NewConfig())
+
+	errCh := make(chan error, 1)
+	producer, err := NewPulsarDMLProducer(ctx, producers, errCh)
+	require.NoError(t, err)
+
+	acked := 0
+	for i := 0; i < 10; i++ {
+		err = producer.AsyncSendMessage(ctx, "test", int32(i%2), &common.Message{
+			Key:      []byte("test-key"),
+			Value:    []byte("test-value"),
+			Callback: func() { acked++ },
+		})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 10, acked)
+	// The messages are sent to the partitions picked by the dispatcher.
+	require.Len(t, client.GetMessages("test-partition-0"), 5)
+	require.Len(t, client.GetMessages("test-partition-1"), 5)
+	msg := client.GetMessages("test-partition-1")[0]
+	require.Equal(t, "test-key", msg.Key)
+	require.Equal(t, []byte("test-value"), msg.Payload)
+
+	// The callback is not called if the message is not acknowledged,
+	// and the error is reported.
+	client.SetSendError(errors.New("fake error"))
+	err = producer.AsyncSendMessage(ctx, "test", 0, &common.Message{
+		Callback: func() { acked++ },
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10, acked)
+	err = <-errCh
+	require.ErrorContains(t, err, "pulsar send message failed")
+	require.ErrorContains(t, err, "fake error")
+
+	producer.Close()
+	require.True(t, client.IsClosed())
+	// Close again should not panic.
+	producer.Close()
+	err = producer.AsyncSendMessage(ctx, "test", 0, &common.Message{})
+	require.True(t, cerror.ErrPulsarProducerClosed.Equal(err))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/columnselector"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
 	"github.com/pingcap/tiflow/cdc/sink/util"
@@ -106,14 +107,20 @@ func NewKafkaDMLSink(
 		return nil, errors.Trace(err)
 	}
 
+	columnSelector, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	encoderConfig, err := util.GetEncoderConfig(sinkURI, protocol, replicaConfig,
 		options.MaxMessageBytes)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
-	s, err := newDMLSink(ctx, p, adminClient, topicManager, eventRouter, encoderConfig,
-		replicaConfig.Sink.EncoderConcurrency, errCh)
+	s, err := newDMLSink(ctx, p, adminClient, topicManager, eventRouter, columnSelector,
+		encoderConfig, replicaConfig.Sink.EncoderConcurrency,
+		replicaConfig.Sink.TableMetricsEnabled(), errCh)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -0,0 +1,49 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+)
+
+// pulsarTopicManager is a manager for pulsar topics.
+// Topics are created by the brokers when they are first used,
+// so the manager only looks up the partitions of the topics.
+type pulsarTopicManager struct {
+	producers *pulsarsink.Producers
+}
+
+// NewPulsarTopicManager creates a new topic manager for pulsar.
+func NewPulsarTopicManager(producers *pulsarsink.Producers) TopicManager {
+	return &pulsarTopicManager{producers: producers}
+}
+
+// GetPartitionNum returns the number of partitions of the topic.
+func (m *pulsarTopicManager) GetPartitionNum(
+	_ context.Context, topic string,
+) (int32, error) {
+	partitionNum, err := m.producers.GetPartitionNum(topic)
+	return partitionNum, errors.Trace(err)
+}
+
+// CreateTopicAndWaitUntilVisible looks up the topic, which makes the
+// brokers create it if auto topic creation is enabled.
+func (m *pulsarTopicManager) CreateTopicAndWaitUntilVisible(
+	ctx context.Context, topic string,
+) (int32, error) {
+	return m.GetPartitionNum(ctx, topic)
+}
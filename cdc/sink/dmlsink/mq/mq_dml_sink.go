@@ -20,7 +20,9 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/columnselector"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/manager"
@@ -29,13 +31,17 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
 )
 
 // Assert EventSink[E event.TableEvent] implementation
-var _ dmlsink.EventSink[*model.RowChangedEvent] = (*dmlSink)(nil)
+var (
+	_ dmlsink.EventSink[*model.RowChangedEvent] = (*dmlSink)(nil)
+	_ dmlsink.TableStatsCollector               = (*dmlSink)(nil)
+)
 
 // dmlSink is the mq sink.
 // It will send the events to the MQ system.
@@ -48,6 +54,9 @@ type dmlSink struct {
 	worker *worker
 	// eventRouter used to route events to the right topic and partition.
 	eventRouter *dispatcher.EventRouter
+	// columnSelector used to select the columns sent to the downstream,
+	// it is nil if no column selector is configured.
+	columnSelector *columnselector.ColumnSelector
 	// topicManager used to manage topics.
 	// It is also responsible for creating topics.
 	topicManager manager.TopicManager
@@ -55,6 +64,8 @@ type dmlSink struct {
 	// adminClient is used to query kafka cluster information, it's shared among
 	// multiple place, it's sink's responsibility to close it.
 	adminClient kafka.ClusterAdminClient
+	// schemaCompatibility is nil if the encoder does not register schemas.
+	schemaCompatibility codec.SchemaCompatibilityReporter
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -68,8 +79,10 @@ func newDMLSink(
 	adminClient kafka.ClusterAdminClient,
 	topicManager manager.TopicManager,
 	eventRouter *dispatcher.EventRouter,
+	columnSelector *columnselector.ColumnSelector,
 	encoderConfig *common.Config,
 	encoderConcurrency int,
+	enableTableMetrics bool,
 	errCh chan error,
 ) (*dmlSink, error) {
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
@@ -81,18 +94,25 @@ func newDMLSink(
 
 	ctx, cancel := context.WithCancel(ctx)
 	statistics := metrics.NewStatistics(ctx, sink.RowSink)
+	if enableTableMetrics {
+		statistics.EnableTableMetrics()
+	}
 	worker := newWorker(changefeedID, encoderConfig.Protocol,
 		encoderBuilder, encoderConcurrency, producer, statistics)
+	schemaCompatibility, _ := encoderBuilder.(codec.SchemaCompatibilityReporter)
 	s := &dmlSink{
-		id:           changefeedID,
-		protocol:     encoderConfig.Protocol,
-		worker:       worker,
-		eventRouter:  eventRouter,
-		topicManager: topicManager,
-		adminClient:  adminClient,
-		ctx:          ctx,
-		cancel:       cancel,
-		dead:         make(chan struct{}),
+		id:             changefeedID,
+		protocol:       encoderConfig.Protocol,
+		worker:         worker,
+		eventRouter:    eventRouter,
+		columnSelector: columnSelector,
+		topicManager:   topicManager,
+		adminClient:    adminClient,
+		ctx:            ctx,
+		cancel:         cancel,
+		dead:           make(chan struct{}),
+
+		schemaCompatibility: schemaCompatibility,
 	}
 
 	// Spawn a goroutine to send messages by the worker.
@@ -125,17 +145,20 @@ func (s *dmlSink) WriteEvents(rows ...*dmlsink.RowChangeCallbackableEvent) error
 			row.Callback()
 			continue
 		}
+		if err := s.columnSelector.Apply(row.Event); err != nil {
+			return errors.Trace(err)
+		}
 		topic := s.eventRouter.GetTopicForRowChange(row.Event)
 		partitionNum, err := s.topicManager.GetPartitionNum(s.ctx, topic)
 		if err != nil {
 			return errors.Trace(err)
 		}
 		partition := s.eventRouter.GetPartitionForRowChange(row.Event, partitionNum)
+		key := TopicPartitionKey{Topic: topic, Partition: partition}
+		s.trackPosition(key, row)
 		// This never be blocked because this is an unbounded channel.
 		s.worker.msgChan.In() <- mqEvent{
-			key: TopicPartitionKey{
-				Topic: topic, Partition: partition,
-			},
+			key:      key,
 			rowEvent: row,
 		}
 	}
@@ -143,6 +166,29 @@ func (s *dmlSink) WriteEvents(rows ...*dmlsink.RowChangeCallbackableEvent) error
 	return nil
 }
 
+// trackPosition moves the table of the event to the position of the message
+// carrying the event once the message is produced.
+func (s *dmlSink) trackPosition(key TopicPartitionKey, row *dmlsink.RowChangeCallbackableEvent) {
+	tableID := row.Event.Table.TableID
+	callback := row.Callback
+	row.Callback = func() {
+		s.worker.positions.produceTable(tableID, key)
+		callback()
+	}
+}
+
+// GetTableStats returns the latest positions produced for the table, and the
+// compatibility of its schema if the encoder registers schemas.
+func (s *dmlSink) GetTableStats(span tablepb.Span) dmlsink.TableStats {
+	stats := dmlsink.TableStats{
+		MQPositions: s.worker.positions.get(span.TableID),
+	}
+	if s.schemaCompatibility != nil {
+		stats.SchemaCompatibility = s.schemaCompatibility.SchemaCompatibility(span.TableID)
+	}
+	return stats
+}
+
 // Close closes the sink.
 func (s *dmlSink) Close() {
 	if s.cancel != nil {
@@ -22,12 +22,15 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 )
 
 func initBroker(t *testing.T, partitionNum int) (*sarama.MockBroker, string) {
@@ -123,3 +126,66 @@ func TestWriteEvents(t *testing.T) {
 	require.Len(t, s.worker.producer.(*dmlproducer.MockDMLProducer).GetAllEvents(), 3000)
 	s.Close()
 }
+
+func TestGetTableStatsMQPositions(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader, topic := initBroker(t, kafka.DefaultMockPartitionNum)
+	defer leader.Close()
+	uriTemplate := "kafka://%s/%s?kafka-version=0.9.0.0&max-batch-size=1" +
+		"&max-message-bytes=1048576&partition-num=1" +
+		"&kafka-client-id=unit-test&auto-create-topic=false&compression=gzip&protocol=open-protocol"
+	uri := fmt.Sprintf(uriTemplate, leader.Addr(), topic)
+
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	s, err := NewKafkaDMLSink(ctx, sinkURI, replicaConfig, errCh,
+		kafka.NewMockFactory, dmlproducer.NewDMLMockProducer)
+	require.Nil(t, err)
+	defer s.Close()
+
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	// Nothing is produced yet.
+	require.Empty(t, s.GetTableStats(span1).MQPositions)
+
+	tableStatus := state.TableSinkSinking
+	acked := atomic.NewInt64(0)
+	newEvent := func(tableID model.TableID) *dmlsink.RowChangeCallbackableEvent {
+		return &dmlsink.RowChangeCallbackableEvent{
+			Event: &model.RowChangedEvent{
+				CommitTs: 1,
+				Table:    &model.TableName{Schema: "a", Table: "b", TableID: tableID},
+				Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+			},
+			Callback:  func() { acked.Inc() },
+			SinkState: &tableStatus,
+		}
+	}
+	// Both tables are dispatched to the same partition by the table name.
+	// Each event is sent in its own message, so the table 1 ends at
+	// the offset 2 and the table 2 ends at the offset 4.
+	partition := s.eventRouter.GetPartitionForRowChange(
+		newEvent(1).Event, kafka.DefaultMockPartitionNum)
+	require.Nil(t, s.WriteEvents(newEvent(1), newEvent(1), newEvent(1)))
+	require.Eventually(t, func() bool {
+		return acked.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Nil(t, s.WriteEvents(newEvent(2), newEvent(2)))
+	require.Eventually(t, func() bool {
+		return acked.Load() == 5
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, []tablepb.MQPosition{{Topic: topic, Partition: partition, Offset: 2}},
+		s.GetTableStats(span1).MQPositions)
+	require.Equal(t, []tablepb.MQPosition{{Topic: topic, Partition: partition, Offset: 4}},
+		s.GetTableStats(span2).MQPositions)
+	require.Empty(t, s.GetTableStats(spanz.TableIDToComparableSpan(3)).MQPositions)
+}
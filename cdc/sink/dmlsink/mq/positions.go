@@ -0,0 +1,89 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+)
+
+// producedPositions tracks the latest positions produced to the downstream.
+// The offset of a partition is recorded when a message is acknowledged by the
+// producer, and then the callbacks of the events in the message copy it to
+// the tables of the events. So a table is positioned at the message that
+// carries its last acknowledged event.
+type producedPositions struct {
+	mu         sync.Mutex
+	partitions map[TopicPartitionKey]int64
+	tables     map[model.TableID]map[TopicPartitionKey]int64
+}
+
+func newProducedPositions() *producedPositions {
+	return &producedPositions{
+		partitions: make(map[TopicPartitionKey]int64),
+		tables:     make(map[model.TableID]map[TopicPartitionKey]int64),
+	}
+}
+
+// producePartition records the offset of an acknowledged message.
+func (p *producedPositions) producePartition(key TopicPartitionKey, offset int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.partitions[key] = offset
+}
+
+// produceTable moves the table to the latest acknowledged message of
+// the partition.
+func (p *producedPositions) produceTable(tableID model.TableID, key TopicPartitionKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	offset, ok := p.partitions[key]
+	if !ok {
+		return
+	}
+	positions, ok := p.tables[tableID]
+	if !ok {
+		positions = make(map[TopicPartitionKey]int64)
+		p.tables[tableID] = positions
+	}
+	positions[key] = offset
+}
+
+// get returns the positions of the table, sorted by topic and partition.
+func (p *producedPositions) get(tableID model.TableID) []tablepb.MQPosition {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	positions := p.tables[tableID]
+	if len(positions) == 0 {
+		return nil
+	}
+	res := make([]tablepb.MQPosition, 0, len(positions))
+	for key, offset := range positions {
+		res = append(res, tablepb.MQPosition{
+			Topic:     key.Topic,
+			Partition: key.Partition,
+			Offset:    offset,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Topic != res[j].Topic {
+			return res[i].Topic < res[j].Topic
+		}
+		return res[i].Partition < res[j].Partition
+	})
+	return res
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	pulsarsink "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestNewPulsarDMLSinkUnsupportedProtocol(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sinkURI, err := url.Parse("pulsar://127.0.0.1:6650/test?protocol=open-protocol")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	client := pulsarsink.NewMockClient()
+	s, err := NewPulsarDMLSink(ctx, sinkURI, replicaConfig, make(chan error, 1),
+		pulsarsink.NewMockCreatorFactory(client), dmlproducer.NewPulsarDMLProducer)
+	require.True(t, cerror.ErrPulsarInvalidConfig.Equal(err))
+	require.Nil(t, s)
+}
+
+func TestPulsarWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sinkURI, err := url.Parse("pulsar://127.0.0.1:6650/test?protocol=canal-json")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"a.b"}, TopicRule: "{schema}_{table}", PartitionRule: "table"},
+	}
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	client := pulsarsink.NewMockClient()
+	client.DefaultPartitionNum = 3
+	s, err := NewPulsarDMLSink(ctx, sinkURI, replicaConfig, errCh,
+		pulsarsink.NewMockCreatorFactory(client), dmlproducer.NewPulsarDMLProducer)
+	require.NoError(t, err)
+
+	tableStatus := state.TableSinkSinking
+	acked := atomic.NewInt64(0)
+	events := make([]*dmlsink.RowChangeCallbackableEvent, 0, 10)
+	for i := 0; i < 10; i++ {
+		events = append(events, &dmlsink.RowChangeCallbackableEvent{
+			Event: &model.RowChangedEvent{
+				CommitTs: 1,
+				Table:    &model.TableName{Schema: "a", Table: "b"},
+				Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+			},
+			Callback:  func() { acked.Inc() },
+			SinkState: &tableStatus,
+		})
+	}
+	require.NoError(t, s.WriteEvents(events...))
+	require.Eventually(t, func() bool {
+		return acked.Load() == 10
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+
+	// All the events are routed to the topic of the table and
+	// dispatched to the same partition by the table name.
+	partition := s.eventRouter.GetPartitionForRowChange(events[0].Event, 3)
+	messages := client.GetMessages(fmt.Sprintf("a_b-partition-%d", partition))
+	require.NotEmpty(t, messages)
+	require.Contains(t, string(messages[0].Payload), `"table":"b"`)
+
+	s.Close()
+	require.True(t, client.IsClosed())
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/chann"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -70,6 +71,8 @@ type worker struct {
 
 	// producer is used to send the messages to the Kafka broker.
 	producer dmlproducer.DMLProducer
+	// positions tracks the latest positions produced for tables.
+	positions *producedPositions
 
 	// metricMQWorkerSendMessageDuration tracks the time duration cost on send messages.
 	metricMQWorkerSendMessageDuration prometheus.Observer
@@ -97,6 +100,7 @@ func newWorker(
 		ticker:                            time.NewTicker(flushInterval),
 		encoderGroup:                      codec.NewEncoderGroup(builder, encoderConcurrency, id),
 		producer:                          producer,
+		positions:                         newProducedPositions(),
 		metricMQWorkerSendMessageDuration: mq.WorkerSendMessageDuration.WithLabelValues(id.Namespace, id.ID),
 		metricMQWorkerBatchSize:           mq.WorkerBatchSize.WithLabelValues(id.Namespace, id.ID),
 		metricMQWorkerBatchDuration:       mq.WorkerBatchDuration.WithLabelValues(id.Namespace, id.ID),
@@ -294,7 +298,9 @@ func (w *worker) sendMessages(ctx context.Context) error {
 			if err := future.Ready(ctx); err != nil {
 				return errors.Trace(err)
 			}
+			key := TopicPartitionKey{Topic: future.Topic, Partition: future.Partition}
 			for _, message := range future.Messages {
+				w.trackPosition(key, message)
 				start := time.Now()
 				if err := w.statistics.RecordBatchExecution(func() (int, error) {
 					if err := w.producer.AsyncSendMessage(ctx, future.Topic, future.Partition, message); err != nil {
@@ -310,6 +316,18 @@ func (w *worker) sendMessages(ctx context.Context) error {
 	}
 }
 
+// trackPosition records the offset of the message once it is produced,
+// before the callbacks of its events are called.
+func (w *worker) trackPosition(key TopicPartitionKey, message *common.Message) {
+	callback := message.Callback
+	message.Callback = func() {
+		w.positions.producePartition(key, message.Offset)
+		if callback != nil {
+			callback()
+		}
+	}
+}
+
 func (w *worker) close() {
 	w.msgChan.CloseAndDrain()
 	w.producer.Close()
@@ -0,0 +1,299 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	subscriptionProto "github.com/pingcap/tiflow/proto/subscription"
+)
+
+// brokers holds the brokers of the subscribable changefeeds on this capture.
+var brokers = struct {
+	sync.RWMutex
+	m map[model.ChangeFeedID]*Broker
+}{m: make(map[model.ChangeFeedID]*Broker)}
+
+func registerBroker(b *Broker) {
+	brokers.Lock()
+	defer brokers.Unlock()
+	brokers.m[b.changefeedID] = b
+}
+
+func unregisterBroker(b *Broker) {
+	brokers.Lock()
+	defer brokers.Unlock()
+	// The broker may be replaced by a new one if the sink is restarted.
+	if brokers.m[b.changefeedID] == b {
+		delete(brokers.m, b.changefeedID)
+	}
+}
+
+func getBroker(changefeedID model.ChangeFeedID) *Broker {
+	brokers.RLock()
+	defer brokers.RUnlock()
+	return brokers.m[changefeedID]
+}
+
+// UpdateResolvedTs updates the resolved ts pushed to the consumers of the
+// changefeed. It's a no-op if the changefeed is not subscribable on this capture.
+func UpdateResolvedTs(changefeedID model.ChangeFeedID, resolvedTs model.Ts) {
+	if b := getBroker(changefeedID); b != nil {
+		b.updateResolvedTs(resolvedTs)
+	}
+}
+
+type pendingEvent struct {
+	event     *subscriptionProto.Event
+	callback  dmlsink.CallbackFunc
+	sinkState *state.TableSinkState
+}
+
+// Broker buffers the events of a changefeed until they are acknowledged by
+// the consumer. Only one consumer can subscribe to a broker at the same time,
+// a new subscription preempts the old one and all unacknowledged events are
+// sent to the new consumer again.
+type Broker struct {
+	changefeedID     model.ChangeFeedID
+	maxPendingEvents int
+
+	mu sync.Mutex
+	// notifyCh is closed and recreated when the state of the broker changes.
+	notifyCh chan struct{}
+	nextSeq  uint64
+	// pending holds the unacknowledged events ordered by their sequences.
+	pending []*pendingEvent
+	// sent is the number of events at the head of pending which have been
+	// sent to the current subscriber.
+	sent       int
+	resolvedTs model.Ts
+	// subscriber identifies the current subscriber, 0 means no subscriber.
+	subscriber uint64
+	closed     bool
+}
+
+// NewBroker creates a new Broker. At most maxPendingEvents events are
+// buffered, publishing more events blocks until some events are acknowledged.
+func NewBroker(changefeedID model.ChangeFeedID, maxPendingEvents int) *Broker {
+	return &Broker{
+		changefeedID:     changefeedID,
+		maxPendingEvents: maxPendingEvents,
+		notifyCh:         make(chan struct{}),
+		nextSeq:          1,
+	}
+}
+
+// notifyLocked wakes up all the waiters, it must be called with mu held.
+func (b *Broker) notifyLocked() {
+	close(b.notifyCh)
+	b.notifyCh = make(chan struct{})
+}
+
+func (b *Broker) closedError() error {
+	return cerror.ErrSubscriptionClosed.GenWithStackByArgs(b.changefeedID.ID)
+}
+
+// Publish appends an event to the broker, the callback is called after the
+// event is acknowledged. It blocks if the broker is full.
+func (b *Broker) Publish(
+	ctx context.Context,
+	event *subscriptionProto.Event,
+	callback dmlsink.CallbackFunc,
+	sinkState *state.TableSinkState,
+) error {
+	b.mu.Lock()
+	for len(b.pending) >= b.maxPendingEvents && !b.closed {
+		notifyCh := b.notifyCh
+		b.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-notifyCh:
+		}
+		b.mu.Lock()
+	}
+	defer b.mu.Unlock()
+	if b.closed {
+		return b.closedError()
+	}
+
+	event.Seq = b.nextSeq
+	b.nextSeq++
+	b.pending = append(b.pending, &pendingEvent{
+		event:     event,
+		callback:  callback,
+		sinkState: sinkState,
+	})
+	b.notifyLocked()
+	return nil
+}
+
+func (b *Broker) updateResolvedTs(resolvedTs model.Ts) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if resolvedTs <= b.resolvedTs {
+		return
+	}
+	b.resolvedTs = resolvedTs
+	b.notifyLocked()
+}
+
+// subscribe registers a new subscriber and returns its identifier. The
+// unacknowledged events are sent to the new subscriber from the beginning.
+func (b *Broker) subscribe() (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return 0, b.closedError()
+	}
+	b.subscriber++
+	b.sent = 0
+	b.notifyLocked()
+	return b.subscriber, nil
+}
+
+// ack acknowledges all events whose sequences are not greater than seq.
+func (b *Broker) ack(subscriber uint64, seq uint64) error {
+	b.mu.Lock()
+	if b.subscriber != subscriber {
+		b.mu.Unlock()
+		return cerror.ErrSubscriptionPreempted.GenWithStackByArgs(b.changefeedID.ID)
+	}
+	i := 0
+	for i < len(b.pending) && b.pending[i].event.Seq <= seq {
+		i++
+	}
+	acked := b.pending[:i]
+	b.pending = b.pending[i:]
+	b.sent -= i
+	if b.sent < 0 {
+		b.sent = 0
+	}
+	if i > 0 {
+		b.notifyLocked()
+	}
+	b.mu.Unlock()
+
+	for _, e := range acked {
+		e.callback()
+	}
+	return nil
+}
+
+// fetch returns at most maxEvents unsent events and the resolved ts if it's
+// greater than lastResolvedTs. It blocks until there is something to send.
+func (b *Broker) fetch(
+	ctx context.Context, subscriber uint64, lastResolvedTs model.Ts, maxEvents int,
+) ([]*subscriptionProto.Event, model.Ts, error) {
+	b.mu.Lock()
+	for {
+		if b.closed {
+			b.mu.Unlock()
+			return nil, 0, b.closedError()
+		}
+		if b.subscriber != subscriber {
+			b.mu.Unlock()
+			return nil, 0, cerror.ErrSubscriptionPreempted.GenWithStackByArgs(b.changefeedID.ID)
+		}
+		if b.sent < len(b.pending) || b.resolvedTs > lastResolvedTs {
+			break
+		}
+		notifyCh := b.notifyCh
+		b.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, 0, errors.Trace(ctx.Err())
+		case <-notifyCh:
+		}
+		b.mu.Lock()
+	}
+	defer b.mu.Unlock()
+
+	end := b.sent + maxEvents
+	if end > len(b.pending) {
+		end = len(b.pending)
+	}
+	events := make([]*subscriptionProto.Event, 0, end-b.sent)
+	for _, e := range b.pending[b.sent:end] {
+		events = append(events, e.event)
+	}
+	b.sent = end
+
+	resolvedTs := model.Ts(0)
+	if b.resolvedTs > lastResolvedTs {
+		resolvedTs = b.resolvedTs
+	}
+	return events, resolvedTs, nil
+}
+
+// dropStoppedEvents drops the events of the stopping tables. It's safe
+// because the progress of a stopping table sink is frozen, and the dropped
+// events will be replicated again by the new table sink.
+func (b *Broker) dropStoppedEvents() {
+	b.mu.Lock()
+	var dropped []*pendingEvent
+	kept := b.pending[:0]
+	sent := b.sent
+	for i, e := range b.pending {
+		if e.sinkState.Load() == state.TableSinkSinking {
+			kept = append(kept, e)
+			continue
+		}
+		dropped = append(dropped, e)
+		if i < b.sent {
+			sent--
+		}
+	}
+	// Clear the references of the dropped events at the tail.
+	for i := len(kept); i < len(b.pending); i++ {
+		b.pending[i] = nil
+	}
+	b.pending = kept
+	b.sent = sent
+	if len(dropped) > 0 {
+		b.notifyLocked()
+	}
+	b.mu.Unlock()
+
+	for _, e := range dropped {
+		e.callback()
+	}
+}
+
+// pendingCount returns the number of unacknowledged events.
+func (b *Broker) pendingCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+// close closes the broker, the unacknowledged events are discarded and the
+// blocked publishers and subscribers return.
+func (b *Broker) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	b.pending = nil
+	b.sent = 0
+	b.notifyLocked()
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	subscriptionProto "github.com/pingcap/tiflow/proto/subscription"
+	"github.com/stretchr/testify/require"
+)
+
+func publishEvents(
+	t *testing.T, b *Broker, commitTs []uint64, sinkState *state.TableSinkState, acked *int,
+) {
+	for _, ts := range commitTs {
+		err := b.Publish(context.Background(), &subscriptionProto.Event{CommitTs: ts},
+			func() { *acked++ }, sinkState)
+		require.NoError(t, err)
+	}
+}
+
+func TestBrokerFetchAndAck(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(model.DefaultChangeFeedID("test"), 10)
+	sinkState := state.TableSinkSinking
+	acked := 0
+	publishEvents(t, b, []uint64{1, 2, 3}, &sinkState, &acked)
+
+	ctx := context.Background()
+	subscriber, err := b.subscribe()
+	require.NoError(t, err)
+	events, resolvedTs, err := b.fetch(ctx, subscriber, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resolvedTs)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(1), events[0].Seq)
+	require.Equal(t, uint64(2), events[1].Seq)
+
+	b.updateResolvedTs(5)
+	events, resolvedTs, err = b.fetch(ctx, subscriber, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resolvedTs)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(3), events[0].Seq)
+
+	require.NoError(t, b.ack(subscriber, 2))
+	require.Equal(t, 2, acked)
+	require.Equal(t, 1, b.pendingCount())
+
+	// Nothing to send, fetch blocks until the context is done.
+	cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, _, err = b.fetch(cctx, subscriber, 5, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBrokerResendAfterResubscribe(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(model.DefaultChangeFeedID("test"), 10)
+	sinkState := state.TableSinkSinking
+	acked := 0
+	publishEvents(t, b, []uint64{1, 2, 3}, &sinkState, &acked)
+
+	ctx := context.Background()
+	old, err := b.subscribe()
+	require.NoError(t, err)
+	events, _, err := b.fetch(ctx, old, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.NoError(t, b.ack(old, 1))
+
+	// The new subscriber preempts the old one.
+	subscriber, err := b.subscribe()
+	require.NoError(t, err)
+	_, _, err = b.fetch(ctx, old, 0, 10)
+	require.True(t, cerror.ErrSubscriptionPreempted.Equal(err))
+	require.True(t, cerror.ErrSubscriptionPreempted.Equal(b.ack(old, 3)))
+
+	// The unacknowledged events are sent again.
+	events, _, err = b.fetch(ctx, subscriber, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(2), events[0].Seq)
+	require.Equal(t, uint64(3), events[1].Seq)
+	require.NoError(t, b.ack(subscriber, 3))
+	require.Equal(t, 3, acked)
+}
+
+func TestBrokerFlowControl(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(model.DefaultChangeFeedID("test"), 2)
+	sinkState := state.TableSinkSinking
+	acked := 0
+	publishEvents(t, b, []uint64{1, 2}, &sinkState, &acked)
+
+	// The broker is full, publishing blocks.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := b.Publish(ctx, &subscriptionProto.Event{CommitTs: 3}, func() {}, &sinkState)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- b.Publish(context.Background(),
+			&subscriptionProto.Event{CommitTs: 3}, func() {}, &sinkState)
+	}()
+	subscriber, err := b.subscribe()
+	require.NoError(t, err)
+	require.NoError(t, b.ack(subscriber, 1))
+	require.NoError(t, <-done)
+	require.Equal(t, 2, b.pendingCount())
+
+	// Closing the broker unblocks the publishers.
+	go func() {
+		done <- b.Publish(context.Background(),
+			&subscriptionProto.Event{CommitTs: 4}, func() {}, &sinkState)
+	}()
+	b.close()
+	require.True(t, cerror.ErrSubscriptionClosed.Equal(<-done))
+	_, err = b.subscribe()
+	require.True(t, cerror.ErrSubscriptionClosed.Equal(err))
+}
+
+func TestBrokerDropStoppedEvents(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(model.DefaultChangeFeedID("test"), 10)
+	sinking := state.TableSinkSinking
+	stopping := state.TableSinkSinking
+	acked := 0
+	publishEvents(t, b, []uint64{1}, &sinking, &acked)
+	publishEvents(t, b, []uint64{2}, &stopping, &acked)
+	publishEvents(t, b, []uint64{3}, &sinking, &acked)
+
+	subscriber, err := b.subscribe()
+	require.NoError(t, err)
+	events, _, err := b.fetch(context.Background(), subscriber, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	stopping.Store(state.TableSinkStopping)
+	b.dropStoppedEvents()
+	require.Equal(t, 1, acked)
+	require.Equal(t, 2, b.pendingCount())
+
+	events, _, err = b.fetch(context.Background(), subscriber, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(3), events[0].Seq)
+}
+
+func TestUpdateResolvedTs(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := model.DefaultChangeFeedID("test-update-resolved-ts")
+	b := NewBroker(changefeedID, 10)
+	// It's a no-op if the broker is not registered.
+	UpdateResolvedTs(changefeedID, 10)
+	require.Equal(t, uint64(0), b.resolvedTs)
+
+	registerBroker(b)
+	UpdateResolvedTs(changefeedID, 10)
+	require.Equal(t, uint64(10), b.resolvedTs)
+	// The resolved ts never goes back.
+	UpdateResolvedTs(changefeedID, 5)
+	require.Equal(t, uint64(10), b.resolvedTs)
+
+	unregisterBroker(b)
+	require.Nil(t, getBroker(changefeedID))
+}
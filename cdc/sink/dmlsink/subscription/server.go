@@ -0,0 +1,157 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"context"
+	"io"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	subscriptionProto "github.com/pingcap/tiflow/proto/subscription"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxEventsPerResponse is the max number of events batched in a response.
+const maxEventsPerResponse = 256
+
+// Assert ChangefeedSubscriptionServer implementation
+var _ subscriptionProto.ChangefeedSubscriptionServer = (*Server)(nil)
+
+// Server implements the gRPC ChangefeedSubscription service. It serves the
+// brokers of the subscription sinks running on this capture.
+type Server struct{}
+
+// NewServer creates a new Server.
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Subscribe implements ChangefeedSubscriptionServer.
+func (s *Server) Subscribe(stream subscriptionProto.ChangefeedSubscription_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	changefeedID := model.ChangeFeedID{Namespace: req.Namespace, ID: req.Changefeed}
+	if changefeedID.Namespace == "" {
+		changefeedID.Namespace = model.DefaultNamespace
+	}
+	b := getBroker(changefeedID)
+	if b == nil {
+		return status.Errorf(codes.NotFound,
+			"changefeed %s/%s is not subscribable on this capture",
+			changefeedID.Namespace, changefeedID.ID)
+	}
+	subscriber, err := b.subscribe()
+	if err != nil {
+		return toRPCError(err)
+	}
+	log.Info("consumer subscribed to changefeed",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("consumerID", req.ConsumerId),
+		zap.Uint64("subscriber", subscriber))
+	// The events acknowledged in the previous stream can be acked by the
+	// first request of a new stream.
+	if req.AckSeq != 0 {
+		if err := b.ack(subscriber, req.AckSeq); err != nil {
+			return toRPCError(err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	// NB: the goroutines are not waited, because Recv can only be canceled by
+	// returning the handler. errCh is buffered to avoid leaking them.
+	errCh := make(chan error, 2)
+	go func() {
+		errCh <- receiveAcks(stream, b, subscriber)
+	}()
+	go func() {
+		errCh <- sendEvents(ctx, stream, b, subscriber)
+	}()
+	for {
+		err := <-errCh
+		if err == nil {
+			// The consumer closes the send direction, it can still receive events.
+			continue
+		}
+		log.Info("consumer subscription stopped",
+			zap.String("namespace", changefeedID.Namespace),
+			zap.String("changefeed", changefeedID.ID),
+			zap.String("consumerID", req.ConsumerId),
+			zap.Uint64("subscriber", subscriber),
+			zap.Error(err))
+		return toRPCError(err)
+	}
+}
+
+func receiveAcks(
+	stream subscriptionProto.ChangefeedSubscription_SubscribeServer,
+	b *Broker, subscriber uint64,
+) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := b.ack(subscriber, req.AckSeq); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func sendEvents(
+	ctx context.Context,
+	stream subscriptionProto.ChangefeedSubscription_SubscribeServer,
+	b *Broker, subscriber uint64,
+) error {
+	lastResolvedTs := model.Ts(0)
+	for {
+		events, resolvedTs, err := b.fetch(ctx, subscriber, lastResolvedTs, maxEventsPerResponse)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if resolvedTs > lastResolvedTs {
+			lastResolvedTs = resolvedTs
+		}
+		err = stream.Send(&subscriptionProto.SubscribeResponse{
+			Events:     events,
+			ResolvedTs: resolvedTs,
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func toRPCError(err error) error {
+	switch {
+	case cerror.ErrSubscriptionPreempted.Equal(err):
+		return status.Error(codes.Aborted, err.Error())
+	case cerror.ErrSubscriptionClosed.Equal(err):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Cause(err) == context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return err
+}
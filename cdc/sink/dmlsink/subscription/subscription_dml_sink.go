@@ -0,0 +1,221 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/cdc/sink/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
+	subscriptionProto "github.com/pingcap/tiflow/proto/subscription"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxPendingEventsKey is the sink URI parameter of the max number of
+	// unacknowledged events buffered for the consumer.
+	MaxPendingEventsKey = "max-pending-events"
+
+	defaultMaxPendingEvents = 10240
+	// dropStoppedInterval is the interval to drop the events of the stopping
+	// tables, so that the tables can be closed without waiting for the consumer.
+	dropStoppedInterval = time.Second
+)
+
+// Assert EventSink[E event.TableEvent] implementation
+var _ dmlsink.EventSink[*model.SingleTableTxn] = (*DMLSink)(nil)
+
+// DMLSink is the subscription sink. It buffers the encoded transactions
+// in a Broker, and external consumers pull them through the gRPC
+// ChangefeedSubscription service. The callback of a transaction is called
+// only after the consumer acknowledges it, so the checkpoint of the
+// changefeed never exceeds the progress of the consumer.
+type DMLSink struct {
+	changefeedID model.ChangeFeedID
+	broker       *Broker
+
+	// encoder is not thread-safe.
+	encoderMu sync.Mutex
+	encoder   codec.TxnEventEncoder
+
+	statistics *metrics.Statistics
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	isDead atomic.Bool
+	dead   chan struct{}
+}
+
+// NewDMLSink creates a subscription sink and registers its broker, so that
+// the consumers can subscribe to the changefeed on this capture.
+func NewDMLSink(
+	ctx context.Context,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (*DMLSink, error) {
+	maxPendingEvents := defaultMaxPendingEvents
+	if s := sinkURI.Query().Get(MaxPendingEventsKey); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil || v <= 0 {
+			return nil, cerror.ErrSinkURIInvalid.GenWithStackByArgs(
+				MaxPendingEventsKey + " should be a positive integer")
+		}
+		maxPendingEvents = v
+	}
+
+	protocolStr := replicaConfig.Sink.Protocol
+	if protocolStr == "" {
+		protocolStr = config.ProtocolCanalJSON.String()
+	}
+	protocol, err := util.GetProtocol(protocolStr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	encoderConfig, err := util.GetEncoderConfig(sinkURI, protocol, replicaConfig, math.MaxInt)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	encoderBuilder, err := builder.NewTxnEventEncoderBuilder(encoderConfig)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	statistics := metrics.NewStatistics(ctx, sink.TxnSink)
+	if replicaConfig.Sink.TableMetricsEnabled() {
+		statistics.EnableTableMetrics()
+	}
+	s := &DMLSink{
+		changefeedID: changefeedID,
+		broker:       NewBroker(changefeedID, maxPendingEvents),
+		encoder:      encoderBuilder.Build(),
+		statistics:   statistics,
+		ctx:          ctx,
+		cancel:       cancel,
+		dead:         make(chan struct{}),
+	}
+	registerBroker(s.broker)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(ctx)
+		s.isDead.Store(true)
+		close(s.dead)
+	}()
+
+	log.Info("subscription sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("protocol", protocol.String()),
+		zap.Int("maxPendingEvents", maxPendingEvents))
+	return s, nil
+}
+
+func (s *DMLSink) run(ctx context.Context) {
+	ticker := time.NewTicker(dropStoppedInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.broker.dropStoppedEvents()
+		}
+	}
+}
+
+// WriteEvents encodes the transactions and publishes them to the broker.
+// It blocks if there are too many unacknowledged events.
+func (s *DMLSink) WriteEvents(txns ...*dmlsink.CallbackableEvent[*model.SingleTableTxn]) error {
+	if s.isDead.Load() {
+		return errors.Trace(errors.New("dead dmlSink"))
+	}
+
+	for _, txn := range txns {
+		if txn.GetTableSinkState() != state.TableSinkSinking {
+			// The table where the event comes from is in stopping, so it's safe
+			// to drop the event directly.
+			txn.Callback()
+			continue
+		}
+
+		event, err := s.encode(txn.Event)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		s.statistics.ObserveRows(txn.Event.Rows...)
+		if err := s.broker.Publish(s.ctx, event, txn.Callback, txn.SinkState); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (s *DMLSink) encode(txn *model.SingleTableTxn) (*subscriptionProto.Event, error) {
+	s.encoderMu.Lock()
+	defer s.encoderMu.Unlock()
+	if err := s.encoder.AppendTxnEvent(txn, nil); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var value bytes.Buffer
+	for _, msg := range s.encoder.Build() {
+		value.Write(msg.Value)
+	}
+	return &subscriptionProto.Event{
+		CommitTs: txn.CommitTs,
+		Schema:   txn.Table.Schema,
+		Table:    txn.Table.Table,
+		Value:    value.Bytes(),
+	}, nil
+}
+
+// Close closes the sink and unregisters its broker.
+func (s *DMLSink) Close() {
+	unregisterBroker(s.broker)
+	s.broker.close()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	if s.statistics != nil {
+		s.statistics.Close()
+	}
+}
+
+// Dead checks whether it's dead or not.
+func (s *DMLSink) Dead() <-chan struct{} {
+	return s.dead
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	subscriptionProto "github.com/pingcap/tiflow/proto/subscription"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func newTestClient(t *testing.T) subscriptionProto.ChangefeedSubscriptionClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	subscriptionProto.RegisterChangefeedSubscriptionServer(grpcServer, NewServer())
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return subscriptionProto.NewChangefeedSubscriptionClient(conn)
+}
+
+func generateTxnEvents(
+	count *atomic.Int64, sinkState *state.TableSinkState, commitTs ...uint64,
+) []*dmlsink.TxnCallbackableEvent {
+	txns := make([]*dmlsink.TxnCallbackableEvent, 0, len(commitTs))
+	for _, ts := range commitTs {
+		table := &model.TableName{Schema: "test", Table: "t"}
+		txns = append(txns, &dmlsink.TxnCallbackableEvent{
+			Event: &model.SingleTableTxn{
+				CommitTs: ts,
+				Table:    table,
+				Rows: []*model.RowChangedEvent{{
+					CommitTs: ts,
+					Table:    table,
+					Columns: []*model.Column{{
+						Name:  "c",
+						Type:  mysql.TypeVarchar,
+						Value: []byte("v"),
+					}},
+				}},
+			},
+			Callback:  func() { count.Inc() },
+			SinkState: sinkState,
+		})
+	}
+	return txns
+}
+
+func TestSubscribeChangefeed(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := model.DefaultChangeFeedID("test-subscribe")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = contextutil.PutChangefeedIDInCtx(ctx, changefeedID)
+
+	uri, err := url.Parse("subscription://?max-pending-events=16")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(uri))
+	s, err := NewDMLSink(ctx, uri, replicaConfig)
+	require.NoError(t, err)
+	defer s.Close()
+
+	client := newTestClient(t)
+	stream, err := client.Subscribe(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&subscriptionProto.SubscribeRequest{
+		Namespace:  changefeedID.Namespace,
+		Changefeed: changefeedID.ID,
+		ConsumerId: "consumer-1",
+	}))
+
+	var acked atomic.Int64
+	sinkState := state.TableSinkSinking
+	require.NoError(t, s.WriteEvents(generateTxnEvents(&acked, &sinkState, 10, 11)...))
+
+	received := make([]*subscriptionProto.Event, 0, 2)
+	for len(received) < 2 {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		received = append(received, resp.Events...)
+	}
+	require.Equal(t, uint64(10), received[0].CommitTs)
+	require.Equal(t, uint64(11), received[1].CommitTs)
+	require.Equal(t, "test", received[1].Schema)
+	require.Contains(t, string(received[1].Value), `"table":"t"`)
+	require.Equal(t, int64(0), acked.Load())
+
+	// The callbacks are called after the events are acknowledged.
+	require.NoError(t, stream.Send(&subscriptionProto.SubscribeRequest{AckSeq: received[1].Seq}))
+	require.Eventually(t, func() bool {
+		return acked.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	UpdateResolvedTs(changefeedID, 11)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Empty(t, resp.Events)
+	require.Equal(t, uint64(11), resp.ResolvedTs)
+}
+
+func TestResubscribeChangefeed(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := model.DefaultChangeFeedID("test-resubscribe")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = contextutil.PutChangefeedIDInCtx(ctx, changefeedID)
+
+	uri, err := url.Parse("subscription://")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(uri))
+	s, err := NewDMLSink(ctx, uri, replicaConfig)
+	require.NoError(t, err)
+	defer s.Close()
+
+	var acked atomic.Int64
+	sinkState := state.TableSinkSinking
+	require.NoError(t, s.WriteEvents(generateTxnEvents(&acked, &sinkState, 10, 11)...))
+
+	client := newTestClient(t)
+	subscribe := func() subscriptionProto.ChangefeedSubscription_SubscribeClient {
+		stream, err := client.Subscribe(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&subscriptionProto.SubscribeRequest{
+			Namespace:  changefeedID.Namespace,
+			Changefeed: changefeedID.ID,
+		}))
+		return stream
+	}
+	old := subscribe()
+	resp, err := old.Recv()
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+
+	// The unacknowledged events are delivered to the new consumer again,
+	// and the old one is preempted.
+	stream := subscribe()
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	_, err = old.Recv()
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// The sink is closed, the consumer should resubscribe later.
+	s.Close()
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	stream = subscribe()
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -17,6 +17,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 )
 
@@ -34,3 +36,24 @@ type backend interface {
 	// Close the backend.
 	Close() error
 }
+
+// retryCounter is implemented by backends that retry failed writes.
+type retryCounter interface {
+	// RetryCount returns the number of retried writes of the table.
+	// This is a thread-safe method.
+	RetryCount(tableID model.TableID) uint64
+}
+
+// connectionPooler is implemented by backends sharing a connection pool.
+type connectionPooler interface {
+	// ConnectionPool returns the status of the connection pool.
+	// This is a thread-safe method.
+	ConnectionPool() tablepb.ConnectionPool
+}
+
+// idempotencyTracker is implemented by backends that can write idempotently.
+type idempotencyTracker interface {
+	// Idempotency returns the idempotency status of the table.
+	// This is a thread-safe method.
+	Idempotency(tableID model.TableID) tablepb.Idempotency
+}
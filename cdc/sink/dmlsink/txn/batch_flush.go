@@ -0,0 +1,72 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+)
+
+// batchFlushStats collects statistics of batch flushes of each table.
+// A flush of a worker is counted for every table that has rows in it.
+type batchFlushStats struct {
+	mu     sync.Mutex
+	tables map[model.TableID]*tableBatchFlushes
+}
+
+type tableBatchFlushes struct {
+	// since is the time of the first flush of the table.
+	since   time.Time
+	flushes uint64
+	rows    uint64
+}
+
+func newBatchFlushStats() *batchFlushStats {
+	return &batchFlushStats{tables: make(map[model.TableID]*tableBatchFlushes)}
+}
+
+// observe records a flush with rows of the table.
+func (s *batchFlushStats) observe(tableID model.TableID, rows int, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tables[tableID]
+	if !ok {
+		t = &tableBatchFlushes{since: now}
+		s.tables[tableID] = t
+	}
+	t.flushes++
+	t.rows += uint64(rows)
+}
+
+// get returns the batch flush statistics of the table.
+func (s *batchFlushStats) get(tableID model.TableID, now time.Time) tablepb.BatchFlush {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tables[tableID]
+	if !ok {
+		return tablepb.BatchFlush{}
+	}
+	// Avoid a huge rate right after the first flush.
+	elapsed := now.Sub(t.since)
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	return tablepb.BatchFlush{
+		AvgBatchSize:     float64(t.rows) / float64(t.flushes),
+		FlushesPerSecond: float64(t.flushes) / elapsed.Seconds(),
+	}
+}
@@ -46,6 +46,20 @@ func (e *txnEvent) ConflictKeys(numSlots uint64) []uint64 {
 	return keys
 }
 
+// GroupID implements causality.groupedTxnEvent interface, transactions are
+// grouped by tables.
+func (e *txnEvent) GroupID() int64 {
+	if len(e.Event.Rows) == 0 {
+		return 0
+	}
+	return e.Event.Rows[0].Table.TableID
+}
+
+// Size implements causality.groupedTxnEvent interface.
+func (e *txnEvent) Size() int {
+	return len(e.Event.Rows)
+}
+
 // genTxnKeys returns hash keys for `txn`.
 func genTxnKeys(txn *model.SingleTableTxn) []uint64 {
 	if len(txn.Rows) == 0 {
@@ -0,0 +1,156 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+)
+
+// tableCompactor keeps the latest change of each row of a table.
+type tableCompactor struct {
+	tableInfo *timodel.TableInfo
+	// keys is the identity keys of rows in the order they first appear.
+	keys    []string
+	changes map[string]*sqlmodel.RowChange
+}
+
+// dmlCompactor merges the changes of the same row in all transactions of a
+// flush into one change, e.g., INSERT{1} + UPDATE{1 -> 2} -> INSERT{2} and
+// INSERT{1} + DELETE{1} -> DELETE{1}.
+// It is only used for tables with handle key, and it is safe because all
+// transactions of a flush are executed in one downstream transaction.
+type dmlCompactor struct {
+	maxTxnRow int
+	// tables is the compactors of tables in the order they first appear.
+	tables   []*tableCompactor
+	tableMap map[string]*tableCompactor
+}
+
+func newDMLCompactor(maxTxnRow int) *dmlCompactor {
+	return &dmlCompactor{
+		maxTxnRow: maxTxnRow,
+		tableMap:  make(map[string]*tableCompactor),
+	}
+}
+
+// tryAdd adds rows of the event to the compactor, it returns false if the
+// table of the event has no handle key and the rows can't be compacted.
+func (c *dmlCompactor) tryAdd(event *dmlsink.TxnCallbackableEvent) bool {
+	firstRow := event.Event.Rows[0]
+	tableColumns := firstRow.Columns
+	if firstRow.IsDelete() {
+		tableColumns = firstRow.PreColumns
+	}
+	if !hasHandleKey(tableColumns) {
+		return false
+	}
+
+	quoteTable := firstRow.Table.QuoteString()
+	table, ok := c.tableMap[quoteTable]
+	if !ok {
+		table = &tableCompactor{
+			tableInfo: model.BuildTiDBTableInfo(tableColumns, firstRow.IndexColumns),
+			changes:   make(map[string]*sqlmodel.RowChange),
+		}
+		c.tableMap[quoteTable] = table
+		c.tables = append(c.tables, table)
+	}
+
+	for _, row := range event.Event.Rows {
+		convertBinaryToString(row.Columns)
+		convertBinaryToString(row.PreColumns)
+
+		switch {
+		case row.IsInsert():
+			table.add(convert2RowChanges(row, table.tableInfo, sqlmodel.RowChangeInsert))
+		case row.IsDelete():
+			table.add(convert2RowChanges(row, table.tableInfo, sqlmodel.RowChangeDelete))
+		case row.IsUpdate():
+			change := convert2RowChanges(row, table.tableInfo, sqlmodel.RowChangeUpdate)
+			// An update of the handle key changes two rows.
+			if change.IsIdentityUpdated() {
+				deleteChange, insertChange := change.SplitUpdate()
+				table.add(deleteChange)
+				table.add(insertChange)
+				continue
+			}
+			table.add(change)
+		}
+	}
+	return true
+}
+
+func (t *tableCompactor) add(change *sqlmodel.RowChange) {
+	key := change.IdentityKey()
+	if prev, ok := t.changes[key]; ok {
+		change.Reduce(prev)
+	} else {
+		t.keys = append(t.keys, key)
+	}
+	t.changes[key] = change
+}
+
+// build generates SQLs for the compacted changes. For each table, old rows of
+// updates and deletes are removed by batched DELETEs at first, then new rows
+// of inserts and updates are written by multi-row INSERT ON DUPLICATE KEY
+// UPDATE statements. Removing the old rows at first avoids conflicts on
+// unique keys, and the statements are idempotent, so they are safe to be
+// re-executed.
+func (c *dmlCompactor) build() (sqls []string, values [][]interface{}) {
+	for _, table := range c.tables {
+		deleteRows := make([]*sqlmodel.RowChange, 0, len(table.keys))
+		upsertRows := make([]*sqlmodel.RowChange, 0, len(table.keys))
+		for _, key := range table.keys {
+			change := table.changes[key]
+			switch change.Type() {
+			case sqlmodel.RowChangeInsert:
+				upsertRows = append(upsertRows, change)
+			case sqlmodel.RowChangeDelete:
+				deleteRows = append(deleteRows, change)
+			case sqlmodel.RowChangeUpdate:
+				deleteChange, insertChange := change.SplitUpdate()
+				deleteRows = append(deleteRows, deleteChange)
+				upsertRows = append(upsertRows, insertChange)
+			}
+		}
+
+		for _, rows := range splitRowChanges(deleteRows, c.maxTxnRow) {
+			sql, value := sqlmodel.GenDeleteSQL(rows...)
+			sqls = append(sqls, sql)
+			values = append(values, value)
+		}
+		for _, rows := range splitRowChanges(upsertRows, c.maxTxnRow) {
+			sql, value := sqlmodel.GenInsertSQL(sqlmodel.DMLInsertOnDuplicateUpdate, rows...)
+			sqls = append(sqls, sql)
+			values = append(values, value)
+		}
+	}
+	return
+}
+
+// splitRowChanges splits rows into batches with at most batchSize rows.
+func splitRowChanges(rows []*sqlmodel.RowChange, batchSize int) [][]*sqlmodel.RowChange {
+	var batches [][]*sqlmodel.RowChange
+	for len(rows) > batchSize {
+		batches = append(batches, rows[:batchSize])
+		rows = rows[batchSize:]
+	}
+	if len(rows) > 0 {
+		batches = append(batches, rows)
+	}
+	return batches
+}
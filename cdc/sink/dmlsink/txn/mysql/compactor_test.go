@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/stretchr/testify/require"
+)
+
+func newCompactorTestColumns(table *model.TableName, id int, value string) []*model.Column {
+	idFlag := model.HandleKeyFlag | model.PrimaryKeyFlag
+	if table.Table == "no_pk" {
+		idFlag = 0
+	}
+	return []*model.Column{
+		{Name: "id", Type: mysql.TypeLong, Flag: idFlag, Value: id},
+		{Name: "v", Type: mysql.TypeVarchar, Value: value},
+	}
+}
+
+func newCompactorTestRow(
+	table *model.TableName, startTs model.Ts, pre, post []*model.Column,
+) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		StartTs:             startTs,
+		CommitTs:            startTs + 1,
+		Table:               table,
+		PreColumns:          pre,
+		Columns:             post,
+		IndexColumns:        [][]int{{0}},
+		ApproximateDataSize: 10,
+	}
+}
+
+func TestPrepareCompactedDMLs(t *testing.T) {
+	t.Parallel()
+
+	pk := &model.TableName{Schema: "test", Table: "pk"}
+	noPK := &model.TableName{Schema: "test", Table: "no_pk"}
+	cols := func(table *model.TableName, id int, value string) []*model.Column {
+		return newCompactorTestColumns(table, id, value)
+	}
+	txns := [][]*model.RowChangedEvent{
+		{
+			newCompactorTestRow(pk, 10, nil, cols(pk, 1, "a")),
+			newCompactorTestRow(pk, 10, nil, cols(pk, 2, "b")),
+		},
+		{
+			newCompactorTestRow(noPK, 11, nil, cols(noPK, 1, "a")),
+		},
+		{
+			newCompactorTestRow(pk, 12, cols(pk, 1, "a"), cols(pk, 1, "c")),
+			newCompactorTestRow(pk, 12, cols(pk, 2, "b"), nil),
+			newCompactorTestRow(pk, 12, nil, cols(pk, 3, "d")),
+		},
+		{
+			// The handle key is updated.
+			newCompactorTestRow(pk, 14, cols(pk, 3, "d"), cols(pk, 4, "d")),
+			newCompactorTestRow(pk, 14, cols(pk, 5, "e"), cols(pk, 5, "f")),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ms := newMySQLBackendWithoutDB(ctx)
+	ms.cfg.DMLCompactionEnable = true
+	ms.cfg.SafeMode = true
+	for _, rows := range txns {
+		ms.events = append(ms.events, &dmlsink.TxnCallbackableEvent{
+			Event: &model.SingleTableTxn{Rows: rows},
+		})
+		ms.rows += len(rows)
+	}
+	dmls := ms.prepareDMLs()
+	require.Equal(t, []model.Ts{10, 11, 12, 14}, dmls.startTs)
+	require.Equal(t, 8, dmls.rowCount)
+	require.Equal(t, []string{
+		// Tables without handle key are not compacted.
+		"REPLACE INTO `test`.`no_pk` (`id`,`v`) VALUES (?,?)",
+		"DELETE FROM `test`.`pk` WHERE (`id` = ?) OR (`id` = ?) OR (`id` = ?)",
+		"INSERT INTO `test`.`pk` (`id`,`v`) VALUES (?,?),(?,?),(?,?) " +
+			"ON DUPLICATE KEY UPDATE `id`=VALUES(`id`),`v`=VALUES(`v`)",
+	}, dmls.sqls)
+	require.Equal(t, [][]interface{}{
+		{1, "a"},
+		{2, 3, 5},
+		{1, "c", 4, "d", 5, "f"},
+	}, dmls.values)
+
+	// Statements are split by max-txn-row.
+	ms.cfg.MaxTxnRow = 2
+	dmls = ms.prepareDMLs()
+	require.Equal(t, []string{
+		"REPLACE INTO `test`.`no_pk` (`id`,`v`) VALUES (?,?)",
+		"DELETE FROM `test`.`pk` WHERE (`id` = ?) OR (`id` = ?)",
+		"DELETE FROM `test`.`pk` WHERE (`id` = ?)",
+		"INSERT INTO `test`.`pk` (`id`,`v`) VALUES (?,?),(?,?) " +
+			"ON DUPLICATE KEY UPDATE `id`=VALUES(`id`),`v`=VALUES(`v`)",
+		"INSERT INTO `test`.`pk` (`id`,`v`) VALUES (?,?) " +
+			"ON DUPLICATE KEY UPDATE `id`=VALUES(`id`),`v`=VALUES(`v`)",
+	}, dmls.sqls)
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"sync"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+)
+
+// idempotencyStats tracks the transactions of each table that are written
+// idempotently, i.e. in safe mode. It's shared by the backends of a sink.
+type idempotencyStats struct {
+	mu     sync.Mutex
+	tables map[model.TableID]tablepb.Idempotency
+}
+
+func newIdempotencyStats() *idempotencyStats {
+	return &idempotencyStats{tables: make(map[model.TableID]tablepb.Idempotency)}
+}
+
+// observe records a transaction of the table. The idempotency of the table
+// is active as long as its latest transaction is written idempotently.
+func (s *idempotencyStats) observe(tableID model.TableID, commitTs model.Ts, idempotent bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.tables[tableID]
+	t.Active = idempotent
+	if idempotent {
+		if t.CoveredStartTs == 0 || commitTs < t.CoveredStartTs {
+			t.CoveredStartTs = commitTs
+		}
+		if commitTs > t.CoveredEndTs {
+			t.CoveredEndTs = commitTs
+		}
+	}
+	s.tables[tableID] = t
+}
+
+// get returns the idempotency status of the table.
+func (s *idempotencyStats) get(tableID model.TableID) tablepb.Idempotency {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tables[tableID]
+}
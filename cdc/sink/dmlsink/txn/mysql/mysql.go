@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"math"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	dmysql "github.com/go-sql-driver/mysql"
@@ -33,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sink/metrics/txn"
@@ -54,15 +57,14 @@ const (
 	networkDriftDuration = 5 * time.Second
 
 	defaultDMLMaxRetry uint64 = 8
-
-	// To limit memory usage for prepared statements.
-	prepStmtCacheSize int = 16 * 1024
 )
 
 type mysqlBackend struct {
 	workerID    int
 	changefeed  string
 	db          *sql.DB
+	pool        *connPool
+	idempotency *idempotencyStats
 	cfg         *pmysql.Config
 	dmlMaxRetry uint64
 
@@ -79,6 +81,17 @@ type mysqlBackend struct {
 	// Indicate if the CachePrepStmts should be enabled or not
 	cachePrepStmts   bool
 	maxAllowedPacket int64
+
+	// retries counts retried writes of each table.
+	retriesMu sync.Mutex
+	retries   map[model.TableID]uint64
+}
+
+// connPool is the connection pool shared by the backends of a sink.
+type connPool struct {
+	db *sql.DB
+	// waiting is the number of backends waiting for a connection.
+	waiting atomic.Int32
 }
 
 // NewMySQLBackends creates a new MySQL sink using schema storage
@@ -118,16 +131,18 @@ func NewMySQLBackends(
 	// The first connection is held in the tx variable, which is used to manage the transaction.
 	// The second connection is requested through a call to s.db.Prepare
 	// in case of a cache miss for the statement query.
-	// The connection pool for CDC is configured with a static size, equal to the number of workers.
+	// The connection pool for CDC is configured with a static size, equal to the number of workers
+	// by default.
 	// CDC may hang at the "Get Connection" call is due to the limited size of the connection pool.
 	// When the connection pool is small,
 	// the chance of all connections being active at the same time increases,
 	// leading to exhaustion of available connections and a hang at the "Get Connection" call.
 	// This issue is less likely to occur when the connection pool is larger,
 	// as there are more connections available for use.
-	// Adding an extra connection to the connection pool solves the connection exhaustion issue.
-	db.SetMaxIdleConns(cfg.WorkerCount + 1)
-	db.SetMaxOpenConns(cfg.WorkerCount + 1)
+	// Adding an extra connection to the connection pool solves the connection exhaustion issue,
+	// so the pool size is at least worker-count + 1.
+	db.SetMaxIdleConns(cfg.ConnPoolSize)
+	db.SetMaxOpenConns(cfg.ConnPoolSize)
 
 	// Inherit the default value of the prepared statement cache from the SinkURI Options
 	cachePrepStmts := cfg.CachePrepStmts
@@ -143,17 +158,17 @@ func NewMySQLBackends(
 		}
 		// if maxPreparedStmtCount == 0,
 		// it means that the prepared statement cache is disabled on serverside.
-		// if maxPreparedStmtCount/(cfg.WorkerCount+1) == 0, for each single connection,
+		// if maxPreparedStmtCount/cfg.ConnPoolSize == 0, for each single connection,
 		// it means that the prepared statement cache is disabled on clientsize.
 		// Because each connection can not hold at lease one prepared statement.
-		if maxPreparedStmtCount == 0 || maxPreparedStmtCount/(cfg.WorkerCount+1) == 0 {
+		if maxPreparedStmtCount == 0 || maxPreparedStmtCount/cfg.ConnPoolSize == 0 {
 			cachePrepStmts = false
 		}
 	}
 
 	var stmtCache *lru.Cache
 	if cachePrepStmts {
-		stmtCache, err = lru.NewWithEvict(prepStmtCacheSize, func(key, value interface{}) {
+		stmtCache, err = lru.NewWithEvict(cfg.MaxCachedStmts, func(key, value interface{}) {
 			stmt := value.(*sql.Stmt)
 			stmt.Close()
 		})
@@ -169,12 +184,16 @@ func NewMySQLBackends(
 		maxAllowedPacket = int64(variable.DefMaxAllowedPacket)
 	}
 
+	pool := &connPool{db: db}
+	idempotency := newIdempotencyStats()
 	backends := make([]*mysqlBackend, 0, cfg.WorkerCount)
 	for i := 0; i < cfg.WorkerCount; i++ {
 		backends = append(backends, &mysqlBackend{
 			workerID:    i,
 			changefeed:  changefeed,
 			db:          db,
+			pool:        pool,
+			idempotency: idempotency,
 			cfg:         cfg,
 			dmlMaxRetry: defaultDMLMaxRetry,
 			statistics:  statistics,
@@ -185,6 +204,7 @@ func NewMySQLBackends(
 			stmtCache:                       stmtCache,
 			cachePrepStmts:                  cachePrepStmts,
 			maxAllowedPacket:                maxAllowedPacket,
+			retries:                         make(map[model.TableID]uint64),
 		})
 	}
 
@@ -530,6 +550,11 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 	// we only translate into insert when old value is enabled and safe mode is disabled
 	translateToInsert := s.cfg.EnableOldValue && !s.cfg.SafeMode
 
+	var compactor *dmlCompactor
+	if s.cfg.DMLCompactionEnable {
+		compactor = newDMLCompactor(s.cfg.MaxTxnRow)
+	}
+
 	rowCount := 0
 	approximateSize := int64(0)
 	for _, event := range s.events {
@@ -553,11 +578,20 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 			zap.Uint64("firstRowReplicatingTs", firstRow.ReplicatingTs),
 			zap.Bool("enableOldValue", s.cfg.EnableOldValue),
 			zap.Bool("safeMode", s.cfg.SafeMode))
+		s.idempotency.observe(firstRow.Table.TableID, firstRow.CommitTs, !translateToInsert)
 
 		if event.Callback != nil {
 			callbacks = append(callbacks, event.Callback)
 		}
 
+		// Merge changes of the same row in all transactions of the flush.
+		if compactor != nil && compactor.tryAdd(event) {
+			for _, row := range event.Event.Rows {
+				approximateSize += row.ApproximateDataSize
+			}
+			continue
+		}
+
 		// Determine whether to use batch dml feature here.
 		if s.cfg.BatchDMLEnable {
 			tableColumns := firstRow.Columns
@@ -632,6 +666,15 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 		}
 	}
 
+	if compactor != nil {
+		sql, value := compactor.build()
+		sqls = append(sqls, sql...)
+		values = append(values, value...)
+		for _, stmt := range sql {
+			approximateSize += int64(len(stmt))
+		}
+	}
+
 	if len(callbacks) == 0 {
 		callbacks = nil
 	}
@@ -740,7 +783,11 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 	// approximateSize is multiplied by 2 because in extreme circustumas, every
 	// byte in dmls can be escaped and adds one byte.
 	fallbackToSeqWay := dmls.approximateSize*2 > s.maxAllowedPacket
+	tries := 0
 	return retry.Do(pctx, func() error {
+		if tries++; tries > 1 {
+			s.observeRetry()
+		}
 		writeTimeout, _ := time.ParseDuration(s.cfg.WriteTimeout)
 		writeTimeout += networkDriftDuration
 
@@ -752,7 +799,9 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 		failpoint.Inject("MySQLSinkHangLongTime", func() { util.Hang(pctx, time.Hour) })
 
 		err := s.statistics.RecordBatchExecution(func() (int, error) {
+			s.pool.waiting.Add(1)
 			tx, err := s.db.BeginTx(pctx, nil)
+			s.pool.waiting.Add(-1)
 			if err != nil {
 				return 0, logDMLTxnErr(
 					cerror.WrapError(cerror.ErrMySQLTxnError, err),
@@ -815,6 +864,45 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 		retry.WithIsRetryableErr(isRetryableDMLError))
 }
 
+// observeRetry counts a retried write for the tables of pending events.
+func (s *mysqlBackend) observeRetry() {
+	s.retriesMu.Lock()
+	defer s.retriesMu.Unlock()
+	tables := make(map[model.TableID]struct{}, len(s.events))
+	for _, event := range s.events {
+		if len(event.Event.Rows) == 0 {
+			continue
+		}
+		tableID := event.Event.Rows[0].Table.TableID
+		if _, ok := tables[tableID]; !ok {
+			tables[tableID] = struct{}{}
+			s.retries[tableID]++
+		}
+	}
+}
+
+// RetryCount returns the number of retried writes of the table.
+func (s *mysqlBackend) RetryCount(tableID model.TableID) uint64 {
+	s.retriesMu.Lock()
+	defer s.retriesMu.Unlock()
+	return s.retries[tableID]
+}
+
+// Idempotency returns the idempotency status of the table.
+func (s *mysqlBackend) Idempotency(tableID model.TableID) tablepb.Idempotency {
+	return s.idempotency.get(tableID)
+}
+
+// ConnectionPool returns the status of the connection pool.
+func (s *mysqlBackend) ConnectionPool() tablepb.ConnectionPool {
+	stats := s.pool.db.Stats()
+	return tablepb.ConnectionPool{
+		Active:  uint32(stats.InUse),
+		Idle:    uint32(stats.Idle),
+		Waiting: uint32(s.pool.waiting.Load()),
+	}
+}
+
 func logDMLTxnErr(
 	err error, start time.Time, changefeed string,
 	query string, count int, startTs []model.Ts,
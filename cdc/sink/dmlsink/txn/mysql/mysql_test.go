@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -51,8 +52,9 @@ func newMySQLBackendWithoutDB(ctx context.Context) *mysqlBackend {
 	cfg := pmysql.NewConfig()
 	cfg.BatchDMLEnable = false
 	return &mysqlBackend{
-		statistics: metrics.NewStatistics(ctx, sink.TxnSink),
-		cfg:        cfg,
+		statistics:  metrics.NewStatistics(ctx, sink.TxnSink),
+		cfg:         cfg,
+		idempotency: newIdempotencyStats(),
 	}
 }
 
@@ -169,6 +171,55 @@ func TestPrepareDML(t *testing.T) {
 	}
 }
 
+func TestPrepareDMLIdempotency(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ms := newMySQLBackendWithoutDB(ctx)
+	ms.cfg.EnableOldValue = true
+	prepare := func(commitTs, replicatingTs model.Ts) {
+		ms.events = []*dmlsink.TxnCallbackableEvent{{
+			Event: &model.SingleTableTxn{Rows: []*model.RowChangedEvent{{
+				StartTs:       commitTs - 1,
+				CommitTs:      commitTs,
+				ReplicatingTs: replicatingTs,
+				Table:         &model.TableName{Schema: "s1", Table: "t1", TableID: 1},
+				Columns: []*model.Column{{
+					Name:  "a",
+					Type:  mysql.TypeLong,
+					Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+					Value: 1,
+				}},
+			}}},
+		}}
+		ms.rows = 1
+		ms.prepareDMLs()
+	}
+	require.Equal(t, tablepb.Idempotency{}, ms.Idempotency(1))
+
+	// Transactions committed before the table is replicating are written
+	// in safe mode.
+	prepare(5, 10)
+	prepare(8, 10)
+	require.Equal(t, tablepb.Idempotency{
+		Active: true, CoveredStartTs: 5, CoveredEndTs: 8,
+	}, ms.Idempotency(1))
+
+	prepare(12, 10)
+	require.Equal(t, tablepb.Idempotency{
+		Active: false, CoveredStartTs: 5, CoveredEndTs: 8,
+	}, ms.Idempotency(1))
+
+	// All transactions are written in safe mode if it's enabled.
+	ms.cfg.SafeMode = true
+	prepare(15, 10)
+	require.Equal(t, tablepb.Idempotency{
+		Active: true, CoveredStartTs: 5, CoveredEndTs: 15,
+	}, ms.Idempotency(1))
+	require.Equal(t, tablepb.Idempotency{}, ms.Idempotency(2))
+}
+
 func TestAdjustSQLMode(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -577,6 +628,79 @@ func TestExecDMLRollbackErrRetryable(t *testing.T) {
 	})
 	err = sink.Flush(context.Background())
 	require.Equal(t, errLockDeadlock, errors.Cause(err))
+	require.Equal(t, uint64(1), sink.RetryCount(1))
+	require.Zero(t, sink.RetryCount(2))
+
+	require.Nil(t, sink.Close())
+}
+
+func TestConnectionPool(t *testing.T) {
+	rows := []*model.RowChangedEvent{
+		{
+			Table: &model.TableName{Schema: "s1", Table: "t1", TableID: 1},
+			Columns: []*model.Column{
+				{
+					Name:  "a",
+					Type:  mysql.TypeLong,
+					Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+					Value: 1,
+				},
+			},
+		},
+	}
+
+	dbIndex := 0
+	mockGetDBConn := func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() { dbIndex++ }()
+
+		if dbIndex == 0 {
+			// test db
+			db, err := pmysql.MockTestDB(true)
+			require.Nil(t, err)
+			return db, nil
+		}
+
+		// normal db
+		db, mock := newTestMockDB(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("REPLACE INTO `s1`.`t1` (`a`) VALUES (?)").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(
+		"mysql://127.0.0.1:4000/?time-zone=UTC&worker-count=1&cache-prep-stmts=false")
+	require.Nil(t, err)
+	sink, err := newMySQLBackend(ctx, sinkURI,
+		config.GetDefaultReplicaConfig(), mockGetDBConn)
+	require.Nil(t, err)
+	require.Equal(t, tablepb.ConnectionPool{Idle: 1}, sink.ConnectionPool())
+
+	// Hold the only connection, so the flush has to wait for it.
+	sink.db.SetMaxOpenConns(1)
+	conn, err := sink.db.Conn(ctx)
+	require.Nil(t, err)
+	require.Equal(t, tablepb.ConnectionPool{Active: 1}, sink.ConnectionPool())
+
+	_ = sink.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: rows},
+	})
+	flushed := make(chan error, 1)
+	go func() {
+		flushed <- sink.Flush(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return sink.ConnectionPool() == tablepb.ConnectionPool{Active: 1, Waiting: 1}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Nil(t, conn.Close())
+	require.Nil(t, <-flushed)
+	require.Equal(t, tablepb.ConnectionPool{Idle: 1}, sink.ConnectionPool())
 
 	require.Nil(t, sink.Close())
 }
@@ -0,0 +1,283 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/metrics/txn"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	poracle "github.com/pingcap/tiflow/pkg/sink/oracle"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	// Max interval for flushing transactions to the downstream.
+	maxFlushInterval = 10 * time.Millisecond
+
+	// networkDriftDuration is used to construct a context timeout for database operations.
+	networkDriftDuration = 5 * time.Second
+
+	defaultDMLMaxRetry uint64 = 8
+)
+
+type oracleBackend struct {
+	workerID    int
+	changefeed  string
+	db          *sql.DB
+	cfg         *poracle.Config
+	dmlMaxRetry uint64
+
+	events []*dmlsink.TxnCallbackableEvent
+	rows   int
+
+	statistics                    *metrics.Statistics
+	metricTxnSinkDMLBatchCommit   prometheus.Observer
+	metricTxnSinkDMLBatchCallback prometheus.Observer
+}
+
+// NewOracleBackends creates a new Oracle sink backend.
+func NewOracleBackends(
+	ctx context.Context,
+	sinkURI *url.URL,
+	dbConnFactory poracle.Factory,
+	statistics *metrics.Statistics,
+) ([]*oracleBackend, error) {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	changefeed := fmt.Sprintf("%s.%s", changefeedID.Namespace, changefeedID.ID)
+
+	cfg := poracle.NewConfig()
+	if err := cfg.Apply(sinkURI); err != nil {
+		return nil, err
+	}
+
+	db, err := dbConnFactory(ctx, poracle.GenerateDSN(sinkURI))
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxIdleConns(cfg.WorkerCount)
+	db.SetMaxOpenConns(cfg.WorkerCount)
+
+	backends := make([]*oracleBackend, 0, cfg.WorkerCount)
+	for i := 0; i < cfg.WorkerCount; i++ {
+		backends = append(backends, &oracleBackend{
+			workerID:    i,
+			changefeed:  changefeed,
+			db:          db,
+			cfg:         cfg,
+			dmlMaxRetry: defaultDMLMaxRetry,
+			statistics:  statistics,
+
+			metricTxnSinkDMLBatchCommit:   txn.SinkDMLBatchCommit.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+			metricTxnSinkDMLBatchCallback: txn.SinkDMLBatchCallback.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		})
+	}
+
+	log.Info("Oracle backends is created",
+		zap.String("changefeed", changefeed),
+		zap.Int("workerCount", cfg.WorkerCount))
+	return backends, nil
+}
+
+// OnTxnEvent implements interface backend.
+// It adds the event to the buffer, and return true if it needs flush immediately.
+func (s *oracleBackend) OnTxnEvent(event *dmlsink.TxnCallbackableEvent) (needFlush bool) {
+	s.events = append(s.events, event)
+	s.rows += len(event.Event.Rows)
+	return event.Event.ToWaitFlush() || s.rows >= s.cfg.MaxTxnRow
+}
+
+// Flush implements interface backend.
+func (s *oracleBackend) Flush(ctx context.Context) (err error) {
+	if s.rows == 0 {
+		return
+	}
+
+	for _, event := range s.events {
+		s.statistics.ObserveRows(event.Event.Rows...)
+	}
+
+	dmls := s.prepareDMLs()
+	log.Debug("prepare DMLs", zap.Any("rows", s.rows),
+		zap.Strings("sqls", dmls.sqls), zap.Any("values", dmls.values))
+
+	start := time.Now()
+	if err := s.execDMLWithMaxRetries(ctx, dmls); err != nil {
+		if errors.Cause(err) != context.Canceled {
+			log.Error("execute DMLs failed", zap.Error(err))
+		}
+		return errors.Trace(err)
+	}
+	startCallback := time.Now()
+	for _, callback := range dmls.callbacks {
+		callback()
+	}
+	s.metricTxnSinkDMLBatchCommit.Observe(startCallback.Sub(start).Seconds())
+	s.metricTxnSinkDMLBatchCallback.Observe(time.Since(startCallback).Seconds())
+
+	// Be friently to GC.
+	for i := 0; i < len(s.events); i++ {
+		s.events[i] = nil
+	}
+	if cap(s.events) > 1024 {
+		s.events = make([]*dmlsink.TxnCallbackableEvent, 0)
+	}
+	s.events = s.events[:0]
+	s.rows = 0
+	return
+}
+
+// Close implements interface backend.
+func (s *oracleBackend) Close() (err error) {
+	if s.db != nil {
+		err = s.db.Close()
+		s.db = nil
+	}
+	return
+}
+
+// MaxFlushInterval implements interface backend.
+func (s *oracleBackend) MaxFlushInterval() time.Duration {
+	return maxFlushInterval
+}
+
+type preparedDMLs struct {
+	startTs   []model.Ts
+	sqls      []string
+	values    [][]interface{}
+	callbacks []dmlsink.CallbackFunc
+	rowCount  int
+}
+
+// prepareDMLs converts the buffered events to the Oracle statements.
+func (s *oracleBackend) prepareDMLs() *preparedDMLs {
+	startTs := make([]model.Ts, 0, len(s.events))
+	sqls := make([]string, 0, s.rows)
+	values := make([][]interface{}, 0, s.rows)
+	callbacks := make([]dmlsink.CallbackFunc, 0, len(s.events))
+	rowCount := 0
+	for _, event := range s.events {
+		if len(event.Event.Rows) == 0 {
+			continue
+		}
+		if len(startTs) == 0 || startTs[len(startTs)-1] != event.Event.StartTs {
+			startTs = append(startTs, event.Event.StartTs)
+		}
+		for _, row := range event.Event.Rows {
+			rowSQLs, rowValues := poracle.PrepareDMLs(row)
+			sqls = append(sqls, rowSQLs...)
+			values = append(values, rowValues...)
+			rowCount++
+		}
+		if event.Callback != nil {
+			callbacks = append(callbacks, event.Callback)
+		}
+	}
+	return &preparedDMLs{
+		startTs:   startTs,
+		sqls:      sqls,
+		values:    values,
+		callbacks: callbacks,
+		rowCount:  rowCount,
+	}
+}
+
+func (s *oracleBackend) execDMLWithMaxRetries(pctx context.Context, dmls *preparedDMLs) error {
+	start := time.Now()
+	return retry.Do(pctx, func() error {
+		writeTimeout, _ := time.ParseDuration(s.cfg.WriteTimeout)
+		writeTimeout += networkDriftDuration
+
+		err := s.statistics.RecordBatchExecution(func() (int, error) {
+			tx, err := s.db.BeginTx(pctx, nil)
+			if err != nil {
+				return 0, logDMLTxnErr(
+					cerror.WrapError(cerror.ErrOracleTxnError, err),
+					start, s.changefeed, "BEGIN", dmls.rowCount, dmls.startTs)
+			}
+
+			for i, query := range dmls.sqls {
+				args := dmls.values[i]
+				log.Debug("exec row", zap.Int("workerID", s.workerID),
+					zap.String("sql", query), zap.Any("args", args))
+				ctx, cancelFunc := context.WithTimeout(pctx, writeTimeout)
+				_, execError := tx.ExecContext(ctx, query, args...)
+				cancelFunc()
+				if execError != nil {
+					err := logDMLTxnErr(
+						cerror.WrapError(cerror.ErrOracleTxnError, execError),
+						start, s.changefeed, query, dmls.rowCount, dmls.startTs)
+					if rbErr := tx.Rollback(); rbErr != nil {
+						if errors.Cause(rbErr) != context.Canceled {
+							log.Warn("failed to rollback txn", zap.Error(rbErr))
+						}
+					}
+					return 0, err
+				}
+			}
+
+			if err = tx.Commit(); err != nil {
+				return 0, logDMLTxnErr(
+					cerror.WrapError(cerror.ErrOracleTxnError, err),
+					start, s.changefeed, "COMMIT", dmls.rowCount, dmls.startTs)
+			}
+			return dmls.rowCount, nil
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		log.Debug("Exec Rows succeeded",
+			zap.Int("workerID", s.workerID),
+			zap.String("changefeed", s.changefeed),
+			zap.Int("numOfRows", dmls.rowCount))
+		return nil
+	}, retry.WithBackoffBaseDelay(poracle.BackoffBaseDelay.Milliseconds()),
+		retry.WithBackoffMaxDelay(poracle.BackoffMaxDelay.Milliseconds()),
+		retry.WithMaxTries(s.dmlMaxRetry),
+		retry.WithIsRetryableErr(cerror.IsRetryableError))
+}
+
+func logDMLTxnErr(
+	err error, start time.Time, changefeed string,
+	query string, count int, startTs []model.Ts,
+) error {
+	if len(query) > 1024 {
+		query = query[:1024]
+	}
+	if cerror.IsRetryableError(err) {
+		log.Warn("execute DMLs with error, retry later",
+			zap.Error(err), zap.Duration("duration", time.Since(start)),
+			zap.String("query", query), zap.Int("count", count),
+			zap.Uint64s("startTs", startTs),
+			zap.String("changefeed", changefeed))
+	} else {
+		log.Error("execute DMLs with error, can not retry",
+			zap.Error(err), zap.Duration("duration", time.Since(start)),
+			zap.String("query", query), zap.Int("count", count),
+			zap.String("changefeed", changefeed))
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBackend(t *testing.T, expect func(mock sqlmock.Sqlmock)) *oracleBackend {
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(),
+		model.DefaultChangeFeedID("test-changefeed"))
+	mockGetDBConn := func(ctx context.Context, dsn string) (*sql.DB, error) {
+		require.Equal(t, "oracle://127.0.0.1:1521/ORCL", dsn)
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.Nil(t, err)
+		expect(mock)
+		mock.ExpectClose()
+		return db, nil
+	}
+	sinkURI, err := url.Parse("oracle://127.0.0.1:1521/ORCL?worker-count=1")
+	require.Nil(t, err)
+	backends, err := NewOracleBackends(ctx, sinkURI, mockGetDBConn,
+		metrics.NewStatistics(ctx, sink.TxnSink))
+	require.Nil(t, err)
+	require.Len(t, backends, 1)
+	backends[0].dmlMaxRetry = 2
+	return backends[0]
+}
+
+func newTestRows() []*model.RowChangedEvent {
+	table := &model.TableName{Schema: "s1", Table: "t1", TableID: 1}
+	return []*model.RowChangedEvent{
+		{
+			StartTs:  1,
+			CommitTs: 2,
+			Table:    table,
+			Columns: []*model.Column{
+				{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+				{Name: "b", Type: mysql.TypeVarchar, Value: "test"},
+			},
+		},
+		{
+			StartTs:  1,
+			CommitTs: 2,
+			Table:    table,
+			PreColumns: []*model.Column{
+				{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 2},
+				{Name: "b", Type: mysql.TypeVarchar, Value: "test"},
+			},
+		},
+	}
+}
+
+const testMerge = `MERGE INTO "S1"."T1" t USING (SELECT :1 "A", :2 "B" FROM DUAL) s ` +
+	`ON (t."A" = s."A") WHEN MATCHED THEN UPDATE SET t."B" = s."B" ` +
+	`WHEN NOT MATCHED THEN INSERT ("A", "B") VALUES (s."A", s."B")`
+
+func TestOracleBackendFlush(t *testing.T) {
+	t.Parallel()
+
+	backend := newTestBackend(t, func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(testMerge).WithArgs(1, "test").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DELETE FROM "S1"."T1" WHERE "A" = :1`).WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+	})
+
+	flushed := false
+	needFlush := backend.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event:    &model.SingleTableTxn{Rows: newTestRows()},
+		Callback: func() { flushed = true },
+	})
+	require.False(t, needFlush)
+	require.Nil(t, backend.Flush(context.Background()))
+	require.True(t, flushed)
+	require.Equal(t, 0, backend.rows)
+	require.Nil(t, backend.Close())
+}
+
+func TestOracleBackendFlushRetry(t *testing.T) {
+	t.Parallel()
+
+	backend := newTestBackend(t, func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(testMerge).WithArgs(1, "test").
+			WillReturnError(errors.New("ORA-03113: end-of-file on communication channel"))
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectExec(testMerge).WithArgs(1, "test").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DELETE FROM "S1"."T1" WHERE "A" = :1`).WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+	})
+
+	flushed := false
+	backend.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event:    &model.SingleTableTxn{Rows: newTestRows()},
+		Callback: func() { flushed = true },
+	})
+	require.Nil(t, backend.Flush(context.Background()))
+	require.True(t, flushed)
+	require.Nil(t, backend.Close())
+}
@@ -18,17 +18,21 @@ import (
 	"net/url"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn/mysql"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn/oracle"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/causality"
 	"github.com/pingcap/tiflow/pkg/config"
 	psink "github.com/pingcap/tiflow/pkg/sink"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	poracle "github.com/pingcap/tiflow/pkg/sink/oracle"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -38,7 +42,10 @@ const (
 )
 
 // Assert EventSink[E event.TableEvent] implementation
-var _ dmlsink.EventSink[*model.SingleTableTxn] = (*dmlSink)(nil)
+var (
+	_ dmlsink.EventSink[*model.SingleTableTxn] = (*dmlSink)(nil)
+	_ dmlsink.TableStatsCollector              = (*dmlSink)(nil)
+)
 
 // dmlSink is the dmlSink for SingleTableTxn.
 type dmlSink struct {
@@ -50,6 +57,13 @@ type dmlSink struct {
 	dead   chan struct{}
 	isDead atomic.Bool
 
+	// conflicts counts transactions of each table that conflict with
+	// unfinished transactions in the conflict detector.
+	conflictsMu sync.Mutex
+	conflicts   map[model.TableID]uint64
+
+	batchFlushes *batchFlushStats
+
 	statistics *metrics.Statistics
 }
 
@@ -69,6 +83,9 @@ func NewMySQLSink(
 ) (*dmlSink, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	statistics := metrics.NewStatistics(ctx, psink.TxnSink)
+	if replicaConfig.Sink.TableMetricsEnabled() {
+		statistics.EnableTableMetrics()
+	}
 
 	backendImpls, err := mysql.NewMySQLBackends(ctx, sinkURI, replicaConfig, GetDBConnImpl, statistics)
 	if err != nil {
@@ -87,19 +104,58 @@ func NewMySQLSink(
 	return sink, nil
 }
 
+// GetOracleDBConnImpl is the implementation of poracle.Factory.
+// Exported for testing.
+var GetOracleDBConnImpl poracle.Factory = poracle.CreateOracleDBConn
+
+// NewOracleSink creates an oracle dmlSink with given parameters. It shares
+// the conflict detector and the workers with the mysql dmlSink.
+func NewOracleSink(
+	ctx context.Context,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan<- error,
+	conflictDetectorSlots uint64,
+) (*dmlSink, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	statistics := metrics.NewStatistics(ctx, psink.TxnSink)
+	if replicaConfig.Sink.TableMetricsEnabled() {
+		statistics.EnableTableMetrics()
+	}
+
+	backendImpls, err := oracle.NewOracleBackends(ctx, sinkURI, GetOracleDBConnImpl, statistics)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	backends := make([]backend, 0, len(backendImpls))
+	for _, impl := range backendImpls {
+		backends = append(backends, impl)
+	}
+	sink := newSink(ctx, backends, errCh, conflictDetectorSlots)
+	sink.statistics = statistics
+	sink.cancel = cancel
+
+	return sink, nil
+}
+
 func newSink(ctx context.Context, backends []backend,
 	errCh chan<- error, conflictDetectorSlots uint64,
 ) *dmlSink {
 	ctx, cancel := context.WithCancel(ctx)
 	sink := &dmlSink{
-		workers: make([]*worker, 0, len(backends)),
-		cancel:  cancel,
-		dead:    make(chan struct{}),
+		workers:   make([]*worker, 0, len(backends)),
+		cancel:    cancel,
+		dead:      make(chan struct{}),
+		conflicts: make(map[model.TableID]uint64),
+
+		batchFlushes: newBatchFlushStats(),
 	}
 
 	g, ctx1 := errgroup.WithContext(ctx)
 	for i, backend := range backends {
-		w := newWorker(ctx1, i, backend, len(backends))
+		w := newWorker(ctx1, i, backend, len(backends), sink.batchFlushes)
 		g.Go(func() error { return w.runLoop() })
 		sink.workers = append(sink.workers, w)
 	}
@@ -136,11 +192,51 @@ func (s *dmlSink) WriteEvents(txnEvents ...*dmlsink.TxnCallbackableEvent) error
 			continue
 		}
 
-		s.conflictDetector.Add(newTxnEvent(txn))
+		if s.conflictDetector.Add(newTxnEvent(txn)) {
+			s.observeConflict(txn.Event)
+		}
 	}
 	return nil
 }
 
+func (s *dmlSink) observeConflict(txn *model.SingleTableTxn) {
+	if len(txn.Rows) == 0 {
+		return
+	}
+	s.conflictsMu.Lock()
+	defer s.conflictsMu.Unlock()
+	s.conflicts[txn.Rows[0].Table.TableID]++
+}
+
+// GetTableStats implements dmlsink.TableStatsCollector.
+func (s *dmlSink) GetTableStats(span tablepb.Span) dmlsink.TableStats {
+	s.conflictsMu.Lock()
+	stats := dmlsink.TableStats{ConflictCount: s.conflicts[span.TableID]}
+	s.conflictsMu.Unlock()
+	stats.BatchFlush = s.batchFlushes.get(span.TableID, time.Now())
+	txns, maxSize := s.conflictDetector.InFlight(span.TableID)
+	stats.Causality = tablepb.Causality{
+		GroupsInFlight: uint64(txns),
+		MaxGroupSize:   uint64(maxSize),
+	}
+
+	for _, w := range s.workers {
+		if counter, ok := w.backend.(retryCounter); ok {
+			stats.RetryCount += counter.RetryCount(span.TableID)
+		}
+	}
+	// All backends share the same connection pool and idempotency status.
+	if len(s.workers) > 0 {
+		if pooler, ok := s.workers[0].backend.(connectionPooler); ok {
+			stats.ConnectionPool = pooler.ConnectionPool()
+		}
+		if tracker, ok := s.workers[0].backend.(idempotencyTracker); ok {
+			stats.Idempotency = tracker.Idempotency(span.TableID)
+		}
+	}
+	return stats
+}
+
 // Close closes the dmlSink. It won't wait for all pending items backend handled.
 func (s *dmlSink) Close() {
 	if s.cancel != nil {
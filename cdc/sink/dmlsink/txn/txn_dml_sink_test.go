@@ -16,14 +16,17 @@ package txn
 import (
 	"context"
 	"sort"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
 )
 
@@ -237,3 +240,280 @@ func TestGenKeys(t *testing.T) {
 		require.Equal(t, tc.expected, keys)
 	}
 }
+
+// execLog records the executed rows of all workers in order.
+type execLog struct {
+	mu      sync.Mutex
+	workers map[int]struct{}
+	values  []interface{}
+}
+
+type recorder struct {
+	blackhole
+	id  int
+	log *execLog
+}
+
+func (r *recorder) OnTxnEvent(e *dmlsink.TxnCallbackableEvent) bool {
+	r.log.mu.Lock()
+	r.log.workers[r.id] = struct{}{}
+	r.log.values = append(r.log.values, e.Event.Rows[0].Columns[1].Value)
+	r.log.mu.Unlock()
+	return r.blackhole.OnTxnEvent(e)
+}
+
+// TestTxnSinkParallelWithinTable checks the transactions of one table are
+// dispatched to different workers if they don't conflict, and the conflicting
+// transactions are executed in order.
+func TestTxnSinkParallelWithinTable(t *testing.T) {
+	t.Parallel()
+
+	log := &execLog{workers: make(map[int]struct{})}
+	bes := make([]backend, 0, 4)
+	for i := 0; i < 4; i++ {
+		bes = append(bes, &recorder{id: i, log: log})
+	}
+	errCh := make(chan error, 1)
+	sink := newSink(context.Background(), bes, errCh, DefaultConflictDetectorSlots)
+	defer sink.Close()
+
+	var handled uint32 = 0
+	writeRow := func(key, value int) {
+		sinkState := new(state.TableSinkState)
+		*sinkState = state.TableSinkSinking
+		sink.WriteEvents(&dmlsink.CallbackableEvent[*model.SingleTableTxn]{
+			Event: &model.SingleTableTxn{
+				Rows: []*model.RowChangedEvent{{
+					Table: &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+					Columns: []*model.Column{
+						{Name: "a", Value: key, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+						{Name: "b", Value: value},
+					},
+					IndexColumns: [][]int{{0}},
+				}},
+			},
+			Callback:  func() { atomic.AddUint32(&handled, 1) },
+			SinkState: sinkState,
+		})
+	}
+	// Rows with different keys don't conflict.
+	for i := 0; i < 100; i++ {
+		writeRow(i, i)
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&handled) == 100
+	}, 5*time.Second, 10*time.Millisecond)
+	log.mu.Lock()
+	require.Greater(t, len(log.workers), 1)
+	log.values = nil
+	log.mu.Unlock()
+
+	// Rows with the same key are executed in order.
+	for i := 0; i < 100; i++ {
+		writeRow(1000, i)
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&handled) == 200
+	}, 5*time.Second, 10*time.Millisecond)
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	require.Len(t, log.values, 100)
+	for i, value := range log.values {
+		require.Equal(t, i, value)
+	}
+}
+
+type retryingBlackhole struct {
+	blackhole
+	retries map[model.TableID]uint64
+}
+
+func (b *retryingBlackhole) RetryCount(tableID model.TableID) uint64 {
+	return b.retries[tableID]
+}
+
+func TestGetTableStatsConflictAndRetryCount(t *testing.T) {
+	t.Parallel()
+
+	bes := make([]backend, 0, 2)
+	for i := 0; i < 2; i++ {
+		bes = append(bes, &retryingBlackhole{
+			blackhole: blackhole{blockOnEvents: 1},
+			retries:   map[model.TableID]uint64{1: uint64(i + 1)},
+		})
+	}
+	errCh := make(chan error, 1)
+	sink := newSink(context.Background(), bes, errCh, DefaultConflictDetectorSlots)
+	defer sink.Close()
+
+	var handled uint32 = 0
+	writeTxn := func(tableID model.TableID) {
+		sinkState := new(state.TableSinkState)
+		*sinkState = state.TableSinkSinking
+		table := &model.TableName{Schema: "test", Table: "t", TableID: tableID}
+		sink.WriteEvents(&dmlsink.CallbackableEvent[*model.SingleTableTxn]{
+			Event: &model.SingleTableTxn{
+				Table: table,
+				Rows: []*model.RowChangedEvent{{
+					Table:   table,
+					Columns: []*model.Column{{Name: "a", Value: 1}},
+				}},
+			},
+			Callback:  func() { atomic.AddUint32(&handled, 1) },
+			SinkState: sinkState,
+		})
+	}
+	// Rows without keys conflict with unfinished rows of the same table.
+	for i := 0; i < 3; i++ {
+		writeTxn(1)
+	}
+	writeTxn(2)
+
+	stats := sink.GetTableStats(spanz.TableIDToComparableSpan(1))
+	require.Equal(t, uint64(2), stats.ConflictCount)
+	require.Equal(t, uint64(3), stats.RetryCount)
+	stats = sink.GetTableStats(spanz.TableIDToComparableSpan(2))
+	require.Zero(t, stats.ConflictCount)
+	require.Zero(t, stats.RetryCount)
+
+	for _, be := range bes {
+		atomic.StoreInt32(&be.(*retryingBlackhole).blockOnEvents, 0)
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&handled) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestGetTableStatsBatchFlush(t *testing.T) {
+	t.Parallel()
+
+	bes := []backend{&blackhole{}}
+	errCh := make(chan error, 1)
+	sink := newSink(context.Background(), bes, errCh, DefaultConflictDetectorSlots)
+	defer sink.Close()
+
+	span := spanz.TableIDToComparableSpan(1)
+	require.Equal(t, tablepb.BatchFlush{}, sink.GetTableStats(span).BatchFlush)
+
+	// The blackhole backend flushes every transaction.
+	table := &model.TableName{Schema: "test", Table: "t", TableID: 1}
+	for i := 0; i < 3; i++ {
+		sinkState := new(state.TableSinkState)
+		*sinkState = state.TableSinkSinking
+		sink.WriteEvents(&dmlsink.CallbackableEvent[*model.SingleTableTxn]{
+			Event: &model.SingleTableTxn{
+				Table: table,
+				Rows: []*model.RowChangedEvent{
+					{Table: table, Columns: []*model.Column{{Name: "a", Value: 1}}},
+					{Table: table, Columns: []*model.Column{{Name: "a", Value: 2}}},
+				},
+			},
+			Callback:  func() {},
+			SinkState: sinkState,
+		})
+	}
+	require.Eventually(t, func() bool {
+		sink.batchFlushes.mu.Lock()
+		defer sink.batchFlushes.mu.Unlock()
+		flushes := sink.batchFlushes.tables[1]
+		return flushes != nil && flushes.flushes == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	stats := sink.GetTableStats(span).BatchFlush
+	require.Equal(t, float64(2), stats.AvgBatchSize)
+	require.Greater(t, stats.FlushesPerSecond, float64(0))
+}
+
+type pooledBlackhole struct {
+	blackhole
+	pool tablepb.ConnectionPool
+}
+
+func (b *pooledBlackhole) ConnectionPool() tablepb.ConnectionPool {
+	return b.pool
+}
+
+func TestGetTableStatsConnectionPool(t *testing.T) {
+	t.Parallel()
+
+	pool := tablepb.ConnectionPool{Active: 2, Idle: 1, Waiting: 3}
+	bes := []backend{&pooledBlackhole{pool: pool}, &pooledBlackhole{pool: pool}}
+	errCh := make(chan error, 1)
+	sink := newSink(context.Background(), bes, errCh, DefaultConflictDetectorSlots)
+	defer sink.Close()
+
+	// The pool is shared by backends, so it's not summed up.
+	require.Equal(t, pool,
+		sink.GetTableStats(spanz.TableIDToComparableSpan(1)).ConnectionPool)
+}
+
+type idempotentBlackhole struct {
+	blackhole
+	idempotency map[model.TableID]tablepb.Idempotency
+}
+
+func (b *idempotentBlackhole) Idempotency(tableID model.TableID) tablepb.Idempotency {
+	return b.idempotency[tableID]
+}
+
+func TestGetTableStatsIdempotency(t *testing.T) {
+	t.Parallel()
+
+	idempotency := map[model.TableID]tablepb.Idempotency{
+		1: {Active: true, CoveredStartTs: 5, CoveredEndTs: 8},
+	}
+	bes := []backend{
+		&idempotentBlackhole{idempotency: idempotency},
+		&idempotentBlackhole{idempotency: idempotency},
+	}
+	errCh := make(chan error, 1)
+	sink := newSink(context.Background(), bes, errCh, DefaultConflictDetectorSlots)
+	defer sink.Close()
+
+	require.Equal(t, idempotency[1],
+		sink.GetTableStats(spanz.TableIDToComparableSpan(1)).Idempotency)
+	require.Equal(t, tablepb.Idempotency{},
+		sink.GetTableStats(spanz.TableIDToComparableSpan(2)).Idempotency)
+}
+
+func TestGetTableStatsCausality(t *testing.T) {
+	t.Parallel()
+
+	bes := []backend{&blackhole{blockOnEvents: 1}}
+	errCh := make(chan error, 1)
+	sink := newSink(context.Background(), bes, errCh, DefaultConflictDetectorSlots)
+	defer sink.Close()
+
+	var handled uint32 = 0
+	writeTxn := func(tableID model.TableID, rows int) {
+		sinkState := new(state.TableSinkState)
+		*sinkState = state.TableSinkSinking
+		table := &model.TableName{Schema: "test", Table: "t", TableID: tableID}
+		txn := &model.SingleTableTxn{Table: table}
+		for i := 0; i < rows; i++ {
+			txn.Rows = append(txn.Rows, &model.RowChangedEvent{
+				Table:   table,
+				Columns: []*model.Column{{Name: "a", Value: i}},
+			})
+		}
+		sink.WriteEvents(&dmlsink.CallbackableEvent[*model.SingleTableTxn]{
+			Event:     txn,
+			Callback:  func() { atomic.AddUint32(&handled, 1) },
+			SinkState: sinkState,
+		})
+	}
+	writeTxn(1, 2)
+	writeTxn(1, 1)
+	writeTxn(2, 3)
+
+	span := spanz.TableIDToComparableSpan(1)
+	require.Equal(t, tablepb.Causality{GroupsInFlight: 2, MaxGroupSize: 2},
+		sink.GetTableStats(span).Causality)
+	require.Equal(t, tablepb.Causality{GroupsInFlight: 1, MaxGroupSize: 3},
+		sink.GetTableStats(spanz.TableIDToComparableSpan(2)).Causality)
+
+	atomic.StoreInt32(&bes[0].(*blackhole).blockOnEvents, 0)
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&handled) == 3 &&
+			sink.GetTableStats(span).Causality == tablepb.Causality{}
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/metrics/txn"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/chann"
@@ -41,6 +42,8 @@ type worker struct {
 	txnCh   *chann.DrainableChann[txnWithNotifier]
 	backend backend
 
+	batchFlushes *batchFlushStats
+
 	// Metrics.
 	metricConflictDetectDuration prometheus.Observer
 	metricQueueDuration          prometheus.Observer
@@ -52,9 +55,14 @@ type worker struct {
 	flushInterval     time.Duration
 	hasPending        bool
 	wantMoreCallbacks []func()
+	// pendingRows is the number of rows of each table pending to flush.
+	pendingRows map[model.TableID]int
 }
 
-func newWorker(ctx context.Context, ID int, backend backend, workerCount int) *worker {
+func newWorker(
+	ctx context.Context, ID int, backend backend, workerCount int,
+	batchFlushes *batchFlushStats,
+) *worker {
 	wid := fmt.Sprintf("%d", ID)
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
 	return &worker{
@@ -66,6 +74,8 @@ func newWorker(ctx context.Context, ID int, backend backend, workerCount int) *w
 		txnCh:   chann.NewAutoDrainChann[txnWithNotifier](chann.Cap(-1 /*unbounded*/)),
 		backend: backend,
 
+		batchFlushes: batchFlushes,
+
 		metricConflictDetectDuration: txn.ConflictDetectDuration.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricQueueDuration:          txn.QueueDuration.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricTxnWorkerFlushDuration: txn.WorkerFlushDuration.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
@@ -75,6 +85,7 @@ func newWorker(ctx context.Context, ID int, backend backend, workerCount int) *w
 		flushInterval:     backend.MaxFlushInterval(),
 		hasPending:        false,
 		wantMoreCallbacks: make([]func(), 0, 1024),
+		pendingRows:       make(map[model.TableID]int),
 	}
 }
 
@@ -162,6 +173,9 @@ func (w *worker) onEvent(txn txnWithNotifier) bool {
 	w.metricQueueDuration.Observe(time.Since(txn.start).Seconds())
 	w.metricTxnWorkerHandledRows.Add(float64(len(txn.Event.Rows)))
 	w.wantMoreCallbacks = append(w.wantMoreCallbacks, txn.wantMore)
+	if rows := txn.Event.Rows; len(rows) > 0 {
+		w.pendingRows[rows[0].Table.TableID] += len(rows)
+	}
 	return w.backend.OnTxnEvent(txn.txnEvent.TxnCallbackableEvent)
 }
 
@@ -192,6 +206,11 @@ func (w *worker) doFlush(flushTimeSlice *time.Duration) error {
 			// Resize the buffer if it's too big.
 			w.wantMoreCallbacks = make([]func(), 0, 1024)
 		}
+		now := time.Now()
+		for tableID, rows := range w.pendingRows {
+			w.batchFlushes.observe(tableID, rows, now)
+			delete(w.pendingRows, tableID)
+		}
 	}
 
 	w.hasPending = false
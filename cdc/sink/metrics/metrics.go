@@ -56,6 +56,36 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 18),
 		}, []string{"namespace", "changefeed", "type"}) // type is for `sinkType`
 
+	// ExecBatchDurationHistogram records the time to write a batch to the downstream.
+	ExecBatchDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "sink",
+			Name:      "batch_exec_duration",
+			Help:      "Bucketed histogram of the time (s) to write a batch to the downstream.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 20), // 1ms~524s
+		}, []string{"namespace", "changefeed", "type"}) // type is for `sinkType`
+
+	// ExecDMLRowsCounter is the counter of the rows emitted to the downstream,
+	// by the DML type.
+	ExecDMLRowsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sink",
+			Name:      "dml_rows_count",
+			Help:      "Total count of the rows emitted to the downstream by the DML type.",
+		}, []string{"namespace", "changefeed", "type", "dml_type"}) // type is for `sinkType`
+
+	// TableExecDMLRowsCounter is the same as ExecDMLRowsCounter but labeled by
+	// table, it's only updated if the table metrics of the changefeed is enabled.
+	TableExecDMLRowsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sink",
+			Name:      "table_dml_rows_count",
+			Help:      "Total count of the rows of a table emitted to the downstream by the DML type.",
+		}, []string{"namespace", "changefeed", "table", "dml_type"})
+
 	// ExecutionErrorCounter is the counter of execution errors.
 	ExecutionErrorCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -67,11 +97,14 @@ var (
 )
 
 // InitMetrics registers all metrics in this file.
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(ExecBatchHistogram)
 	registry.MustRegister(ExecDDLHistogram)
 	registry.MustRegister(LargeRowSizeHistogram)
 	registry.MustRegister(ExecutionErrorCounter)
+	registry.MustRegister(ExecBatchDurationHistogram)
+	registry.MustRegister(ExecDMLRowsCounter)
+	registry.MustRegister(TableExecDMLRowsCounter)
 
 	tablesink.InitMetrics(registry)
 	txn.InitMetrics(registry)
@@ -23,6 +23,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+const (
+	dmlTypeInsert = "insert"
+	dmlTypeUpdate = "update"
+	dmlTypeDelete = "delete"
+)
+
 // NewStatistics creates a statistics
 func NewStatistics(ctx context.Context, sinkType sink.Type) *Statistics {
 	statistics := &Statistics{
@@ -38,6 +44,13 @@ func NewStatistics(ctx context.Context, sinkType sink.Type) *Statistics {
 	statistics.metricExecBatchHis = ExecBatchHistogram.WithLabelValues(namespcae, changefeedID, s)
 	statistics.metricRowSizeHis = LargeRowSizeHistogram.WithLabelValues(namespcae, changefeedID, s)
 	statistics.metricExecErrCnt = ExecutionErrorCounter.WithLabelValues(namespcae, changefeedID, s)
+	statistics.metricExecBatchDurationHis = ExecBatchDurationHistogram.
+		WithLabelValues(namespcae, changefeedID, s)
+	statistics.metricExecDMLRowsCnt = make(map[string]prometheus.Counter, 3)
+	for _, dmlType := range []string{dmlTypeInsert, dmlTypeUpdate, dmlTypeDelete} {
+		statistics.metricExecDMLRowsCnt[dmlType] = ExecDMLRowsCounter.
+			WithLabelValues(namespcae, changefeedID, s, dmlType)
+	}
 	return statistics
 }
 
@@ -56,11 +69,40 @@ type Statistics struct {
 	metricRowSizeHis prometheus.Observer
 	// Counter for sink error.
 	metricExecErrCnt prometheus.Counter
+	// Histogram for DML batch executing duration.
+	metricExecBatchDurationHis prometheus.Observer
+	// Counters for DML rows, the key is the DML type.
+	metricExecDMLRowsCnt map[string]prometheus.Counter
+
+	// enableTableMetrics indicates whether to count the DML rows per table.
+	enableTableMetrics bool
+}
+
+// EnableTableMetrics enables counting the DML rows per table. It must be
+// called before the statistics is used.
+func (b *Statistics) EnableTableMetrics() {
+	b.enableTableMetrics = true
+}
+
+func getDMLType(row *model.RowChangedEvent) string {
+	if row.IsInsert() {
+		return dmlTypeInsert
+	}
+	if row.IsDelete() {
+		return dmlTypeDelete
+	}
+	return dmlTypeUpdate
 }
 
 // ObserveRows stats all received `RowChangedEvent`s.
 func (b *Statistics) ObserveRows(rows ...*model.RowChangedEvent) {
 	for _, row := range rows {
+		dmlType := getDMLType(row)
+		b.metricExecDMLRowsCnt[dmlType].Inc()
+		if b.enableTableMetrics && row.Table != nil {
+			TableExecDMLRowsCounter.WithLabelValues(b.changefeedID.Namespace,
+				b.changefeedID.ID, row.Table.QuoteString(), dmlType).Inc()
+		}
 		// only track row with data size larger than `rowSizeLowBound` to reduce
 		// the overhead of calling `Observe` method.
 		if row.ApproximateDataSize >= largeRowSizeLowBound {
@@ -71,11 +113,13 @@ func (b *Statistics) ObserveRows(rows ...*model.RowChangedEvent) {
 
 // RecordBatchExecution stats batch executors which return (batchRowCount, error).
 func (b *Statistics) RecordBatchExecution(executor func() (int, error)) error {
+	start := time.Now()
 	batchSize, err := executor()
 	if err != nil {
 		b.metricExecErrCnt.Inc()
 		return err
 	}
+	b.metricExecBatchDurationHis.Observe(time.Since(start).Seconds())
 	b.metricExecBatchHis.Observe(float64(batchSize))
 	return nil
 }
@@ -97,4 +141,15 @@ func (b *Statistics) Close() {
 	ExecBatchHistogram.DeleteLabelValues(b.changefeedID.Namespace, b.changefeedID.ID)
 	LargeRowSizeHistogram.DeleteLabelValues(b.changefeedID.Namespace, b.changefeedID.ID)
 	ExecutionErrorCounter.DeleteLabelValues(b.changefeedID.Namespace, b.changefeedID.ID)
+	labels := prometheus.Labels{
+		"namespace":  b.changefeedID.Namespace,
+		"changefeed": b.changefeedID.ID,
+		"type":       b.sinkType.String(),
+	}
+	ExecBatchDurationHistogram.DeletePartialMatch(labels)
+	ExecDMLRowsCounter.DeletePartialMatch(labels)
+	if b.enableTableMetrics {
+		delete(labels, "type")
+		TableExecDMLRowsCounter.DeletePartialMatch(labels)
+	}
 }
@@ -0,0 +1,94 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Stats is the statistics of the sink of a changefeed, it's collected from
+// the sink metrics of the current capture.
+type Stats struct {
+	// Rows is the number of rows emitted to the downstream by the DML type.
+	Rows map[string]uint64
+	// TableRows is the same as Rows but grouped by table, it's only collected
+	// if the table metrics of the changefeed is enabled.
+	TableRows map[string]map[string]uint64
+
+	BatchCount uint64
+	BatchRows  uint64
+	// BatchExecDuration is the total seconds spent on writing batches.
+	BatchExecDuration float64
+
+	ConflictDetectCount uint64
+	// ConflictDetectDuration is the total seconds spent on conflict detection.
+	ConflictDetectDuration float64
+
+	ExecutionErrors uint64
+}
+
+// CollectStats collects the sink statistics of the changefeed from the gatherer.
+func CollectStats(
+	gatherer prometheus.Gatherer, changefeedID model.ChangeFeedID,
+) (*Stats, error) {
+	families, err := gatherer.Gather()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	stats := &Stats{
+		Rows:      make(map[string]uint64),
+		TableRows: make(map[string]map[string]uint64),
+	}
+	for _, family := range families {
+		for _, m := range family.Metric {
+			labels := getLabels(m)
+			if labels["namespace"] != changefeedID.Namespace ||
+				labels["changefeed"] != changefeedID.ID {
+				continue
+			}
+			switch family.GetName() {
+			case "ticdc_sink_dml_rows_count":
+				stats.Rows[labels["dml_type"]] += uint64(m.GetCounter().GetValue())
+			case "ticdc_sink_table_dml_rows_count":
+				table := labels["table"]
+				if stats.TableRows[table] == nil {
+					stats.TableRows[table] = make(map[string]uint64)
+				}
+				stats.TableRows[table][labels["dml_type"]] += uint64(m.GetCounter().GetValue())
+			case "ticdc_sink_batch_row_count":
+				stats.BatchCount += m.GetHistogram().GetSampleCount()
+				stats.BatchRows += uint64(m.GetHistogram().GetSampleSum())
+			case "ticdc_sink_batch_exec_duration":
+				stats.BatchExecDuration += m.GetHistogram().GetSampleSum()
+			case "ticdc_sink_txn_conflict_detect_duration":
+				stats.ConflictDetectCount += m.GetHistogram().GetSampleCount()
+				stats.ConflictDetectDuration += m.GetHistogram().GetSampleSum()
+			case "ticdc_sink_execution_error":
+				stats.ExecutionErrors += uint64(m.GetCounter().GetValue())
+			}
+		}
+	}
+	return stats, nil
+}
+
+func getLabels(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.Label))
+	for _, l := range m.Label {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
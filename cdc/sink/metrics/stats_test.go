@@ -0,0 +1,68 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectStats(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+	InitMetrics(registry)
+
+	changefeedID := model.DefaultChangeFeedID("test-collect-stats")
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(), changefeedID)
+	statistics := NewStatistics(ctx, sink.TxnSink)
+	statistics.EnableTableMetrics()
+	defer statistics.Close()
+
+	table := &model.TableName{Schema: "test", Table: "t"}
+	col := &model.Column{Name: "a", Value: 1}
+	statistics.ObserveRows(
+		&model.RowChangedEvent{Table: table, Columns: []*model.Column{col}},
+		&model.RowChangedEvent{Table: table, Columns: []*model.Column{col}},
+		&model.RowChangedEvent{
+			Table: table, Columns: []*model.Column{col}, PreColumns: []*model.Column{col},
+		},
+		&model.RowChangedEvent{Table: table, PreColumns: []*model.Column{col}},
+	)
+	require.NoError(t, statistics.RecordBatchExecution(func() (int, error) {
+		return 4, nil
+	}))
+
+	stats, err := CollectStats(registry, changefeedID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{
+		dmlTypeInsert: 2, dmlTypeUpdate: 1, dmlTypeDelete: 1,
+	}, stats.Rows)
+	require.Equal(t, stats.Rows, stats.TableRows[table.QuoteString()])
+	require.Equal(t, uint64(1), stats.BatchCount)
+	require.Equal(t, uint64(4), stats.BatchRows)
+	require.Equal(t, uint64(0), stats.ExecutionErrors)
+
+	// The statistics of other changefeeds are not collected.
+	stats, err = CollectStats(registry, model.DefaultChangeFeedID("test-other"))
+	require.NoError(t, err)
+	require.Empty(t, stats.Rows)
+	require.Zero(t, stats.BatchCount)
+}
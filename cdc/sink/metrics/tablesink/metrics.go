@@ -27,6 +27,6 @@ var TotalRowsCountCounter = prometheus.NewCounterVec(
 	}, []string{"namespace", "changefeed"})
 
 // InitMetrics registers all metrics in this file.
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(TotalRowsCountCounter)
 }
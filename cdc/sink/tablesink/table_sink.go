@@ -15,6 +15,7 @@ package tablesink
 
 import (
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 )
 
 // TableSink is the interface for table sink.
@@ -32,7 +33,22 @@ type TableSink interface {
 	// For example, calculating the current progress from the statistics of the table sink.
 	// This is a thread-safe method.
 	GetCheckpointTs() model.ResolvedTs
+	// GetStats returns the statistics of the table sink.
+	// This is a thread-safe method.
+	GetStats() Stats
 	// Close closes the table sink.
 	// We should make sure this method is cancellable.
 	Close()
 }
+
+// Stats is the statistics of a table sink.
+type Stats struct {
+	// BufferedEvents is the number of events appended to the table sink
+	// and not acknowledged by the backend sink yet.
+	BufferedEvents uint64
+	// Rows is the number of rows appended to the table sink.
+	Rows uint64
+	// LargeTxn is the large transaction written in batches that holds back
+	// the checkpoint, if any.
+	LargeTxn tablepb.LargeTxn
+}
@@ -16,6 +16,8 @@ package tablesink
 import (
 	"fmt"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/log"
@@ -46,7 +48,20 @@ type EventTableSink[E dmlsink.TableEvent, P dmlsink.Appender[E]] struct {
 	eventAppender   P
 	// NOTICE: It is ordered by commitTs.
 	eventBuffer []E
-	state       state.TableSinkState
+	// bufferedEvents is the length of eventBuffer, it is used to
+	// get the statistics concurrently.
+	bufferedEvents atomic.Int64
+	// rows is the number of rows appended to the table sink.
+	rows  atomic.Uint64
+	state state.TableSinkState
+
+	// lastAppended is the last appended row, it is only used in
+	// AppendRowChangedEvents and UpdateResolvedTs.
+	lastAppended *model.RowChangedEvent
+	// largeTxn is the latest transaction written in batches, it holds back
+	// the checkpoint until all of its events are acknowledged.
+	largeTxnMu sync.Mutex
+	largeTxn   struct{ startTs, commitTs model.Ts }
 
 	// For dataflow metrics.
 	metricsTableSinkTotalRows prometheus.Counter
@@ -78,7 +93,12 @@ func New[E dmlsink.TableEvent, P dmlsink.Appender[E]](
 // AppendRowChangedEvents appends row changed or txn events to the table sink.
 func (e *EventTableSink[E, P]) AppendRowChangedEvents(rows ...*model.RowChangedEvent) {
 	e.eventBuffer = e.eventAppender.Append(e.eventBuffer, rows...)
+	e.bufferedEvents.Store(int64(len(e.eventBuffer)))
+	e.rows.Add(uint64(len(rows)))
 	e.metricsTableSinkTotalRows.Add(float64(len(rows)))
+	if len(rows) > 0 {
+		e.lastAppended = rows[len(rows)-1]
+	}
 }
 
 // UpdateResolvedTs advances the resolved ts of the table sink.
@@ -89,6 +109,14 @@ func (e *EventTableSink[E, P]) UpdateResolvedTs(resolvedTs model.ResolvedTs) err
 		return nil
 	}
 	e.maxResolvedTs = resolvedTs
+	if resolvedTs.IsBatchMode() && e.lastAppended != nil &&
+		e.lastAppended.CommitTs == resolvedTs.Ts {
+		// Only a part of the transaction is resolved, it's a large one.
+		e.largeTxnMu.Lock()
+		e.largeTxn.startTs = e.lastAppended.StartTs
+		e.largeTxn.commitTs = resolvedTs.Ts
+		e.largeTxnMu.Unlock()
+	}
 
 	i := sort.Search(len(e.eventBuffer), func(i int) bool {
 		return e.eventBuffer[i].GetCommitTs() > resolvedTs.Ts
@@ -103,6 +131,7 @@ func (e *EventTableSink[E, P]) UpdateResolvedTs(resolvedTs model.ResolvedTs) err
 	// We have to create a new slice for the rest of the elements,
 	// otherwise we cannot GC the flushed values as soon as possible.
 	e.eventBuffer = append(make([]E, 0, len(e.eventBuffer[i:])), e.eventBuffer[i:]...)
+	e.bufferedEvents.Store(int64(len(e.eventBuffer)))
 
 	resolvedCallbackableEvents := make([]*dmlsink.CallbackableEvent[E], 0, len(resolvedEvents))
 	for _, ev := range resolvedEvents {
@@ -124,6 +153,27 @@ func (e *EventTableSink[E, P]) GetCheckpointTs() model.ResolvedTs {
 	return e.progressTracker.advance()
 }
 
+// GetStats returns the statistics of the table sink.
+func (e *EventTableSink[E, P]) GetStats() Stats {
+	stats := Stats{
+		BufferedEvents: uint64(e.bufferedEvents.Load()) +
+			uint64(e.progressTracker.trackingCount()),
+		Rows: e.rows.Load(),
+	}
+
+	e.largeTxnMu.Lock()
+	largeTxn := e.largeTxn
+	e.largeTxnMu.Unlock()
+	if largeTxn.commitTs != 0 &&
+		e.progressTracker.advance().ResolvedMark() < largeTxn.commitTs {
+		stats.LargeTxn = tablepb.LargeTxn{
+			HoldingCheckpoint: true,
+			StartTs:           largeTxn.startTs,
+		}
+	}
+	return stats
+}
+
 // Close the table sink and wait for all callbacks be called.
 // Notice: It will be blocked until all callbacks be called.
 func (e *EventTableSink[E, P]) Close() {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/spanz"
@@ -269,6 +270,69 @@ func TestGetCheckpointTs(t *testing.T) {
 	require.Equal(t, model.NewResolvedTs(105), tb.GetCheckpointTs(), "checkpointTs should be 105")
 }
 
+func TestGetStatsBufferedEvents(t *testing.T) {
+	t.Parallel()
+
+	sink := &mockEventSink{dead: make(chan struct{})}
+	tb := New[*model.SingleTableTxn](
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1), model.Ts(0),
+		sink, &dmlsink.TxnEventAppender{}, prometheus.NewCounter(prometheus.CounterOpts{}))
+	require.Equal(t, uint64(0), tb.GetStats().BufferedEvents)
+
+	tb.AppendRowChangedEvents(getTestRows()...)
+	require.Len(t, tb.eventBuffer, 7)
+	require.Equal(t, uint64(7), tb.GetStats().BufferedEvents)
+	require.Equal(t, uint64(len(getTestRows())), tb.GetStats().Rows)
+
+	// One event is written to the sink, but it is not acknowledged.
+	err := tb.UpdateResolvedTs(model.NewResolvedTs(101))
+	require.Nil(t, err)
+	require.Len(t, tb.eventBuffer, 6)
+	require.Equal(t, uint64(7), tb.GetStats().BufferedEvents)
+	sink.acknowledge(101)
+	tb.GetCheckpointTs()
+	require.Equal(t, uint64(6), tb.GetStats().BufferedEvents)
+
+	// Flush and acknowledge all events.
+	err = tb.UpdateResolvedTs(model.NewResolvedTs(105))
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), tb.GetStats().BufferedEvents)
+	sink.acknowledge(105)
+	tb.GetCheckpointTs()
+	require.Equal(t, uint64(0), tb.GetStats().BufferedEvents)
+}
+
+func TestGetStatsLargeTxn(t *testing.T) {
+	t.Parallel()
+
+	sink := &mockEventSink{dead: make(chan struct{})}
+	tb := New[*model.SingleTableTxn](
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1), model.Ts(0),
+		sink, &dmlsink.TxnEventAppender{}, prometheus.NewCounter(prometheus.CounterOpts{}))
+	tb.AppendRowChangedEvents(getTestRows()...)
+	err := tb.UpdateResolvedTs(model.NewResolvedTs(104))
+	require.Nil(t, err)
+	sink.acknowledge(104)
+	require.Equal(t, tablepb.LargeTxn{}, tb.GetStats().LargeTxn)
+
+	// The transaction at 105 is written in batches.
+	err = tb.UpdateResolvedTs(model.ResolvedTs{
+		Mode: model.BatchResolvedMode, Ts: 105, BatchID: 1,
+	})
+	require.Nil(t, err)
+	require.Equal(t, tablepb.LargeTxn{HoldingCheckpoint: true, StartTs: 103},
+		tb.GetStats().LargeTxn)
+	sink.acknowledge(105)
+	require.Equal(t, tablepb.LargeTxn{HoldingCheckpoint: true, StartTs: 103},
+		tb.GetStats().LargeTxn)
+
+	// The checkpoint passes the transaction after it is fully resolved.
+	err = tb.UpdateResolvedTs(model.NewResolvedTs(105))
+	require.Nil(t, err)
+	require.Equal(t, uint64(105), tb.GetCheckpointTs().ResolvedMark())
+	require.Equal(t, tablepb.LargeTxn{}, tb.GetStats().LargeTxn)
+}
+
 func TestClose(t *testing.T) {
 	t.Parallel()
 
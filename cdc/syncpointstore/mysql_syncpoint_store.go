@@ -38,8 +38,12 @@ const (
 )
 
 type mysqlSyncPointStore struct {
-	db                     *sql.DB
-	clusterID              string
+	db        *sql.DB
+	clusterID string
+	// isTiDB indicates whether the downstream is TiDB. The secondary ts is
+	// only available in TiDB, it's always 0 for other MySQL compatible
+	// databases.
+	isTiDB                 bool
 	syncPointRetention     time.Duration
 	lastCleanSyncPointTime time.Time
 }
@@ -76,12 +80,18 @@ func newMySQLSyncPointStore(
 	if err != nil {
 		return nil, cerror.ErrMySQLConnectionError.Wrap(err).GenWithStack("fail to open MySQL connection")
 	}
+	isTiDB, err := mysql.CheckIsTiDB(ctx, syncDB)
+	if err != nil {
+		_ = syncDB.Close()
+		return nil, errors.Trace(err)
+	}
 
-	log.Info("Start mysql syncpoint sink")
+	log.Info("Start mysql syncpoint sink", zap.Bool("isTiDB", isTiDB))
 
 	return &mysqlSyncPointStore{
 		db:                     syncDB,
 		clusterID:              config.GetGlobalServerConfig().ClusterID,
+		isTiDB:                 isTiDB,
 		syncPointRetention:     syncPointRetention,
 		lastCleanSyncPointTime: time.Now(),
 	}, nil
@@ -142,16 +152,18 @@ func (s *mysqlSyncPointStore) SinkSyncPoint(ctx context.Context,
 		log.Error("sync table: begin Tx fail", zap.Error(err))
 		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
 	}
-	row := tx.QueryRow("select @@tidb_current_ts")
-	var secondaryTs string
-	err = row.Scan(&secondaryTs)
-	if err != nil {
-		log.Info("sync table: get tidb_current_ts err")
-		err2 := tx.Rollback()
-		if err2 != nil {
-			log.Error("failed to write syncpoint table", zap.Error(err))
+	secondaryTs := "0"
+	if s.isTiDB {
+		row := tx.QueryRow("select @@tidb_current_ts")
+		err = row.Scan(&secondaryTs)
+		if err != nil {
+			log.Info("sync table: get tidb_current_ts err")
+			err2 := tx.Rollback()
+			if err2 != nil {
+				log.Error("failed to write syncpoint table", zap.Error(err))
+			}
+			return cerror.WrapError(cerror.ErrMySQLTxnError, err)
 		}
-		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
 	}
 	// insert ts map
 	query := "insert ignore into " + schemaName + "." + syncPointTableName +
@@ -167,18 +179,20 @@ func (s *mysqlSyncPointStore) SinkSyncPoint(ctx context.Context,
 
 	// set global tidb_external_ts to secondary ts
 	// TiDB supports tidb_external_ts system variable since v6.4.0.
-	query = fmt.Sprintf("set global tidb_external_ts = %s", secondaryTs)
-	_, err = tx.Exec(query)
-	if err != nil {
-		if errorutil.IsSyncPointIgnoreError(err) {
-			// TODO(dongmen): to confirm if we need to log this error.
-			log.Warn("set global external ts failed, ignore this error", zap.Error(err))
-		} else {
-			err2 := tx.Rollback()
-			if err2 != nil {
-				log.Error("failed to write syncpoint table", zap.Error(err2))
+	if s.isTiDB {
+		query = fmt.Sprintf("set global tidb_external_ts = %s", secondaryTs)
+		_, err = tx.Exec(query)
+		if err != nil {
+			if errorutil.IsSyncPointIgnoreError(err) {
+				// TODO(dongmen): to confirm if we need to log this error.
+				log.Warn("set global external ts failed, ignore this error", zap.Error(err))
+			} else {
+				err2 := tx.Rollback()
+				if err2 != nil {
+					log.Error("failed to write syncpoint table", zap.Error(err2))
+				}
+				return cerror.WrapError(cerror.ErrMySQLTxnError, err)
 			}
-			return cerror.WrapError(cerror.ErrMySQLTxnError, err)
 		}
 	}
 
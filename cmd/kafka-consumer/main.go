@@ -15,6 +15,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"fmt"
 	"math"
@@ -43,13 +44,16 @@ import (
 	cmdUtil "github.com/pingcap/tiflow/pkg/cmd/util"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/integrity"
 	"github.com/pingcap/tiflow/pkg/logutil"
 	"github.com/pingcap/tiflow/pkg/quotes"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
 	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
+	"github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
@@ -71,6 +75,16 @@ var (
 	protocol            config.Protocol
 	enableTiDBExtension bool
 	enableRowChecksum   bool
+	// corruptionHandleLevel decides whether to stop consuming once a
+	// corrupted row is received, or only log it.
+	corruptionHandleLevel = integrity.CorruptionHandleLevelWarn
+
+	// largeMessageHandle decides how to consume the messages which exceed
+	// the max-message-bytes of the changefeed.
+	largeMessageHandle = config.NewDefaultLargeMessageHandleConfig()
+	// upstreamTiDBDSN is used to fetch the whole row of the handle-key-only
+	// messages from the upstream TiDB.
+	upstreamTiDBDSN string
 
 	// eventRouterReplicaConfig only used to initialize the consumer's eventRouter
 	// which then can be used to check RowChangedEvent dispatched correctness
@@ -101,6 +115,8 @@ func init() {
 	flag.StringVar(&ca, "ca", "", "CA certificate path for Kafka SSL connection")
 	flag.StringVar(&cert, "cert", "", "Certificate path for Kafka SSL connection")
 	flag.StringVar(&key, "key", "", "Private key path for Kafka SSL connection")
+	flag.StringVar(&upstreamTiDBDSN, "upstream-tidb-dsn", "",
+		"upstream TiDB DSN, used to fetch the row of the handle-key-only messages")
 	flag.Parse()
 
 	err := logutil.InitLogger(&logutil.Config{
@@ -205,12 +221,43 @@ func init() {
 			log.Panic("invalid enable-row-checksum of upstream-uri")
 		}
 		if enableRowChecksum {
-			if protocol != config.ProtocolAvro {
-				log.Panic("enable-row-checksum only work with avro")
+			if protocol != config.ProtocolCanalJSON && protocol != config.ProtocolAvro {
+				log.Panic("enable-row-checksum only work with canal-json / avro")
+			}
+			if !enableTiDBExtension {
+				log.Panic("enable-row-checksum only work with enable-tidb-extension")
 			}
 		}
 	}
 
+	s = upstreamURI.Query().Get("corruption-handle-level")
+	if s != "" {
+		if s != integrity.CorruptionHandleLevelWarn && s != integrity.CorruptionHandleLevelError {
+			log.Panic("invalid corruption-handle-level of upstream-uri", zap.String("level", s))
+		}
+		corruptionHandleLevel = s
+	}
+
+	s = upstreamURI.Query().Get("large-message-handle-option")
+	if s != "" {
+		largeMessageHandle.LargeMessageHandleOption = s
+	}
+	s = upstreamURI.Query().Get("claim-check-storage-uri")
+	if s != "" {
+		largeMessageHandle.ClaimCheckStorageURI = s
+	}
+	if err := largeMessageHandle.AdjustAndValidate(); err != nil {
+		log.Panic("invalid large message handle config of upstream-uri", zap.Error(err))
+	}
+	if !largeMessageHandle.Disabled() {
+		if protocol != config.ProtocolCanalJSON && protocol != config.ProtocolOpen {
+			log.Panic("large-message-handle-option only work with open-protocol / canal-json")
+		}
+		if protocol == config.ProtocolCanalJSON && !enableTiDBExtension {
+			log.Panic("large-message-handle-option of canal-json only work with enable-tidb-extension")
+		}
+	}
+
 	if configFile != "" {
 		eventRouterReplicaConfig = config.GetDefaultReplicaConfig()
 		eventRouterReplicaConfig.Sink.Protocol = protocol.String()
@@ -404,6 +451,10 @@ type Consumer struct {
 	protocol            config.Protocol
 	enableTiDBExtension bool
 	enableRowChecksum   bool
+	codecConfig         *common.Config
+	// upstreamTiDB is used to fetch the whole row of the handle-key-only
+	// messages, it's nil if the upstream TiDB DSN is not set.
+	upstreamTiDB *sql.DB
 
 	eventRouter *dispatcher.EventRouter
 
@@ -433,6 +484,19 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 	c.enableTiDBExtension = enableTiDBExtension
 	c.enableRowChecksum = enableRowChecksum
 
+	c.codecConfig = common.NewConfig(protocol)
+	c.codecConfig.EnableTiDBExtension = enableTiDBExtension
+	c.codecConfig.EnableRowChecksum = enableRowChecksum
+	c.codecConfig.CorruptionHandleLevel = corruptionHandleLevel
+	c.codecConfig.LargeMessageHandle = largeMessageHandle
+	if upstreamTiDBDSN != "" {
+		db, err := mysql.CreateMySQLDBConn(ctx, upstreamTiDBDSN)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		c.upstreamTiDB = db
+	}
+
 	if c.protocol == config.ProtocolAvro {
 		keySchemaM, valueSchemaM, err := avro.NewKeyAndValueSchemaManagers(
 			ctx, schemaRegistryURI, nil)
@@ -561,13 +625,14 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 	)
 	switch c.protocol {
 	case config.ProtocolOpen, config.ProtocolDefault:
-		decoder = open.NewBatchDecoder()
+		decoder, err = open.NewBatchDecoder(session.Context(), c.codecConfig, c.upstreamTiDB)
 	case config.ProtocolCanalJSON:
-		decoder = canal.NewBatchDecoder(c.enableTiDBExtension, "")
+		decoder, err = canal.NewBatchDecoder(session.Context(), c.codecConfig, c.upstreamTiDB)
 	case config.ProtocolAvro:
 		decoder = avro.NewDecoder(&avro.Options{
-			EnableTiDBExtension: c.enableTiDBExtension,
-			EnableRowChecksum:   c.enableRowChecksum,
+			EnableTiDBExtension:   c.enableTiDBExtension,
+			EnableRowChecksum:     c.enableRowChecksum,
+			CorruptionHandleLevel: c.codecConfig.CorruptionHandleLevel,
 			// avro must set this to true to make the consumer works.
 			EnableWatermarkEvent: true,
 		}, c.keySchemaM, c.valueSchemaM, kafkaTopic, c.tz)
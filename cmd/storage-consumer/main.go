@@ -172,6 +172,11 @@ func newConsumer(ctx context.Context) (*consumer, error) {
 	if err != nil {
 		return nil, err
 	}
+	if protocol == config.ProtocolCanalJSON {
+		// Always enable tidb extension for canal-json protocol
+		// because we need to get the commit ts from the extension field.
+		codecConfig.EnableTiDBExtension = true
+	}
 
 	extension := sinkutil.GetFileExtension(protocol)
 
@@ -305,9 +310,10 @@ func (c *consumer) emitDMLEvents(
 			return errors.Trace(err)
 		}
 	case config.ProtocolCanalJSON:
-		// Always enable tidb extension for canal-json protocol
-		// because we need to get the commit ts from the extension field.
-		decoder = canal.NewBatchDecoder(true, c.codecCfg.Terminator)
+		decoder, err = canal.NewBatchDecoder(ctx, c.codecCfg, nil)
+		if err != nil {
+			return errors.Trace(err)
+		}
 		err := decoder.AddKeyValue(nil, content)
 		if err != nil {
 			return errors.Trace(err)
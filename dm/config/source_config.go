@@ -65,6 +65,13 @@ type PurgeConfig struct {
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
 }
 
+// FailoverReplica is a replica of the upstream which can be failed over to,
+// it shares the user, password and security config with `from` of the source.
+type FailoverReplica struct {
+	Host string `yaml:"host" toml:"host" json:"host"`
+	Port int    `yaml:"port" toml:"port" json:"port"`
+}
+
 // SourceConfig is the configuration for source.
 type SourceConfig struct {
 	Enable     bool `yaml:"enable" toml:"enable" json:"enable"`
@@ -87,6 +94,9 @@ type SourceConfig struct {
 
 	SourceID string            `yaml:"source-id" toml:"source-id" json:"source-id"`
 	From     dbconfig.DBConfig `yaml:"from" toml:"from" json:"from"`
+	// other replicas of the upstream, relay fails over to them in order when
+	// the upstream in `From` is unavailable, only available when GTID is enabled.
+	FailoverReplicas []FailoverReplica `yaml:"failover-replicas,omitempty" toml:"failover-replicas,omitempty" json:"failover-replicas,omitempty"`
 
 	// config items for purger
 	Purge PurgeConfig `yaml:"purge" toml:"purge" json:"purge"`
@@ -252,9 +262,34 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	if len(c.FailoverReplicas) > 0 {
+		// the binlog position of different replicas are not comparable,
+		// so we can only resume from the GTID sets after failing over.
+		if !c.EnableGTID {
+			return terror.ErrConfigInvalidFailoverReplicas.Generate(c.FailoverReplicas, "enable-gtid is false")
+		}
+		for _, replica := range c.FailoverReplicas {
+			if len(replica.Host) == 0 || replica.Port <= 0 {
+				return terror.ErrConfigInvalidFailoverReplicas.Generate(c.FailoverReplicas, "host or port is empty")
+			}
+		}
+	}
+
 	return nil
 }
 
+// FailoverDBConfigs returns the DB configs of the failover replicas.
+func (c *SourceConfig) FailoverDBConfigs() []dbconfig.DBConfig {
+	cfgs := make([]dbconfig.DBConfig, 0, len(c.FailoverReplicas))
+	for _, replica := range c.FailoverReplicas {
+		cfg := c.From
+		cfg.Host = replica.Host
+		cfg.Port = replica.Port
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs
+}
+
 // DecryptPassword returns a decrypted config replica in config.
 func (c *SourceConfig) DecryptPassword() *SourceConfig {
 	clone := c.Clone()
@@ -435,28 +470,31 @@ type SourceConfigForDowngrade struct {
 	// any new config item, we mark it omitempty
 	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	// FailoverReplicas is added since v7.2.0
+	FailoverReplicas []FailoverReplica `yaml:"failover-replicas,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
 func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngrade {
 	return &SourceConfigForDowngrade{
-		Enable:          sourceCfg.Enable,
-		EnableGTID:      sourceCfg.EnableGTID,
-		RelayDir:        sourceCfg.RelayDir,
-		Flavor:          sourceCfg.Flavor,
-		Charset:         sourceCfg.Charset,
-		EnableRelay:     sourceCfg.EnableRelay,
-		RelayBinLogName: sourceCfg.RelayBinLogName,
-		RelayBinlogGTID: sourceCfg.RelayBinlogGTID,
-		UUIDSuffix:      sourceCfg.UUIDSuffix,
-		SourceID:        sourceCfg.SourceID,
-		From:            sourceCfg.From,
-		Purge:           sourceCfg.Purge,
-		Checker:         sourceCfg.Checker,
-		ServerID:        sourceCfg.ServerID,
-		Tracer:          sourceCfg.Tracer,
-		CaseSensitive:   sourceCfg.CaseSensitive,
-		Filters:         sourceCfg.Filters,
+		Enable:           sourceCfg.Enable,
+		EnableGTID:       sourceCfg.EnableGTID,
+		RelayDir:         sourceCfg.RelayDir,
+		Flavor:           sourceCfg.Flavor,
+		Charset:          sourceCfg.Charset,
+		EnableRelay:      sourceCfg.EnableRelay,
+		RelayBinLogName:  sourceCfg.RelayBinLogName,
+		RelayBinlogGTID:  sourceCfg.RelayBinlogGTID,
+		UUIDSuffix:       sourceCfg.UUIDSuffix,
+		SourceID:         sourceCfg.SourceID,
+		From:             sourceCfg.From,
+		Purge:            sourceCfg.Purge,
+		Checker:          sourceCfg.Checker,
+		ServerID:         sourceCfg.ServerID,
+		Tracer:           sourceCfg.Tracer,
+		CaseSensitive:    sourceCfg.CaseSensitive,
+		Filters:          sourceCfg.Filters,
+		FailoverReplicas: sourceCfg.FailoverReplicas,
 	}
 }
 
@@ -220,6 +220,32 @@ func TestConfigVerify(t *testing.T) {
 			},
 			"",
 		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.FailoverReplicas = []FailoverReplica{{Host: "127.0.0.2", Port: 3306}}
+				return cfg
+			},
+			".*invalid failover replicas.*enable-gtid is false.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.EnableGTID = true
+				cfg.FailoverReplicas = []FailoverReplica{{Host: "127.0.0.2"}}
+				return cfg
+			},
+			".*invalid failover replicas.*host or port is empty.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.EnableGTID = true
+				cfg.FailoverReplicas = []FailoverReplica{{Host: "127.0.0.2", Port: 3306}}
+				return cfg
+			},
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -235,6 +261,25 @@ func TestConfigVerify(t *testing.T) {
 	}
 }
 
+func TestFailoverDBConfigs(t *testing.T) {
+	cfg, err := ParseYaml(SampleSourceConfig)
+	require.NoError(t, err)
+	require.Len(t, cfg.FailoverDBConfigs(), 0)
+
+	cfg.FailoverReplicas = []FailoverReplica{
+		{Host: "127.0.0.2", Port: 3306},
+		{Host: "127.0.0.3", Port: 3307},
+	}
+	dbCfgs := cfg.FailoverDBConfigs()
+	require.Len(t, dbCfgs, 2)
+	for i, dbCfg := range dbCfgs {
+		require.Equal(t, cfg.FailoverReplicas[i].Host, dbCfg.Host)
+		require.Equal(t, cfg.FailoverReplicas[i].Port, dbCfg.Port)
+		require.Equal(t, cfg.From.User, dbCfg.User)
+		require.Equal(t, cfg.From.Password, dbCfg.Password)
+	}
+}
+
 func TestSourceConfigForDowngrade(t *testing.T) {
 	cfg, err := ParseYaml(SampleSourceConfig)
 	require.NoError(t, err)
@@ -183,6 +183,14 @@ func OpenAPITaskToSubTaskConfigs(task *openapi.Task, toDBCfg *dbconfig.DBConfig,
 		}
 		// set online ddl plugin config
 		subTaskCfg.OnlineDDL = task.EnhanceOnlineSchemaChange
+		if onlineDDLCfg := task.OnlineDdlConfig; onlineDDLCfg != nil {
+			if onlineDDLCfg.ShadowTableRules != nil {
+				subTaskCfg.ShadowTableRules = *onlineDDLCfg.ShadowTableRules
+			}
+			if onlineDDLCfg.TrashTableRules != nil {
+				subTaskCfg.TrashTableRules = *onlineDDLCfg.TrashTableRules
+			}
+		}
 		// set case sensitive from source
 		subTaskCfg.CaseSensitive = sourceCfgMap[sourceCfg.SourceName].CaseSensitive
 		// set source db config
@@ -614,6 +622,19 @@ func SubTaskConfigsToOpenAPITask(subTaskConfigList []*SubTaskConfig) *openapi.Ta
 	if len(filterMap) > 0 {
 		task.BinlogFilterRule = &filterRuleMap
 	}
+	// only set the online ddl rules which are not the default ones
+	onlineDDLCfg := openapi.TaskOnlineDDLConfig{}
+	if rules := oneSubtaskConfig.ShadowTableRules; len(rules) > 0 &&
+		!(len(rules) == 1 && rules[0] == DefaultShadowTableRules) {
+		onlineDDLCfg.ShadowTableRules = &rules
+	}
+	if rules := oneSubtaskConfig.TrashTableRules; len(rules) > 0 &&
+		!(len(rules) == 1 && rules[0] == DefaultTrashTableRules) {
+		onlineDDLCfg.TrashTableRules = &rules
+	}
+	if onlineDDLCfg.ShadowTableRules != nil || onlineDDLCfg.TrashTableRules != nil {
+		task.OnlineDdlConfig = &onlineDDLCfg
+	}
 	task.TableMigrateRule = tableMigrateRuleList
 	if len(oneSubtaskConfig.IgnoreCheckingItems) != 0 {
 		ignoreItems := oneSubtaskConfig.IgnoreCheckingItems
@@ -378,6 +378,47 @@ func TestConvertWithIgnoreCheckItems(t *testing.T) {
 	require.Equal(t, *newTask, task)
 }
 
+func TestConvertWithOnlineDDLConfig(t *testing.T) {
+	task, err := fixtures.GenNoShardOpenAPITaskForTest()
+	require.NoError(t, err)
+	shadowTableRules := []string{"^_(.+)_(?:new|gho|shadow)$"}
+	trashTableRules := []string{"^_(.+)_(?:ghc|del|old|trash)$"}
+	task.OnlineDdlConfig = &openapi.TaskOnlineDDLConfig{
+		ShadowTableRules: &shadowTableRules,
+		TrashTableRules:  &trashTableRules,
+	}
+	sourceCfg1, err := ParseYamlAndVerify(SampleSourceConfig)
+	require.NoError(t, err)
+	source1Name := task.SourceConfig.SourceConf[0].SourceName
+	sourceCfg1.SourceID = task.SourceConfig.SourceConf[0].SourceName
+	sourceCfgMap := map[string]*SourceConfig{source1Name: sourceCfg1}
+	toDBCfg := &dbconfig.DBConfig{
+		Host:     task.TargetConfig.Host,
+		Port:     task.TargetConfig.Port,
+		User:     task.TargetConfig.User,
+		Password: task.TargetConfig.Password,
+	}
+	subTaskConfigList, err := OpenAPITaskToSubTaskConfigs(&task, toDBCfg, sourceCfgMap)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(subTaskConfigList))
+	require.Equal(t, shadowTableRules, subTaskConfigList[0].ShadowTableRules)
+	require.Equal(t, trashTableRules, subTaskConfigList[0].TrashTableRules)
+
+	subTaskConfigMap := make(map[string]map[string]*SubTaskConfig)
+	subTaskConfigMap[task.Name] = make(map[string]*SubTaskConfig)
+	subTaskConfigMap[task.Name][source1Name] = subTaskConfigList[0]
+	taskList := SubTaskConfigsToOpenAPITaskList(subTaskConfigMap)
+	require.Equal(t, 1, len(taskList))
+	require.Equal(t, task, *taskList[0])
+
+	// the default rules are not shown in the openapi task
+	subTaskConfigList[0].ShadowTableRules = []string{DefaultShadowTableRules}
+	subTaskConfigList[0].TrashTableRules = []string{DefaultTrashTableRules}
+	taskList = SubTaskConfigsToOpenAPITaskList(subTaskConfigMap)
+	require.Equal(t, 1, len(taskList))
+	require.Nil(t, taskList[0].OnlineDdlConfig)
+}
+
 func TestConvertBetweenOpenAPITaskAndTaskConfig(t *testing.T) {
 	// one source task
 	task, err := fixtures.GenNoShardOpenAPITaskForTest()
@@ -14,7 +14,9 @@
 package master
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pb"
@@ -31,6 +33,7 @@ func NewSourceTableSchemaCmd() *cobra.Command {
 		newSourceTableSchemaUpdateCmd(),
 		newSourceTableSchemaDeleteCmd(),
 		newSourceTableSchemaListCmd(),
+		newSourceTableSchemaResolveCmd(),
 	)
 
 	return cmd
@@ -187,3 +190,118 @@ func newSourceTableSchemaDeleteCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func newSourceTableSchemaResolveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve <task-name> [lock-ID] [schema-file]",
+		Short: "show or resolve the conflicts of shard DDL lock in optimistic mode",
+		Long: `show or resolve the conflicts of shard DDL lock in optimistic mode.
+Without lock-ID, the conflicted shard DDL locks of the task are shown.
+With lock-ID, the conflicted tables are resolved by the target table structure in schema-file,
+and the tables specified by --skip and --exec are resolved by skipping or executing their DDLs.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 || len(args) > 3 {
+				return cmd.Help()
+			}
+			taskName := common.GetTaskNameFromArgOrFile(args[0])
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if len(args) == 1 {
+				sources, err := common.GetSourceArgs(cmd)
+				if err != nil {
+					return err
+				}
+				resp := &pb.ShowDDLConflictsResponse{}
+				err = common.SendRequest(
+					ctx,
+					"ShowDDLConflicts",
+					&pb.ShowDDLConflictsRequest{
+						Task:    taskName,
+						Sources: sources,
+					},
+					&resp,
+				)
+				if err != nil {
+					return err
+				}
+				common.PrettyPrintResponse(resp)
+				return nil
+			}
+
+			lockID := args[1]
+			var schemaContent []byte
+			if len(args) == 3 {
+				var err error
+				schemaContent, err = common.GetFileContent(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			var actions []*pb.DDLConflictAction
+			for _, flag := range []string{"skip", "exec"} {
+				op := pb.UnlockDDLLockOp_SkipLock
+				if flag == "exec" {
+					op = pb.UnlockDDLLockOp_ExecLock
+				}
+				tables, err := cmd.Flags().GetStringSlice(flag)
+				if err != nil {
+					return err
+				}
+				for _, table := range tables {
+					action, err := parseDDLConflictAction(table)
+					if err != nil {
+						common.PrintLinesf("error in parse `--%s`: %v", flag, err)
+						return errors.New("please check output to see error")
+					}
+					action.Op = op
+					actions = append(actions, action)
+				}
+			}
+			if len(schemaContent) == 0 && len(actions) == 0 {
+				common.PrintLinesf("must specify schema-file, --skip or --exec to resolve the conflicts")
+				return errors.New("please check output to see error")
+			}
+
+			resp := &pb.ResolveDDLConflictResponse{}
+			err := common.SendRequest(
+				ctx,
+				"ResolveDDLConflict",
+				&pb.ResolveDDLConflictRequest{
+					ID:           lockID,
+					TargetSchema: string(schemaContent),
+					Actions:      actions,
+				},
+				&resp,
+			)
+			if err != nil {
+				common.PrintLinesf("can not resolve the conflicts of DDL lock %s", lockID)
+				return err
+			}
+			common.PrettyPrintResponse(resp)
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice("skip", nil, "skip the DDLs of the conflicted tables, in the format of <source>:<database>.<table>")
+	cmd.Flags().StringSlice("exec", nil, "execute the DDLs of the conflicted tables, in the format of <source>:<database>.<table>")
+	return cmd
+}
+
+// parseDDLConflictAction parses a table in the format of <source>:<database>.<table>.
+func parseDDLConflictAction(s string) (*pb.DDLConflictAction, error) {
+	source, table, ok := strings.Cut(s, ":")
+	if !ok || source == "" {
+		return nil, errors.New("source is not specified in " + s)
+	}
+	database, table, ok := strings.Cut(table, ".")
+	if !ok || database == "" || table == "" {
+		return nil, errors.New("database or table is not specified in " + s)
+	}
+	return &pb.DDLConflictAction{
+		Source:   source,
+		Database: database,
+		Table:    table,
+	}, nil
+}
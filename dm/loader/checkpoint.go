@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/cputil"
@@ -104,11 +105,18 @@ func (cp *LightningCheckpointList) Prepare(ctx context.Context) error {
 		task_name varchar(255) NOT NULL,
 		source_name varchar(255) NOT NULL,
 		status varchar(10) NOT NULL DEFAULT 'init' COMMENT 'init,running,finished',
+		import_mode varchar(10) NOT NULL DEFAULT '' COMMENT 'logical,physical',
 		PRIMARY KEY (task_name, source_name)
 	);
 `
 	sql2 := fmt.Sprintf(createTable, cp.tableName)
 	_, err = connection.ExecuteSQL(tctx, nil, "lightning-checkpoint", []string{sql2})
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	// the table created by older versions has no import_mode column.
+	addColumn := fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS import_mode varchar(10) NOT NULL DEFAULT '' COMMENT 'logical,physical'", cp.tableName)
+	_, err = connection.ExecuteSQL(tctx, nil, "lightning-checkpoint", []string{addColumn})
 	return terror.WithScope(err, terror.ScopeDownstream)
 }
 
@@ -152,6 +160,54 @@ func (cp *LightningCheckpointList) UpdateStatus(ctx context.Context, status ligh
 	return nil
 }
 
+// UpdateImportMode records the import mode of the load unit.
+func (cp *LightningCheckpointList) UpdateImportMode(ctx context.Context, mode config.LoadMode) error {
+	connection, err := cp.db.GetBaseConn(ctx)
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer cp.db.ForceCloseConnWithoutErr(connection)
+
+	sql := fmt.Sprintf("UPDATE %s set import_mode = ? WHERE `task_name` = ? AND `source_name` = ?", cp.tableName)
+	cp.logger.Info("update lightning loader import mode",
+		zap.String("task", cp.taskName), zap.String("source", cp.sourceName),
+		zap.String("import mode", string(mode)))
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "lightning-checkpoint")))
+	_, err = connection.ExecuteSQL(tctx, nil, "lightning-checkpoint", []string{sql},
+		[]interface{}{string(mode), cp.taskName, cp.sourceName})
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "update lightning import mode"), terror.ScopeDownstream)
+	}
+	return nil
+}
+
+// importMode returns the recorded import mode of the load unit, it's empty
+// if the import mode is not recorded.
+func (cp *LightningCheckpointList) importMode(ctx context.Context) (config.LoadMode, error) {
+	connection, err := cp.db.GetBaseConn(ctx)
+	if err != nil {
+		return "", terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer cp.db.ForceCloseConnWithoutErr(connection)
+
+	query := fmt.Sprintf("SELECT import_mode FROM %s WHERE `task_name` = ? AND `source_name` = ?", cp.tableName)
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "lightning-checkpoint")))
+	// nolint:rowserrcheck
+	rows, err := connection.QuerySQL(tctx, query, cp.taskName, cp.sourceName)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		var mode string
+		if err = rows.Scan(&mode); err != nil {
+			return "", terror.WithScope(err, terror.ScopeDownstream)
+		}
+		return config.LoadMode(mode), nil
+	}
+	return "", nil
+}
+
 func (cp *LightningCheckpointList) taskStatus(ctx context.Context) (lightingLoadStatus, error) {
 	connection, err := cp.db.GetBaseConn(ctx)
 	if err != nil {
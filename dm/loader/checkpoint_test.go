@@ -20,9 +20,11 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	. "github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
 var _ = Suite(&lightningCpListSuite{})
@@ -56,6 +58,9 @@ func (s *lightningCpListSuite) TestLightningCheckpointListPrepare(c *C) {
 	s.mock.ExpectBegin()
 	s.mock.ExpectExec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s.*", s.cpList.tableName)).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS import_mode.*", s.cpList.tableName)).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
 	err := s.cpList.Prepare(ctx)
 	c.Assert(err, IsNil)
 }
@@ -98,3 +103,34 @@ func (s *lightningCpListSuite) TestLightningCheckpointListUpdateStatus(c *C) {
 	err := s.cpList.UpdateStatus(context.Background(), lightningStatusRunning)
 	c.Assert(err, IsNil)
 }
+
+func (s *lightningCpListSuite) TestLightningCheckpointListUpdateImportMode(c *C) {
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(fmt.Sprintf("UPDATE %s set import_mode = \\? WHERE `task_name` = \\? AND `source_name` = \\?", s.cpList.tableName)).
+		WithArgs("physical", s.cpList.taskName, s.cpList.sourceName).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	s.mock.ExpectCommit()
+	c.Assert(s.cpList.UpdateImportMode(context.Background(), config.LoadModePhysical), IsNil)
+}
+
+func (s *lightningCpListSuite) TestLightningCheckpointListImportMode(c *C) {
+	s.mock.ExpectQuery(fmt.Sprintf("SELECT import_mode FROM %s WHERE `task_name` = \\? AND `source_name` = \\?", s.cpList.tableName)).
+		WithArgs(s.cpList.taskName, s.cpList.sourceName).
+		WillReturnRows(sqlmock.NewRows([]string{"import_mode"}).AddRow("physical"))
+	mode, err := s.cpList.importMode(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(mode, Equals, config.LoadModePhysical)
+}
+
+func (s *lightningCpListSuite) TestCheckImportModeChanged(c *C) {
+	cfg := &config.SubTaskConfig{}
+	cfg.LoaderConfig.ImportMode = config.LoadModePhysical
+	l := &LightningLoader{cfg: cfg, checkPointList: s.cpList}
+
+	// resume the interrupted load unit with a different import mode
+	s.mock.ExpectQuery(fmt.Sprintf("SELECT import_mode FROM %s.*", s.cpList.tableName)).
+		WithArgs(s.cpList.taskName, s.cpList.sourceName).
+		WillReturnRows(sqlmock.NewRows([]string{"import_mode"}).AddRow("logical"))
+	err := l.checkImportMode(context.Background(), lightningStatusRunning)
+	c.Assert(terror.ErrLoadLightningImportModeChanged.Equal(err), IsTrue)
+}
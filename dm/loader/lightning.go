@@ -414,6 +414,24 @@ func (l *LightningLoader) getLightningConfig() (*lcfg.Config, error) {
 	return cfg, nil
 }
 
+// checkImportMode makes sure that an interrupted load unit is resumed with
+// the same import mode, because the checkpoints and the imported data of
+// different import modes are not compatible.
+func (l *LightningLoader) checkImportMode(ctx context.Context, status lightingLoadStatus) error {
+	mode := l.cfg.LoaderConfig.ImportMode
+	if status == lightningStatusRunning {
+		lastMode, err := l.checkPointList.importMode(ctx)
+		if err != nil {
+			return err
+		}
+		// the import mode is not recorded by older versions.
+		if lastMode != "" && lastMode != mode {
+			return terror.ErrLoadLightningImportModeChanged.Generate(lastMode, mode)
+		}
+	}
+	return l.checkPointList.UpdateImportMode(ctx, mode)
+}
+
 func (l *LightningLoader) restore(ctx context.Context) error {
 	if err := putLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 		return err
@@ -445,6 +463,9 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 		if err = l.checkPointList.RegisterCheckPoint(ctx); err != nil {
 			return err
 		}
+		if err = l.checkImportMode(ctx, status); err != nil {
+			return err
+		}
 		var cfg *lcfg.Config
 		cfg, err = l.getLightningConfig()
 		if err != nil {
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"go.uber.org/zap"
 )
 
@@ -775,6 +776,82 @@ func (s *Server) DMAPIOperateTableStructure(c *gin.Context, taskName string, sou
 	}
 }
 
+// DMAPIGetShardDDLConflicts get the conflicting shard DDL locks of the task url is: (GET /api/v1/tasks/{task-name}/shard_ddl_conflicts).
+func (s *Server) DMAPIGetShardDDLConflicts(c *gin.Context, taskName string, params openapi.DMAPIGetShardDDLConflictsParams) {
+	var sources []string
+	if params.SourceNameList != nil {
+		sources = *params.SourceNameList
+	}
+	conflicts, err := s.optimist.ShowConflicts(taskName, sources)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	data := make([]openapi.ShardDDLConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		tables := make([]openapi.ShardDDLConflictTable, 0, len(conflict.Tables))
+		for _, table := range conflict.Tables {
+			t := openapi.ShardDDLConflictTable{
+				SourceName:     table.Source,
+				SchemaName:     table.Database,
+				TableName:      table.Table,
+				Conflicted:     table.Conflicted,
+				TableStructure: table.Schema,
+			}
+			if table.Conflicted {
+				ddls, msg := table.DDLs, table.ConflictMsg
+				t.Ddls = &ddls
+				t.ConflictMessage = &msg
+			}
+			tables = append(tables, t)
+		}
+		joined := conflict.JoinedSchema
+		data = append(data, openapi.ShardDDLConflict{
+			LockId:               conflict.ID,
+			JoinedTableStructure: &joined,
+			Tables:               tables,
+		})
+	}
+	c.IndentedJSON(http.StatusOK, openapi.GetShardDDLConflictsResponse{Total: len(data), Data: data})
+}
+
+// DMAPIResolveShardDDLConflict resolve a conflicting shard DDL lock of the task url is: (POST /api/v1/tasks/{task-name}/shard_ddl_conflicts/resolve).
+func (s *Server) DMAPIResolveShardDDLConflict(c *gin.Context, taskName string) {
+	var req openapi.ResolveShardDDLConflictRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if utils.ExtractTaskFromLockID(req.LockId) != taskName {
+		_ = c.Error(terror.ErrOpenAPICommonError.Generatef("lock %s does not belong to task %s", req.LockId, taskName))
+		return
+	}
+	resolveReq := &pb.ResolveDDLConflictRequest{ID: req.LockId}
+	if req.TargetTableStructure != nil {
+		resolveReq.TargetSchema = *req.TargetTableStructure
+	}
+	if req.Actions != nil {
+		for _, action := range *req.Actions {
+			op := pb.UnlockDDLLockOp_SkipLock
+			if action.Action == openapi.ShardDDLConflictActionActionExec {
+				op = pb.UnlockDDLLockOp_ExecLock
+			}
+			resolveReq.Actions = append(resolveReq.Actions, &pb.DDLConflictAction{
+				Source:   action.SourceName,
+				Database: action.SchemaName,
+				Table:    action.TableName,
+				Op:       op,
+			})
+		}
+	}
+	sources, err := s.resolveDDLConflict(resolveReq)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, openapi.ResolveShardDDLConflictResponse{ResumedSourceNameList: sources})
+}
+
 // DMAPIConvertTask turns task into the format of a configuration file or vice versa url is: (POST /api/v1/tasks/,).
 func (s *Server) DMAPIConvertTask(c *gin.Context) {
 	var req openapi.ConverterTaskRequest
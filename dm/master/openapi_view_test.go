@@ -1178,6 +1178,57 @@ func (s *OpenAPIViewSuite) TestTaskAPI() {
 	s.Equal(0, resultTaskList.Total)
 }
 
+func (s *OpenAPIViewSuite) TestShardDDLConflictAPI() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s1 := setupTestServer(ctx, s.T())
+	defer func() {
+		cancel()
+		s1.Close()
+	}()
+
+	taskName := "test"
+	conflictsURL := fmt.Sprintf("/api/v1/tasks/%s/shard_ddl_conflicts", taskName)
+	resolveURL := conflictsURL + "/resolve"
+
+	// no conflict
+	result := testutil.NewRequest().Get(conflictsURL).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	var conflicts openapi.GetShardDDLConflictsResponse
+	s.NoError(result.UnmarshalBodyToObject(&conflicts))
+	s.Equal(0, conflicts.Total)
+
+	// lock of another task
+	targetTableStructure := "CREATE TABLE tb (id INT PRIMARY KEY)"
+	req := openapi.ResolveShardDDLConflictRequest{
+		LockId:               utils.GenDDLLockID("another-task", "db", "tb"),
+		TargetTableStructure: &targetTableStructure,
+	}
+	result = testutil.NewRequest().Post(resolveURL).WithJsonBody(req).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+	errResp := &openapi.ErrorWithMessage{}
+	s.NoError(result.UnmarshalBodyToObject(errResp))
+	s.Regexp("does not belong to task", errResp.ErrorMsg)
+
+	// invalid action
+	req.LockId = utils.GenDDLLockID(taskName, "db", "tb")
+	req.Actions = &[]openapi.ShardDDLConflictAction{{
+		SourceName: "source-1",
+		SchemaName: "db",
+		TableName:  "tb",
+		Action:     "replace",
+	}}
+	result = testutil.NewRequest().Post(resolveURL).WithJsonBody(req).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+
+	// lock not found
+	req.Actions = nil
+	result = testutil.NewRequest().Post(resolveURL).WithJsonBody(req).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+	errResp = &openapi.ErrorWithMessage{}
+	s.NoError(result.UnmarshalBodyToObject(errResp))
+	s.Regexp("not found", errResp.ErrorMsg)
+}
+
 func TestOpenAPIViewSuite(t *testing.T) {
 	suite.Run(t, new(OpenAPIViewSuite))
 }
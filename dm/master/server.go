@@ -31,6 +31,10 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	toolutils "github.com/pingcap/tidb-tools/pkg/utils"
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tiflow/dm/checker"
 	dmcommon "github.com/pingcap/tiflow/dm/common"
@@ -963,6 +967,86 @@ func (s *Server) UnlockDDLLock(ctx context.Context, req *pb.UnlockDDLLockRequest
 	return resp, nil
 }
 
+// ShowDDLConflicts implements MasterServer.ShowDDLConflicts.
+func (s *Server) ShowDDLConflicts(ctx context.Context, req *pb.ShowDDLConflictsRequest) (*pb.ShowDDLConflictsResponse, error) {
+	var (
+		resp2 *pb.ShowDDLConflictsResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.ShowDDLConflictsResponse{}
+	conflicts, err := s.optimist.ShowConflicts(req.Task, req.Sources)
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Result = true
+	resp.Conflicts = conflicts
+	if len(conflicts) == 0 {
+		resp.Msg = "no conflicting DDL lock exists"
+	}
+	return resp, nil
+}
+
+// ResolveDDLConflict implements MasterServer.ResolveDDLConflict.
+func (s *Server) ResolveDDLConflict(ctx context.Context, req *pb.ResolveDDLConflictRequest) (*pb.ResolveDDLConflictResponse, error) {
+	var (
+		resp2 *pb.ResolveDDLConflictResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.ResolveDDLConflictResponse{}
+	sources, err := s.resolveDDLConflict(req)
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Result = true
+	resp.Sources = sources
+	return resp, nil
+}
+
+// resolveDDLConflict resolves a conflicting optimistic shard DDL lock, and resumes the subtasks of the
+// resolved sources which may be paused by the conflict or by the user to resolve it.
+// it returns the sources whose subtasks are resumed.
+func (s *Server) resolveDDLConflict(req *pb.ResolveDDLConflictRequest) ([]string, error) {
+	var (
+		targetTI *model.TableInfo
+		err      error
+	)
+	if req.TargetSchema != "" {
+		targetTI, err = buildTableInfoFromCreateStmt(req.TargetSchema)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sources, err := s.optimist.ResolveConflict(req.ID, targetTI, req.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	task := utils.ExtractTaskFromLockID(req.ID)
+	resumeSources := make([]string, 0, len(sources))
+	for _, source := range sources {
+		switch s.scheduler.GetExpectSubTaskStage(task, source).Expect {
+		case pb.Stage_Running, pb.Stage_Paused:
+			resumeSources = append(resumeSources, source)
+		}
+	}
+	if err = s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Running, task, resumeSources...); err != nil {
+		return nil, terror.Annotate(err, "the conflict is resolved, but fail to resume the subtasks, please resume them manually")
+	}
+	return resumeSources, nil
+}
+
 // PurgeWorkerRelay implements MasterServer.PurgeWorkerRelay.
 func (s *Server) PurgeWorkerRelay(ctx context.Context, req *pb.PurgeWorkerRelayRequest) (*pb.PurgeWorkerRelayResponse, error) {
 	var (
@@ -3165,3 +3249,20 @@ func genValidationWorkerErrorResp(req *workerrpc.Request, err error, logMsg, wor
 		return nil
 	}
 }
+
+// buildTableInfoFromCreateStmt builds the table info from a `CREATE TABLE` statement.
+func buildTableInfoFromCreateStmt(createSQL string) (*model.TableInfo, error) {
+	node, err := parser.New().ParseOneStmt(createSQL, "", "")
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerInvalidCreateTableStmt.Delegate(err, createSQL)
+	}
+	stmt, ok := node.(*ast.CreateTableStmt)
+	if !ok {
+		return nil, terror.ErrSchemaTrackerInvalidCreateTableStmt.Generate(createSQL)
+	}
+	ti, err := ddl.BuildTableInfoFromAST(stmt)
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerRestoreStmtFail.Delegate(err)
+	}
+	return ti, nil
+}
@@ -2922,3 +2922,15 @@ func (t *testMasterSuite) TestGetLatestMeta() {
 	require.Equal(t.T(), meta.BinLogPos, uint32(11232))
 	require.Equal(t.T(), meta.BinLogGTID, "1-2-100")
 }
+
+func TestBuildTableInfoFromCreateStmt(t *testing.T) {
+	ti, err := buildTableInfoFromCreateStmt("CREATE TABLE tb (id INT PRIMARY KEY, c1 TEXT)")
+	require.NoError(t, err)
+	require.Equal(t, "tb", ti.Name.O)
+	require.Len(t, ti.Columns, 2)
+
+	_, err = buildTableInfoFromCreateStmt("CREATE TABLE tb (id INT")
+	require.True(t, terror.ErrSchemaTrackerInvalidCreateTableStmt.Equal(err))
+	_, err = buildTableInfoFromCreateStmt("ALTER TABLE tb ADD COLUMN c1 TEXT")
+	require.True(t, terror.ErrSchemaTrackerInvalidCreateTableStmt.Equal(err))
+}
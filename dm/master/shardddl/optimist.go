@@ -17,11 +17,15 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/schemacmp"
 	"github.com/pingcap/tiflow/dm/common"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
@@ -298,6 +302,216 @@ func (o *Optimist) UnlockLock(ctx context.Context, id, source, upstreamSchema, u
 	return nil
 }
 
+// ShowConflicts is used by `binlog-schema resolve` command to show the conflicting locks,
+// the table structures of all upstream tables in these locks are returned.
+func (o *Optimist) ShowConflicts(task string, sources []string) ([]*pb.DDLConflict, error) {
+	opm, _, err := optimism.GetAllOperations(o.cli)
+	if err != nil {
+		return nil, err
+	}
+	ifm, _, err := optimism.GetAllInfo(o.cli)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*pb.DDLConflict, 0)
+	for _, lock := range o.lk.Locks() {
+		if task != "" && task != lock.Task {
+			continue // specify task but mismatch
+		}
+		// upstream table ID -> conflicting operation.
+		conflictOps := make(map[string]optimism.Operation)
+		for _, opmss := range opm[lock.Task] {
+			for _, opmsst := range opmss {
+				for _, op := range opmsst {
+					if op.ID == lock.ID && op.ConflictStage == optimism.ConflictDetected {
+						conflictOps[utils.GenDDLLockID(op.Source, op.UpSchema, op.UpTable)] = op
+					}
+				}
+			}
+		}
+		if len(conflictOps) == 0 {
+			continue
+		}
+
+		tables := lock.Tables()
+		if len(sources) > 0 {
+			matched := false
+			for _, source := range sources {
+				if _, ok := tables[source]; ok {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue // specify sources but mismatch
+			}
+		}
+
+		conflict := &pb.DDLConflict{
+			ID:   lock.ID,
+			Task: lock.Task,
+		}
+		if joined, err2 := lock.Joined(); err2 == nil {
+			conflict.JoinedSchema = restoreCreateTable(joined, lock.DownTable)
+		}
+		for source, schemaTables := range tables {
+			for schema, tbs := range schemaTables {
+				for table, ti := range tbs {
+					t := &pb.DDLConflictTable{
+						Source:   source,
+						Database: schema,
+						Table:    table,
+						Schema:   restoreCreateTable(ti, table),
+					}
+					if op, ok := conflictOps[utils.GenDDLLockID(source, schema, table)]; ok {
+						t.Conflicted = true
+						t.ConflictMsg = op.ConflictMsg
+						if info, ok := ifm[lock.Task][source][schema][table]; ok && len(info.TableInfosAfter) > 0 {
+							t.DDLs = info.DDLs
+							t.Schema = restoreCreateTable(schemacmp.Encode(info.TableInfosAfter[len(info.TableInfosAfter)-1]), table)
+						}
+					}
+					conflict.Tables = append(conflict.Tables, t)
+				}
+			}
+		}
+		sort.Slice(conflict.Tables, func(i, j int) bool {
+			ti, tj := conflict.Tables[i], conflict.Tables[j]
+			if ti.Source != tj.Source {
+				return ti.Source < tj.Source
+			}
+			if ti.Database != tj.Database {
+				return ti.Database < tj.Database
+			}
+			return ti.Table < tj.Table
+		})
+		ret = append(ret, conflict)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret, nil
+}
+
+// ResolveConflict resolves a conflicting shard DDL lock manually when using `binlog-schema resolve` command.
+// ID: the shard DDL lock ID.
+// targetTI: the table structure of the conflicted tables after resolved, the conflicting DDLs of the
+// conflicted tables which are not in actions will be skipped if it's not nil.
+// actions: whether to skip/exec the conflicting DDLs for the specified upstream tables.
+// it returns the sources of the resolved tables.
+func (o *Optimist) ResolveConflict(id string, targetTI *model.TableInfo, actions []*pb.DDLConflictAction) ([]string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil, terror.ErrMasterOptimistNotStarted.Generate()
+	}
+	// 1. find the lock.
+	lock := o.lk.FindLock(id)
+	if lock == nil {
+		return nil, terror.ErrMasterLockNotFound.Generate(id)
+	}
+
+	// 2. find out the conflicting operations and related infos.
+	infos, ops, _, err := optimism.GetInfosOperationsByTask(o.cli, lock.Task)
+	if err != nil {
+		return nil, terror.ErrMasterLockIsResolving.Generatef("fail to get info and operation for task %s", lock.Task)
+	}
+	infoMap := make(map[string]optimism.Info, len(infos))
+	for _, info := range infos {
+		infoMap[utils.GenDDLLockID(info.Source, info.UpSchema, info.UpTable)] = info
+	}
+	actionMap := make(map[string]pb.UnlockDDLLockOp, len(actions))
+	for _, action := range actions {
+		if action.Op != pb.UnlockDDLLockOp_SkipLock && action.Op != pb.UnlockDDLLockOp_ExecLock {
+			return nil, terror.ErrMasterInvalidOperateOp.Generate(action.Op.String(), "shard DDL conflict")
+		}
+		actionMap[utils.GenDDLLockID(action.Source, action.Database, action.Table)] = action.Op
+	}
+
+	// 3. rewrite operation.DDLs to skip/exec DDLs for the conflicted tables.
+	resolved := make([]optimism.Operation, 0, len(ops))
+	resolvedInfos := make([]optimism.Info, 0, len(ops))
+	for _, op := range ops {
+		if op.ID != id || op.ConflictStage != optimism.ConflictDetected {
+			continue
+		}
+		key := utils.GenDDLLockID(op.Source, op.UpSchema, op.UpTable)
+		action, ok := actionMap[key]
+		switch {
+		case ok:
+			delete(actionMap, key)
+		case targetTI != nil:
+			// the target table structure is tracked instead of the one after the conflicting DDLs.
+			action = pb.UnlockDDLLockOp_SkipLock
+		default:
+			continue // keep waiting for another resolution.
+		}
+		info, ok := infoMap[key]
+		if !ok {
+			return nil, terror.ErrMasterLockIsResolving.Generatef("fail to find related info of table %s for lock %s", key, id)
+		}
+		switch action {
+		case pb.UnlockDDLLockOp_ExecLock:
+			op.DDLs = info.DDLs
+		case pb.UnlockDDLLockOp_SkipLock:
+			op.DDLs = op.DDLs[:0]
+		}
+		op.ConflictStage = optimism.ConflictUnlocked
+		op.TableInfo = targetTI
+		resolved = append(resolved, op)
+		resolvedInfos = append(resolvedInfos, info)
+	}
+	if len(actionMap) > 0 {
+		unmatched := make([]string, 0, len(actionMap))
+		for key := range actionMap {
+			unmatched = append(unmatched, key)
+		}
+		sort.Strings(unmatched)
+		return nil, terror.ErrMasterLockIsResolving.Generatef("tables %v are not conflicted in lock %s", unmatched, id)
+	}
+	if len(resolved) == 0 {
+		return nil, terror.ErrMasterLockIsResolving.Generatef("no conflicted table is resolved for lock %s", id)
+	}
+
+	// 4. put operations into etcd in one transaction for workers to execute.
+	infoModRevs := make([]int64, 0, len(resolved))
+	for _, op := range resolved {
+		infoModRevs = append(infoModRevs, op.Revision+1)
+	}
+	rev, skipped, err := optimism.PutOperations(o.cli, true, infoModRevs, resolved...)
+	if err != nil {
+		return nil, err
+	}
+	skippedSet := make(map[string]struct{}, len(skipped))
+	for _, op := range skipped {
+		skippedSet[utils.GenDDLLockID(op.Source, op.UpSchema, op.UpTable)] = struct{}{}
+		o.logger.Warn("skip putting shard DDL lock operation to resolve conflict, a done one already exists",
+			zap.String("lock", id), zap.Stringer("operation", op), zap.Int64("revision", rev))
+	}
+	sourceSet := make(map[string]struct{})
+	for i, op := range resolved {
+		if _, ok := skippedSet[utils.GenDDLLockID(op.Source, op.UpSchema, op.UpTable)]; ok {
+			continue
+		}
+		switch {
+		case targetTI != nil:
+			lock.UpdateTableInfo(op.Source, op.UpSchema, op.UpTable, targetTI)
+		case len(op.DDLs) > 0:
+			lock.UpdateTableAfterUnlock(resolvedInfos[i])
+		}
+		sourceSet[op.Source] = struct{}{}
+		o.logger.Info("put shard DDL lock operation to resolve conflict", zap.String("lock", id),
+			zap.Stringer("operation", op), zap.Int64("revision", rev))
+	}
+	sources := make([]string, 0, len(sourceSet))
+	for source := range sourceSet {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	return sources, nil
+}
+
 // RemoveMetaDataWithTask removes meta data for a specified task
 // NOTE: this function can only be used when the specified task is not running.
 // This function only be used when --remove-meta or stop-task
@@ -502,6 +716,10 @@ func (o *Optimist) recoverLocks(
 						o.logger.Warn("lock for the operation not found", zap.Stringer("operation", op))
 						continue
 					}
+					// recover the table structure resolved manually.
+					if op.ConflictStage == optimism.ConflictUnlocked && op.TableInfo != nil {
+						lock.UpdateTableInfo(op.Source, op.UpSchema, op.UpTable, op.TableInfo)
+					}
 					if op.Done {
 						lock.TryMarkDone(op.Source, op.UpSchema, op.UpTable)
 						err := lock.DeleteColumnsByOp(op)
@@ -880,3 +1098,10 @@ func (o *Optimist) deleteInfosOps(lock *optimism.Lock) (bool, error) {
 	}
 	return deleted, nil
 }
+
+// restoreCreateTable restores the table structure to a `CREATE TABLE` statement.
+func restoreCreateTable(ti schemacmp.Table, table string) string {
+	var sb strings.Builder
+	ti.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb), table)
+	return sb.String()
+}
@@ -26,11 +26,14 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/mock"
+	"github.com/pingcap/tidb/util/schemacmp"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/optimism"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -770,6 +773,129 @@ func (t *testOptimistSuite) TestOptimistLockConflict() {
 	require.Equal(t.T(), 0, len(errCh))
 }
 
+func (t *testOptimistSuite) TestOptimistResolveConflict() {
+	var (
+		watchTimeout       = 5 * time.Second
+		logger             = log.L()
+		o                  = NewOptimist(&logger, getDownstreamMeta)
+		task               = "task-test-optimist"
+		source1            = "mysql-replica-1"
+		downSchema         = "foo"
+		downTable          = "bar"
+		st1                = optimism.NewSourceTables(task, source1)
+		p                  = parser.New()
+		se                 = mock.NewContext()
+		tblID        int64 = 222
+		DDLs1              = []string{"ALTER TABLE bar ADD COLUMN c1 TEXT"}
+		DDLs2              = []string{"ALTER TABLE bar ADD COLUMN c1 DATETIME"}
+		ti0                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY)`)
+		ti1                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 TEXT)`)
+		ti2                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 DATETIME)`)
+		i1                 = optimism.NewInfo(task, source1, "foo", "bar-1", downSchema, downTable, DDLs1, ti0, []*model.TableInfo{ti1})
+		i2                 = optimism.NewInfo(task, source1, "foo", "bar-2", downSchema, downTable, DDLs2, ti0, []*model.TableInfo{ti2})
+		lockID             = utils.GenDDLLockID(task, downSchema, downTable)
+	)
+
+	st1.AddTable("foo", "bar-1", downSchema, downTable)
+	st1.AddTable("foo", "bar-2", downSchema, downTable)
+	_, err := optimism.PutSourceTables(t.etcdTestCli, st1)
+	require.NoError(t.T(), err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		o.Close()
+	}()
+
+	// not started.
+	_, err = o.ResolveConflict(lockID, ti1, nil)
+	require.True(t.T(), terror.ErrMasterOptimistNotStarted.Equal(err))
+
+	require.NoError(t.T(), o.Start(ctx, t.etcdTestCli))
+
+	// lock not found.
+	_, err = o.ResolveConflict(lockID, ti1, nil)
+	require.True(t.T(), terror.ErrMasterLockNotFound.Equal(err))
+
+	// PUT i1, will create a lock but not synced.
+	rev1, err := optimism.PutInfo(t.etcdTestCli, i1)
+	require.NoError(t.T(), err)
+	ctx2, cancel2 := context.WithTimeout(ctx, watchTimeout)
+	op1, err := watchExactOneOperation(ctx2, t.etcdTestCli, task, source1, i1.UpSchema, i1.UpTable, rev1)
+	cancel2()
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), optimism.ConflictNone, op1.ConflictStage)
+
+	conflicts, err := o.ShowConflicts(task, nil)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 0)
+
+	// PUT i2, conflict will be detected.
+	rev2, err := optimism.PutInfo(t.etcdTestCli, i2)
+	require.NoError(t.T(), err)
+	ctx2, cancel2 = context.WithTimeout(ctx, watchTimeout)
+	op2, err := watchExactOneOperation(ctx2, t.etcdTestCli, task, source1, i2.UpSchema, i2.UpTable, rev2)
+	cancel2()
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), optimism.ConflictDetected, op2.ConflictStage)
+
+	// show the conflict.
+	conflicts, err = o.ShowConflicts(task, []string{"not-exist-source"})
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 0)
+	conflicts, err = o.ShowConflicts(task, []string{source1})
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 1)
+	require.Equal(t.T(), lockID, conflicts[0].ID)
+	require.Equal(t.T(), task, conflicts[0].Task)
+	require.Contains(t.T(), conflicts[0].JoinedSchema, "TEXT")
+	require.Len(t.T(), conflicts[0].Tables, 2)
+	require.Equal(t.T(), "bar-1", conflicts[0].Tables[0].Table)
+	require.False(t.T(), conflicts[0].Tables[0].Conflicted)
+	require.Contains(t.T(), conflicts[0].Tables[0].Schema, "TEXT")
+	require.Equal(t.T(), "bar-2", conflicts[0].Tables[1].Table)
+	require.True(t.T(), conflicts[0].Tables[1].Conflicted)
+	require.Equal(t.T(), DDLs2, conflicts[0].Tables[1].DDLs)
+	require.Contains(t.T(), conflicts[0].Tables[1].Schema, "DATETIME")
+	require.NotEmpty(t.T(), conflicts[0].Tables[1].ConflictMsg)
+
+	// invalid resolutions.
+	_, err = o.ResolveConflict(lockID, nil, nil)
+	require.True(t.T(), terror.ErrMasterLockIsResolving.Equal(err))
+	_, err = o.ResolveConflict(lockID, nil, []*pb.DDLConflictAction{
+		{Source: source1, Database: "foo", Table: "bar-1", Op: pb.UnlockDDLLockOp_SkipLock},
+	})
+	require.True(t.T(), terror.ErrMasterLockIsResolving.Equal(err))
+	_, err = o.ResolveConflict(lockID, nil, []*pb.DDLConflictAction{
+		{Source: source1, Database: "foo", Table: "bar-2", Op: pb.UnlockDDLLockOp_InvalidLockOp},
+	})
+	require.True(t.T(), terror.ErrMasterInvalidOperateOp.Equal(err))
+
+	// resolve with the target table structure, the conflicting DDLs are skipped.
+	sources, err := o.ResolveConflict(lockID, ti1, nil)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), []string{source1}, sources)
+	ctx2, cancel2 = context.WithTimeout(ctx, watchTimeout)
+	op2, err = watchExactOneOperation(ctx2, t.etcdTestCli, task, source1, i2.UpSchema, i2.UpTable, op2.Revision+1)
+	cancel2()
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), optimism.ConflictUnlocked, op2.ConflictStage)
+	require.Len(t.T(), op2.DDLs, 0)
+	require.Equal(t.T(), ti1.Columns[1].FieldType.GetType(), op2.TableInfo.Columns[1].FieldType.GetType())
+
+	// the lock uses the target table structure for the conflicted table, and it's not conflicting now.
+	lock := o.Locks()[lockID]
+	require.NotNil(t.T(), lock)
+	cmp, err := lock.Tables()[source1]["foo"]["bar-2"].Compare(schemacmp.Encode(ti1))
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), 0, cmp)
+	conflicts, err = o.ShowConflicts(task, nil)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 0)
+	_, err = o.ResolveConflict(lockID, ti1, nil)
+	require.True(t.T(), terror.ErrMasterLockIsResolving.Equal(err))
+}
+
 func (t *testOptimistSuite) TestOptimistLockMultipleTarget() {
 	var (
 		tick               = 100 * time.Millisecond
@@ -216,6 +216,14 @@ type ClientInterface interface {
 
 	DMAPIUpdateTask(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIGetShardDDLConflicts request
+	DMAPIGetShardDDLConflicts(ctx context.Context, taskName string, params *DMAPIGetShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIResolveShardDDLConflict request with any body
+	DMAPIResolveShardDDLConflictWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIResolveShardDDLConflict(ctx context.Context, taskName string, body DMAPIResolveShardDDLConflictJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -802,6 +810,42 @@ func (c *Client) DMAPIUpdateTask(ctx context.Context, taskName string, body DMAP
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIGetShardDDLConflicts(ctx context.Context, taskName string, params *DMAPIGetShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetShardDDLConflictsRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIResolveShardDDLConflictWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResolveShardDDLConflictRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIResolveShardDDLConflict(ctx context.Context, taskName string, body DMAPIResolveShardDDLConflictJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIResolveShardDDLConflictRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIGetTaskMigrateTargetsRequest(c.Server, taskName, sourceName, params)
 	if err != nil {
@@ -2317,6 +2361,106 @@ func NewDMAPIUpdateTaskRequestWithBody(server string, taskName string, contentTy
 	return req, nil
 }
 
+// NewDMAPIGetShardDDLConflictsRequest generates requests for DMAPIGetShardDDLConflicts
+func NewDMAPIGetShardDDLConflictsRequest(server string, taskName string, params *DMAPIGetShardDDLConflictsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard_ddl_conflicts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SourceNameList != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIResolveShardDDLConflictRequest calls the generic DMAPIResolveShardDDLConflict builder with application/json body
+func NewDMAPIResolveShardDDLConflictRequest(server string, taskName string, body DMAPIResolveShardDDLConflictJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIResolveShardDDLConflictRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIResolveShardDDLConflictRequestWithBody generates requests for DMAPIResolveShardDDLConflict with any type of body
+func NewDMAPIResolveShardDDLConflictRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard_ddl_conflicts/resolve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewDMAPIGetTaskMigrateTargetsRequest generates requests for DMAPIGetTaskMigrateTargets
 func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams) (*http.Request, error) {
 	var err error
@@ -2972,6 +3116,14 @@ type ClientWithResponsesInterface interface {
 
 	DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
 
+	// DMAPIGetShardDDLConflicts request
+	DMAPIGetShardDDLConflictsWithResponse(ctx context.Context, taskName string, params *DMAPIGetShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*DMAPIGetShardDDLConflictsResponse, error)
+
+	// DMAPIResolveShardDDLConflict request with any body
+	DMAPIResolveShardDDLConflictWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLConflictResponse, error)
+
+	DMAPIResolveShardDDLConflictWithResponse(ctx context.Context, taskName string, body DMAPIResolveShardDDLConflictJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLConflictResponse, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error)
 
@@ -3768,6 +3920,52 @@ func (r DMAPIUpdateTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIGetShardDDLConflictsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetShardDDLConflictsResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetShardDDLConflictsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetShardDDLConflictsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIResolveShardDDLConflictResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResolveShardDDLConflictResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIResolveShardDDLConflictResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIResolveShardDDLConflictResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DMAPIGetTaskMigrateTargetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4373,6 +4571,32 @@ func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, t
 	return ParseDMAPIUpdateTaskResponse(rsp)
 }
 
+// DMAPIGetShardDDLConflictsWithResponse request returning *DMAPIGetShardDDLConflictsResponse
+func (c *ClientWithResponses) DMAPIGetShardDDLConflictsWithResponse(ctx context.Context, taskName string, params *DMAPIGetShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*DMAPIGetShardDDLConflictsResponse, error) {
+	rsp, err := c.DMAPIGetShardDDLConflicts(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetShardDDLConflictsResponse(rsp)
+}
+
+// DMAPIResolveShardDDLConflictWithBodyWithResponse request with arbitrary body returning *DMAPIResolveShardDDLConflictResponse
+func (c *ClientWithResponses) DMAPIResolveShardDDLConflictWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLConflictResponse, error) {
+	rsp, err := c.DMAPIResolveShardDDLConflictWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIResolveShardDDLConflictResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIResolveShardDDLConflictWithResponse(ctx context.Context, taskName string, body DMAPIResolveShardDDLConflictJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIResolveShardDDLConflictResponse, error) {
+	rsp, err := c.DMAPIResolveShardDDLConflict(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIResolveShardDDLConflictResponse(rsp)
+}
+
 // DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
 func (c *ClientWithResponses) DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error) {
 	rsp, err := c.DMAPIGetTaskMigrateTargets(ctx, taskName, sourceName, params, reqEditors...)
@@ -5438,6 +5662,72 @@ func ParseDMAPIUpdateTaskResponse(rsp *http.Response) (*DMAPIUpdateTaskResponse,
 	return response, nil
 }
 
+// ParseDMAPIGetShardDDLConflictsResponse parses an HTTP response from a DMAPIGetShardDDLConflictsWithResponse call
+func ParseDMAPIGetShardDDLConflictsResponse(rsp *http.Response) (*DMAPIGetShardDDLConflictsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetShardDDLConflictsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetShardDDLConflictsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIResolveShardDDLConflictResponse parses an HTTP response from a DMAPIResolveShardDDLConflictWithResponse call
+func ParseDMAPIResolveShardDDLConflictResponse(rsp *http.Response) (*DMAPIResolveShardDDLConflictResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIResolveShardDDLConflictResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResolveShardDDLConflictResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDMAPIGetTaskMigrateTargetsResponse parses an HTTP response from a DMAPIGetTaskMigrateTargetsWithResponse call
 func ParseDMAPIGetTaskMigrateTargetsResponse(rsp *http.Response) (*DMAPIGetTaskMigrateTargetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -122,6 +122,12 @@ type ServerInterface interface {
 	// update a task
 	// (PUT /api/v1/tasks/{task-name})
 	DMAPIUpdateTask(c *gin.Context, taskName string)
+	// get the conflicting shard DDL locks of the task in optimistic mode
+	// (GET /api/v1/tasks/{task-name}/shard_ddl_conflicts)
+	DMAPIGetShardDDLConflicts(c *gin.Context, taskName string, params DMAPIGetShardDDLConflictsParams)
+	// resolve a conflicting shard DDL lock of the task in optimistic mode
+	// (POST /api/v1/tasks/{task-name}/shard_ddl_conflicts/resolve)
+	DMAPIResolveShardDDLConflict(c *gin.Context, taskName string)
 	// get task source table and target table route relation
 	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/migrate_targets)
 	DMAPIGetTaskMigrateTargets(c *gin.Context, taskName string, sourceName string, params DMAPIGetTaskMigrateTargetsParams)
@@ -806,6 +812,59 @@ func (siw *ServerInterfaceWrapper) DMAPIUpdateTask(c *gin.Context) {
 	siw.Handler.DMAPIUpdateTask(c, taskName)
 }
 
+// DMAPIGetShardDDLConflicts operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetShardDDLConflicts(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetShardDDLConflictsParams
+
+	// ------------- Optional query parameter "source_name_list" -------------
+	if paramValue := c.Query("source_name_list"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source_name_list: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetShardDDLConflicts(c, taskName, params)
+}
+
+// DMAPIResolveShardDDLConflict operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIResolveShardDDLConflict(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIResolveShardDDLConflict(c, taskName)
+}
+
 // DMAPIGetTaskMigrateTargets operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskMigrateTargets(c *gin.Context) {
 	var err error
@@ -1225,6 +1284,10 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name", wrapper.DMAPIUpdateTask)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/shard_ddl_conflicts", wrapper.DMAPIGetShardDDLConflicts)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/shard_ddl_conflicts/resolve", wrapper.DMAPIResolveShardDDLConflict)
+
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/migrate_targets", wrapper.DMAPIGetTaskMigrateTargets)
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas", wrapper.DMAPIGetSchemaListByTaskAndSource)
@@ -1248,109 +1311,120 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TXVEKCHADmklDtNs/ay6Zp/TSazrgUFXPqXkg97F1FjXSu0xVFP",
-	"MVwcJenrkLoI5ZRPSq4RkzwL+dUn9DVHmovqeysgv+pjKTmBYiTIr4KQkhgvgxgnDqLph0A+BJiANUwT",
-	"EFOWQgFWQmT8eDqNaMj3MkyWIcz2QppO/72aChwtplzARYKmcpGJnidnUM47kdNN4jxJ9pxk68OcZ5Rw",
-	"9KdE3eYYhY4DUidvMAQFulAc5GUNzWB9FNKTWGrLx/OTfqY3K/ohfiBWdlHOtegJ5nJjPqEErq1lG3ow",
-	"lH8AQQEXNAMQMDkcMDN+3IDSolKp2Pv1+XuYonM52snwJ3maXSg/pA1e5Z9EeZqBnOA2THLZBAkUBYoR",
-	"1W+ad0fHo4jmiwRVe0fydCF9ufEIcYFTKFAgqIBJwOjN0DdjTDBfoShYrAXa+KUNFtKQObDCRBwdjno9",
-	"1Nr74zahWqg0wXRTycVsp2QzXoNM9DKbehosMEnoMlgKHDn5gwlMluDt5dlJYczzjAuGYAr0qzVjh17C",
-	"eRzu709QOHsxmc/Ry8liH4aT2f7hPgzn89lsdnA8nzx/cfhyNB6RPEkkXg2XtTKRNRDdVr8EUeqzyup3",
-	"g6kN/wKTvZn83/5wWCJsvJ0Y5onklb2pfqCXqMMmwYgwQ6GgbA1uVoghBZrel4QuAeZSMUh+GgDBNrTD",
-	"KWOU/R2L1TvEudPXkSyj7A1AcmyLjdSvQSjdnta76hkItUvUlKaxeTXlS9+bqQGqzzZUE41teFyS9BYJ",
-	"49GekZj6HYBQDwpcYmGeASy3rdQauU9tSE0zzOVvhk1NPC2gunHTAYncdj+GERRwcORQj7YdAY5SYMrS",
-	"DlCaUlLk6t1IaP59eCRMKLNlJLTv84DQV87U9sHWDsODAm58kC2DL324B6R56eJvGeR3eMmUC8uWSPAH",
-	"BL428WNg8rCcky+qOR8D+ktpgC8Ey0ORM+THQgMYhCrwCPjXpB7UvPl0+uryFFy+en1+Cr6I+Rfwly84",
-	"+gIwEX+Zz38B7z9cgve/n5+DV79ffgjO3r/5dPru9P3l+OOns3evPv0D/PfpP/Qbv4Dpr5f/759G76Mo",
-	"wCRCf3wGb85/v7g8/XR6An6d/gJO3789e3/61zNC6MlrcHL626vfzy/Bm7+9+nRxevnXXMQv0sUhePPh",
-	"/PzV5Wnxb+lWudISBrV2pBYtnIkS5ew6hqvf5wMi0/L1Yi6Lqs6taiTvHjw9fTCbze6dnj6nMOoPuxIK",
-	"I3fY1REF+f2MFAlo3GVLKCpUreelx9+mB6NLhjh3PtRxynCYGlRrBUT2fNbSdVQcgLtI3sjC3pcvfOny",
-	"QTz0Yv9o3ksNw/V9rPRBeeCoO2EVrlB4FTDEVVjS5LiMoYkaAcwIOxqqHmIOMsg5ivaAW9Tvk0QZ12Hs",
-	"wbSpiXuDXh2nIKB0iDfojZOcr2oRnA626rP+nWGBuIrVNF5yAZXIlhhkFBMBuPwFCnDyDoSQaEnGAsBY",
-	"RgYMlXGpfK1Iv7WOZPjXJAgpEYg4cONfE7CmObiBRFgY1vbOYWnAl3BemZrCGkhzMwZfwn3/owP3o3vY",
-	"l/90Gpg1CdvI/p5FsKA5zQROMRc4BHwFWSTJKDWAtN7gBouVzribraEkWYOco0hG2ARAE6gCGoY54wAT",
-	"75wnJ+cgrQWn5dY0k4/WPrkY13FWs41T0/ubpY85cwX5VUYilPjnGchogsM1qGWc27H/Hxlmxq8r5GnW",
-	"FCY1SGcQBNb5mXI5O34uTIgnD2KZOfknu9aOX7nuwdGstfTlCoFisJSgDDFMIxzCJFkDo/LidkpGoxWN",
-	"gZkcXMMkR8dALSEZiqOQkojfDXqGUohJwDMYohoG82dN+N9hgtM8BTFDCESYXwH1loLh7eu7LH/r44kH",
-	"zWM/Yt6uL09XWzNDIY7XBnieL6zsXEwZaIG9B85iQKgA+k0seUKduUtVJQAlCNzgJAELpBTQHrhQkJqz",
-	"nWOwD9Hzo8ODw0n8/GU8mc/Ri8kiQvtFOlQ6mi80KvP+BGBD0ts0dsm72tY3Sojb9FAWTR9NFULZFnGV",
-	"eQ70w8ojtGzYzzzyTuWRb31c0h+t2Gq7ziWmeqIKPepTNGhYHIRqMdGGpSLqXxpUnY/B/OXzl7+4hL22",
-	"rof5XDx3D2brZi43CJpwRRWEBOjhAQihCFdBngVpWRFVB+JmJT0UJpW4GgvyTDtT5e5Y4ZdPzJ16dTP+",
-	"rPDem/J8oaZ0uYnu0ouCiJora9N9ygmRL/dpzjqzOpnIRte1wz6iF2C7VPGFclfL45i2nGl3Vukedbwz",
-	"rpJk/VmYRmLsAoU5w2LdXkY50aZKhvOk7uFp8xZjlESlZVvhKEJEO9dLJMqgxp6oNgmIGU3VEOV7xdLP",
-	"aaulRviKmAhgktAbFAUhaYP9hqYpJeC90cwXF+dAvoNjHEKdPCiJ1UsczpMghP7Ay5pYq6pipM1tTp6V",
-	"E0tMvFP/Zk0n8fh4+s54C9P/eTZ7WVSFNFDrX/UKrf2LvqnWk7uSMXwtUbtC67IkxVq8Z71mZFSnpYMG",
-	"bQCd0mGCsreM5pkjbRwl7VK33o2OMeMiSGiorYzrFRmNomizaYXOpruG5mTzCVvJEjX7uMK5hUgJtrWg",
-	"k6hllU5D1ejf3b5ezS+JYcJb6ZHSkqgoXGsAGTap12sq3rzetibGrazM5aD1qHSztRMpo7lcKiillbnW",
-	"OS7z7gUhTuA1dVgz/XtZ11fSquH2uSSxCPFd1AamJtJd+OjMAEDObyiLvDOWA+pTHhw+OxriiRYZBvfc",
-	"8qE178HB7MgVzWZFQqGzlFUNqlyVMh7peskOXaSgWhat88yoGCff6aoXtSpFB1eFaq9js6Lb3uNPyK+G",
-	"F3VcQn5VlXSMRzl3+XoGN/mwhR+jVAystgscGWqzZF2Ei391aKEOx8cq2fU7PnrUZJj3Y5Pct17pQbrq",
-	"WfqLUrRDxFXeT7pEN4y6fM+C53kJTC/PV6xyD/5lKEtwCD183CjHbGfNTDWz8baTNdAlzyYN7tCJG9Zx",
-	"FpxlA+LkHRmWd1Z2MpTSaxSkSJ8rD7Yk+j2VV1au7AJy5QlF9IaYeKj42Z26hzEKUhqhQOAUBVGRI21H",
-	"RzhFoHgszYp8s8g7W3p7xp0apyLXIP3QEDats5hQQDpgg/zKlAuqATZA+7PZ0WQ2n8z2wfzZ8ezwePZs",
-	"WIn1haBZ55bdHycJLM3FYKrfQKzjFo0vzeqkf8YHYlarR2g7qXmaDRR0qyp3g0K4wTonoTAaCIl1UG0d",
-	"ejrYpDij7+DQPiXlD/L7TN6FGmj89YGYXaxJWGGmTtndmMlHQMFmc4U6pxq7XHyGOE2uURQoD52GV4Hn",
-	"KL1TzRYXRpykcZ8U+3VnQUqDp1OVVuToyPFJrN0VCSb/oed1ILuQlMBkKaniWsI+dbtZ4XBVJsQwB8XL",
-	"G8XxrazjwPygw0SHiIhAZEMLLcwBULBAK0wiK+U25N0yQHQYFfmsE6PaCD9Guq4CXRd3PAfAZcrZB9PA",
-	"koOlDNq79lwPaGw7ZAjkZFLMYm99p1jXMgW90bRNCBvJ2q6PhyUF69vj3IymHLjoZIXvtlD52MolzKo8",
-	"4r65RF+JVlvSLk3lR1t5+tREjBNJP5brhAKMIizfgsnH2ug+vf8ak3O6/E1N9knO5TLLiKwgCVGgb90G",
-	"RXHeCpIl6q31sFxCHcMAnmcy0lFHgqp0QF/mjaIEZEm+xGTIZVu8JJShQB0yS2Yoyd+40KuGgYwhcxyt",
-	"hjl36xoxrpM//YoRCWjIUD9litKJcpSbRHA4vQp9Ligrqi+8BzbVpN4aKr87YXMjv3KHd5QEUa7CGeGY",
-	"bUVv5OatIIl0bjVOcChQpDBRkWme6gPTLNGp6OIigya+JV+WmpVKRrn37uOOG7hWBymUSl0EBZJmzVos",
-	"Q5ybepPReFQVn7gX02Z9WFpEeUPqBSs3cpe0RG9trQrvU11AXApycyelwJgxQI0ZDy9OVkrMVCg3hLuR",
-	"a92ANrrU+QQK+FqGbkWCxb2VBeRFNGZ2L86TRCJCQoZSRHTtMExUPWrFsDBJhjpuFQg92qrB7E38nbvS",
-	"ZCC3vXDoUtfphEBK4OXEHEBRnNgm6BolLV1vlJyyro7QRf5c+NUe/VcbUyMtiNJkiK4zMJga7HYFXQaF",
-	"QEzVrmib5AfGN7yC639PmIod+zP6zh34LU8Sw+9SeH0Xha1cgeTEUr4kF3HHBU3CMReIhI7TPqWjiGA0",
-	"AYXawsT4YeoAT5c7USYVZqwua5WzAch5ziSv1vcmF9RFAjmdp+5GUCaj1wizttrfmxbrB0Zht2bWAwKx",
-	"YghG9Wqzw6YlUwTTL0j6hZQYd9Ppw+LUO/P8yDm1fqN3ah8HnJGQbcYBlhLyMIA0bMECirBe2Dpv18PZ",
-	"c0kXdMUowf8ul1JzAPQHCnP1k5SHrzkkAqul3MVsWTKQfE1E7kzD+p0Wt3dRiYy6UdOimdGYlY/Ue8Ju",
-	"3hDFEZnluPguRijNvcES5o2hS7gTq2a9BsBNcBqL+UyGP8IofbjO+IJfDQ4vKp+mnVhrRLvVCrODOJzt",
-	"Hx1M9l+EzyfzOXo+gUfPDiZH4Wzx4jB69jI+mB3PJ89nh/PD/YPx7Nnh88PoILSGvzh4tj/Znx1Ei/3D",
-	"oyg6iI7nk/nzmbMlSL1+zGrxoR5UhXy+NzNaJ9ChMz2wnZx/Rxbet/k1L9MDyoShBErb0V0oLFVn6bSE",
-	"Zo/7PLmmtbzVHtnG8zR1bt3j9hK5idFgt9bi5L7shA2HdxuKHGnhnV4ImmUqe1BVPP1mLtY44wunr+0v",
-	"0tNOvaD2UYjt4vOBMX/DeqqHaoKCfx0qQz4edsbHO2sbBvKlHSN78idjcIOTKIQsKhID9eB3Mfn1nlnx",
-	"1hmnL1suqvKMdhA2AFbhhLXzfM4yFz47ITx2uOKeh9yMiCKuS7JNlqbAmDe2ZX5HCg5cwGeRG+QZ3sTG",
-	"Ebt2kLRK03TT9ElVpGynAuUuhSFbqppw1kmUNPHuOkozKR/e81J6jdgNwwJtdMBdvqW9bWFWKf/ov/VU",
-	"rdsPuu9eYgxxolri8Kt2fqqj8sJ5+bBUp/3drgoFVk3q1F1No5KHIeLcA+5mdXztucZtariA0lfhHrQB",
-	"13A1pBd/5F5ajU41XUelHeGGvwSlvdHVit47T+ZyEweF9RLUlMXwrsZdfQe9dyiZ6SuSabR1fPiLz97G",
-	"hFu9+XyrUj9CKuPkhIaOhN3JO/AhQ+TVxzNw8uGNVLksGR2P+nrqTaTxnGiXFlNiWuzp+CKmisWxUIi3",
-	"FigOYY5HR5KA6nQiQwRmeHQ8OlA/SY0vVgraKczw9Ho+Nf0bpsX0xl8qWyudRWqtVx/P6u2J1CGl1qxq",
-	"vv3ZzGT8ikJvmOlUsUTjX1wXwlR+VGcPVHcjJEX1hlnUikxtIs/TFLL16FjiAMpGSCSmgOfhCkAOat2R",
-	"BFxyq3PR6LMqGfVhr5VPkwBKDF/TaP1guLf7LLWQNsuChVz39gnvQ65oVtuKPSfhb8ctftQHzHwoS1Zd",
-	"pR6HMR1drLrIMh4dPiAYrc5ojqW1Oe8QDKvhbWG4NtmY6Tf9h4oIb7X+S5D2Ax079SGOE0yQJtt7fdqU",
-	"QQZTpHf5n63jLwu8IiZX7SKgWI0KQzCyYBjZalwffbvym/6+0p9bjHPo8MOf2I5STddG++JBG1k4DAMl",
-	"rGp59jgS5mixtmMSZrVd3kjCzMZMvxkvbCMJM97jAAmzwfNLmAXDjy1h9SbanRsZpXsFcE7JeovECQ3/",
-	"6+LDe48o1cGSc5X3/NrsFtEQqOUqqCIaNiAyPmoHOH+7fHc+CBw5sAecldAH5D5wdJDXr3qqRoV9zCzl",
-	"q7jvpW4Ol1coFE9/zRFbW0yNxSooRziY2F06dTt2fExhDRgSOdOtXHSZ1sR0cSiuIrhAqDUv2ASGz9vV",
-	"vo7ekA5JsS/YJkUH1wYfNIdU/FDE+CpG4779t5t9b8vZdvQT39zhnj8YPGVO5MnbOd0ID0ASFaWJEBB0",
-	"Y++6a8PbOmD6zTpZ6LdyJ+phyRSdOmGZ0IVqp5MT/DWv3wr3G7z6Qccgg+e9lddWGDHV97toVkACE25a",
-	"1xR9CVRCx5RTuFSHmuOeOmMHDK/mAwD7eGo8xIbsIq88jk3bpj3p0Gdlx+BDJy8aylMBYvWRkrZ96WKI",
-	"vjTOzvDE5+3YPVca/7aeCJXg3n4f1nhieshkseB9bds00p/lUElwv9tjPt6xWyzaFzM8OduiifwAm1o1",
-	"pujYU/2NjJ9bus0tLd3Q++6oCsk2E9ZPRX+6H9OcuL43dGvsya5qhqpBWJwT3WKyuHT1MAy2geL4wdnL",
-	"8YWhXeUuo6S2zlxl65sO3qp6q/64rNXuLzvcDX7anKY4oNYWc3Nesr6+OyDE1k0EhyRrt8A6/hY82w1w",
-	"640Td+SAqugypItXfcnZoewx/ab/qDJ4A5hF1Xw/PV4ZdxT4epavcB+4vLP+d6tcWr+Rv1tMquuf786j",
-	"ZVeRIRqsbLv1dKxh58WZRzkLanw2aUfYx/62s/2t8/t7WIJBwmPzwWm/e3Vphv3oucZ2OeufxcUqGKFU",
-	"VRRA/T0EXSvQw136iKdPMxVfjetlIMnzkF895um3uTe1WBfNy3SbJ9eaxbOhBqtsq9W1qkM+mss227mN",
-	"N0pPWzZzy6q29XFABxMqIiemzdzTUbQlVBW762r6Icf7l7pPz/YO9+3rAt/zaN/1pawdOucvvxNV3+Gm",
-	"OpuGxTfseY95NB+73+b+1z+n72UBHGsexhxgkuVC91Y2ulT3mS+w0l1Gqy/V6x7llIFrHCJwjRiHW2Wi",
-	"Bkq7w0aXqkBKUZmYRq2mnTyNAWz26G8RdW8A5xV3x4aZ1OJ22CPUs+64ai8v591Lx19WN/u2IevmTtf3",
-	"U+8+AJ6oPq/t7CbCNdVNZnqU+5ka9Ej73ryjujkb7G8Jnt3Rz6Z10N3Z4ptqkbdJDV+DOzaKju0ufY6w",
-	"uIRlYFDsa++303Vz/pvVTQU+2FjuzjbNfjjF3rbXXVvuLZCr7lj/3PSdKU0buu8t/X03rf1UOaKr2FrB",
-	"gK4RAThWXeoBzxdF2MfKXkU/y619kf4AM7EzfPEIudLvoZ0aQeShrzNeR1G1f/f7SqqfMgNstYr6fgnG",
-	"2Y+eYCyrqwcmGC2T5TmfK3rwFf01h6SDan07+c4oskcvjnCesegu0aY78chX9PDr8Bl1I+nuCdWYXx//",
-	"TLzNLTt3Mq7O6uzqCkgi043W/MBoLsxdNFy7WHx3qRxcS1ZWkb1eS1q/ItHdTtB/EKH8Wd3Wxd/uErd7",
-	"c/GGJW9lsdtPlv5ZhLezsuSsxHtgUZLvLRK0YUpikaALwfJQ5OynTD01mRr7O9r6SF5wwGCau78Vtfvp",
-	"+5rkcYvFN03O/JSQnxIy/z7BUp35dj9Y6hRDf5asTM/8FMWNF/9RBPHhU5RWUrAph3+uWmwtcRuazW6v",
-	"VcDeOpfyE+A/WOa79enzXb2Pq78vfrfk87CbRdaHDHdQ2ZctzXe9tn5HLzGZaxWaezbjTpr1Ki/9Lfwf",
-	"TndptHdfddHMr7nUx0fYdbGj9ebza5rvRTSFmKjW8yNJajOBWxeM+rrdRzQc3OLe9LSffs1xeDVRGnii",
-	"y1InVVewmo4ZuTwzhfZ2obrBYjWJUgsetWwbmqILbDmu+OH28+3/BQAA//8+kDDk07YAAA==",
+
+	"H4sIAAAAAAAC/+w9a3PbOJJ/BcfbqpvMSZZkO07iq60rJ/Zkfec8KvbU7NZUloFISMKYBBgAtEeb8X/f",
+	"woNvgKRsybHG3g87jggCjUa/u9H85gU0TihBRHDv8JvHgwWKofrzKEJMvIMEzhG7oAmN6Hwpf08YTRAT",
+	"GKlRC8qF/C/6HcZJhLxDb7L7Yme8M96ZeANPLBP5ExcMk7l3M/ASyqrDX41f7eXjMBFojph3czPwGPqa",
+	"YoZC7/BXvYh5+XM+mk5/Q4GQs76JUi4Qewfl/zdhhGGofg0RDxhOBKbEO1S/Is4BnQGxQCBIGUNEgFhN",
+	"AggNkTewbevw5e6BdW8wwleouQ4lESYIcAFFalbD3CxTXkGwFOWzTimNECRy2gjBEFngx7w8k9qDGdpj",
+	"UgJjVD02PY1lY7WzUG9mm82hG2gktxyOm4SgJDQ/1pTmi9K4vzA08w69/xwVRDoyFDqykufNwJszOIME",
+	"9p7nrR5fnkKjIp/Bj7CmcSxQzLvm00RYns5gBDIG1b8TRmMkFijlvYH8mL9Snviasstbw/mLetkN5437",
+	"KPWr343PpjQloc9pygLkZ4RcXVM/BPIhUMOBoJpbNM6ay8ZL/jUajtsWFHBuWUpPrx7mzO1aRI21rdBk",
+	"Rz1Ff3aUqK9CakOUlT8puUJM0izkl5/Q1xRx0TxbAfllF0nJCRQhQX7pB5TM8Nyf4ciCNP0QyIcAE7CE",
+	"cQRmlMVQgIUQCT8cjUIa8J0Ek3kAk52AxqN/LUYCh9MRF3AaoZFcZKjnSRmU8w7ldMNZGkU7VrR17Zwn",
+	"lHD0p9x6mWLUdiyQWmmDISjQuaIgJ2loAuvCkJ6kJLZcND/sJnqzohviNZGyDXO2RY8xlwfzCUVwWVq2",
+	"JgcD+YcURFzQBEDA5HDAzPhBDcoSlnLB3i3P38MYncnRVoI/TuPkXNkhTfAK+yRM4wSkBDdhkstGSKDQ",
+	"V4SoftO06x16IU2nESrOjqTxFDG5LOICx1AgX1ABI5/R675vzjDBfIFCf7oUaOWXVlhIQ2bZFSbiYN/r",
+	"tFAr7w+aiGpspQ6mHUs2Yjshq9EaZKKT2NRTf4pJROf+XODQSh9MYDIHby9OjzNlniZcMARjoF+tKDv0",
+	"Ck5mwe7uEAXjl8PJBL0aTndhMBzv7u/CYDIZj8d7h5Phi5f7r7yBR9IogtOGyVqoyAqIDq2fgSjlmRzS",
+	"B0yt+KeY7Izl/3b7wxJiY+3MYBoJ79DbGekHeokqbBKMEDMUCMqW4HqBGFKg6XOJ6BxgLgWDpKceEGxC",
+	"OpwwRtkvWCzeIc6tto4kGaVvAJJjG2SkfvUDGlreVc9AoE2iOjcNzKsxn7vejA1QXbqhmGhQhsfGSW+R",
+	"MBbtKZlRtwEQ6EG+jS3MM4DlseVSI3WJDSlp+pn8dbepvs8SUO170w6JPHb3DkMoYG/PoTKvzcFRAkzO",
+	"0kdoegO9evsmNP2ufxN63k1v4nwBWXh8fPaGklmEA8HXtIn6vBvfh7K71ngKhVG4ebC14bNWwPWUmwZf",
+	"2qJrxHnuqmwY5Hd4zpQpzuZobRTfmPg+drJeykmnxZz3Af2FNCTOBUsDkTLk3oUG0A+UA+Xzr1HVOXvz",
+	"6eTo4gRcHL0+OwFfxOQL+OELDr8ATMQPk8kz8P7DBXj/89kZOPr54oN/+v7Np5N3J+8vBh8/nb47+vQP",
+	"8P8n/9BvPAOjHy/+41ejv1DoYxKi3z+DN2c/n1+cfDo5Bj+OnoGT929P35/89ZQQevwaHJ/8dPTz2QV4",
+	"87ejT+cnF39NxexlPN0Hbz6cnR1dnGT/luahLbxittb0OMOpNeCjjHbLcPX7pIeHnb+ezVXCqvWoakHI",
+	"tYfZ98bj8Z3D7GcUht3uY0RhaHcfW7w5t70UIwGN2V9iimKrpee559LEB6Nzhji3PtT+Vn+YalhrOHbl",
+	"+UpLV7diAdyG8lo0+a504Qr796IhGY/txIah+i5S+qA8CdQeeAsWKLj0GeLKvapTXMLQUI0AZkTZqyse",
+	"Yg4SyDkKd4Cd1e8SDBpUYezYaV0Sdzrv2t9CQMkQp/M+i1K+qHii2mmszvoLwwJx5XPqfemQOAJqBwnF",
+	"RAAuf4ECHL8DASSak7EAcCYQk1jO/Gv5mtl/M7XEv0YyrigQseyNf43AkqbgGhJR2qE3aNc04EswKVRN",
+	"pg2kuhmAL8Gu+9Ge/dEd9Mv/WBXMkgTNzf6chDDDOU0EjjEXOABcWu0SjVICSO0NrrFY6MyBORpKoiVI",
+	"OQplpIAAaBxuQIMgZVzGjV1zHh+fgbjiZOdHUw+ils7JRriWnNMmsr93V0sfU2YLVhSRlUDuP01AQiMc",
+	"LEElct7gJvR7ghniFX4a15lJDYKaTbGOM+XLeYOmCnHEc0pqTv7JrmBUWXfvYNxY+mKBQDZYclCCGKYh",
+	"DmAULYERebNmaElvKxwAMzm4glGKDoFaQhIURwElIb8d9AzFEBOfJzBAlR1Mntfhf4cJjtMYzBiSETF+",
+	"CdRbCoa3r2+z/I2LJtYaj7/H+GNXvLGyZoICPFsa4Hk6LUUZZ5SBBtg74HQGCBVAv4klTUgYIygQF4AS",
+	"BK5xFIEpUgJoB5wrSE2O6hDsQvTiYH9vfzh78Womw7ovh9MQ7WZhXWlovtRbmXQHMmuc3sSxjd/Vsb5R",
+	"TNzEh9Jo6lnOlE0WVxF0Xz88/NYQlIOnePh2xcNvXFTS7a2UxXaVSkwVSOF6VKeo4TBL6Go20YqlQOoP",
+	"NaxOBmDy6sWrZzZmr6zrID4bzd2B2NqJyw6CRlxWzSEBWj8AARTBwk8TP84ru6pAXC+QWCAmhbgaC9JE",
+	"G1P56ZTcLxebW+XqavRZ7HtnxNOpmtKyK0cJSYZETZWV6T6lhMiXuyRnlVitRFTeru2EXUjPwLaLYk6j",
+	"K1SPQztT71rn8lsHuI/U+7aoWUSDS5OgKcdr+OVwMvwSTr/sfFFO1Be7D8jmSOhUrc8z/8xOE2oQyAfl",
+	"VU0GQBTqEbxwmiSCwkFllDHUufttScQ0FcAgDECGAL/ESYJCad5h8V+8UOBt3pOKWMlYnXSgTt9fgFI8",
+	"bqBcp4uTv1886ySwDL8rUYHLsWeIpzGqlCb1ymLqqHsli1k1HxzT2oA+V3PmczVVhHquS8jUJIOCZLsD",
+	"iDXqPEdByrBYNpdR/p8GGHAeVZ0TTTUzjKIwN8oWOAwR0X7hHIncHy9PVJkEzBiN1RDlNsxggCwatRZ5",
+	"QUz4MIroNQr9gDTBfkPjmBLw3hgV5+dnQL6DZziAOu6VI6sTOZxHfgDdMYPSxFrLZiPLZG8Vt3JiuRPn",
+	"1D+VppP7+Hjyzhi6o78/H78yf9e31r3qJVq6F31TrCdPJWH4Sm7tEi3zqrDS4h3r1Z36Ki4tOGgCaOWO",
+	"Gi83dwEr0kzFH1TwQQoKuYVScMLEI6ok9hvFBIX9hK4e65K9mchc4GChBKX0bgqRajuju2mLrEjoVipM",
+	"BQGt5bY2cZsv1+eQjHZsoHBBr6WFZHSRUxNBUphNatXGmUHHAlIzAcoA+h0FqXCsYIJWIb0mhcFK0liR",
+	"7SVOFKGjwPtswfmqCZxacXAxXj8YbiLrU16zCvGgmhEyWOxzoppYmrxXP6m6IdFkSUstnx7qx0W1TwMl",
+	"JS5y294LVDFmNEDZm9ocp3qksYxIqNj0GmKtvajUa5mlZDXUwzDibl/fZlJlBLyCGnqINJa9sZpVOtAG",
+	"oliUfjMGaX5aM6r/Ubc978OY7M0qVSlew4STgTCZv2U0TSyZ+jBq3pLopIwZZlz4EQ1gJv6sCQAUrjat",
+	"djusQ1Oy+oSN/JSafVDsubGRHOzSglak5gXetlsWjvBaJRQ0gxFHA6cAka60mktFqtXrFafGvN6UC3ps",
+	"KULRaz0KUo503E4G0FNpWCtHmGtb2RZRcYIwi+AVtQQQ9O/5lZAcV7VIm43ls6yKDdvAXKex35mxzZZA",
+	"zq8pC50z5gOqU+7tPz/oE/zLkjr2ueXD0rx7e+MDWwIhyXI4rbeg1KAiOpSHgNteKkeLJaOWPLFWsy0b",
+	"15T0zqtGvS8U6UDPave1OivO5H2O3p60TEcXfvTASzlizr3Jh439MUrFioK+nMgzS1ZZOPtXixRqcdiL",
+	"g2hx2FuVc91rL6PctV4etLOVQnfXM2tHnqtUq7Rhrhm1hfsymuc5MJ00X5DKHeiXoSTCAXTQce0mTzNR",
+	"qQdkUeJoWb6Mh2wyccUrQBlllQGx0o6ATLReCmIoplfIj5GAK2kS/Z5K5asQzBRy5cEXbk7+s71aAs6Q",
+	"Lx1kX+AY+WGWlm6aeThGIHss1Yp8M3OtS3J7zDuM1VtF2gY6maSAtMAGZRpXDlDp8ApAu+PxwXA8GY53",
+	"weT54Xj/cPy83+28c0GT1iO7+54ksDQVvbGeeyx6vzSpov4577mzSglo00hN46Qno5cudN0M1i9zZAFQ",
+	"T0hKtYGlOjMLmWRmfoc71Sak3HmVLpV3rgYae73nzs6XJCh2pgob7TuTj4CCrUwVciUbzCnJPF5fWehF",
+	"RGo1MZvdNbaixl6c55adGSrNPq2itEBHS1pV7tpeBGpSTnpey2anEhOYzH27z18pdNIhvyx9hjnIXl7J",
+	"8W8kenumZC0qOkBE+CLpW9tqam78KVpgEpaynH3ezR1Ei1KRz1p3VBnh3pEuZUVXWXuQHnDpV/rjoMQH",
+	"c+m0t525HlA7dsgQSMmwFD/qH5fNIwWd3nQZEeVNVk590C8PWz0e62HU+cCGp5L7XmYqF1nZmFnFF++a",
+	"A2uJXdU47cIU2zaFp0tMzHAk8cdSHVCAYYjlWzD6WBndJfdfY3JG5z+pyT6llfh7gQxEFpAEyNcNW/zs",
+	"PsQCkjnqLK8tmYTahwE8TaSnkwfa9LQgDCOQROkckz59WvCcUIZ8VdcniSFHf3V1PQwkDJkKQDXMelpX",
+	"iHEd/OkWjEhAg4bK/r0wHspnjaIei9Grts8FZVnBq7NGppjUWbbuNifqWRzbLJT4YarcGYGcSZIFJGFU",
+	"iYrKnZRSFQwlkU6hZndgNfKteQtDSlLB94tWSGL9oF4yWQATtZDCSrkJ9vjvNVxK4ANKpUyDQsV4S0An",
+	"iHOTjfMGXpGaswJtzIP+AGvDugTtbcIbndeiVJgg1ne/coFQpwjJeGYMUGMG/e+VKWFoLpfVhEQtZrsC",
+	"bvQttWMo4GvIUR6osR9lBnnm1ZnTk3095EZIwFCMiL72BSN1laggfBhFfQ3AAoQOqVdjmvr+radSJyC7",
+	"3rHIZFt2XiAlOOTEHECRFdtF6ApFDZ1hhKXS0s3Z1M+Zfe6Qo5UxFdSCMI76yEwDg7k+17z8kEAhEFNl",
+	"x1q3uYFxDS/g+ucxUz5od2bAegI/pVFk6F0yr6tXTSnmICkx5y9JRdyWV+SYC0QCS7WLklFEMBqBTGxh",
+	"Yuw5VcCiK9Upk4J3pvoF5LMByHnKJK1WzyYV1IYCOZ2jZFpQJr3gELOm+tgZZev7RvA3ZtYDfLFgCIbV",
+	"iwL7dY2oEKZfkPgLKDFmq9UWxrFz5smBdWoc95raRQGnJGCrUUBJCDkIQCpIfyqLCKsbaF5lKM8lTdkF",
+	"owT/K19KzWFqCuRPkh++ppAIrJay30NIop7oq2/k1jisXke2WykFy8hBTZwZiVnYWn2zzyJLtRUvCNed",
+	"ViW5V1iiXBbZYwl7gNasVwO4Dk5tMZfKcHsquS3Y6qfwy95uSt0I6+YMq42vK/j4Aob0WhU+CAb5IquE",
+	"oDMwXwwpF+pRIoaUB8qfjSXp61JP+b5Rf6a0iaNmVEWvYKqp1PAmxAzN0wjKKp2EIc5VXamgeq0ymAVw",
+	"tS0JSiOHV/FP/4ed/37m//C/hwRd/zFf0Gd/6YNphY07Ql3H6G2Ani+CP0IU/UGjsA/gLlFQGMLNqG4t",
+	"1FJAMd6bBePdg73h7svghayUfzGEB8/3hgfBePpyP3z+arY3lpXy4/3J/u7eYPx8/8V+uBeUhr/ce747",
+	"3B3vhdPd/YMw3AsPJ8PJi7FtH7X7IgUU+kFxccf1ZkKrSNy3xqY2k3BqSQF97jwRGw+bZCBDEZQGR/vF",
+	"QKlvc0s3MGfcZf7XTawbbcavPE9dUVfdNCeS6zvq7QuVKLkrNFaGw3kMWYA+c2lkcidRoavihsNP5iK9",
+	"1Sm1OmjuSznaExS0nIcr+4W8Z8CpZnKph2qCjH4tYkU+7pdg5q2FNT3pshygcQTvBrJ6PAxkPaCJSlUj",
+	"L9Phj3dMyTQS7K5UjbBXNOrz6gGrsMLao74sc1atNCocxltBPes8jJAirjW53mC+Y147lsktMdhzAZcZ",
+	"V0NP/+abloBHC0qLGGE7Th9UOdRmyp9uU5W0oZIda5FOjhPnqaM4kfzhTNbTK8SuGRZopeqK/C3togmz",
+	"Sv5Hd5eDYt1u0F3XlWYQR+qqAr9sBjVbyn6szUZycdrdpTcTYMWkVtlVVyppECDOHeCuVkTanGvQxIYN",
+	"KN36Yq2Ng/uLIb34PfcArnXYbMvTt/io7vqn5kEXKzp7HJhmBhxk2ktQU5PF2xoOd1UZ3KJeq6tCq9aO",
+	"fv2NjpwN1Tfa6ehGxQuFFMbRMQ0s/u3xO/AhQeTo4yk4/vBGilwWeYdeVy/woVSeQ23SYkpMa3DtX8yo",
+	"InEsImRbIMsAHnoHEoHyHZogAhPsHXp76icp8cVCQTuCCR5dTUamX9som97YS3lL2NNQrXX08bTaVtWT",
+	"WNOSVc23Ox6bMHF2Ow4mOr8gt/Eb11VYhR3V+u0GewNXhfWaWtSCTB0iT+MYsqV3KPcA8gauZEYBT+UV",
+	"Mg4qXV0FnPNSx1Xvs6pXdu1eC586AhQbvqbhcm17b/aHbWzaLAumct2bB3wOqcJZ5Sh2rIi/GTToUVc3",
+	"8L4kWXTDvR/CtHTfbUPLwNtfIxiNjs6WpbU6b2GM0oc6MsW1ysGMvuk/lEd4o+VfhARynNSH2SzCBGm0",
+	"vTcXRyGDMdKn/GsjZ1oCL/PJ5e9SgHmZIvBKMHhlMa7rLmxBcff3cD43CGffYoc/sBOlGq+1z670OsjM",
+	"YOjJYUWr5vvhMEtr6C3jsNLnYlbiMHMwo2/6j9U4zFiPPTisDJ6bw0owPG4Oq378p/Ugw3gnA87KWW+R",
+	"OKbB/51/eO9gpSpYcq68OUKT3EIaALVcAVVIgxpExkZtAedvF+/OeoEjB3aAsxBx1AaOdvK6RU/RmLyL",
+	"mCV/ZZcN1dXk/P6OoumvKWLLElFjsfDzERYittft3QwsH4FbAoZEyvQteF0jODRd27J7MDYQKs3KVoHh",
+	"82alr6UXvIVTyl1JIsytdFAfUtBD5uMrH427zr/8kaJNGduW7yCtbnBP1gZPHhN58HpON75W+W1TFwsB",
+	"QdflU7cdeFMGjL6VMgvdWu5YPcyJolUmzCM6Ve0zU4K/ptVWOm6FV0109FJ4ziuhTYExo/pyIU0ySGDE",
+	"TavKrA+ZvrOva3BsokPNcUeZsQWKV9MBgF00NeijQ7aRVu5Hp21Sn7TIM/NE0tq+OwtJZZ19SmxWdhtB",
+	"dIVxtoYmPm9G79nC+Dc3N3Vwb74PaTwwOWSiWPCuum0U6s8JSkBbzB7z0cHtItEun+HB6RaN5DUcKiI9",
+	"zvSEPB3ppo80N0PveqLKJVuNWT9l/agfpzqxfSf1xuiTbZUMRUPgWUp0S/nsxt96CGwFwfHIyeuE/Gmo",
+	"ywipjRNX3nephbaKbyk8XtJqfk+ivxn8sClNUUClDf7qtGSA6Bmm1Z2X+wRrN0A67v5Pm3Vwq92mtyRB",
+	"ZfCv53IGZ/uSx+ib/qOI4PUgFlXz/fBoZdBS4OtYvth7z+XD6X1TabUdxHYRqa5/vj2N5i1t+kiwvOfb",
+	"w9GGrRdn7iUXVPtM6paQj+qBW/kAR3aJ7K4WlmCQ8BliHebVhRn22GONzXLWP4uJlRFCLqoogPr7Z7pW",
+	"oIO6dIqnSzJlX4nuJCAkdDH9PWa/zb2p6RJknQLnrnR39qyvwsp7urWtauGP+rL1XoKDlcLTJZ25YVHb",
+	"+Bi4hQgVkiPT4/DhCNocqoLcdTV9n/S+3PdGk/vl6wLfM7Vv+zLuFuX58+/CVk+4Ls5GASVXiGWVu23H",
+	"rwdu8vwzUDpIAM80DWMOMElSoRt7G1mqP86T7Uq3uJU3ZMy3CNWHXSgDVzhAQBbgw40SUW1L20NGF6pA",
+	"SmGZmC7B5hs88gMl9Q8bNZC604Pysrtj/VRqdjvsHupZt1y0Z3i9m4y/KG72bYLXzZ2u7yfeXQA8UHle",
+	"OdlVmGukOxN1CPdTNeiezr1+R3V1MtjdEDzbI5/1qd6BLL7JH1aq4atRx0recblFpMUtzmHp6RS7ektu",
+	"dd2c+2Z1XYD3Vpbbc0zjRyfYm/q67cidBXLFHeunQ9+a0rS+596Q37eT2g+VItqKrRUM6AoR2XuN0xjJ",
+	"b+pnbh/LexU9lVu7PP0eamJr6OIeYqXfQzrVnMh9VzvFlqJq9+l3lVQ/ZALYaBX13QKM48ceYMyrq3sG",
+	"GEsqa6Tbp2dd2CMciB6Z3tqnWPnWSC3bh9C2OeXROImtTDE7v8xb+mQtd3w5+460PjJfDukIxjg+4//I",
+	"hLUDC9/p9osTmu3hAEN8oPWD8etlAWs5RtZyNevB3Sf6X+ntvZUa4H5q4az6Rc3imy8YeK4atx/7z6j7",
+	"RLdPqMb8eP8lUE1q2TotJam1Ukyn+pWrzZgfGE2FuXqMK30kbs+VvUuH86Lh10uJ6yMS3q5g6pEw5VMx",
+	"cxt92yua70zFK1Y457XNTyT9VHO9tbxkLbxeMyvJ92S/nNUi0PIqrWBpIFL2xFMPjacG7gbmLpRnFNAb",
+	"5/bvUm5/trbCebxE4qvG4p845IlDJt/HWaoS3/Y7S61s6E6K5NH4J1ZcefHHwojrD3KWckB1PvxzXb3R",
+	"HLei2my3WgXsLGs8l2MeYaIz3/e2t19Qh3zLXGO/i6Sljx0/5RW/31WqLb2zam7RaepZjTpp0im8aPIo",
+	"ZRdN/hyiiyZuySWHInaVnWj1WyNLmu6ENIaYqC+NeDef8wnsssDr+rhJSIPeXzQxnzAZfU1xcDlUEnio",
+	"byEMiyaQFRnj2SwzfrlxqGSt1zCMS/CoZZvQZE2/83HZDzefb/49AGIss4R6yQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,6 +8,13 @@ import (
 	"fmt"
 )
 
+// Defines values for ShardDDLConflictActionAction.
+const (
+	ShardDDLConflictActionActionExec ShardDDLConflictActionAction = "exec"
+
+	ShardDDLConflictActionActionSkip ShardDDLConflictActionAction = "skip"
+)
+
 // Defines values for TaskOnDuplicate.
 const (
 	TaskOnDuplicateError TaskOnDuplicate = "error"
@@ -75,7 +82,7 @@ type ClusterWorker struct {
 	// address of the current master node
 	Addr string `json:"addr"`
 
-	// bound source name of this worker node
+	// source name bound to this worker node
 	BoundSourceName string `json:"bound_source_name"`
 
 	// bound stage of this worker node
@@ -172,6 +179,12 @@ type GetClusterWorkerListResponse struct {
 	Total int             `json:"total"`
 }
 
+// GetShardDDLConflictsResponse defines model for GetShardDDLConflictsResponse.
+type GetShardDDLConflictsResponse struct {
+	Data  []ShardDDLConflict `json:"data"`
+	Total int                `json:"total"`
+}
+
 // GetSourceListResponse defines model for GetSourceListResponse.
 type GetSourceListResponse struct {
 	Data  []Source `json:"data"`
@@ -314,6 +327,21 @@ type RelayStatus struct {
 	Stage string `json:"stage"`
 }
 
+// ResolveShardDDLConflictRequest defines model for ResolveShardDDLConflictRequest.
+type ResolveShardDDLConflictRequest struct {
+	Actions *[]ShardDDLConflictAction `json:"actions,omitempty"`
+	LockId  string                    `json:"lock_id"`
+
+	// the table structure of the conflicted tables after resolved, the conflicting DDLs of the conflicted tables without actions are skipped if it's specified
+	TargetTableStructure *string `json:"target_table_structure,omitempty"`
+}
+
+// ResolveShardDDLConflictResponse defines model for ResolveShardDDLConflictResponse.
+type ResolveShardDDLConflictResponse struct {
+	// source name list
+	ResumedSourceNameList SourceNameList `json:"resumed_source_name_list"`
+}
+
 // schema name list
 type SchemaNameList []string
 
@@ -332,6 +360,43 @@ type Security struct {
 	SslKeyContent string `json:"ssl_key_content"`
 }
 
+// a conflicting shard DDL lock in optimistic mode
+type ShardDDLConflict struct {
+	// the joined table structure of the tables which are not conflicted
+	JoinedTableStructure *string                 `json:"joined_table_structure,omitempty"`
+	LockId               string                  `json:"lock_id"`
+	Tables               []ShardDDLConflictTable `json:"tables"`
+}
+
+// how to resolve the conflicting DDLs of an upstream table
+type ShardDDLConflictAction struct {
+	// skip or execute the conflicting DDLs in the downstream
+	Action     ShardDDLConflictActionAction `json:"action"`
+	SchemaName string                       `json:"schema_name"`
+	SourceName string                       `json:"source_name"`
+	TableName  string                       `json:"table_name"`
+}
+
+// skip or execute the conflicting DDLs in the downstream
+type ShardDDLConflictActionAction string
+
+// an upstream table of the conflicting shard DDL lock
+type ShardDDLConflictTable struct {
+	ConflictMessage *string `json:"conflict_message,omitempty"`
+
+	// whether the DDLs of the table conflict with other tables and are waiting to be resolved
+	Conflicted bool `json:"conflicted"`
+
+	// the conflicting DDLs of the table
+	Ddls       *[]string `json:"ddls,omitempty"`
+	SchemaName string    `json:"schema_name"`
+	SourceName string    `json:"source_name"`
+	TableName  string    `json:"table_name"`
+
+	// the table structure, it's the structure after the DDLs for the conflicted table
+	TableStructure string `json:"table_structure"`
+}
+
 // ShardingGroup defines model for ShardingGroup.
 type ShardingGroup struct {
 	DdlList       []string `json:"ddl_list"`
@@ -494,6 +559,9 @@ type Task struct {
 	// how to handle conflicted data
 	OnDuplicate TaskOnDuplicate `json:"on_duplicate"`
 
+	// configuration of the online ddl plugin, the shadow and trash tables of gh-ost and pt-osc are matched if the rules are not set
+	OnlineDdlConfig *TaskOnlineDDLConfig `json:"online_ddl_config,omitempty"`
+
 	// the way to coordinate DDL
 	ShardMode *TaskShardMode `json:"shard_mode,omitempty"`
 
@@ -569,6 +637,15 @@ type TaskMigrateTarget struct {
 // task name list
 type TaskNameList []string
 
+// configuration of the online ddl plugin, the shadow and trash tables of gh-ost and pt-osc are matched if the rules are not set
+type TaskOnlineDDLConfig struct {
+	// regular expressions to match the shadow tables of the online ddl tools
+	ShadowTableRules *[]string `json:"shadow_table_rules,omitempty"`
+
+	// regular expressions to match the trash tables of the online ddl tools
+	TrashTableRules *[]string `json:"trash_table_rules,omitempty"`
+}
+
 // TaskSourceConf defines model for TaskSourceConf.
 type TaskSourceConf struct {
 	BinlogGtid *string `json:"binlog_gtid,omitempty"`
@@ -763,6 +840,15 @@ type DMAPIGetTaskParams struct {
 // DMAPIUpdateTaskJSONBody defines parameters for DMAPIUpdateTask.
 type DMAPIUpdateTaskJSONBody UpdateTaskRequest
 
+// DMAPIGetShardDDLConflictsParams defines parameters for DMAPIGetShardDDLConflicts.
+type DMAPIGetShardDDLConflictsParams struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
+// DMAPIResolveShardDDLConflictJSONBody defines parameters for DMAPIResolveShardDDLConflict.
+type DMAPIResolveShardDDLConflictJSONBody ResolveShardDDLConflictRequest
+
 // DMAPIGetTaskMigrateTargetsParams defines parameters for DMAPIGetTaskMigrateTargets.
 type DMAPIGetTaskMigrateTargetsParams struct {
 	SchemaPattern *string `json:"schema_pattern,omitempty"`
@@ -820,6 +906,9 @@ type DMAPIImportTaskTemplateJSONRequestBody DMAPIImportTaskTemplateJSONBody
 // DMAPIUpdateTaskJSONRequestBody defines body for DMAPIUpdateTask for application/json ContentType.
 type DMAPIUpdateTaskJSONRequestBody DMAPIUpdateTaskJSONBody
 
+// DMAPIResolveShardDDLConflictJSONRequestBody defines body for DMAPIResolveShardDDLConflict for application/json ContentType.
+type DMAPIResolveShardDDLConflictJSONRequestBody DMAPIResolveShardDDLConflictJSONBody
+
 // DMAPIOperateTableStructureJSONRequestBody defines body for DMAPIOperateTableStructure for application/json ContentType.
 type DMAPIOperateTableStructureJSONRequestBody DMAPIOperateTableStructureJSONBody
 
@@ -416,6 +416,46 @@ func (mr *MockMasterClientMockRecorder) RegisterWorker(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWorker", reflect.TypeOf((*MockMasterClient)(nil).RegisterWorker), varargs...)
 }
 
+// ResolveDDLConflict mocks base method.
+func (m *MockMasterClient) ResolveDDLConflict(arg0 context.Context, arg1 *pb.ResolveDDLConflictRequest, arg2 ...grpc.CallOption) (*pb.ResolveDDLConflictResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveDDLConflict", varargs...)
+	ret0, _ := ret[0].(*pb.ResolveDDLConflictResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDDLConflict indicates an expected call of ResolveDDLConflict.
+func (mr *MockMasterClientMockRecorder) ResolveDDLConflict(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDDLConflict", reflect.TypeOf((*MockMasterClient)(nil).ResolveDDLConflict), varargs...)
+}
+
+// ShowDDLConflicts mocks base method.
+func (m *MockMasterClient) ShowDDLConflicts(arg0 context.Context, arg1 *pb.ShowDDLConflictsRequest, arg2 ...grpc.CallOption) (*pb.ShowDDLConflictsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShowDDLConflicts", varargs...)
+	ret0, _ := ret[0].(*pb.ShowDDLConflictsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowDDLConflicts indicates an expected call of ShowDDLConflicts.
+func (mr *MockMasterClientMockRecorder) ShowDDLConflicts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDDLConflicts", reflect.TypeOf((*MockMasterClient)(nil).ShowDDLConflicts), varargs...)
+}
+
 // ShowDDLLocks mocks base method.
 func (m *MockMasterClient) ShowDDLLocks(arg0 context.Context, arg1 *pb.ShowDDLLocksRequest, arg2 ...grpc.CallOption) (*pb.ShowDDLLocksResponse, error) {
 	m.ctrl.T.Helper()
@@ -864,6 +904,36 @@ func (mr *MockMasterServerMockRecorder) RegisterWorker(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWorker", reflect.TypeOf((*MockMasterServer)(nil).RegisterWorker), arg0, arg1)
 }
 
+// ResolveDDLConflict mocks base method.
+func (m *MockMasterServer) ResolveDDLConflict(arg0 context.Context, arg1 *pb.ResolveDDLConflictRequest) (*pb.ResolveDDLConflictResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDDLConflict", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ResolveDDLConflictResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDDLConflict indicates an expected call of ResolveDDLConflict.
+func (mr *MockMasterServerMockRecorder) ResolveDDLConflict(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDDLConflict", reflect.TypeOf((*MockMasterServer)(nil).ResolveDDLConflict), arg0, arg1)
+}
+
+// ShowDDLConflicts mocks base method.
+func (m *MockMasterServer) ShowDDLConflicts(arg0 context.Context, arg1 *pb.ShowDDLConflictsRequest) (*pb.ShowDDLConflictsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowDDLConflicts", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ShowDDLConflictsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowDDLConflicts indicates an expected call of ShowDDLConflicts.
+func (mr *MockMasterServerMockRecorder) ShowDDLConflicts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDDLConflicts", reflect.TypeOf((*MockMasterServer)(nil).ShowDDLConflicts), arg0, arg1)
+}
+
 // ShowDDLLocks mocks base method.
 func (m *MockMasterServer) ShowDDLLocks(arg0 context.Context, arg1 *pb.ShowDDLLocksRequest) (*pb.ShowDDLLocksResponse, error) {
 	m.ctrl.T.Helper()
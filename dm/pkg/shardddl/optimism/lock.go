@@ -390,16 +390,39 @@ func (l *Lock) HasTables() bool {
 
 // UpdateTableAfterUnlock updates table's schema info after unlock exec action.
 func (l *Lock) UpdateTableAfterUnlock(info Info) {
+	l.UpdateTableInfo(info.Source, info.UpSchema, info.UpTable, info.TableInfosAfter[len(info.TableInfosAfter)-1])
+}
+
+// UpdateTableInfo updates table's schema info to the specified one,
+// it's used after the conflict resolved with a target table structure.
+func (l *Lock) UpdateTableInfo(source, schema, table string, ti *model.TableInfo) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	var ok bool
-	if _, ok = l.tables[info.Source]; !ok {
-		l.tables[info.Source] = make(map[string]map[string]schemacmp.Table)
+	if _, ok = l.tables[source]; !ok {
+		l.tables[source] = make(map[string]map[string]schemacmp.Table)
 	}
-	if _, ok = l.tables[info.Source][info.UpSchema]; !ok {
-		l.tables[info.Source][info.UpSchema] = make(map[string]schemacmp.Table)
+	if _, ok = l.tables[source][schema]; !ok {
+		l.tables[source][schema] = make(map[string]schemacmp.Table)
+	}
+	l.tables[source][schema][table] = schemacmp.Encode(ti)
+}
+
+// Tables returns a copy of the table info of all upstream tables.
+func (l *Lock) Tables() map[string]map[string]map[string]schemacmp.Table {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	ret := make(map[string]map[string]map[string]schemacmp.Table, len(l.tables))
+	for source, schemaTables := range l.tables {
+		ret[source] = make(map[string]map[string]schemacmp.Table, len(schemaTables))
+		for schema, tables := range schemaTables {
+			ret[source][schema] = make(map[string]schemacmp.Table, len(tables))
+			for table, ti := range tables {
+				ret[source][schema][table] = ti
+			}
+		}
 	}
-	l.tables[info.Source][info.UpSchema][info.UpTable] = schemacmp.Encode(info.TableInfosAfter[len(info.TableInfosAfter)-1])
+	return ret
 }
 
 // IsSynced returns whether the lock has synced.
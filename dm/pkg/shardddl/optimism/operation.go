@@ -18,9 +18,11 @@ import (
 	"encoding/json"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/dm/common"
 	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/clientv3util"
@@ -70,6 +72,10 @@ type Operation struct {
 	Done          bool          `json:"done"`             // whether the operation has done
 	Cols          []string      `json:"cols"`             // drop columns' name
 
+	// the table info of the upstream table after the conflict is resolved with a target table structure,
+	// only set for the `unlocked` operation, DM-worker should track it instead of the table info after the DDLs.
+	TableInfo *model.TableInfo `json:"table-info,omitempty"`
+
 	// only set it when get from etcd
 	// use for sort infos in recovering locks
 	Revision int64 `json:"-"`
@@ -171,6 +177,79 @@ func PutOperation(cli *clientv3.Client, skipDone bool, op Operation, infoModRev
 	return resp.Header.Revision, resp.Succeeded, nil
 }
 
+// PutOperations puts the shard DDL operations into etcd in one txn.
+// Every operation is guarded like PutOperation with its infoModRev in
+// infoModRevs, it returns the operations which are skipped by their guards.
+// NOTE: etcd compare has no `OR` operator, so the guards of every operation
+// are chained in nested txns, the key is checked as "not exist" first to
+// avoid the strange behavior of `clientv3.Value` for *not-exist* kv.
+// This function should often be called by DM-master when resolving a conflicting lock.
+func PutOperations(
+	cli *clientv3.Client, skipDone bool, infoModRevs []int64, ops ...Operation,
+) (rev int64, skipped []Operation, err error) {
+	if len(infoModRevs) != len(ops) {
+		return 0, nil, errors.Errorf("%d operations with %d info mod revisions", len(ops), len(infoModRevs))
+	}
+	opsPut := make([]clientv3.Op, 0, len(ops))
+	for i, op := range ops {
+		opPut, err2 := putOperationOp(op)
+		if err2 != nil {
+			return 0, nil, err2
+		}
+		if !skipDone {
+			opsPut = append(opsPut, opPut)
+			continue
+		}
+		opDone := op
+		opDone.Done = true // set `done` to `true`.
+		valueDone, err2 := opDone.toJSON()
+		if err2 != nil {
+			return 0, nil, err2
+		}
+		key := common.ShardDDLOptimismOperationKeyAdapter.Encode(op.Task, op.Source, op.UpSchema, op.UpTable)
+		// PUT if the key has less mod revision than info's mod revision, see PutOperation.
+		opLessRev := clientv3.OpTxn(
+			[]clientv3.Cmp{clientv3.Compare(clientv3.ModRevision(key), "<", infoModRevs[i])},
+			[]clientv3.Op{opPut}, nil)
+		// PUT if the `done` field of the key is not `true`.
+		opNotDone := clientv3.OpTxn(
+			[]clientv3.Cmp{clientv3.Compare(clientv3.Value(key), "!=", valueDone)},
+			[]clientv3.Op{opPut}, []clientv3.Op{opLessRev})
+		// PUT if the key does not exist.
+		opsPut = append(opsPut, clientv3.OpTxn(
+			[]clientv3.Cmp{clientv3util.KeyMissing(key)},
+			[]clientv3.Op{opPut}, []clientv3.Op{opNotDone}))
+	}
+
+	resp, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(opsPut...))
+	if err != nil {
+		return 0, nil, err
+	}
+	if skipDone {
+		for i, op := range ops {
+			if !nestedTxnSucceeded(resp.Responses[i].GetResponseTxn()) {
+				skipped = append(skipped, op)
+			}
+		}
+	}
+	return rev, skipped, nil
+}
+
+// nestedTxnSucceeded returns whether any txn in the chain of nested txns built
+// by PutOperations succeeded.
+func nestedTxnSucceeded(resp *etcdserverpb.TxnResponse) bool {
+	for resp != nil {
+		if resp.Succeeded {
+			return true
+		}
+		if len(resp.Responses) == 0 {
+			return false
+		}
+		resp = resp.Responses[0].GetResponseTxn()
+	}
+	return false
+}
+
 // GetAllOperations gets all shard DDL operation in etcd currently.
 // This function should often be called by DM-master.
 // k/k/k/k/v: task-name -> source-ID -> upstream-schema-name -> upstream-table-name -> shard DDL operation.
@@ -322,6 +401,16 @@ func WatchOperationPut(ctx context.Context, cli *clientv3.Client,
 	}
 }
 
+// putOperationOp returns a PUT etcd operation for Operation.
+func putOperationOp(o Operation) (clientv3.Op, error) {
+	value, err := o.toJSON()
+	if err != nil {
+		return clientv3.Op{}, err
+	}
+	key := common.ShardDDLOptimismOperationKeyAdapter.Encode(o.Task, o.Source, o.UpSchema, o.UpTable)
+	return clientv3.OpPut(key, value), nil
+}
+
 // deleteOperationOp returns a DELETE etcd operation for Operation.
 func deleteOperationOp(op Operation) clientv3.Op {
 	return clientv3.OpDelete(common.ShardDDLOptimismOperationKeyAdapter.Encode(op.Task, op.Source, op.UpSchema, op.UpTable))
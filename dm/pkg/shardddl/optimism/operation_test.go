@@ -163,3 +163,66 @@ func (t *testForEtcd) TestOperationEtcd(c *C) {
 	c.Assert(succ, IsFalse)
 	c.Assert(rev9, Equals, rev8)
 }
+
+func (t *testForEtcd) TestPutOperations(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		task     = "test"
+		source1  = "mysql-replica-1"
+		source2  = "mysql-replica-2"
+		upSchema = "foo_1"
+		upTable  = "bar_1"
+		ID       = "test-`foo`.`bar`"
+		DDLs     = []string{"ALTER TABLE bar ADD COLUMN c1 INT"}
+		op1      = NewOperation(ID, task, source1, upSchema, upTable, DDLs, ConflictUnlocked, "", false, []string{})
+		op2      = NewOperation(ID, task, source2, upSchema, upTable, []string{}, ConflictUnlocked, "", false, []string{})
+	)
+
+	// all operations are put in one txn, so they have the same revision.
+	rev, skipped, err := PutOperations(etcdTestCli, true, []int64{0, 0}, op1, op2)
+	c.Assert(err, IsNil)
+	c.Assert(skipped, HasLen, 0)
+	opm, _, err := GetAllOperations(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(opm[task], HasLen, 2)
+	op1.Revision = rev
+	op2.Revision = rev
+	c.Assert(opm[task][source1][upSchema][upTable], DeepEquals, op1)
+	c.Assert(opm[task][source2][upSchema][upTable], DeepEquals, op2)
+
+	// mismatched info mod revisions.
+	_, _, err = PutOperations(etcdTestCli, true, []int64{rev}, op1, op2)
+	c.Assert(err, NotNil)
+
+	// op1 is done, putting it again with an older info mod revision is skipped,
+	// while op2 which is not done is still put in the same txn.
+	op1Done := op1
+	op1Done.Done = true
+	rev2, _, err := PutOperation(etcdTestCli, false, op1Done, 0)
+	c.Assert(err, IsNil)
+	rev3, skipped, err := PutOperations(etcdTestCli, true, []int64{rev2, rev2}, op1, op2)
+	c.Assert(err, IsNil)
+	c.Assert(rev3, Greater, rev2)
+	c.Assert(skipped, DeepEquals, []Operation{op1})
+	opm, _, err = GetAllOperations(etcdTestCli)
+	c.Assert(err, IsNil)
+	op1Done.Revision = rev2
+	op2.Revision = rev3
+	c.Assert(opm[task][source1][upSchema][upTable], DeepEquals, op1Done)
+	c.Assert(opm[task][source2][upSchema][upTable], DeepEquals, op2)
+
+	// with a newer info mod revision, the done operation is overwritten.
+	rev4, skipped, err := PutOperations(etcdTestCli, true, []int64{rev3 + 1, rev3 + 1}, op1, op2)
+	c.Assert(err, IsNil)
+	c.Assert(skipped, HasLen, 0)
+	opm, _, err = GetAllOperations(etcdTestCli)
+	c.Assert(err, IsNil)
+	op1.Revision = rev4
+	c.Assert(opm[task][source1][upSchema][upTable], DeepEquals, op1)
+
+	// without skipDone, operations are put unconditionally.
+	_, skipped, err = PutOperations(etcdTestCli, false, []int64{0, 0}, op1Done, op2)
+	c.Assert(err, IsNil)
+	c.Assert(skipped, HasLen, 0)
+}
@@ -31,6 +31,8 @@ type Config struct {
 	Flavor      string            `toml:"flavor" json:"flavor"`
 	Charset     string            `toml:"charset" json:"charset"`
 	From        dbconfig.DBConfig `toml:"data-source" json:"data-source"`
+	// the replicas of the upstream to fail over to when `From` is unavailable
+	FailoverFrom []dbconfig.DBConfig `toml:"failover-data-sources" json:"failover-data-sources"`
 
 	// synchronous start point (if no meta saved before)
 	// do not need to specify binlog-pos, because relay will fetch the whole file
@@ -60,9 +62,11 @@ func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 		ServerID:   clone.ServerID,
 		Charset:    clone.Charset,
 		From:       clone.From,
-		BinLogName: clone.RelayBinLogName,
-		BinlogGTID: clone.RelayBinlogGTID,
-		UUIDSuffix: clone.UUIDSuffix,
+		// only take effect when GTID is enabled, which is checked in the source config
+		FailoverFrom: clone.FailoverDBConfigs(),
+		BinLogName:   clone.RelayBinLogName,
+		BinlogGTID:   clone.RelayBinlogGTID,
+		UUIDSuffix:   clone.UUIDSuffix,
 		ReaderRetry: ReaderRetryConfig{ // we use config from TaskChecker now
 			BackoffRollback: clone.Checker.BackoffRollback.Duration,
 			BackoffMax:      clone.Checker.BackoffMax.Duration,
@@ -131,6 +131,11 @@ type Relay struct {
 
 	writer    Writer
 	listeners map[Listener]struct{} // make it a set to make it easier to remove listener
+
+	// upstreams are `From` and the failover replicas of the upstream,
+	// the relay reads binlog from upstreams[upstreamIdx].
+	upstreams   []dbconfig.DBConfig
+	upstreamIdx int
 }
 
 // NewRealRelay creates an instance of Relay.
@@ -142,6 +147,7 @@ func NewRealRelay(cfg *Config) Process {
 		listeners: make(map[Listener]struct{}),
 	}
 	r.writer = NewFileWriter(r.logger, cfg.RelayDir)
+	r.resetUpstreams()
 	return r
 }
 
@@ -181,6 +187,70 @@ func (r *Relay) Process(ctx context.Context) pb.ProcessResult {
 }
 
 func (r *Relay) process(ctx context.Context) error {
+	for {
+		err := r.processFromUpstream(ctx)
+		if err == nil || errors.Cause(err) == replication.ErrSyncClosed {
+			return err
+		}
+		if !r.tryFailover(ctx) {
+			return err
+		}
+		// the new upstream is taken as a new server, relay resumes from
+		// the GTID sets in meta after re-setup the meta.
+		r.logger.Warn("upstream is unavailable, fail over to another replica",
+			zap.String("new upstream", r.masterNode()), log.ShortError(err))
+	}
+}
+
+// resetUpstreams resets the upstreams to `From` and the failover replicas.
+func (r *Relay) resetUpstreams() {
+	r.upstreams = append([]dbconfig.DBConfig{r.cfg.From}, r.cfg.FailoverFrom...)
+	r.upstreamIdx = 0
+}
+
+// tryFailover switches the upstream to the next available replica if the
+// current upstream is unavailable, it returns true if the upstream is switched.
+func (r *Relay) tryFailover(ctx context.Context) bool {
+	if !r.cfg.EnableGTID || len(r.upstreams) <= 1 || r.isUpstreamAvailable(ctx, &r.cfg.From) {
+		return false
+	}
+
+	for i := 1; i < len(r.upstreams); i++ {
+		idx := (r.upstreamIdx + i) % len(r.upstreams)
+		from := r.upstreams[idx]
+		if !r.isUpstreamAvailable(ctx, &from) {
+			r.logger.Warn("replica is unavailable, skip it",
+				zap.String("replica", fmt.Sprintf("%s:%d", from.Host, from.Port)))
+			continue
+		}
+
+		r.Lock()
+		r.closeDB()
+		r.cfg.From = from
+		r.upstreamIdx = idx
+		r.Unlock()
+		return true
+	}
+	return false
+}
+
+// isUpstreamAvailable checks whether the upstream can be connected.
+func (r *Relay) isUpstreamAvailable(ctx context.Context, from *dbconfig.DBConfig) bool {
+	failpoint.Inject("MockUpstreamAvailable", func(val failpoint.Value) {
+		failpoint.Return(fmt.Sprintf("%s:%d", from.Host, from.Port) == val.(string))
+	})
+	ctx2, cancel := context.WithTimeout(ctx, conn.DefaultDBTimeout)
+	defer cancel()
+	db, err := conn.GetUpstreamDB(from)
+	if err != nil {
+		return false
+	}
+	defer db.Close()
+	return db.DB.PingContext(ctx2) == nil
+}
+
+// processFromUpstream relays the binlog from the current upstream.
+func (r *Relay) processFromUpstream(ctx context.Context) error {
 	err := r.setSyncConfig()
 	if err != nil {
 		return err
@@ -309,7 +379,18 @@ func (r *Relay) process(ctx context.Context) error {
 		return err
 	}
 
-	go r.doIntervalOps(ctx)
+	// make sure the interval operations exit before failing over.
+	ctx2, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.doIntervalOps(ctx2)
+	}()
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
 
 	// handles binlog events with retry mechanism.
 	// it only do the retry for some binlog reader error now.
@@ -1088,6 +1169,8 @@ func (r *Relay) Reload(newCfg *Config) error {
 
 	// Update From
 	r.cfg.From = newCfg.From
+	r.cfg.FailoverFrom = newCfg.FailoverFrom
+	r.resetUpstreams()
 
 	// Update Charset
 	r.cfg.Charset = newCfg.Charset
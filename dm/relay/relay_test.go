@@ -624,6 +624,43 @@ func (t *testRelaySuite) TestReSetupMeta(c *C) {
 	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
 }
 
+func (t *testRelaySuite) TestTryFailover(c *C) {
+	ctx := context.Background()
+	relayCfg := newRelayCfg(c, gmysql.MySQLFlavor)
+	relayCfg.From.Host = "127.0.0.1"
+	relayCfg.From.Port = 3306
+	relayCfg.FailoverFrom = []dbconfig.DBConfig{
+		{Host: "127.0.0.2", Port: 3306},
+		{Host: "127.0.0.3", Port: 3306},
+	}
+	r := NewRelay(relayCfg).(*Relay)
+
+	// GTID is not enabled
+	c.Assert(r.tryFailover(ctx), IsFalse)
+
+	r.cfg.EnableGTID = true
+	// the current upstream is available
+	c.Assert(failpoint.Enable("github.com/pingcap/tiflow/dm/relay/MockUpstreamAvailable", `return("127.0.0.1:3306")`), IsNil)
+	c.Assert(r.tryFailover(ctx), IsFalse)
+	c.Assert(r.masterNode(), Equals, "127.0.0.1:3306")
+
+	// fail over to the next available replica
+	c.Assert(failpoint.Enable("github.com/pingcap/tiflow/dm/relay/MockUpstreamAvailable", `return("127.0.0.3:3306")`), IsNil)
+	c.Assert(r.tryFailover(ctx), IsTrue)
+	c.Assert(r.masterNode(), Equals, "127.0.0.3:3306")
+
+	// fail over back to `From`
+	c.Assert(failpoint.Enable("github.com/pingcap/tiflow/dm/relay/MockUpstreamAvailable", `return("127.0.0.1:3306")`), IsNil)
+	c.Assert(r.tryFailover(ctx), IsTrue)
+	c.Assert(r.masterNode(), Equals, "127.0.0.1:3306")
+
+	// no replica is available
+	c.Assert(failpoint.Enable("github.com/pingcap/tiflow/dm/relay/MockUpstreamAvailable", `return("")`), IsNil)
+	c.Assert(r.tryFailover(ctx), IsFalse)
+	c.Assert(r.masterNode(), Equals, "127.0.0.1:3306")
+	c.Assert(failpoint.Disable("github.com/pingcap/tiflow/dm/relay/MockUpstreamAvailable"), IsNil)
+}
+
 func (t *testRelaySuite) verifyMetadata(c *C, r *Relay, uuidExpected string,
 	posExpected gmysql.Position, gsStrExpected string, uuidsExpected []string,
 ) {
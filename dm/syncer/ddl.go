@@ -881,6 +881,17 @@ func (ddl *Optimist) handleDDL(qec *queryEventContext) error {
 		return err
 	}
 
+	// the conflict is resolved with a target table structure, track it instead of the table info after the DDLs.
+	if op.ConflictStage == optimism.ConflictUnlocked && op.TableInfo != nil {
+		if err = ddl.schemaTracker.DropTable(upTable); err != nil {
+			return err
+		}
+		if err = ddl.schemaTracker.CreateTableIfNotExists(upTable, op.TableInfo); err != nil {
+			return err
+		}
+		ddl.logger.Info("track the resolved table structure in optimistic shard mode", zap.Stringer("table", upTable))
+	}
+
 	// updated needHandleDDLs to DDLs received from DM-master.
 	qec.needHandleDDLs = op.DDLs
 
@@ -842,3 +842,7 @@ func (m mockOnlinePlugin) CheckAndUpdate(tctx *tcontext.Context, schemas map[str
 func (m mockOnlinePlugin) CheckRegex(stmt ast.StmtNode, schema string, flavor conn.LowerCaseTableNamesFlavor) error {
 	return nil
 }
+
+func (m mockOnlinePlugin) UpdateRules(shadowTableRules, trashTableRules []string) error {
+	return nil
+}
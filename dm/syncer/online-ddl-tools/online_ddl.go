@@ -67,6 +67,8 @@ type OnlinePlugin interface {
 	CheckAndUpdate(tctx *tcontext.Context, schemas map[string]string, tables map[string]map[string]string) error
 	// CheckRegex checks the regex of shadow/trash table rules and reports an error if a ddl event matches only either of the rules
 	CheckRegex(stmt ast.StmtNode, schema string, flavor conn.LowerCaseTableNamesFlavor) error
+	// UpdateRules updates the shadow/trash table rules
+	UpdateRules(shadowTableRules, trashTableRules []string) error
 }
 
 // TableType is type of table.
@@ -411,21 +413,9 @@ func NewRealOnlinePlugin(
 	cfg *config.SubTaskConfig,
 	metricProxies *metrics.Proxies,
 ) (OnlinePlugin, error) {
-	shadowRegs := make([]*regexp.Regexp, 0, len(cfg.ShadowTableRules))
-	trashRegs := make([]*regexp.Regexp, 0, len(cfg.TrashTableRules))
-	for _, sg := range cfg.ShadowTableRules {
-		shadowReg, err := regexp.Compile(sg)
-		if err != nil {
-			return nil, terror.ErrConfigOnlineDDLInvalidRegex.Generate(config.ShadowTableRules, sg, "fail to compile: "+err.Error())
-		}
-		shadowRegs = append(shadowRegs, shadowReg)
-	}
-	for _, tg := range cfg.TrashTableRules {
-		trashReg, err := regexp.Compile(tg)
-		if err != nil {
-			return nil, terror.ErrConfigOnlineDDLInvalidRegex.Generate(config.TrashTableRules, tg, "fail to compile: "+err.Error())
-		}
-		trashRegs = append(trashRegs, trashReg)
+	shadowRegs, trashRegs, err := compileTableRules(cfg.ShadowTableRules, cfg.TrashTableRules)
+	if err != nil {
+		return nil, err
 	}
 	r := &RealOnlinePlugin{
 		storage: NewOnlineDDLStorage(
@@ -440,6 +430,27 @@ func NewRealOnlinePlugin(
 	return r, r.storage.Init(tctx)
 }
 
+// compileTableRules compiles the regex of shadow/trash table rules.
+func compileTableRules(shadowTableRules, trashTableRules []string) ([]*regexp.Regexp, []*regexp.Regexp, error) {
+	shadowRegs := make([]*regexp.Regexp, 0, len(shadowTableRules))
+	trashRegs := make([]*regexp.Regexp, 0, len(trashTableRules))
+	for _, sg := range shadowTableRules {
+		shadowReg, err := regexp.Compile(sg)
+		if err != nil {
+			return nil, nil, terror.ErrConfigOnlineDDLInvalidRegex.Generate(config.ShadowTableRules, sg, "fail to compile: "+err.Error())
+		}
+		shadowRegs = append(shadowRegs, shadowReg)
+	}
+	for _, tg := range trashTableRules {
+		trashReg, err := regexp.Compile(tg)
+		if err != nil {
+			return nil, nil, terror.ErrConfigOnlineDDLInvalidRegex.Generate(config.TrashTableRules, tg, "fail to compile: "+err.Error())
+		}
+		trashRegs = append(trashRegs, trashReg)
+	}
+	return shadowRegs, trashRegs, nil
+}
+
 // Apply implements interface.
 // returns ddls, error.
 func (r *RealOnlinePlugin) Apply(tctx *tcontext.Context, tables []*filter.Table, statement string, stmt ast.StmtNode, p *parser.Parser) ([]string, error) {
@@ -583,6 +594,17 @@ func (r *RealOnlinePlugin) ResetConn(tctx *tcontext.Context) error {
 	return r.storage.ResetConn(tctx)
 }
 
+// UpdateRules implements interface.
+func (r *RealOnlinePlugin) UpdateRules(shadowTableRules, trashTableRules []string) error {
+	shadowRegs, trashRegs, err := compileTableRules(shadowTableRules, trashTableRules)
+	if err != nil {
+		return err
+	}
+	r.shadowRegs = shadowRegs
+	r.trashRegs = trashRegs
+	return nil
+}
+
 // CheckAndUpdate try to check and fix the schema/table case-sensitive issue.
 func (r *RealOnlinePlugin) CheckAndUpdate(tctx *tcontext.Context, schemas map[string]string, tables map[string]map[string]string) error {
 	return r.storage.CheckAndUpdate(tctx, schemas, tables, r.RealName)
@@ -3222,6 +3222,8 @@ func (s *Syncer) CheckCanUpdateCfg(newCfg *config.SubTaskConfig) error {
 	oldCfg.BWList = newCfg.BWList
 	oldCfg.RouteRules = newCfg.RouteRules
 	oldCfg.FilterRules = newCfg.FilterRules
+	oldCfg.ShadowTableRules = newCfg.ShadowTableRules
+	oldCfg.TrashTableRules = newCfg.TrashTableRules
 	oldCfg.SyncerConfig = newCfg.SyncerConfig
 	oldCfg.To.Session = newCfg.To.Session // session is adjusted in `createDBs`
 
@@ -3297,6 +3299,14 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 		return terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
 	}
 
+	// update online ddl rules, the old rules are kept if any new rule fails to compile
+	if s.onlineDDL != nil {
+		err = s.onlineDDL.UpdateRules(cfg.ShadowTableRules, cfg.TrashTableRules)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch s.cfg.ShardMode {
 	case config.ShardPessimistic:
 		// re-init sharding group
@@ -3320,6 +3330,8 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	s.cfg.BAList = cfg.BAList
 	s.cfg.RouteRules = cfg.RouteRules
 	s.cfg.FilterRules = cfg.FilterRules
+	s.cfg.ShadowTableRules = cfg.ShadowTableRules
+	s.cfg.TrashTableRules = cfg.TrashTableRules
 	s.cfg.ColumnMappingRules = cfg.ColumnMappingRules
 
 	// update timezone
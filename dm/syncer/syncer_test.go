@@ -1962,4 +1962,11 @@ func TestCheckCanUpdateCfg(t *testing.T) {
 	cfg2.FilterRules = []*bf.BinlogEventRule{{SchemaPattern: "test"}}
 	cfg2.SyncerConfig.Compact = !cfg.SyncerConfig.Compact
 	require.NoError(t, syncer.CheckCanUpdateCfg(cfg))
+
+	// update online ddl rules is ok
+	cfg3, err := cfg.Clone()
+	require.NoError(t, err)
+	cfg3.ShadowTableRules = []string{"^_(.+)_(?:shadow)$"}
+	cfg3.TrashTableRules = []string{"^_(.+)_(?:trash)$"}
+	require.NoError(t, syncer.CheckCanUpdateCfg(cfg3))
 }
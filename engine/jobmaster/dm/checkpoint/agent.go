@@ -48,6 +48,7 @@ const (
 		task_name varchar(255) NOT NULL,
 		source_name varchar(255) NOT NULL,
 		status varchar(10) NOT NULL DEFAULT 'init' COMMENT 'init,running,finished',
+		import_mode varchar(10) NOT NULL DEFAULT '' COMMENT 'logical,physical',
 		PRIMARY KEY (task_name, source_name)
 	);`
 	syncCheckpointTable = `CREATE TABLE IF NOT EXISTS %s (
@@ -60,6 +60,7 @@ func TestCheckpoint(t *testing.T) {
 		task_name varchar(255) NOT NULL,
 		source_name varchar(255) NOT NULL,
 		status varchar(10) NOT NULL DEFAULT 'init' COMMENT 'init,running,finished',
+		import_mode varchar(10) NOT NULL DEFAULT '' COMMENT 'logical,physical',
 		PRIMARY KEY (task_name, source_name)
 	);`, "`meta`.`test_lightning_checkpoint_list`"))).WillReturnResult(sqlmock.NewResult(1, 1))
 	require.NoError(t, createLoadCheckpointTable(context.Background(), jobID, jobCfg, conn.NewBaseDBForTest(db)))
@@ -29,11 +29,15 @@ type APIV2Interface interface {
 	StatusGetter
 	CapturesGetter
 	ProcessorsGetter
+	DebugGetter
 }
 
 // APIV2Client implements APIV1Interface and it is used to interact with cdc owner http api.
 type APIV2Client struct {
 	restClient rest.CDCRESTInterface
+	// namespace is the namespace of the changefeeds operated by the client,
+	// empty means the default namespace.
+	namespace string
 }
 
 // RESTClient returns a RESTClient that is used to communicate with owner api
@@ -90,8 +94,19 @@ func (c *APIV2Client) Processors() ProcessorInterface {
 	return newProcessors(c)
 }
 
-// NewAPIClient creates a new APIV1Client.
-func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Client, error) {
+// Debug returns a DebugInterface to communicate with cdc api
+func (c *APIV2Client) Debug() DebugInterface {
+	if c == nil {
+		return nil
+	}
+	return newDebug(c)
+}
+
+// NewAPIClient creates a new APIV2Client, which operates the changefeeds
+// in the given namespace.
+func NewAPIClient(
+	serverAddr string, credential *security.Credential, namespace string,
+) (*APIV2Client, error) {
 	c := &rest.Config{}
 	c.APIPath = "/api"
 	c.Version = "v2"
@@ -102,5 +117,5 @@ func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Cli
 		return nil, errors.Trace(err)
 	}
 
-	return &APIV2Client{client}, nil
+	return &APIV2Client{restClient: client, namespace: namespace}, nil
 }
@@ -51,19 +51,32 @@ type ChangefeedInterface interface {
 
 // changefeeds implements ChangefeedInterface
 type changefeeds struct {
-	client rest.CDCRESTInterface
+	client    rest.CDCRESTInterface
+	namespace string
 }
 
 // newChangefeed returns changefeeds
 func newChangefeeds(c *APIV2Client) *changefeeds {
 	return &changefeeds{
-		client: c.RESTClient(),
+		client:    c.RESTClient(),
+		namespace: c.namespace,
 	}
 }
 
+// withNamespace sets the namespace of the request if the client has one.
+func (c *changefeeds) withNamespace(r *rest.Request) *rest.Request {
+	if c.namespace == "" {
+		return r
+	}
+	return r.WithParam("namespace", c.namespace)
+}
+
 func (c *changefeeds) Create(ctx context.Context,
 	cfg *v2.ChangefeedConfig,
 ) (*v2.ChangeFeedInfo, error) {
+	if cfg.Namespace == "" {
+		cfg.Namespace = c.namespace
+	}
 	result := &v2.ChangeFeedInfo{}
 	err := c.client.Post().
 		WithURI("changefeeds").
@@ -89,8 +102,8 @@ func (c *changefeeds) Update(ctx context.Context,
 ) (*v2.ChangeFeedInfo, error) {
 	result := &v2.ChangeFeedInfo{}
 	u := fmt.Sprintf("changefeeds/%s", name)
-	err := c.client.Put().
-		WithURI(u).
+	err := c.withNamespace(c.client.Put().
+		WithURI(u)).
 		WithBody(cfg).
 		Do(ctx).
 		Into(result)
@@ -102,8 +115,8 @@ func (c *changefeeds) Resume(ctx context.Context,
 	cfg *v2.ResumeChangefeedConfig, name string,
 ) error {
 	u := fmt.Sprintf("changefeeds/%s/resume", name)
-	return c.client.Post().
-		WithURI(u).
+	return c.withNamespace(c.client.Post().
+		WithURI(u)).
 		WithBody(cfg).
 		Do(ctx).Error()
 }
@@ -113,8 +126,8 @@ func (c *changefeeds) Delete(ctx context.Context,
 	name string,
 ) error {
 	u := fmt.Sprintf("changefeeds/%s", name)
-	return c.client.Delete().
-		WithURI(u).
+	return c.withNamespace(c.client.Delete().
+		WithURI(u)).
 		Do(ctx).Error()
 }
 
@@ -123,8 +136,8 @@ func (c *changefeeds) Pause(ctx context.Context,
 	name string,
 ) error {
 	u := fmt.Sprintf("changefeeds/%s/pause", name)
-	return c.client.Post().
-		WithURI(u).
+	return c.withNamespace(c.client.Post().
+		WithURI(u)).
 		Do(ctx).Error()
 }
 
@@ -138,8 +151,8 @@ func (c *changefeeds) Get(ctx context.Context,
 	}
 	result := new(v2.ChangeFeedInfo)
 	u := fmt.Sprintf("changefeeds/%s", name)
-	err = c.client.Get().
-		WithURI(u).
+	err = c.withNamespace(c.client.Get().
+		WithURI(u)).
 		Do(ctx).
 		Into(result)
 	return result, err
@@ -150,9 +163,9 @@ func (c *changefeeds) List(ctx context.Context,
 	state string,
 ) ([]v2.ChangefeedCommonInfo, error) {
 	result := &v2.ListResponse[v2.ChangefeedCommonInfo]{}
-	err := c.client.Get().
+	err := c.withNamespace(c.client.Get().
 		WithURI("changefeeds").
-		WithParam("state", state).
+		WithParam("state", state)).
 		Do(ctx).
 		Into(result)
 	return result.Items, err
@@ -0,0 +1,53 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/internal/rest"
+)
+
+// DebugGetter has a method to return a DebugInterface.
+type DebugGetter interface {
+	Debug() DebugInterface
+}
+
+// DebugInterface has methods to work with debug api
+type DebugInterface interface {
+	Info(ctx context.Context) (*v2.DebugInfo, error)
+}
+
+// debug implements DebugInterface
+type debug struct {
+	client rest.CDCRESTInterface
+}
+
+// newDebug returns debug
+func newDebug(c *APIV2Client) *debug {
+	return &debug{
+		client: c.RESTClient(),
+	}
+}
+
+// Info returns the debug info of the server
+func (c *debug) Info(ctx context.Context) (*v2.DebugInfo, error) {
+	result := new(v2.DebugInfo)
+	err := c.client.Get().
+		WithURI("debug/info").
+		Do(ctx).
+		Into(result)
+	return result, err
+}
@@ -121,7 +121,16 @@ func (ra *RedoApplier) catchError(ctx context.Context) error {
 }
 
 func (ra *RedoApplier) initSink(ctx context.Context) (err error) {
+	sinkURI, err := url.Parse(ra.cfg.SinkURI)
+	if err != nil {
+		return errors.WrapError(errors.ErrSinkURIInvalid, err)
+	}
 	replicaConfig := config.GetDefaultReplicaConfig()
+	// Apply parameters in the sink URI, e.g. the protocol of MQ and cloud
+	// storage sinks.
+	if err := replicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return err
+	}
 	ra.sinkFactory, err = dmlfactory.New(ctx, ra.cfg.SinkURI, replicaConfig, ra.errCh)
 	if err != nil {
 		return err
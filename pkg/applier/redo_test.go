@@ -226,6 +226,19 @@ func TestApplyMeetSinkError(t *testing.T) {
 	require.Regexp(t, "CDC:ErrMySQLConnectionError", err)
 }
 
+func TestApplyMQSinkWithoutProtocol(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := &RedoApplierConfig{
+		Storage: "blackhole://",
+		SinkURI: "kafka://127.0.0.1:9092/topic",
+	}
+	ap := NewRedoApplier(cfg)
+	err := ap.Apply(ctx)
+	require.Regexp(t, "CDC:ErrSinkUnknownProtocol", err)
+}
+
 func getMockDB(t *testing.T) *sql.DB {
 	// normal db
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -36,6 +36,12 @@ type ConflictDetector[Worker worker[Txn], Txn txnEvent] struct {
 	// nextWorkerID is used to dispatch transactions round-robin.
 	nextWorkerID atomic.Int64
 
+	// inFlight tracks the sizes of unfinished transactions of each group.
+	inFlight struct {
+		sync.Mutex
+		groups map[int64]map[*internal.Node]int
+	}
+
 	// Used to run a background goroutine to GC or notify nodes.
 	notifiedNodes *chann.DrainableChann[func()]
 	garbageNodes  *chann.DrainableChann[txnFinishedEvent]
@@ -61,6 +67,7 @@ func NewConflictDetector[Worker worker[Txn], Txn txnEvent](
 		garbageNodes:  chann.NewAutoDrainChann[txnFinishedEvent](),
 		closeCh:       make(chan struct{}),
 	}
+	ret.inFlight.groups = make(map[int64]map[*internal.Node]int)
 
 	ret.wg.Add(1)
 	go func() {
@@ -71,22 +78,67 @@ func NewConflictDetector[Worker worker[Txn], Txn txnEvent](
 	return ret
 }
 
-// Add pushes a transaction to the ConflictDetector.
+// Add pushes a transaction to the ConflictDetector. It returns true if the
+// transaction conflicts with any unfinished transactions.
 //
 // NOTE: if multiple threads access this concurrently, Txn.ConflictKeys must be sorted.
-func (d *ConflictDetector[Worker, Txn]) Add(txn Txn) {
+func (d *ConflictDetector[Worker, Txn]) Add(txn Txn) (conflicted bool) {
 	conflictKeys := txn.ConflictKeys(d.numSlots)
 	node := internal.NewNode()
+	var onFinished func()
+	if grouped, ok := any(txn).(groupedTxnEvent); ok {
+		onFinished = d.trackInFlight(node, grouped.GroupID(), grouped.Size())
+	}
 	node.OnResolved = func(workerID int64) {
 		unlock := func() {
 			node.Remove()
+			if onFinished != nil {
+				onFinished()
+			}
 			d.garbageNodes.In() <- txnFinishedEvent{node, conflictKeys}
 		}
 		d.sendToWorker(txn, unlock, workerID)
 	}
 	node.RandWorkerID = func() int64 { return d.nextWorkerID.Add(1) % int64(len(d.workers)) }
 	node.OnNotified = func(callback func()) { d.notifiedNodes.In() <- callback }
-	d.slots.Add(node, conflictKeys)
+	return d.slots.Add(node, conflictKeys)
+}
+
+// InFlight returns the number of unfinished transactions of the group and
+// the size of the largest one.
+func (d *ConflictDetector[Worker, Txn]) InFlight(groupID int64) (txns int, maxSize int) {
+	d.inFlight.Lock()
+	defer d.inFlight.Unlock()
+	for _, size := range d.inFlight.groups[groupID] {
+		txns++
+		if size > maxSize {
+			maxSize = size
+		}
+	}
+	return
+}
+
+// trackInFlight tracks the transaction of the node until the returned
+// function is called.
+func (d *ConflictDetector[Worker, Txn]) trackInFlight(
+	node *internal.Node, groupID int64, size int,
+) func() {
+	d.inFlight.Lock()
+	defer d.inFlight.Unlock()
+	nodes, ok := d.inFlight.groups[groupID]
+	if !ok {
+		nodes = make(map[*internal.Node]int)
+		d.inFlight.groups[groupID] = nodes
+	}
+	nodes[node] = size
+	return func() {
+		d.inFlight.Lock()
+		defer d.inFlight.Unlock()
+		delete(nodes, node)
+		if len(nodes) == 0 {
+			delete(d.inFlight.groups, groupID)
+		}
+	}
 }
 
 // Close closes the ConflictDetector.
@@ -55,8 +55,9 @@ func NewSlots[E SlotNode[E]](numSlots uint64) *Slots[E] {
 	}
 }
 
-// Add adds an elem to the slots and calls DependOn for elem.
-func (s *Slots[E]) Add(elem E, keys []uint64) {
+// Add adds an elem to the slots and calls DependOn for elem. It returns
+// true if elem conflicts with any elem added before.
+func (s *Slots[E]) Add(elem E, keys []uint64) (conflicted bool) {
 	unresolvedDeps := make(map[int64]E, len(keys))
 	resolvedDeps := 0
 
@@ -87,6 +88,7 @@ func (s *Slots[E]) Add(elem E, keys []uint64) {
 			lastSlot = slotIdx
 		}
 	}
+	return len(unresolvedDeps) > 0
 }
 
 // Free removes an element from the Slots.
@@ -32,7 +32,8 @@ func TestSlotsTrivial(t *testing.T) {
 	for i := 0; i < count; i++ {
 		node := NewNode()
 		node.RandWorkerID = func() workerID { return 100 }
-		slots.Add(node, []uint64{1, 2, 3, 4, 5})
+		conflicted := slots.Add(node, []uint64{1, 2, 3, 4, 5})
+		require.Equal(t, i > 0, conflicted)
 		nodes = append(nodes, node)
 	}
 
@@ -28,6 +28,15 @@ type txnEvent interface {
 	ConflictKeys(numSlots uint64) []conflictKey
 }
 
+// groupedTxnEvent is implemented by events whose in-flight statistics are
+// collected by groups, e.g. by tables.
+type groupedTxnEvent interface {
+	// GroupID returns the group of the event.
+	GroupID() int64
+	// Size returns the number of rows in the event.
+	Size() int
+}
+
 type worker[Txn txnEvent] interface {
 	Add(txn Txn, unlock func())
 }
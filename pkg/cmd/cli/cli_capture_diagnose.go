@@ -0,0 +1,72 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// diagnoseCaptureOptions defines flags for the `cli capture diagnose` command.
+type diagnoseCaptureOptions struct {
+	apiv2Client apiv2client.APIV2Interface
+}
+
+// newDiagnoseCaptureOptions creates new diagnoseCaptureOptions for the `cli capture diagnose` command.
+func newDiagnoseCaptureOptions() *diagnoseCaptureOptions {
+	return &diagnoseCaptureOptions{}
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *diagnoseCaptureOptions) complete(f factory.Factory) error {
+	apiv2Client, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiv2Client = apiv2Client
+	return nil
+}
+
+// run runs the `cli capture diagnose` command.
+func (o *diagnoseCaptureOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	info, err := o.apiv2Client.Debug().Info(ctx)
+	if err != nil {
+		return err
+	}
+	return util.JSONPrint(cmd, info)
+}
+
+// newCmdDiagnoseCapture creates the `cli capture diagnose` command.
+func newCmdDiagnoseCapture(f factory.Factory) *cobra.Command {
+	o := newDiagnoseCaptureOptions()
+
+	command := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Dump the runtime state of a capture",
+		Long: "Dump the state of the owner, the schedulers and the processors " +
+			"in the capture specified by --server, which defaults to the owner.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	return command
+}
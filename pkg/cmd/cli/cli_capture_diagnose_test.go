@@ -0,0 +1,48 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureDiagnoseCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	debug := mock.NewMockDebugInterface(ctrl)
+	f := &mockFactory{debug: debug}
+	cmd := newCmdDiagnoseCapture(f)
+	debug.EXPECT().Info(gomock.Any()).Return(&v2.DebugInfo{
+		CaptureID:     "owner",
+		AdvertiseAddr: "127.0.0.1:8300",
+		Owner:         &v2.OwnerDebugInfo{},
+		Processors: []v2.ProcessorDebugInfo{
+			{ChangefeedID: "test", Initialized: true},
+		},
+	}, nil)
+	os.Args = []string{"diagnose"}
+	require.Nil(t, cmd.Execute())
+
+	debug.EXPECT().Info(gomock.Any()).Return(nil, errors.New("test"))
+	o := newDiagnoseCaptureOptions()
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
@@ -33,6 +33,7 @@ type mockAPIV2Client struct {
 	unsafes     apiv2client.UnsafeInterface
 	captures    apiv2client.CaptureInterface
 	processors  apiv2client.ProcessorInterface
+	debug       apiv2client.DebugInterface
 }
 
 func (f *mockAPIV2Client) Changefeeds() apiv2client.ChangefeedInterface {
@@ -55,6 +56,10 @@ func (f *mockAPIV2Client) Processors() apiv2client.ProcessorInterface {
 	return f.processors
 }
 
+func (f *mockAPIV2Client) Debug() apiv2client.DebugInterface {
+	return f.debug
+}
+
 type mockFactory struct {
 	factory.Factory
 	captures    *mock.MockCaptureInterface
@@ -63,6 +68,7 @@ type mockFactory struct {
 	status      *mock.MockStatusInterface
 	tso         *mock.MockTsoInterface
 	unsafes     *mock.MockUnsafeInterface
+	debug       *mock.MockDebugInterface
 }
 
 func newMockFactory(ctrl *gomock.Controller) *mockFactory {
@@ -72,6 +78,7 @@ func newMockFactory(ctrl *gomock.Controller) *mockFactory {
 	statuses := mock.NewMockStatusInterface(ctrl)
 	unsafes := mock.NewMockUnsafeInterface(ctrl)
 	tso := mock.NewMockTsoInterface(ctrl)
+	debug := mock.NewMockDebugInterface(ctrl)
 	return &mockFactory{
 		captures:    cps,
 		changefeeds: cf,
@@ -79,6 +86,7 @@ func newMockFactory(ctrl *gomock.Controller) *mockFactory {
 		status:      statuses,
 		tso:         tso,
 		unsafes:     unsafes,
+		debug:       debug,
 	}
 }
 
@@ -89,6 +97,7 @@ func (f *mockFactory) APIV2Client() (apiv2client.APIV2Interface, error) {
 		tso:         f.tso,
 		unsafes:     f.unsafes,
 		processors:  f.processors,
+		debug:       f.debug,
 	}, nil
 }
 
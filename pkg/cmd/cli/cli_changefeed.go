@@ -34,6 +34,9 @@ func newCmdChangefeed(f factory.Factory) *cobra.Command {
 	cmds.AddCommand(newCmdQueryChangefeed(f))
 	cmds.AddCommand(newCmdRemoveChangefeed(f))
 	cmds.AddCommand(newCmdResumeChangefeed(f))
+	cmds.AddCommand(newCmdVerifyChangefeed(f))
+	cmds.AddCommand(newCmdBackupChangefeed(f))
+	cmds.AddCommand(newCmdRestoreChangefeed(f))
 
 	return cmds
 }
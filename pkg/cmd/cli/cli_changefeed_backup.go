@@ -0,0 +1,148 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// changefeedBackup is the definition of a changefeed in a backup file.
+type changefeedBackup struct {
+	Namespace      string            `json:"namespace"`
+	ID             string            `json:"id"`
+	UpstreamID     uint64            `json:"upstream_id"`
+	SinkURI        string            `json:"sink_uri"`
+	Config         *v2.ReplicaConfig `json:"config"`
+	StartTs        uint64            `json:"start_ts"`
+	TargetTs       uint64            `json:"target_ts"`
+	CheckpointTs   uint64            `json:"checkpoint_ts"`
+	State          model.FeedState   `json:"state"`
+	CreatorVersion string            `json:"creator_version"`
+}
+
+// changefeedsBackup is the content of a backup file.
+type changefeedsBackup struct {
+	BackupTime  model.JSONTime      `json:"backup_time"`
+	Changefeeds []*changefeedBackup `json:"changefeeds"`
+}
+
+// backupChangefeedOptions defines flags for the `cli changefeed backup` command.
+type backupChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	output string
+}
+
+// newBackupChangefeedOptions creates new options for the `cli changefeed backup` command.
+func newBackupChangefeedOptions() *backupChangefeedOptions {
+	return &backupChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *backupChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.output, "output", "o", "",
+		"Path of the file to write the changefeed definitions to")
+	_ = cmd.MarkPersistentFlagRequired("output")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *backupChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed backup` command.
+func (o *backupChangefeedOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	infos, err := o.apiClient.Changefeeds().List(ctx, "all")
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	backup := &changefeedsBackup{
+		BackupTime:  model.JSONTime(time.Now()),
+		Changefeeds: make([]*changefeedBackup, 0, len(infos)),
+	}
+	for _, info := range infos {
+		// The removed changefeeds can not be restored.
+		if info.FeedState == model.StateRemoved {
+			continue
+		}
+		detail, err := o.apiClient.Changefeeds().Get(ctx, info.ID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		backup.Changefeeds = append(backup.Changefeeds, &changefeedBackup{
+			Namespace:      detail.Namespace,
+			ID:             detail.ID,
+			UpstreamID:     detail.UpstreamID,
+			SinkURI:        detail.SinkURI,
+			Config:         detail.Config,
+			StartTs:        detail.StartTs,
+			TargetTs:       detail.TargetTs,
+			CheckpointTs:   detail.CheckpointTs,
+			State:          detail.State,
+			CreatorVersion: detail.CreatorVersion,
+		})
+	}
+
+	data, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.WriteFile(o.output, data, 0o644); err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Printf("Backup %d changefeeds to %s successfully!\n",
+		len(backup.Changefeeds), o.output)
+	return nil
+}
+
+// newCmdBackupChangefeed creates the `cli changefeed backup` command.
+func newCmdBackupChangefeed(f factory.Factory) *cobra.Command {
+	o := newBackupChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "backup",
+		Short: "Export the definitions of all replication tasks (changefeeds) to a file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmdcontext.GetDefaultContext()
+
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(ctx, cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedBackupCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+	cmd := newCmdBackupChangefeed(f)
+	output := filepath.Join(t.TempDir(), "feeds.json")
+
+	f.changefeeds.EXPECT().List(gomock.Any(), "all").Return([]v2.ChangefeedCommonInfo{
+		{Namespace: "default", ID: "normal-1", FeedState: model.StateNormal},
+		{Namespace: "default", ID: "stopped-2", FeedState: model.StateStopped},
+		{Namespace: "default", ID: "removed-3", FeedState: model.StateRemoved},
+	}, nil)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "normal-1").Return(&v2.ChangeFeedInfo{
+		Namespace:    "default",
+		ID:           "normal-1",
+		SinkURI:      "blackhole://",
+		Config:       v2.GetDefaultReplicaConfig(),
+		StartTs:      100,
+		CheckpointTs: 200,
+		State:        model.StateNormal,
+	}, nil)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "stopped-2").Return(&v2.ChangeFeedInfo{
+		Namespace:    "default",
+		ID:           "stopped-2",
+		SinkURI:      "blackhole://",
+		Config:       v2.GetDefaultReplicaConfig(),
+		StartTs:      100,
+		TargetTs:     1000,
+		CheckpointTs: 300,
+		State:        model.StateStopped,
+	}, nil)
+	os.Args = []string{"backup", "--output=" + output}
+	require.Nil(t, cmd.Execute())
+
+	data, err := os.ReadFile(output)
+	require.Nil(t, err)
+	backup := &changefeedsBackup{}
+	require.Nil(t, json.Unmarshal(data, backup))
+	require.Len(t, backup.Changefeeds, 2)
+	require.Equal(t, "normal-1", backup.Changefeeds[0].ID)
+	require.Equal(t, uint64(200), backup.Changefeeds[0].CheckpointTs)
+	require.Equal(t, "stopped-2", backup.Changefeeds[1].ID)
+	require.Equal(t, model.StateStopped, backup.Changefeeds[1].State)
+	require.Equal(t, uint64(1000), backup.Changefeeds[1].TargetTs)
+	require.NotNil(t, backup.Changefeeds[1].Config)
+
+	f.changefeeds.EXPECT().List(gomock.Any(), "all").Return(nil, errors.New("test"))
+	o := newBackupChangefeedOptions()
+	o.output = output
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(context.Background(), cmd))
+}
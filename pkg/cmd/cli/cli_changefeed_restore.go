@@ -0,0 +1,178 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+const (
+	// restoreFromCheckpoint restores a changefeed from its checkpoint ts
+	// at the time of the backup.
+	restoreFromCheckpoint = "checkpoint"
+	// restoreFromStartTs restores a changefeed from its original start ts.
+	restoreFromStartTs = "start-ts"
+	// restoreFromNow restores a changefeed from the current tso.
+	restoreFromNow = "now"
+)
+
+// restoreChangefeedOptions defines flags for the `cli changefeed restore` command.
+type restoreChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	input         string
+	startTsPolicy string
+}
+
+// newRestoreChangefeedOptions creates new options for the `cli changefeed restore` command.
+func newRestoreChangefeedOptions() *restoreChangefeedOptions {
+	return &restoreChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *restoreChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.input, "input", "i", "",
+		"Path of the file generated by `cli changefeed backup`")
+	cmd.PersistentFlags().StringVar(&o.startTsPolicy, "start-ts-policy", restoreFromCheckpoint,
+		"Where the restored changefeeds start from, should be 'checkpoint', 'start-ts' or 'now'")
+	_ = cmd.MarkPersistentFlagRequired("input")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *restoreChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+
+	o.apiClient = apiClient
+	return nil
+}
+
+// validate checks that the provided restore options are specified.
+func (o *restoreChangefeedOptions) validate() error {
+	switch o.startTsPolicy {
+	case restoreFromCheckpoint, restoreFromStartTs, restoreFromNow:
+		return nil
+	default:
+		return errors.Errorf("invalid start-ts-policy %s, "+
+			"should be 'checkpoint', 'start-ts' or 'now'", o.startTsPolicy)
+	}
+}
+
+// run the `cli changefeed restore` command.
+func (o *restoreChangefeedOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	data, err := os.ReadFile(o.input)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	backup := &changefeedsBackup{}
+	if err := json.Unmarshal(data, backup); err != nil {
+		return errors.Annotatef(err, "invalid backup file %s", o.input)
+	}
+
+	var currentTs uint64
+	if o.startTsPolicy == restoreFromNow {
+		tso, err := o.apiClient.Tso().Query(ctx, &v2.UpstreamConfig{})
+		if err != nil {
+			return err
+		}
+		currentTs = oracle.ComposeTS(tso.Timestamp, tso.LogicTime)
+	}
+
+	restored := 0
+	for _, cf := range backup.Changefeeds {
+		// A finished changefeed has nothing left to replicate.
+		if cf.State == model.StateFinished {
+			cmd.Printf("Skip finished changefeed %s\n", cf.ID)
+			continue
+		}
+		_, err := o.apiClient.Changefeeds().Get(ctx, cf.ID)
+		if err == nil {
+			cmd.Printf("Skip changefeed %s, it already exists\n", cf.ID)
+			continue
+		}
+		if cerror.ErrChangeFeedNotExists.NotEqual(err) {
+			return err
+		}
+
+		startTs := cf.StartTs
+		switch o.startTsPolicy {
+		case restoreFromCheckpoint:
+			if cf.CheckpointTs != 0 {
+				startTs = cf.CheckpointTs
+			}
+		case restoreFromNow:
+			startTs = currentTs
+		}
+		_, err = o.apiClient.Changefeeds().Create(ctx, &v2.ChangefeedConfig{
+			Namespace:     cf.Namespace,
+			ID:            cf.ID,
+			StartTs:       startTs,
+			TargetTs:      cf.TargetTs,
+			SinkURI:       cf.SinkURI,
+			ReplicaConfig: cf.Config,
+		})
+		if err != nil {
+			return err
+		}
+		// Keeps the changefeeds which were stopped or failed at the time of
+		// the backup paused, so that users can check them before resuming.
+		if cf.State == model.StateStopped || cf.State == model.StateFailed {
+			if err := o.apiClient.Changefeeds().Pause(ctx, cf.ID); err != nil {
+				return err
+			}
+		}
+		restored++
+		cmd.Printf("Restore changefeed %s from %d successfully!\n", cf.ID, startTs)
+	}
+	cmd.Printf("Restore %d changefeeds from %s successfully!\n", restored, o.input)
+	return nil
+}
+
+// newCmdRestoreChangefeed creates the `cli changefeed restore` command.
+func newCmdRestoreChangefeed(f factory.Factory) *cobra.Command {
+	o := newRestoreChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "restore",
+		Short: "Create the replication tasks (changefeeds) from a backup file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmdcontext.GetDefaultContext()
+
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(ctx, cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
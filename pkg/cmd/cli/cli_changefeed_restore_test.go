@@ -0,0 +1,101 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedRestoreCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+	cmd := newCmdRestoreChangefeed(f)
+	input := filepath.Join(t.TempDir(), "feeds.json")
+
+	backup := &changefeedsBackup{Changefeeds: []*changefeedBackup{
+		{
+			Namespace: "default", ID: "normal-1", SinkURI: "blackhole://",
+			StartTs: 100, CheckpointTs: 200, State: model.StateNormal,
+		},
+		{
+			Namespace: "default", ID: "stopped-2", SinkURI: "blackhole://",
+			StartTs: 100, TargetTs: 1000, CheckpointTs: 300, State: model.StateStopped,
+		},
+		{
+			Namespace: "default", ID: "finished-3", SinkURI: "blackhole://",
+			StartTs: 100, TargetTs: 1000, CheckpointTs: 1000, State: model.StateFinished,
+		},
+		{
+			Namespace: "default", ID: "existing-4", SinkURI: "blackhole://",
+			StartTs: 100, CheckpointTs: 400, State: model.StateNormal,
+		},
+	}}
+	data, err := json.Marshal(backup)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(input, data, 0o644))
+
+	notExist := cerror.ErrChangeFeedNotExists.GenWithStackByArgs("test")
+	f.changefeeds.EXPECT().Get(gomock.Any(), "normal-1").Return(nil, notExist)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "stopped-2").Return(nil, notExist)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "existing-4").Return(&v2.ChangeFeedInfo{}, nil)
+	f.changefeeds.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error) {
+			require.Equal(t, "normal-1", cfg.ID)
+			require.Equal(t, uint64(200), cfg.StartTs)
+			return &v2.ChangeFeedInfo{}, nil
+		})
+	f.changefeeds.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error) {
+			require.Equal(t, "stopped-2", cfg.ID)
+			require.Equal(t, uint64(300), cfg.StartTs)
+			require.Equal(t, uint64(1000), cfg.TargetTs)
+			return &v2.ChangeFeedInfo{}, nil
+		})
+	// The stopped changefeed is kept paused after it's restored.
+	f.changefeeds.EXPECT().Pause(gomock.Any(), "stopped-2").Return(nil)
+	os.Args = []string{"restore", "--input=" + input}
+	require.Nil(t, cmd.Execute())
+
+	// Restores the changefeeds from the current tso.
+	o := newRestoreChangefeedOptions()
+	o.input = input
+	o.startTsPolicy = restoreFromNow
+	require.Nil(t, o.complete(f))
+	require.Nil(t, o.validate())
+	f.tso.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&v2.Tso{
+		Timestamp: 1, LogicTime: 1,
+	}, nil)
+	f.changefeeds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, notExist).Times(3)
+	f.changefeeds.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error) {
+			require.Equal(t, uint64(1<<18+1), cfg.StartTs)
+			return &v2.ChangeFeedInfo{}, nil
+		}).Times(3)
+	f.changefeeds.EXPECT().Pause(gomock.Any(), "stopped-2").Return(nil)
+	require.Nil(t, o.run(context.Background(), cmd))
+
+	o.startTsPolicy = "unknown"
+	require.NotNil(t, o.validate())
+}
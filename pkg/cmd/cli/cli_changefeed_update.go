@@ -35,6 +35,7 @@ type updateChangefeedOptions struct {
 
 	commonChangefeedOptions *changefeedCommonOptions
 	changefeedID            string
+	online                  bool
 }
 
 // newUpdateChangefeedOptions creates new options for the `cli changefeed update` command.
@@ -50,6 +51,9 @@ func (o *updateChangefeedOptions) addFlags(cmd *cobra.Command) {
 	o.commonChangefeedOptions.addFlags(cmd)
 	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
 	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	cmd.PersistentFlags().BoolVar(&o.online, "online", false,
+		"Update a running changefeed, only memory-quota, sink.max-rows-per-second "+
+			"and sink.max-bytes-per-second can be updated online")
 }
 
 func (o *updateChangefeedOptions) getChangefeedConfig(cmd *cobra.Command,
@@ -60,6 +64,7 @@ func (o *updateChangefeedOptions) getChangefeedConfig(cmd *cobra.Command,
 		TargetTs:      info.TargetTs,
 		SinkURI:       info.SinkURI,
 		ReplicaConfig: replicaConfig,
+		Online:        o.online,
 	}
 	cmd.Flags().Visit(func(flag *pflag.Flag) {
 		switch flag.Name {
@@ -166,7 +171,7 @@ func (o *updateChangefeedOptions) applyChanges(oldInfo *v2.ChangeFeedInfo,
 		case "sort-engine":
 		case "sort-dir":
 			log.Warn("this flag cannot be updated and will be ignored", zap.String("flagName", flag.Name))
-		case "changefeed-id", "no-confirm":
+		case "changefeed-id", "no-confirm", "online":
 			// Do nothing, these are some flags from the changefeed command,
 			// we don't use it to update, but we do use these flags.
 		case "pd", "log-level", "key", "cert", "ca", "server":
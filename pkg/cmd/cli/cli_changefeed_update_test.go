@@ -14,6 +14,7 @@
 package cli
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -158,6 +159,26 @@ func TestChangefeedUpdateCli(t *testing.T) {
 	os.Args = []string{"update", "--no-confirm=true", "-c", "abc"}
 	require.Nil(t, cmd.Execute())
 
+	// online update
+	cmd = newCmdUpdateChangefeed(f)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").
+		Return(&v2.ChangeFeedInfo{
+			ID: "abc",
+			Config: &v2.ReplicaConfig{
+				Sink: &v2.SinkConfig{},
+			},
+		}, nil)
+	f.changefeeds.EXPECT().Update(gomock.Any(), gomock.Any(), "abc").
+		DoAndReturn(func(_ context.Context, cfg *v2.ChangefeedConfig,
+			_ string,
+		) (*v2.ChangeFeedInfo, error) {
+			require.True(t, cfg.Online)
+			require.Equal(t, uint64(10), cfg.TargetTs)
+			return &v2.ChangeFeedInfo{}, nil
+		})
+	os.Args = []string{"update", "--no-confirm=true", "--online", "--target-ts=10", "-c", "abc"}
+	require.Nil(t, cmd.Execute())
+
 	cmd = newCmdUpdateChangefeed(f)
 	f.changefeeds.EXPECT().Get(gomock.Any(), "abcd").
 		Return(&v2.ChangeFeedInfo{ID: "abcd"}, errors.New("test"))
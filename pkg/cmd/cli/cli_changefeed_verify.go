@@ -0,0 +1,197 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"net/url"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher"
+	sinkutil "github.com/pingcap/tiflow/cdc/sink/util"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/spf13/cobra"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// verifyChangefeedOptions defines flags for the `cli changefeed verify` command.
+type verifyChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	sinkURI        string
+	schemaRegistry string
+	configFile     string
+	startTs        uint64
+
+	cfg *config.ReplicaConfig
+}
+
+// newVerifyChangefeedOptions creates new options for the `cli changefeed verify` command.
+func newVerifyChangefeedOptions() *verifyChangefeedOptions {
+	return &verifyChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *verifyChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "", "sink uri")
+	cmd.PersistentFlags().StringVar(&o.configFile, "config", "", "Path of the configuration file")
+	cmd.PersistentFlags().StringVar(&o.schemaRegistry, "schema-registry", "",
+		"Avro Schema Registry URI")
+	cmd.PersistentFlags().Uint64Var(&o.startTs, "start-ts", 0,
+		"Start ts of changefeed, the current ts is used if it's not specified")
+	_ = cmd.MarkPersistentFlagRequired("sink-uri")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *verifyChangefeedOptions) complete(f factory.Factory) error {
+	client, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = client
+
+	cfg := config.GetDefaultReplicaConfig()
+	if len(o.configFile) > 0 {
+		if err := util.StrictDecodeFile(o.configFile, "TiCDC changefeed", cfg); err != nil {
+			return err
+		}
+	}
+	if o.schemaRegistry != "" {
+		cfg.Sink.SchemaRegistry = o.schemaRegistry
+	}
+	o.cfg = cfg
+	return nil
+}
+
+// validate checks the replica config offline, which includes the filter
+// rules, the sink uri, the dispatcher rules and the encoder options.
+func (o *verifyChangefeedOptions) validate() error {
+	if _, err := filter.VerifyTableRules(o.cfg.Filter); err != nil {
+		return err
+	}
+	if _, err := filter.NewFilter(o.cfg, ""); err != nil {
+		return err
+	}
+
+	sinkURI, err := url.Parse(o.sinkURI)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	if err := o.cfg.ValidateAndAdjust(sinkURI); err != nil {
+		return err
+	}
+	if !sink.IsMQScheme(sinkURI.Scheme) {
+		return nil
+	}
+
+	// The dispatcher rules and the encoder options only take effect
+	// in the MQ sinks.
+	topic, err := sinkutil.GetTopic(sinkURI)
+	if err != nil {
+		return err
+	}
+	if _, err := dispatcher.NewEventRouter(o.cfg, topic); err != nil {
+		return err
+	}
+	protocol, err := sinkutil.GetProtocol(o.cfg.Sink.Protocol)
+	if err != nil {
+		return err
+	}
+	_, err = sinkutil.GetEncoderConfig(sinkURI, protocol, o.cfg, config.DefaultMaxMessageBytes)
+	return err
+}
+
+// run the `cli changefeed verify` command.
+func (o *verifyChangefeedOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	if o.startTs == 0 {
+		tso, err := o.apiClient.Tso().Query(ctx, &v2.UpstreamConfig{})
+		if err != nil {
+			return err
+		}
+		o.startTs = oracle.ComposeTS(tso.Timestamp, tso.LogicTime)
+	}
+
+	tables, err := o.apiClient.Changefeeds().VerifyTable(ctx, &v2.VerifyTableConfig{
+		ReplicaConfig: v2.ToAPIReplicaConfig(o.cfg),
+		StartTs:       o.startTs,
+	})
+	if err != nil {
+		return err
+	}
+	if err := util.JSONPrint(cmd, tables); err != nil {
+		return err
+	}
+
+	if len(tables.IneligibleTables) != 0 {
+		if o.cfg.ForceReplicate {
+			cmd.Printf("[WARN] Force to replicate %d ineligible tables, "+
+				"these tables do not have a primary key or a not-null unique key\n",
+				len(tables.IneligibleTables))
+		} else {
+			cmd.Printf("[WARN] %d tables are not eligible to replicate, "+
+				"because they do not have a primary key or a not-null unique key\n",
+				len(tables.IneligibleTables))
+		}
+	}
+	if o.replicatedTableCount(tables) == 0 {
+		cmd.Printf("[WARN] No table would be replicated by the changefeed, " +
+			"please check the filter rules\n")
+	}
+	cmd.Printf("Verify changefeed config successfully! "+
+		"%d tables would be replicated from start-ts %d\n",
+		o.replicatedTableCount(tables), o.startTs)
+	return nil
+}
+
+// replicatedTableCount returns the number of tables the changefeed would
+// replicate.
+func (o *verifyChangefeedOptions) replicatedTableCount(tables *v2.Tables) int {
+	if o.cfg.ForceReplicate {
+		return len(tables.EligibleTables) + len(tables.IneligibleTables)
+	}
+	return len(tables.EligibleTables)
+}
+
+// newCmdVerifyChangefeed creates the `cli changefeed verify` command.
+func newCmdVerifyChangefeed(f factory.Factory) *cobra.Command {
+	o := newVerifyChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the config of a changefeed without creating it",
+		Long: "Verify the filter rules, the sink uri, the dispatcher rules and the " +
+			"encoder options of a changefeed, and list the tables which would be " +
+			"replicated, including the ineligible tables without a valid index",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmdcontext.GetDefaultContext()
+
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(ctx, cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
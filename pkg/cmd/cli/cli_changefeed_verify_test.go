@@ -0,0 +1,97 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyChangefeedValidate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+	dir := t.TempDir()
+
+	cases := []struct {
+		config  string
+		sinkURI string
+		err     string
+	}{{
+		config:  "[filter]\nrules = ['*.*', '!test.*']",
+		sinkURI: "mysql://root@127.0.0.1:3306/",
+	}, {
+		config:  "[filter]\nrules = ['*.*', 'rtest1']",
+		sinkURI: "mysql://root@127.0.0.1:3306/",
+		err:     "ErrFilterRuleInvalid",
+	}, {
+		config:  "[sink]\ndispatchers = [{matcher = ['test.*'], partition = 'ts'}]",
+		sinkURI: "kafka://127.0.0.1:9092/topic?protocol=canal-json",
+	}, {
+		sinkURI: "kafka://127.0.0.1:9092/?protocol=canal-json",
+		err:     "no topic is specified in sink-uri",
+	}, {
+		sinkURI: "kafka://127.0.0.1:9092/topic?protocol=avro",
+		err:     "ErrSinkInvalidConfig",
+	}, {
+		config: "[sink]\ndispatchers = [{matcher = ['test.*'], " +
+			"partition = 'ts', dispatcher = 'table'}]",
+		sinkURI: "kafka://127.0.0.1:9092/topic?protocol=canal-json",
+		err:     "dispatcher and partition cannot be configured both",
+	}}
+	for i, cs := range cases {
+		o := newVerifyChangefeedOptions()
+		o.sinkURI = cs.sinkURI
+		if cs.config != "" {
+			o.configFile = filepath.Join(dir, "config"+string(rune('0'+i))+".toml")
+			require.Nil(t, os.WriteFile(o.configFile, []byte(cs.config), 0o644))
+		}
+		require.NoError(t, o.complete(f))
+		err := o.validate()
+		if cs.err == "" {
+			require.NoError(t, err)
+		} else {
+			require.ErrorContains(t, err, cs.err)
+		}
+	}
+}
+
+func TestChangefeedVerifyCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+
+	cmd := newCmdVerifyChangefeed(f)
+	os.Args = []string{
+		"verify",
+		"--sink-uri=blackhole://",
+		"--start-ts=10",
+	}
+	f.changefeeds.EXPECT().VerifyTable(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, cfg *v2.VerifyTableConfig) (*v2.Tables, error) {
+			require.Equal(t, uint64(10), cfg.StartTs)
+			return &v2.Tables{
+				EligibleTables:   []v2.TableName{{Schema: "test", Table: "t1"}},
+				IneligibleTables: []v2.TableName{{Schema: "test", Table: "t2"}},
+			}, nil
+		})
+	require.Nil(t, cmd.Execute())
+}
@@ -40,6 +40,7 @@ type ClientGetter interface {
 	GetPdAddr() string
 	GetServerAddr() string
 	GetLogLevel() string
+	GetNamespace() string
 	GetCredential() *security.Credential
 }
 
@@ -48,6 +49,7 @@ type ClientFlags struct {
 	pdAddr     string
 	serverAddr string
 	logLevel   string
+	namespace  string
 	caPath     string
 	certPath   string
 	keyPath    string
@@ -91,6 +93,11 @@ func (c *ClientFlags) GetServerAddr() string {
 	return c.serverAddr
 }
 
+// GetNamespace returns the namespace of the changefeeds to operate.
+func (c *ClientFlags) GetNamespace() string {
+	return c.namespace
+}
+
 // NewClientFlags creates new client flags.
 func NewClientFlags() *ClientFlags {
 	return &ClientFlags{}
@@ -112,6 +119,8 @@ func (c *ClientFlags) AddFlags(cmd *cobra.Command) {
 		"Private key path for TLS connection to CDC server")
 	cmd.PersistentFlags().StringVar(&c.logLevel, "log-level", "warn",
 		"log level (etc: debug|info|warn|error)")
+	cmd.PersistentFlags().StringVar(&c.namespace, "namespace", "",
+		"Namespace of the changefeeds, empty means the default namespace")
 }
 
 // GetCredential returns credential.
@@ -81,6 +81,11 @@ func (f *factoryImpl) GetLogLevel() string {
 	return f.clientGetter.GetLogLevel()
 }
 
+// GetNamespace returns the namespace of the changefeeds to operate.
+func (f *factoryImpl) GetNamespace() string {
+	return f.clientGetter.GetNamespace()
+}
+
 // GetCredential returns security credentials.
 func (f *factoryImpl) GetCredential() *security.Credential {
 	return f.clientGetter.GetCredential()
@@ -206,7 +211,8 @@ func (f *factoryImpl) APIV2Client() (apiv2client.APIV2Interface, error) {
 		return nil, errors.Trace(err)
 	}
 	log.Info(serverAddr)
-	client, err := apiv2client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	client, err := apiv2client.NewAPIClient(serverAddr,
+		f.clientGetter.GetCredential(), f.clientGetter.GetNamespace())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
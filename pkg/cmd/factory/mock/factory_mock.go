@@ -97,6 +97,20 @@ func (mr *MockFactoryMockRecorder) GetLogLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLevel", reflect.TypeOf((*MockFactory)(nil).GetLogLevel))
 }
 
+// GetNamespace mocks base method.
+func (m *MockFactory) GetNamespace() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespace")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetNamespace indicates an expected call of GetNamespace.
+func (mr *MockFactoryMockRecorder) GetNamespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockFactory)(nil).GetNamespace))
+}
+
 // GetPdAddr mocks base method.
 func (m *MockFactory) GetPdAddr() string {
 	m.ctrl.T.Helper()
@@ -221,6 +235,20 @@ func (mr *MockClientGetterMockRecorder) GetLogLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLevel", reflect.TypeOf((*MockClientGetter)(nil).GetLogLevel))
 }
 
+// GetNamespace mocks base method.
+func (m *MockClientGetter) GetNamespace() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespace")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetNamespace indicates an expected call of GetNamespace.
+func (mr *MockClientGetterMockRecorder) GetNamespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockClientGetter)(nil).GetNamespace))
+}
+
 // GetPdAddr mocks base method.
 func (m *MockClientGetter) GetPdAddr() string {
 	m.ctrl.T.Helper()
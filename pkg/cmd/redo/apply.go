@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/applier"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/spf13/cobra"
 )
 
@@ -36,7 +37,8 @@ func newapplyRedoOptions() *applyRedoOptions {
 // addFlags receives a *cobra.Command reference and binds
 // flags related to template printing to it.
 func (o *applyRedoOptions) addFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target database sink-uri")
+	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "",
+		"target sink-uri, e.g. mysql://, kafka:// or s3://")
 	// the possible error returned from MarkFlagRequired is `no such flag`
 	cmd.MarkFlagRequired("sink-uri") //nolint:errcheck
 }
@@ -48,6 +50,10 @@ func (o *applyRedoOptions) complete(cmd *cobra.Command) error {
 	if err != nil {
 		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
 	}
+	// safe-mode only applies to MySQL compatible sinks.
+	if !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+		return nil
+	}
 	rawQuery := sinkURI.Query()
 	// set safe-mode to true if not set
 	if rawQuery.Get("safe-mode") != "true" {
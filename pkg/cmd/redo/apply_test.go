@@ -39,4 +39,10 @@ func TestComplete(t *testing.T) {
 	err = o.complete(cmd)
 	require.NoError(t, err)
 	require.Equal(t, "mysql://root@127.0.0.1:3306?time-zone=UTC&safe-mode=true", o.sinkURI)
+
+	// safe-mode is not added to non MySQL sinks.
+	o.sinkURI = "kafka://127.0.0.1:9092/topic?protocol=canal-json"
+	err = o.complete(cmd)
+	require.NoError(t, err)
+	require.Equal(t, "kafka://127.0.0.1:9092/topic?protocol=canal-json", o.sinkURI)
 }
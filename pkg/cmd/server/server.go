@@ -100,6 +100,8 @@ func (o *options) addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.serverConfig.Sorter.SortDir, "sort-dir", o.serverConfig.Sorter.SortDir, "sorter's temporary file directory")
 	_ = cmd.Flags().MarkHidden("sort-dir")
 
+	cmd.Flags().DurationVar((*time.Duration)(&o.serverConfig.DrainTimeout), "drain-timeout", time.Duration(o.serverConfig.DrainTimeout), "the max duration to wait for the tables to be moved away before exiting on a shutdown signal, 0 means exiting without waiting")
+
 	cmd.Flags().StringVar(&o.serverPdAddr, "pd", "http://127.0.0.1:2379", "Set the PD endpoints to use. Use ',' to separate multiple PDs")
 	cmd.Flags().StringVar(&o.serverConfigFilePath, "config", "", "Path of the configuration file")
 
@@ -233,6 +235,8 @@ func (o *options) complete(cmd *cobra.Command) error {
 			cfg.Sorter.SortDir = config.DefaultSortDir
 		case "cluster-id":
 			cfg.ClusterID = o.serverConfig.ClusterID
+		case "drain-timeout":
+			cfg.DrainTimeout = o.serverConfig.DrainTimeout
 		case "pd", "config":
 			// do nothing
 		default:
@@ -120,6 +120,7 @@ func TestParseCfg(t *testing.T) {
 		"--gc-ttl", "10",
 		"--tz", "UTC",
 		"--owner-flush-interval", "150ms",
+		"--drain-timeout", "1m",
 		"--processor-flush-interval", "150ms",
 		"--cert", "bb",
 		"--key", "cc",
@@ -161,10 +162,12 @@ func TestParseCfg(t *testing.T) {
 			CertAllowedCN: []string{"dd", "ee"},
 		},
 		KVClient: &config.KVClientConfig{
-			WorkerConcurrent:    8,
-			WorkerPoolSize:      0,
-			RegionScanLimit:     40,
-			RegionRetryDuration: config.TomlDuration(time.Minute),
+			WorkerConcurrent:       8,
+			WorkerPoolSize:         0,
+			RegionScanLimit:        40,
+			RegionRetryDuration:    config.TomlDuration(time.Minute),
+			RegionBackoffBaseDelay: config.TomlDuration(100 * time.Millisecond),
+			RegionBackoffMaxDelay:  config.TomlDuration(10 * time.Second),
 		},
 		Debug: &config.DebugConfig{
 			DB: &config.DBConfig{
@@ -187,6 +190,7 @@ func TestParseCfg(t *testing.T) {
 				ClientMaxBatchSize:           8 * 1024 * 1024,
 				ClientMaxBatchCount:          128,
 				ClientRetryRateLimit:         1.0,
+				ClientCompression:            "none",
 				ServerMaxPendingMessageCount: 102400,
 				ServerAckInterval:            config.TomlDuration(time.Millisecond * 100),
 				ServerWorkerPoolSize:         4,
@@ -204,6 +208,7 @@ func TestParseCfg(t *testing.T) {
 		},
 		ClusterID:           "default",
 		MaxMemoryPercentage: config.DefaultMaxMemoryPercentage,
+		DrainTimeout:        config.TomlDuration(time.Minute),
 	}, o.serverConfig)
 }
 
@@ -307,10 +312,12 @@ check-balance-interval = "10s"
 		},
 		Security: &config.SecurityConfig{},
 		KVClient: &config.KVClientConfig{
-			WorkerConcurrent:    8,
-			WorkerPoolSize:      0,
-			RegionScanLimit:     40,
-			RegionRetryDuration: config.TomlDuration(3 * time.Second),
+			WorkerConcurrent:       8,
+			WorkerPoolSize:         0,
+			RegionScanLimit:        40,
+			RegionRetryDuration:    config.TomlDuration(3 * time.Second),
+			RegionBackoffBaseDelay: config.TomlDuration(100 * time.Millisecond),
+			RegionBackoffMaxDelay:  config.TomlDuration(10 * time.Second),
 		},
 		Debug: &config.DebugConfig{
 			DB: &config.DBConfig{
@@ -332,6 +339,7 @@ check-balance-interval = "10s"
 				ClientMaxBatchSize:           999,
 				ClientMaxBatchCount:          888,
 				ClientRetryRateLimit:         100.0,
+				ClientCompression:            "none",
 				ServerMaxPendingMessageCount: 1024,
 				ServerAckInterval:            config.TomlDuration(1 * time.Second),
 				ServerWorkerPoolSize:         16,
@@ -349,6 +357,7 @@ check-balance-interval = "10s"
 		},
 		ClusterID:           "default",
 		MaxMemoryPercentage: config.DefaultMaxMemoryPercentage,
+		DrainTimeout:        config.TomlDuration(30 * time.Second),
 	}, o.serverConfig)
 }
 
@@ -440,10 +449,12 @@ cert-allowed-cn = ["dd","ee"]
 			CertAllowedCN: []string{"dd", "ee"},
 		},
 		KVClient: &config.KVClientConfig{
-			WorkerConcurrent:    8,
-			WorkerPoolSize:      0,
-			RegionScanLimit:     40,
-			RegionRetryDuration: config.TomlDuration(time.Minute),
+			WorkerConcurrent:       8,
+			WorkerPoolSize:         0,
+			RegionScanLimit:        40,
+			RegionRetryDuration:    config.TomlDuration(time.Minute),
+			RegionBackoffBaseDelay: config.TomlDuration(100 * time.Millisecond),
+			RegionBackoffMaxDelay:  config.TomlDuration(10 * time.Second),
 		},
 		Debug: &config.DebugConfig{
 			DB: &config.DBConfig{
@@ -466,6 +477,7 @@ cert-allowed-cn = ["dd","ee"]
 				ClientMaxBatchSize:           8 * 1024 * 1024,
 				ClientMaxBatchCount:          128,
 				ClientRetryRateLimit:         1.0,
+				ClientCompression:            "none",
 				ServerMaxPendingMessageCount: 102400,
 				ServerAckInterval:            config.TomlDuration(time.Millisecond * 100),
 				ServerWorkerPoolSize:         4,
@@ -483,6 +495,7 @@ cert-allowed-cn = ["dd","ee"]
 		},
 		ClusterID:           "default",
 		MaxMemoryPercentage: config.DefaultMaxMemoryPercentage,
+		DrainTimeout:        config.TomlDuration(30 * time.Second),
 	}, o.serverConfig)
 }
 
@@ -529,6 +542,7 @@ unknown3 = 3
 			ClientMaxBatchSize:           8 * 1024 * 1024,
 			ClientMaxBatchCount:          128,
 			ClientRetryRateLimit:         1.0,
+			ClientCompression:            "none",
 			ServerMaxPendingMessageCount: 102400,
 			ServerAckInterval:            config.TomlDuration(time.Millisecond * 100),
 			ServerWorkerPoolSize:         4,
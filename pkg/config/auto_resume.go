@@ -0,0 +1,64 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// AutoResumeConfig is the policy of resuming a changefeed automatically
+// after it's failed by retryable downstream errors, e.g. the downstream is
+// unreachable for longer than the retry policy allows. The changefeed is
+// resumed with an exponential backoff until the outage lasts longer than
+// MaxOutage, zero values mean the defaults of the owner.
+type AutoResumeConfig struct {
+	// Enable enables resuming the changefeed automatically.
+	Enable bool `toml:"enable" json:"enable"`
+	// BackoffInitInterval is the initial interval of the backoff, 1m by default.
+	BackoffInitInterval TomlDuration `toml:"backoff-init-interval" json:"backoff-init-interval"`
+	// BackoffMaxInterval caps the interval of the backoff, 30m by default.
+	BackoffMaxInterval TomlDuration `toml:"backoff-max-interval" json:"backoff-max-interval"`
+	// MaxOutage is how long the changefeed is resumed since it's failed
+	// before giving up, 12h by default. The checkpoint of the changefeed is
+	// protected from GC within this window.
+	MaxOutage TomlDuration `toml:"max-outage" json:"max-outage"`
+	// RetryableErrors are the RFC codes of errors which can be resumed from,
+	// e.g. "CDC:ErrPulsarSendMessage", in addition to the built-in retryable
+	// downstream errors.
+	RetryableErrors []string `toml:"retryable-errors" json:"retryable-errors,omitempty"`
+}
+
+// ValidateAndAdjust validates the auto resume config of a changefeed.
+func (c *AutoResumeConfig) ValidateAndAdjust() error {
+	if c.BackoffInitInterval < 0 || c.BackoffMaxInterval < 0 || c.MaxOutage < 0 {
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"durations of auto-resume should not be negative")
+	}
+	if c.BackoffInitInterval != 0 && c.BackoffMaxInterval != 0 &&
+		c.BackoffMaxInterval < c.BackoffInitInterval {
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"auto-resume.backoff-max-interval should not be less than auto-resume.backoff-init-interval")
+	}
+	for i, code := range c.RetryableErrors {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"auto-resume.retryable-errors should not contain empty error codes")
+		}
+		c.RetryableErrors[i] = code
+	}
+	return nil
+}
@@ -64,12 +64,15 @@ const (
   },
   "scheduler": {
     "enable-table-across-nodes": false,
-    "region-threshold": 100000
+    "region-threshold": 100000,
+    "balance-strategy": "table-count"
   },
   "integrity": {
     "integrity-check-level": "none",
     "corruption-handle-level": "warn"
- }
+ },
+  "failed-changefeed-retention": 0,
+  "gc-ttl": 0
 }`
 
 	testCfgTestServerConfigMarshal = `{
@@ -110,7 +113,9 @@ const (
     "worker-concurrent": 8,
     "worker-pool-size": 0,
     "region-scan-limit": 40,
-    "region-retry-duration": 60000000000
+    "region-retry-duration": 60000000000,
+    "region-backoff-base-delay": 100000000,
+    "region-backoff-max-delay": 10000000000
   },
   "debug": {
     "db": {
@@ -132,6 +137,7 @@ const (
       "client-max-batch-size": 8388608,
       "client-max-batch-count": 128,
       "client-retry-rate-limit": 1,
+      "client-compression": "none",
       "server-max-pending-message-count": 102400,
       "server-ack-interval": 100000000,
       "server-worker-pool-size": 4,
@@ -144,11 +150,19 @@ const (
       "collect-stats-tick": 200,
       "max-task-concurrency": 10,
       "check-balance-interval": 60000000000,
-      "add-table-batch-size": 50
+      "add-table-batch-size": 50,
+      "affinity": {
+        "zone": "",
+        "rack": ""
+      },
+      "max-heartbeat-response-bytes": 0
     }
   },
   "cluster-id": "default",
-  "max-memory-percentage": 70
+  "max-memory-percentage": 70,
+  "memory-quota": 0,
+  "max-metric-series": 0,
+  "drain-timeout": 30000000000
 }`
 
 	testCfgTestReplicaConfigMarshal1 = `{
@@ -271,12 +285,15 @@ const (
     "region-per-span": 0,
     "region-threshold": 100001,
     "write-key-threshold": 100001,
-    "region-per-span": 0
+    "region-per-span": 0,
+    "balance-strategy": "table-count"
   },
   "integrity": {
     "integrity-check-level": "none",
     "corruption-handle-level": "warn"
-  }
+  },
+  "failed-changefeed-retention": 0,
+  "gc-ttl": 0
 }`
 
 	testCfgTestReplicaConfigMarshal2 = `{
@@ -394,11 +411,14 @@ const (
   "scheduler": {
     "enable-table-across-nodes": true,
     "region-threshold": 100001,
-    "write-key-threshold": 100001
+    "write-key-threshold": 100001,
+    "balance-strategy": "table-count"
   },
   "integrity": {
     "integrity-check-level": "none",
     "corruption-handle-level": "warn"
-  }
+  },
+  "failed-changefeed-retention": 0,
+  "gc-ttl": 0
 }`
 )
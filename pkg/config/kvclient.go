@@ -25,6 +25,11 @@ type KVClientConfig struct {
 	RegionScanLimit int `toml:"region-scan-limit" json:"region-scan-limit"`
 	// the total retry duration of connecting a region
 	RegionRetryDuration TomlDuration `toml:"region-retry-duration" json:"region-retry-duration"`
+	// the base and max delay of the backoff before reconnecting a region which
+	// meets an error that TiKV may be too busy to serve it, the backoff grows
+	// exponentially with jitter until the region is initialized successfully
+	RegionBackoffBaseDelay TomlDuration `toml:"region-backoff-base-delay" json:"region-backoff-base-delay"`
+	RegionBackoffMaxDelay  TomlDuration `toml:"region-backoff-max-delay" json:"region-backoff-max-delay"`
 }
 
 // ValidateAndAdjust validates and adjusts the kv client configuration
@@ -41,5 +46,62 @@ func (c *KVClientConfig) ValidateAndAdjust() error {
 		return errors.ErrInvalidServerOption.GenWithStackByArgs(
 			"region-scan-limit should be positive")
 	}
+	if c.RegionBackoffBaseDelay < 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"region-backoff-base-delay should not be negative")
+	}
+	if c.RegionBackoffMaxDelay < c.RegionBackoffBaseDelay {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"region-backoff-max-delay should not be less than region-backoff-base-delay")
+	}
+	return nil
+}
+
+// KVClientReplicaConfig overrides the kv client config of the server for a
+// changefeed, nil fields are inherited from the server config.
+type KVClientReplicaConfig struct {
+	RegionScanLimit        *int          `toml:"region-scan-limit" json:"region-scan-limit,omitempty"`
+	RegionBackoffBaseDelay *TomlDuration `toml:"region-backoff-base-delay" json:"region-backoff-base-delay,omitempty"`
+	RegionBackoffMaxDelay  *TomlDuration `toml:"region-backoff-max-delay" json:"region-backoff-max-delay,omitempty"`
+}
+
+// WithReplicaConfig returns a copy of the kv client config overridden by
+// the kv client config of a changefeed.
+func (c *KVClientConfig) WithReplicaConfig(o *KVClientReplicaConfig) *KVClientConfig {
+	res := *c
+	if o == nil {
+		return &res
+	}
+	if o.RegionScanLimit != nil {
+		res.RegionScanLimit = *o.RegionScanLimit
+	}
+	if o.RegionBackoffBaseDelay != nil {
+		res.RegionBackoffBaseDelay = *o.RegionBackoffBaseDelay
+	}
+	if o.RegionBackoffMaxDelay != nil {
+		res.RegionBackoffMaxDelay = *o.RegionBackoffMaxDelay
+	}
+	if res.RegionBackoffMaxDelay < res.RegionBackoffBaseDelay {
+		res.RegionBackoffMaxDelay = res.RegionBackoffBaseDelay
+	}
+	return &res
+}
+
+// ValidateAndAdjust validates the kv client config of a changefeed.
+func (c *KVClientReplicaConfig) ValidateAndAdjust() error {
+	if c.RegionScanLimit != nil && *c.RegionScanLimit <= 0 {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"kv-client.region-scan-limit should be at least 1")
+	}
+	if c.RegionBackoffBaseDelay != nil && *c.RegionBackoffBaseDelay < 0 {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"kv-client.region-backoff-base-delay should not be negative")
+	}
+	if c.RegionBackoffBaseDelay != nil && c.RegionBackoffMaxDelay != nil &&
+		*c.RegionBackoffMaxDelay < *c.RegionBackoffBaseDelay {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"kv-client.region-backoff-max-delay should not be less than " +
+				"kv-client.region-backoff-base-delay")
+	}
 	return nil
 }
@@ -0,0 +1,101 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+const (
+	// LargeMessageHandleOptionNone means the changefeed fails if a message
+	// exceeds the max-message-bytes.
+	LargeMessageHandleOptionNone = "none"
+	// LargeMessageHandleOptionHandleKeyOnly means only the handle key columns
+	// of the row are sent if a message exceeds the max-message-bytes.
+	LargeMessageHandleOptionHandleKeyOnly = "handle-key-only"
+	// LargeMessageHandleOptionClaimCheck means the message is stored in the
+	// external storage, and a message which only contains the handle key
+	// columns and the location of the stored message is sent instead.
+	LargeMessageHandleOptionClaimCheck = "claim-check"
+)
+
+// LargeMessageHandleConfig is the config of how to handle the messages
+// which exceed the max-message-bytes of the MQ sinks.
+type LargeMessageHandleConfig struct {
+	LargeMessageHandleOption string `toml:"large-message-handle-option" json:"large-message-handle-option"`
+	ClaimCheckStorageURI     string `toml:"claim-check-storage-uri" json:"claim-check-storage-uri"`
+}
+
+// NewDefaultLargeMessageHandleConfig returns the default LargeMessageHandleConfig.
+func NewDefaultLargeMessageHandleConfig() *LargeMessageHandleConfig {
+	return &LargeMessageHandleConfig{
+		LargeMessageHandleOption: LargeMessageHandleOptionNone,
+	}
+}
+
+// AdjustAndValidate adjusts and validates the config.
+func (c *LargeMessageHandleConfig) AdjustAndValidate() error {
+	if c.LargeMessageHandleOption == "" {
+		c.LargeMessageHandleOption = LargeMessageHandleOptionNone
+	}
+
+	switch c.LargeMessageHandleOption {
+	case LargeMessageHandleOptionNone, LargeMessageHandleOptionHandleKeyOnly:
+		return nil
+	case LargeMessageHandleOptionClaimCheck:
+	default:
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"large-message-handle-option should be one of none, handle-key-only and claim-check")
+	}
+
+	if c.ClaimCheckStorageURI == "" {
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"claim-check-storage-uri should be set if large-message-handle-option is claim-check")
+	}
+	uri, err := url.Parse(c.ClaimCheckStorageURI)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrInvalidReplicaConfig, err)
+	}
+	if !sink.IsStorageScheme(uri.Scheme) {
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"the scheme of claim-check-storage-uri is not a storage scheme: " + uri.Scheme)
+	}
+	return nil
+}
+
+// HandleKeyOnly returns true if only the handle key columns are sent for
+// the large messages.
+func (c *LargeMessageHandleConfig) HandleKeyOnly() bool {
+	if c == nil {
+		return false
+	}
+	return c.LargeMessageHandleOption == LargeMessageHandleOptionHandleKeyOnly
+}
+
+// EnableClaimCheck returns true if the large messages are stored in the
+// external storage.
+func (c *LargeMessageHandleConfig) EnableClaimCheck() bool {
+	if c == nil {
+		return false
+	}
+	return c.LargeMessageHandleOption == LargeMessageHandleOptionClaimCheck
+}
+
+// Disabled returns true if the large messages are not handled.
+func (c *LargeMessageHandleConfig) Disabled() bool {
+	return !c.HandleKeyOnly() && !c.EnableClaimCheck()
+}
@@ -28,6 +28,10 @@ type MessagesConfig struct {
 	ClientMaxBatchSize     int          `toml:"client-max-batch-size" json:"client-max-batch-size"`
 	ClientMaxBatchCount    int          `toml:"client-max-batch-count" json:"client-max-batch-count"`
 	ClientRetryRateLimit   float64      `toml:"client-retry-rate-limit" json:"client-retry-rate-limit"`
+	// ClientCompression is the compression of messages sent by the client,
+	// it is either "none" or "snappy". Note that servers older than the
+	// client can not decompress snappy messages.
+	ClientCompression string `toml:"client-compression" json:"client-compression"`
 
 	ServerMaxPendingMessageCount int          `toml:"server-max-pending-message-count" json:"server-max-pending-message-count"`
 	ServerAckInterval            TomlDuration `toml:"server-ack-interval" json:"server-ack-interval"`
@@ -52,6 +56,7 @@ var defaultMessageConfig = &MessagesConfig{
 	ClientMaxBatchSize:           8 * 1024 * 1024, // 8MB
 	ClientMaxBatchCount:          128,
 	ClientRetryRateLimit:         1.0, // Once per second
+	ClientCompression:            p2p.CompressionNone,
 	ServerMaxPendingMessageCount: 102400,
 	ServerAckInterval:            TomlDuration(time.Millisecond * 100),
 	ServerWorkerPoolSize:         4,
@@ -68,6 +73,10 @@ const (
 	// unsent messages.
 	clientSendChannelSize = 128
 
+	// clientTopicSendQuota is the max number of unsent messages of a topic, it prevents a
+	// flood of messages of a topic from occupying the whole send channel of a client.
+	clientTopicSendQuota = clientSendChannelSize / 2
+
 	// clientDialTimeout represents the timeout given to gRPC to dial. 5 seconds seems reasonable
 	// because it is unlikely that the latency between TiCDC nodes is larger than 5 seconds.
 	clientDialTimeout = time.Second * 5
@@ -118,6 +127,15 @@ func (c *MessagesConfig) ValidateAndAdjust() error {
 		c.ClientRetryRateLimit = defaultMessageConfig.ClientRetryRateLimit
 	}
 
+	switch c.ClientCompression {
+	case "":
+		c.ClientCompression = defaultMessageConfig.ClientCompression
+	case p2p.CompressionNone, p2p.CompressionSnappy:
+	default:
+		return cerrors.ErrInvalidServerOption.GenWithStackByArgs(
+			"client-compression must be either none or snappy")
+	}
+
 	if c.ServerMaxPendingMessageCount <= 0 {
 		c.ServerMaxPendingMessageCount = defaultMessageConfig.ServerMaxPendingMessageCount
 	}
@@ -161,6 +179,7 @@ func (c *MessagesConfig) Clone() *MessagesConfig {
 		ClientMaxBatchSize:           c.ClientMaxBatchSize,
 		ClientMaxBatchCount:          c.ClientMaxBatchCount,
 		ClientRetryRateLimit:         c.ClientRetryRateLimit,
+		ClientCompression:            c.ClientCompression,
 		ServerMaxPendingMessageCount: c.ServerMaxPendingMessageCount,
 		ServerAckInterval:            c.ServerAckInterval,
 		ServerWorkerPoolSize:         c.ServerWorkerPoolSize,
@@ -174,12 +193,14 @@ func (c *MessagesConfig) Clone() *MessagesConfig {
 func (c *MessagesConfig) ToMessageClientConfig() *p2p.MessageClientConfig {
 	return &p2p.MessageClientConfig{
 		SendChannelSize:         clientSendChannelSize,
+		TopicSendQuota:          clientTopicSendQuota,
 		BatchSendInterval:       time.Duration(c.ClientMaxBatchInterval),
 		MaxBatchBytes:           c.ClientMaxBatchSize,
 		MaxBatchCount:           c.ClientMaxBatchCount,
 		RetryRateLimitPerSecond: c.ClientRetryRateLimit,
 		DialTimeout:             clientDialTimeout,
 		MaxRecvMsgSize:          c.MaxRecvMsgSize,
+		Compression:             c.ClientCompression,
 	}
 }
 
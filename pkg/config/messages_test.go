@@ -39,6 +39,8 @@ func TestDefaultMessageServerConfig(t *testing.T) {
 func TestDefaultMessageClientConfig(t *testing.T) {
 	clientConfig := defaultMessageConfig.ToMessageClientConfig()
 	require.Greater(t, clientConfig.SendChannelSize, 0)
+	require.Greater(t, clientConfig.TopicSendQuota, 0)
+	require.LessOrEqual(t, clientConfig.TopicSendQuota, clientConfig.SendChannelSize)
 	require.Greater(t, clientConfig.BatchSendInterval, time.Duration(0))
 	require.Greater(t, clientConfig.MaxBatchBytes, 0)
 	require.Greater(t, clientConfig.MaxBatchCount, 0)
@@ -80,4 +82,15 @@ func TestMessagesConfigValidateAndAdjust(t *testing.T) {
 	illegalConfig.MaxRecvMsgSize = -1
 	err = illegalConfig.ValidateAndAdjust()
 	require.Error(t, err)
+
+	illegalConfig = defaultMessageConfig.Clone()
+	illegalConfig.ClientCompression = "gzip"
+	err = illegalConfig.ValidateAndAdjust()
+	require.Error(t, err)
+	require.Regexp(t, ".*ErrInvalidServerOption.*", err.Error())
+
+	snappyConfig := defaultMessageConfig.Clone()
+	snappyConfig.ClientCompression = "snappy"
+	require.NoError(t, snappyConfig.ValidateAndAdjust())
+	require.Equal(t, "snappy", snappyConfig.ToMessageClientConfig().Compression)
 }
@@ -0,0 +1,25 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// NamespaceQuota is the quota of the changefeeds in a namespace,
+// it's enforced by the owner when a changefeed is created or updated.
+type NamespaceQuota struct {
+	// MaxChangefeedCount is the max number of changefeeds in the namespace,
+	// 0 means unlimited.
+	MaxChangefeedCount int `toml:"max-changefeed-count" json:"max-changefeed-count"`
+	// MaxMemoryQuota is the max sum of the memory quota of the changefeeds
+	// in the namespace, 0 means unlimited.
+	MaxMemoryQuota uint64 `toml:"max-memory-quota" json:"max-memory-quota"`
+}
@@ -75,6 +75,7 @@ var defaultReplicaConfig = &ReplicaConfig{
 		EnableTableAcrossNodes: false,
 		RegionThreshold:        100_000,
 		WriteKeyThreshold:      0,
+		BalanceStrategy:        BalanceStrategyTableCount,
 	},
 	Integrity: &integrity.Config{
 		IntegrityCheckLevel:   integrity.CheckLevelNone,
@@ -122,6 +123,22 @@ type replicaConfig struct {
 	// Scheduler is the configuration for scheduler.
 	Scheduler *ChangefeedSchedulerConfig `toml:"scheduler" json:"scheduler"`
 	Integrity *integrity.Config          `toml:"integrity" json:"integrity"`
+	// FailedChangefeedRetention is how long a failed changefeed keeps
+	// blocking GC, 0 means the default of 24 hours.
+	FailedChangefeedRetention time.Duration `toml:"failed-changefeed-retention" json:"failed-changefeed-retention"`
+	// GCTTL is the TTL in seconds of the service GC safepoint required by
+	// the changefeed, the largest one among changefeeds and the server
+	// gc-ttl is used, 0 means the server gc-ttl.
+	GCTTL int64 `toml:"gc-ttl" json:"gc-ttl"`
+	// KVClient overrides the kv client config of the server for the
+	// changefeed.
+	KVClient *KVClientReplicaConfig `toml:"kv-client" json:"kv-client,omitempty"`
+	// Retry is the policy of restarting the changefeed after it meets
+	// retryable errors.
+	Retry *ChangefeedRetryConfig `toml:"retry" json:"retry,omitempty"`
+	// AutoResume is the policy of resuming the changefeed automatically
+	// after it's failed by retryable downstream errors.
+	AutoResume *AutoResumeConfig `toml:"auto-resume" json:"auto-resume,omitempty"`
 }
 
 // Marshal returns the json marshal format of a ReplicationConfig
@@ -212,12 +229,26 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 						minSyncPointRetention.String()))
 		}
 	}
+	if c.FailedChangefeedRetention < 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(
+				fmt.Sprintf("The FailedChangefeedRetention:%s must not be negative",
+					c.FailedChangefeedRetention.String()))
+	}
+	if c.GCTTL < 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(
+				fmt.Sprintf("The GCTTL:%d must not be negative", c.GCTTL))
+	}
 	if c.MemoryQuota == uint64(0) {
 		c.FixMemoryQuota()
 	}
 	if c.Scheduler == nil {
 		c.FixScheduler(false)
 	}
+	if err := c.Scheduler.ValidateAndAdjust(); err != nil {
+		return err
+	}
 	// TODO: Remove the hack once span replication is compatible with all sinks.
 	if !isSinkCompatibleWithSpanReplication(sinkURI) {
 		c.Scheduler.EnableTableAcrossNodes = false
@@ -238,6 +269,23 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 		}
 	}
 
+	if c.KVClient != nil {
+		if err := c.KVClient.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+
+	if c.Retry != nil {
+		if err := c.Retry.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	if c.AutoResume != nil {
+		if err := c.AutoResume.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -213,6 +213,25 @@ func TestReplicaConfigValidate(t *testing.T) {
 	err = conf.ValidateAndAdjust(sinkURL)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1024), conf.MemoryQuota)
+
+	// Test gc ttl must not be negative.
+	conf = GetDefaultReplicaConfig()
+	conf.GCTTL = -1
+	require.Regexp(t, ".*GCTTL:-1 must not be negative.*",
+		conf.ValidateAndAdjust(sinkURL))
+	conf.GCTTL = 48 * 60 * 60
+	require.NoError(t, conf.ValidateAndAdjust(sinkURL))
+
+	// Test balance strategy.
+	conf = GetDefaultReplicaConfig()
+	conf.Scheduler.BalanceStrategy = "unknown"
+	require.Regexp(t, ".*balance-strategy must be one of.*",
+		conf.ValidateAndAdjust(sinkURL))
+	conf.Scheduler.BalanceStrategy = ""
+	require.NoError(t, conf.ValidateAndAdjust(sinkURL))
+	require.Equal(t, BalanceStrategyTableCount, conf.Scheduler.BalanceStrategy)
+	conf.Scheduler.BalanceStrategy = BalanceStrategyWorkload
+	require.NoError(t, conf.ValidateAndAdjust(sinkURL))
 }
 
 func TestValidateAndAdjust(t *testing.T) {
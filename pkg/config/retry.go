@@ -0,0 +1,62 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ChangefeedRetryConfig is the policy of restarting a changefeed after it
+// meets retryable errors. The changefeed is restarted with an exponential
+// backoff, zero values mean the defaults of the owner.
+type ChangefeedRetryConfig struct {
+	// MaxRetries is the max times of restarting the changefeed before it's
+	// failed, 0 means unlimited.
+	MaxRetries uint64 `toml:"max-retries" json:"max-retries"`
+	// BackoffInitInterval is the initial interval of the backoff, 10s by default.
+	BackoffInitInterval TomlDuration `toml:"backoff-init-interval" json:"backoff-init-interval"`
+	// BackoffMaxInterval caps the interval of the backoff, 30m by default.
+	BackoffMaxInterval TomlDuration `toml:"backoff-max-interval" json:"backoff-max-interval"`
+	// BackoffMaxElapsedTime is how long the changefeed keeps retrying before
+	// it's failed, 90m by default.
+	BackoffMaxElapsedTime TomlDuration `toml:"backoff-max-elapsed-time" json:"backoff-max-elapsed-time"`
+	// FastFailErrors are the RFC codes of errors which fail the changefeed
+	// without retrying, e.g. "CDC:ErrMySQLConnectionError", in addition to
+	// the built-in fast fail errors.
+	FastFailErrors []string `toml:"fast-fail-errors" json:"fast-fail-errors,omitempty"`
+}
+
+// ValidateAndAdjust validates the retry config of a changefeed.
+func (c *ChangefeedRetryConfig) ValidateAndAdjust() error {
+	if c.BackoffInitInterval < 0 || c.BackoffMaxInterval < 0 || c.BackoffMaxElapsedTime < 0 {
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"durations of retry should not be negative")
+	}
+	if c.BackoffInitInterval != 0 && c.BackoffMaxInterval != 0 &&
+		c.BackoffMaxInterval < c.BackoffInitInterval {
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"retry.backoff-max-interval should not be less than retry.backoff-init-interval")
+	}
+	for i, code := range c.FastFailErrors {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"retry.fast-fail-errors should not contain empty error codes")
+		}
+		c.FastFailErrors[i] = code
+	}
+	return nil
+}
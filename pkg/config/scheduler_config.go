@@ -14,6 +14,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -30,6 +31,39 @@ type ChangefeedSchedulerConfig struct {
 	WriteKeyThreshold int `toml:"write-key-threshold" json:"write-key-threshold"`
 	// Deprecated.
 	RegionPerSpan int `toml:"region-per-span" json:"region-per-span"`
+	// BalanceStrategy is how tables are balanced among captures, it is either
+	// BalanceStrategyTableCount or BalanceStrategyWorkload.
+	BalanceStrategy string `toml:"balance-strategy" json:"balance-strategy"`
+}
+
+const (
+	// BalanceStrategyTableCount balances the number of tables among captures.
+	BalanceStrategyTableCount = "table-count"
+	// BalanceStrategyWorkload balances the workload of tables among captures,
+	// the workload of a table is the number of its captured regions.
+	BalanceStrategyWorkload = "workload"
+)
+
+// ValidateAndAdjust verifies and adjusts the changefeed scheduler settings.
+func (c *ChangefeedSchedulerConfig) ValidateAndAdjust() error {
+	switch c.BalanceStrategy {
+	case "":
+		c.BalanceStrategy = BalanceStrategyTableCount
+	case BalanceStrategyTableCount, BalanceStrategyWorkload:
+	default:
+		return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			fmt.Sprintf("balance-strategy must be one of %s and %s, got %s",
+				BalanceStrategyTableCount, BalanceStrategyWorkload, c.BalanceStrategy))
+	}
+	return nil
+}
+
+// AffinityConfig is the placement metadata of a capture.
+type AffinityConfig struct {
+	// Zone is the availability zone of the capture.
+	Zone string `toml:"zone" json:"zone"`
+	// Rack is the rack of the capture.
+	Rack string `toml:"rack" json:"rack"`
 }
 
 // SchedulerConfig configs TiCDC scheduler.
@@ -50,6 +84,13 @@ type SchedulerConfig struct {
 	// When there are only 2 captures, and a large number of tables, this can be helpful to prevent
 	// oom caused by all tables dispatched to only one capture.
 	AddTableBatchSize int `toml:"add-table-batch-size" json:"add-table-batch-size"`
+	// Affinity is the placement of the capture, it's reported to the owner
+	// so that the owner can make locality-aware scheduling decisions.
+	Affinity AffinityConfig `toml:"affinity" json:"affinity"`
+	// MaxHeartbeatResponseBytes caps the size of a heartbeat response, the
+	// rest of table statuses are reported in following heartbeats.
+	// 0 means no limit.
+	MaxHeartbeatResponseBytes int `toml:"max-heartbeat-response-bytes" json:"max-heartbeat-response-bytes"`
 
 	// ChangefeedSettings is setting by changefeed.
 	ChangefeedSettings *ChangefeedSchedulerConfig `toml:"-" json:"-"`
@@ -91,6 +132,10 @@ func (c *SchedulerConfig) ValidateAndAdjust() error {
 		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
 			"add-table-batch-size must be large than 0")
 	}
+	if c.MaxHeartbeatResponseBytes < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"max-heartbeat-response-bytes must not be negative")
+	}
 
 	return nil
 }
@@ -116,7 +116,9 @@ var defaultServerConfig = &ServerConfig{
 		RegionScanLimit:  40,
 		// The default TiKV region election timeout is [10s, 20s],
 		// Use 1 minute to cover region leader missing.
-		RegionRetryDuration: TomlDuration(time.Minute),
+		RegionRetryDuration:    TomlDuration(time.Minute),
+		RegionBackoffBaseDelay: TomlDuration(100 * time.Millisecond),
+		RegionBackoffMaxDelay:  TomlDuration(10 * time.Second),
 	},
 	Debug: &DebugConfig{
 		DB: &DBConfig{
@@ -141,6 +143,8 @@ var defaultServerConfig = &ServerConfig{
 	},
 	ClusterID:           "default",
 	MaxMemoryPercentage: DefaultMaxMemoryPercentage,
+	// It's the same as the default termination grace period of kubernetes.
+	DrainTimeout: TomlDuration(30 * time.Second),
 }
 
 // ServerConfig represents a config for server
@@ -171,6 +175,19 @@ type ServerConfig struct {
 	Debug               *DebugConfig    `toml:"debug" json:"debug"`
 	ClusterID           string          `toml:"cluster-id" json:"cluster-id"`
 	MaxMemoryPercentage int             `toml:"max-memory-percentage" json:"max-memory-percentage"`
+	// MemoryQuota is the memory quota shared by all changefeeds on the
+	// capture, 0 means unlimited.
+	MemoryQuota uint64 `toml:"memory-quota" json:"memory-quota"`
+	// MaxMetricSeries is the max number of series of a metric, the exceeded
+	// series are dropped when the metrics are collected, 0 means unlimited.
+	MaxMetricSeries int `toml:"max-metric-series" json:"max-metric-series"`
+	// DrainTimeout is the max duration to wait for the tables to be moved
+	// away from the capture before it exits on a shutdown signal, 0 means
+	// the capture exits without waiting.
+	DrainTimeout TomlDuration `toml:"drain-timeout" json:"drain-timeout"`
+	// NamespaceQuotas are the quotas of the namespaces, the key is the name
+	// of a namespace. The namespaces without a quota are unlimited.
+	NamespaceQuotas map[string]*NamespaceQuota `toml:"namespace-quotas" json:"namespace-quotas,omitempty"`
 }
 
 // Marshal returns the json marshal format of a ServerConfig
@@ -285,6 +302,18 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 		log.Warn("server max-memory-percentage must be less than 100, set to default value")
 		c.MaxMemoryPercentage = DefaultMaxMemoryPercentage
 	}
+	if c.MaxMetricSeries < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStack("max-metric-series must not be negative")
+	}
+	if c.DrainTimeout < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStack("drain-timeout must not be negative")
+	}
+	for namespace, quota := range c.NamespaceQuotas {
+		if quota != nil && quota.MaxChangefeedCount < 0 {
+			return cerror.ErrInvalidServerOption.GenWithStack(
+				"max-changefeed-count of namespace %s must not be negative", namespace)
+		}
+	}
 
 	return nil
 }
@@ -75,6 +75,19 @@ func TestServerConfigValidateAndAdjust(t *testing.T) {
 	conf.Debug.Messages.ServerWorkerPoolSize = 0
 	require.Nil(t, conf.ValidateAndAdjust())
 	require.EqualValues(t, GetDefaultServerConfig().Debug.Messages.ServerWorkerPoolSize, conf.Debug.Messages.ServerWorkerPoolSize)
+	conf.MaxMetricSeries = -1
+	require.Regexp(t, ".*max-metric-series must not be negative", conf.ValidateAndAdjust())
+	conf.MaxMetricSeries = 0
+	conf.DrainTimeout = -1
+	require.Regexp(t, ".*drain-timeout must not be negative", conf.ValidateAndAdjust())
+	conf.DrainTimeout = 0
+	conf.NamespaceQuotas = map[string]*NamespaceQuota{"test": {MaxChangefeedCount: -1}}
+	require.Regexp(t, ".*max-changefeed-count of namespace test must not be negative",
+		conf.ValidateAndAdjust())
+	conf.NamespaceQuotas["test"].MaxChangefeedCount = 1
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.MaxMetricSeries = 10000
+	require.Nil(t, conf.ValidateAndAdjust())
 }
 
 func TestDBConfigValidateAndAdjust(t *testing.T) {
@@ -99,6 +112,46 @@ func TestKVClientConfigValidateAndAdjust(t *testing.T) {
 	require.Nil(t, conf.ValidateAndAdjust())
 	conf.RegionRetryDuration = -TomlDuration(time.Second)
 	require.Error(t, conf.ValidateAndAdjust())
+
+	conf = GetDefaultServerConfig().Clone().KVClient
+	conf.RegionBackoffBaseDelay = -TomlDuration(time.Second)
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.RegionBackoffBaseDelay = TomlDuration(time.Minute)
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.RegionBackoffMaxDelay = TomlDuration(time.Minute)
+	require.Nil(t, conf.ValidateAndAdjust())
+}
+
+func TestKVClientConfigWithReplicaConfig(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().KVClient
+
+	require.Equal(t, conf, conf.WithReplicaConfig(nil))
+	require.NotSame(t, conf, conf.WithReplicaConfig(nil))
+
+	scanLimit := 10
+	baseDelay := TomlDuration(time.Minute)
+	overridden := conf.WithReplicaConfig(&KVClientReplicaConfig{
+		RegionScanLimit:        &scanLimit,
+		RegionBackoffBaseDelay: &baseDelay,
+	})
+	require.Equal(t, 10, overridden.RegionScanLimit)
+	require.Equal(t, baseDelay, overridden.RegionBackoffBaseDelay)
+	// The max delay is adjusted to be not less than the base delay.
+	require.Equal(t, baseDelay, overridden.RegionBackoffMaxDelay)
+	require.Equal(t, conf.WorkerConcurrent, overridden.WorkerConcurrent)
+	require.Equal(t, 40, conf.RegionScanLimit)
+
+	replicaConf := &KVClientReplicaConfig{}
+	require.Nil(t, replicaConf.ValidateAndAdjust())
+	scanLimit = 0
+	replicaConf.RegionScanLimit = &scanLimit
+	require.Error(t, replicaConf.ValidateAndAdjust())
+	scanLimit = 1
+	maxDelay := TomlDuration(time.Second)
+	replicaConf.RegionBackoffBaseDelay = &baseDelay
+	replicaConf.RegionBackoffMaxDelay = &maxDelay
+	require.Error(t, replicaConf.ValidateAndAdjust())
 }
 
 func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
@@ -133,6 +186,12 @@ func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
 	conf = GetDefaultServerConfig().Clone().Debug.Scheduler
 	conf.AddTableBatchSize = 0
 	require.Error(t, conf.ValidateAndAdjust())
+
+	conf = GetDefaultServerConfig().Clone().Debug.Scheduler
+	conf.MaxHeartbeatResponseBytes = -1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MaxHeartbeatResponseBytes = 0
+	require.NoError(t, conf.ValidateAndAdjust())
 }
 
 func TestIsValidClusterID(t *testing.T) {
@@ -98,6 +98,7 @@ var ForceEnableOldValueProtocols = []string{
 	ProtocolCanal.String(),
 	ProtocolCanalJSON.String(),
 	ProtocolMaxwell.String(),
+	ProtocolDebezium.String(),
 }
 
 // SinkConfig represents sink config for a changefeed
@@ -125,6 +126,15 @@ type SinkConfig struct {
 	// Note: This field is only used internally and only used in the MySQL sink.
 	TiDBSourceID uint64 `toml:"-" json:"-"`
 
+	// MaxRowsPerSecond and MaxBytesPerSecond throttle the rows emitted to the
+	// downstream by the changefeed, 0 or nil means unlimited.
+	MaxRowsPerSecond  *uint64 `toml:"max-rows-per-second" json:"max-rows-per-second,omitempty"`
+	MaxBytesPerSecond *uint64 `toml:"max-bytes-per-second" json:"max-bytes-per-second,omitempty"`
+
+	// EnableTableMetrics enables the sink metrics labeled by table, which may
+	// produce a large number of series if there are many tables.
+	EnableTableMetrics *bool `toml:"enable-table-metrics" json:"enable-table-metrics,omitempty"`
+
 	SafeMode           *bool               `toml:"safe-mode" json:"safe-mode,omitempty"`
 	KafkaConfig        *KafkaConfig        `toml:"kafka-config" json:"kafka-config,omitempty"`
 	MySQLConfig        *MySQLConfig        `toml:"mysql-config" json:"mysql-config,omitempty"`
@@ -247,6 +257,8 @@ type CodecConfig struct {
 	AvroEnableWatermark            *bool   `toml:"avro-enable-watermark" json:"avro-enable-watermark"`
 	AvroDecimalHandlingMode        *string `toml:"avro-decimal-handling-mode" json:"avro-decimal-handling-mode,omitempty"`
 	AvroBigintUnsignedHandlingMode *string `toml:"avro-bigint-unsigned-handling-mode" json:"avro-bigint-unsigned-handling-mode,omitempty"`
+	AvroSubjectNameStrategy        *string `toml:"avro-subject-name-strategy" json:"avro-subject-name-strategy,omitempty"`
+	AvroSchemaCompatibility        *string `toml:"avro-schema-compatibility" json:"avro-schema-compatibility,omitempty"`
 }
 
 // KafkaConfig represents a kafka sink configuration
@@ -279,6 +291,8 @@ type KafkaConfig struct {
 	Key                          *string      `toml:"key" json:"key,omitempty"`
 	InsecureSkipVerify           *bool        `toml:"insecure-skip-verify" json:"insecure-skip-verify,omitempty"`
 	CodecConfig                  *CodecConfig `toml:"codec-config" json:"codec-config,omitempty"`
+
+	LargeMessageHandle *LargeMessageHandleConfig `toml:"large-message-handle" json:"large-message-handle,omitempty"`
 }
 
 // MySQLConfig represents a MySQL sink configuration
@@ -298,6 +312,11 @@ type MySQLConfig struct {
 	EnableBatchDML               *bool   `toml:"enable-batch-dml" json:"enable-batch-dml,omitempty"`
 	EnableMultiStatement         *bool   `toml:"enable-multi-statement" json:"enable-multi-statement,omitempty"`
 	EnableCachePreparedStatement *bool   `toml:"enable-cache-prepared-statement" json:"enable-cache-prepared-statement,omitempty"`
+	EnableDMLCompaction          *bool   `toml:"enable-dml-compaction" json:"enable-dml-compaction,omitempty"`
+	EnableAsyncDDL               *bool   `toml:"enable-async-ddl" json:"enable-async-ddl,omitempty"`
+	MaxCachedPreparedStatements  *int    `toml:"max-cached-prepared-statements" json:"max-cached-prepared-statements,omitempty"`
+	ConnPoolSize                 *int    `toml:"conn-pool-size" json:"conn-pool-size,omitempty"`
+	TransactionIsolation         *string `toml:"transaction-isolation" json:"transaction-isolation,omitempty"`
 }
 
 // CloudStorageConfig represents a cloud storage sink configuration
@@ -307,6 +326,11 @@ type CloudStorageConfig struct {
 	FileSize      *int    `toml:"file-size" json:"file-size,omitempty"`
 }
 
+// TableMetricsEnabled returns true if the sink metrics labeled by table are enabled.
+func (s *SinkConfig) TableMetricsEnabled() bool {
+	return s != nil && s.EnableTableMetrics != nil && *s.EnableTableMetrics
+}
+
 func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) error {
 	if err := s.validateAndAdjustSinkURI(sinkURI); err != nil {
 		return err
@@ -338,6 +362,19 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 		}
 	}
 
+	// Column selectors are applied by the MQ sinks only, the other sinks
+	// require all columns of a row.
+	if len(s.ColumnSelectors) != 0 && sinkURI != nil && !sink.IsMQScheme(sinkURI.Scheme) {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"column-selectors is only supported by MQ sinks, but got %s", sinkURI.Scheme)
+	}
+
+	if s.KafkaConfig != nil && s.KafkaConfig.LargeMessageHandle != nil {
+		if err := s.KafkaConfig.LargeMessageHandle.AdjustAndValidate(); err != nil {
+			return err
+		}
+	}
+
 	if s.EncoderConcurrency < 0 {
 		return cerror.ErrSinkInvalidConfig.GenWithStack(
 			"encoder-concurrency should greater than 0, but got %d", s.EncoderConcurrency)
@@ -428,3 +428,54 @@ func TestValidateAndAdjustStorageConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 16, s.Sink.FileIndexWidth)
 }
+
+func TestValidateColumnSelectors(t *testing.T) {
+	t.Parallel()
+
+	s := GetDefaultReplicaConfig()
+	s.Sink.ColumnSelectors = []*ColumnSelector{
+		{Matcher: []string{"test.*"}, Columns: []string{"id", "name"}},
+	}
+
+	sinkURI, err := url.Parse("kafka://127.0.0.1:9092?protocol=canal-json")
+	require.NoError(t, err)
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+
+	s = GetDefaultReplicaConfig()
+	s.Sink.ColumnSelectors = []*ColumnSelector{
+		{Matcher: []string{"test.*"}, Columns: []string{"id", "name"}},
+	}
+	sinkURI, err = url.Parse("mysql://127.0.0.1:3306")
+	require.NoError(t, err)
+	require.ErrorContains(t, s.ValidateAndAdjust(sinkURI),
+		"column-selectors is only supported by MQ sinks")
+}
+
+func TestValidateLargeMessageHandle(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("kafka://127.0.0.1:9092?protocol=open-protocol")
+	require.NoError(t, err)
+
+	s := GetDefaultReplicaConfig()
+	s.Sink.KafkaConfig = &KafkaConfig{LargeMessageHandle: &LargeMessageHandleConfig{}}
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+	require.Equal(t, LargeMessageHandleOptionNone,
+		s.Sink.KafkaConfig.LargeMessageHandle.LargeMessageHandleOption)
+	require.True(t, s.Sink.KafkaConfig.LargeMessageHandle.Disabled())
+
+	s.Sink.KafkaConfig.LargeMessageHandle.LargeMessageHandleOption = LargeMessageHandleOptionHandleKeyOnly
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+	require.True(t, s.Sink.KafkaConfig.LargeMessageHandle.HandleKeyOnly())
+
+	s.Sink.KafkaConfig.LargeMessageHandle.LargeMessageHandleOption = "unknown"
+	require.ErrorContains(t, s.ValidateAndAdjust(sinkURI), "large-message-handle-option")
+
+	s.Sink.KafkaConfig.LargeMessageHandle.LargeMessageHandleOption = LargeMessageHandleOptionClaimCheck
+	require.ErrorContains(t, s.ValidateAndAdjust(sinkURI), "claim-check-storage-uri")
+	s.Sink.KafkaConfig.LargeMessageHandle.ClaimCheckStorageURI = "kafka://127.0.0.1:9092"
+	require.ErrorContains(t, s.ValidateAndAdjust(sinkURI), "not a storage scheme")
+	s.Sink.KafkaConfig.LargeMessageHandle.ClaimCheckStorageURI = "s3://bucket/prefix"
+	require.NoError(t, s.ValidateAndAdjust(sinkURI))
+	require.True(t, s.Sink.KafkaConfig.LargeMessageHandle.EnableClaimCheck())
+}
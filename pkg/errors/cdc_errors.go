@@ -42,6 +42,10 @@ var (
 		"scheduler request failed, %s",
 		errors.RFCCodeText("CDC:ErrSchedulerRequestFailed"),
 	)
+	ErrSchedulerTransportClosed = errors.Normalize(
+		"scheduler transport is closed",
+		errors.RFCCodeText("CDC:ErrSchedulerTransportClosed"),
+	)
 	ErrGetAllStoresFailed = errors.Normalize(
 		"get stores from pd failed",
 		errors.RFCCodeText("CDC:ErrGetAllStoresFailed"),
@@ -129,6 +133,10 @@ var (
 		"filter rule is invalid %v",
 		errors.RFCCodeText("CDC:ErrFilterRuleInvalid"),
 	)
+	ErrColumnSelectorFailed = errors.Normalize(
+		"handle key column %s must be selected by the column selector",
+		errors.RFCCodeText("CDC:ErrColumnSelectorFailed"),
+	)
 
 	// internal errors
 	ErrAdminStopProcessor = errors.Normalize(
@@ -203,6 +211,30 @@ var (
 		"kafka broker config item not found",
 		errors.RFCCodeText("CDC:ErrKafkaBrokerConfigNotFound"),
 	)
+	ErrPulsarInvalidConfig = errors.Normalize(
+		"pulsar config invalid",
+		errors.RFCCodeText("CDC:ErrPulsarInvalidConfig"),
+	)
+	ErrPulsarNewClient = errors.Normalize(
+		"new pulsar client",
+		errors.RFCCodeText("CDC:ErrPulsarNewClient"),
+	)
+	ErrPulsarNewProducer = errors.Normalize(
+		"new pulsar producer",
+		errors.RFCCodeText("CDC:ErrPulsarNewProducer"),
+	)
+	ErrPulsarProducerClosed = errors.Normalize(
+		"pulsar producer closed",
+		errors.RFCCodeText("CDC:ErrPulsarProducerClosed"),
+	)
+	ErrPulsarSendMessage = errors.Normalize(
+		"pulsar send message failed",
+		errors.RFCCodeText("CDC:ErrPulsarSendMessage"),
+	)
+	ErrPulsarGetTopicPartitions = errors.Normalize(
+		"pulsar get topic partitions failed",
+		errors.RFCCodeText("CDC:ErrPulsarGetTopicPartitions"),
+	)
 	ErrRedoConfigInvalid = errors.Normalize(
 		"redo log config invalid",
 		errors.RFCCodeText("CDC:ErrRedoConfigInvalid"),
@@ -235,6 +267,10 @@ var (
 		"external storage api",
 		errors.RFCCodeText("CDC:ErrS3StorageAPI"),
 	)
+	ErrExternalStorageInvalidConfig = errors.Normalize(
+		"external storage config invalid",
+		errors.RFCCodeText("CDC:ErrExternalStorageInvalidConfig"),
+	)
 	ErrStorageInitialize = errors.Normalize(
 		"fail to open storage for redo log",
 		errors.RFCCodeText("CDC:ErrStorageInitialize"),
@@ -272,6 +308,18 @@ var (
 		"MySQL config invalid",
 		errors.RFCCodeText("CDC:ErrMySQLInvalidConfig"),
 	)
+	ErrOracleTxnError = errors.Normalize(
+		"Oracle txn error",
+		errors.RFCCodeText("CDC:ErrOracleTxnError"),
+	)
+	ErrOracleConnectionError = errors.Normalize(
+		"Oracle connection error",
+		errors.RFCCodeText("CDC:ErrOracleConnectionError"),
+	)
+	ErrOracleInvalidConfig = errors.Normalize(
+		"Oracle config invalid",
+		errors.RFCCodeText("CDC:ErrOracleInvalidConfig"),
+	)
 	ErrMySQLWorkerPanic = errors.Normalize(
 		"MySQL worker panic",
 		errors.RFCCodeText("CDC:ErrMySQLWorkerPanic"),
@@ -296,6 +344,11 @@ var (
 		"schema manager API error",
 		errors.RFCCodeText("CDC:ErrAvroSchemaAPIError"),
 	)
+	ErrAvroIncompatibleSchema = errors.Normalize(
+		"schema is incompatible with the registered schemas of subject %s, "+
+			"compatibility level %s",
+		errors.RFCCodeText("CDC:ErrAvroIncompatibleSchema"),
+	)
 	ErrAvroInvalidMessage = errors.Normalize(
 		"avro invalid message format",
 		errors.RFCCodeText("CDC:ErrAvroInvalidMessage"),
@@ -304,6 +357,10 @@ var (
 		"maxwell encode failed",
 		errors.RFCCodeText("CDC:ErrMaxwellEncodeFailed"),
 	)
+	ErrDebeziumEncodeFailed = errors.Normalize(
+		"debezium encode failed",
+		errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"),
+	)
 	ErrMaxwellInvalidData = errors.Normalize(
 		"maxwell invalid data",
 		errors.RFCCodeText("CDC:ErrMaxwellInvalidData"),
@@ -356,6 +413,14 @@ var (
 		"filename in storage sink is invalid",
 		errors.RFCCodeText("CDC:ErrStorageSinkInvalidFileName"),
 	)
+	ErrSubscriptionPreempted = errors.Normalize(
+		"subscription of changefeed %s is preempted by another consumer",
+		errors.RFCCodeText("CDC:ErrSubscriptionPreempted"),
+	)
+	ErrSubscriptionClosed = errors.Normalize(
+		"subscription of changefeed %s is closed",
+		errors.RFCCodeText("CDC:ErrSubscriptionClosed"),
+	)
 
 	// utilities related errors
 	ErrToTLSConfigFailed = errors.Normalize(
@@ -502,6 +567,9 @@ var (
 		"Changefeed %s.%s stopped due to corrupted data mutation received. "+
 			"Corrupted mutation detail information %+v",
 		errors.RFCCodeText("CDC:ErrCorruptedDataMutation"))
+	ErrCorruptedDataReceived = errors.Normalize(
+		"corrupted row data received, schema %s, table %s, commitTs %d",
+		errors.RFCCodeText("CDC:ErrCorruptedDataReceived"))
 
 	// server related errors
 	ErrCaptureSuicide = errors.Normalize(
@@ -568,6 +636,10 @@ var (
 		"changefeed update error: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateRefused"),
 	)
+	ErrNamespaceQuotaExceeded = errors.Normalize(
+		"the quota of namespace %s is exceeded: %s",
+		errors.RFCCodeText("CDC:ErrNamespaceQuotaExceeded"),
+	)
 	ErrChangefeedUpdateFailedTransaction = errors.Normalize(
 		"changefeed update failed due to unexpected etcd transaction failure: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateFailed"),
@@ -576,6 +648,34 @@ var (
 		"updating service safepoint failed",
 		errors.RFCCodeText("CDC:ErrUpdateServiceSafepointFailed"),
 	)
+	ErrUpdateServiceSafepointTimeout = errors.Normalize(
+		"updating service safepoint timeout after %s",
+		errors.RFCCodeText("CDC:ErrUpdateServiceSafepointTimeout"),
+	)
+	ErrAssessGCTTLFailed = errors.Normalize(
+		"assess gc ttl failed: %s",
+		errors.RFCCodeText("CDC:ErrAssessGCTTLFailed"),
+	)
+	ErrGCManagerRunning = errors.Normalize(
+		"gc manager is already running",
+		errors.RFCCodeText("CDC:ErrGCManagerRunning"),
+	)
+	ErrGCSafepointLeaseLost = errors.Normalize(
+		"gc safepoint lease lost: %s",
+		errors.RFCCodeText("CDC:ErrGCSafepointLeaseLost"),
+	)
+	ErrGCHandoffFailed = errors.Normalize(
+		"hand off gc duties failed: %s",
+		errors.RFCCodeText("CDC:ErrGCHandoffFailed"),
+	)
+	ErrVerifyGCPropagationFailed = errors.Normalize(
+		"verify gc safepoint propagation failed: %s",
+		errors.RFCCodeText("CDC:ErrVerifyGCPropagationFailed"),
+	)
+	ErrSetGCSafepointIfUnchangedFailed = errors.Normalize(
+		"set gc safepoint if unchanged failed: %s",
+		errors.RFCCodeText("CDC:ErrSetGCSafepointIfUnchangedFailed"),
+	)
 	ErrStartTsBeforeGC = errors.Normalize(
 		"fail to create or maintain changefeed because start-ts %d "+
 			"is earlier than or equal to GC safepoint at %d",
@@ -795,6 +895,10 @@ var (
 		"upstream has running import tasks, upstream-id: %d",
 		errors.RFCCodeText("CDC:ErrUpstreamHasRunningImport"),
 	)
+	ErrUpstreamInUse = errors.Normalize(
+		"upstream is used by changefeed %s, upstream-id: %d",
+		errors.RFCCodeText("CDC:ErrUpstreamInUse"),
+	)
 
 	// ReplicationSet error
 	ErrReplicationSetInconsistent = errors.Normalize(
@@ -128,6 +128,20 @@ type CDCEtcdClient interface {
 		namespace string,
 	) (*model.UpstreamInfo, error)
 
+	GetAllUpstreamInfo(ctx context.Context,
+		namespace string,
+	) (map[model.UpstreamID]*model.UpstreamInfo, error)
+
+	SaveUpstreamInfo(ctx context.Context,
+		upstreamInfo *model.UpstreamInfo,
+		namespace string,
+	) error
+
+	DeleteUpstreamInfo(ctx context.Context,
+		upstreamID model.UpstreamID,
+		namespace string,
+	) error
+
 	GetGCServiceID() string
 
 	GetEnsureGCServiceID(tag string) string
@@ -634,6 +648,61 @@ func (c *CDCEtcdClientImpl) GetUpstreamInfo(ctx context.Context,
 	return info, errors.Trace(err)
 }
 
+// GetAllUpstreamInfo gets all upstreamInfos of a namespace from etcd server
+func (c *CDCEtcdClientImpl) GetAllUpstreamInfo(ctx context.Context,
+	namespace string,
+) (map[model.UpstreamID]*model.UpstreamInfo, error) {
+	prefix := NamespacedPrefix(c.ClusterID, namespace) + upstreamKey + "/"
+	resp, err := c.Client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	infos := make(map[model.UpstreamID]*model.UpstreamInfo, resp.Count)
+	for _, rawKv := range resp.Kvs {
+		info := &model.UpstreamInfo{}
+		if err := info.Unmarshal(rawKv.Value); err != nil {
+			return nil, errors.Trace(err)
+		}
+		infos[info.ID] = info
+	}
+	return infos, nil
+}
+
+// SaveUpstreamInfo stores an upstreamInfo into etcd server,
+// the existing one with the same ID is overwritten.
+func (c *CDCEtcdClientImpl) SaveUpstreamInfo(ctx context.Context,
+	upstreamInfo *model.UpstreamInfo,
+	namespace string,
+) error {
+	key := CDCKey{
+		Tp:         CDCKeyTypeUpStream,
+		ClusterID:  c.ClusterID,
+		UpstreamID: upstreamInfo.ID,
+		Namespace:  namespace,
+	}
+	value, err := upstreamInfo.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = c.Client.Put(ctx, key.String(), string(value))
+	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+}
+
+// DeleteUpstreamInfo removes an upstreamInfo from etcd server
+func (c *CDCEtcdClientImpl) DeleteUpstreamInfo(ctx context.Context,
+	upstreamID model.UpstreamID,
+	namespace string,
+) error {
+	key := CDCKey{
+		Tp:         CDCKeyTypeUpStream,
+		ClusterID:  c.ClusterID,
+		UpstreamID: upstreamID,
+		Namespace:  namespace,
+	}
+	_, err := c.Client.Delete(ctx, key.String())
+	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+}
+
 // GcServiceIDForTest returns the gc service ID for tests
 func GcServiceIDForTest() string {
 	return fmt.Sprintf("ticdc-%s-%d", "default", 0)
@@ -254,6 +254,47 @@ func TestUpdateChangefeedAndUpstream(t *testing.T) {
 	require.Equal(t, changeFeedInfo.SinkURI, changefeedResult.SinkURI)
 }
 
+func TestSaveAndDeleteUpstreamInfo(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	for _, info := range []*model.UpstreamInfo{
+		{ID: 1, PDEndpoints: "http://127.0.0.1:2379"},
+		{ID: 2, PDEndpoints: "http://127.0.0.1:2479"},
+	} {
+		err := s.client.SaveUpstreamInfo(ctx, info, model.DefaultNamespace)
+		require.NoError(t, err)
+	}
+	// Upstreams of other namespaces are not listed.
+	err := s.client.SaveUpstreamInfo(ctx,
+		&model.UpstreamInfo{ID: 3}, "other-namespace")
+	require.NoError(t, err)
+
+	infos, err := s.client.GetAllUpstreamInfo(ctx, model.DefaultNamespace)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, "http://127.0.0.1:2479", infos[2].PDEndpoints)
+
+	// The existing upstream is overwritten.
+	err = s.client.SaveUpstreamInfo(ctx,
+		&model.UpstreamInfo{ID: 2, PDEndpoints: "http://127.0.0.1:2579"},
+		model.DefaultNamespace)
+	require.NoError(t, err)
+	info, err := s.client.GetUpstreamInfo(ctx, 2, model.DefaultNamespace)
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:2579", info.PDEndpoints)
+
+	err = s.client.DeleteUpstreamInfo(ctx, 2, model.DefaultNamespace)
+	require.NoError(t, err)
+	_, err = s.client.GetUpstreamInfo(ctx, 2, model.DefaultNamespace)
+	require.True(t, cerror.ErrUpstreamNotFound.Equal(err))
+	infos, err = s.client.GetAllUpstreamInfo(ctx, model.DefaultNamespace)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+}
+
 func TestGetAllCaptureLeases(t *testing.T) {
 	s := &Tester{}
 	s.SetUpTest(t)
@@ -24,6 +24,6 @@ var etcdRequestCounter = prometheus.NewCounterVec(
 	}, []string{"type"})
 
 // InitMetrics registers the etcd request counter.
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(etcdRequestCounter)
 }
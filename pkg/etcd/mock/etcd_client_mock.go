@@ -80,6 +80,20 @@ func (mr *MockCDCEtcdClientMockRecorder) DeleteCaptureInfo(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCaptureInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).DeleteCaptureInfo), arg0, arg1)
 }
 
+// DeleteUpstreamInfo mocks base method.
+func (m *MockCDCEtcdClient) DeleteUpstreamInfo(ctx context.Context, upstreamID model.UpstreamID, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpstreamInfo", ctx, upstreamID, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpstreamInfo indicates an expected call of DeleteUpstreamInfo.
+func (mr *MockCDCEtcdClientMockRecorder) DeleteUpstreamInfo(ctx, upstreamID, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpstreamInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).DeleteUpstreamInfo), ctx, upstreamID, namespace)
+}
+
 // GetAllCDCInfo mocks base method.
 func (m *MockCDCEtcdClient) GetAllCDCInfo(ctx context.Context) ([]*mvccpb.KeyValue, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +124,21 @@ func (mr *MockCDCEtcdClientMockRecorder) GetAllChangeFeedInfo(ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetAllChangeFeedInfo), ctx)
 }
 
+// GetAllUpstreamInfo mocks base method.
+func (m *MockCDCEtcdClient) GetAllUpstreamInfo(ctx context.Context, namespace string) (map[model.UpstreamID]*model.UpstreamInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUpstreamInfo", ctx, namespace)
+	ret0, _ := ret[0].(map[model.UpstreamID]*model.UpstreamInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUpstreamInfo indicates an expected call of GetAllUpstreamInfo.
+func (mr *MockCDCEtcdClientMockRecorder) GetAllUpstreamInfo(ctx, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUpstreamInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetAllUpstreamInfo), ctx, namespace)
+}
+
 // GetCaptures mocks base method.
 func (m *MockCDCEtcdClient) GetCaptures(arg0 context.Context) (int64, []*model.CaptureInfo, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +315,20 @@ func (mr *MockCDCEtcdClientMockRecorder) SaveChangeFeedInfo(ctx, info, changeFee
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveChangeFeedInfo), ctx, info, changeFeedID)
 }
 
+// SaveUpstreamInfo mocks base method.
+func (m *MockCDCEtcdClient) SaveUpstreamInfo(ctx context.Context, upstreamInfo *model.UpstreamInfo, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUpstreamInfo", ctx, upstreamInfo, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveUpstreamInfo indicates an expected call of SaveUpstreamInfo.
+func (mr *MockCDCEtcdClientMockRecorder) SaveUpstreamInfo(ctx, upstreamInfo, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUpstreamInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveUpstreamInfo), ctx, upstreamInfo, namespace)
+}
+
 // UpdateChangefeedAndUpstream mocks base method.
 func (m *MockCDCEtcdClient) UpdateChangefeedAndUpstream(ctx context.Context, upstreamInfo *model.UpstreamInfo, changeFeedInfo *model.ChangeFeedInfo, changeFeedID model.ChangeFeedID) error {
 	m.ctrl.T.Helper()
@@ -43,5 +43,8 @@ func VerifyNone(t *testing.T, options ...goleak.Option) {
 // options can be used to implement other ignore items
 func SetUpLeakTest(m *testing.M, options ...goleak.Option) {
 	options = append(options, defaultOpts...)
+	// Goroutines started by the package initializers are not leaked by tests,
+	// e.g. the keyring used by the pulsar client may connect to the session bus.
+	options = append(options, goleak.IgnoreCurrent())
 	goleak.VerifyTestMain(m, options...)
 }
@@ -0,0 +1,173 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	namespaceLabel  = "namespace"
+	changefeedLabel = "changefeed"
+	tableLabel      = "table"
+)
+
+var (
+	_ prometheus.Registerer = (*Registry)(nil)
+	_ prometheus.Gatherer   = (*Registry)(nil)
+)
+
+var globalRegistry = NewRegistry()
+
+// GetRegistry returns the global registry of all TiCDC metrics.
+func GetRegistry() *Registry {
+	return globalRegistry
+}
+
+// partialDeleter is implemented by all metric vectors, it deletes the series
+// whose labels match the given labels.
+type partialDeleter interface {
+	DeletePartialMatch(labels prometheus.Labels) int
+}
+
+// Registry wraps a prometheus registry. It tracks the registered metric
+// vectors, so that the series of a removed changefeed or a moved table can be
+// deleted from all of them, and it limits the number of series of every
+// metric to protect the monitoring system from the cardinality explosion.
+type Registry struct {
+	registry *prometheus.Registry
+
+	// maxSeries is the max number of series of a metric, the exceeded series
+	// are dropped while gathering, 0 means unlimited.
+	maxSeries atomic.Int64
+
+	mu struct {
+		sync.Mutex
+		vecs map[partialDeleter]struct{}
+		// limited records the metrics which exceed the max number of series,
+		// it's used to avoid printing the warning log on every gathering.
+		limited map[string]struct{}
+	}
+}
+
+// NewRegistry creates a new Registry.
+func NewRegistry() *Registry {
+	r := &Registry{registry: prometheus.NewRegistry()}
+	r.mu.vecs = make(map[partialDeleter]struct{})
+	r.mu.limited = make(map[string]struct{})
+	return r
+}
+
+// SetMaxSeries sets the max number of series of a metric, 0 means unlimited.
+func (r *Registry) SetMaxSeries(maxSeries int) {
+	r.maxSeries.Store(int64(maxSeries))
+}
+
+// Register implements prometheus.Registerer.
+func (r *Registry) Register(c prometheus.Collector) error {
+	if err := r.registry.Register(c); err != nil {
+		return err
+	}
+	if vec, ok := c.(partialDeleter); ok {
+		r.mu.Lock()
+		r.mu.vecs[vec] = struct{}{}
+		r.mu.Unlock()
+	}
+	return nil
+}
+
+// MustRegister implements prometheus.Registerer.
+func (r *Registry) MustRegister(cs ...prometheus.Collector) {
+	for _, c := range cs {
+		if err := r.Register(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Unregister implements prometheus.Registerer.
+func (r *Registry) Unregister(c prometheus.Collector) bool {
+	if vec, ok := c.(partialDeleter); ok {
+		r.mu.Lock()
+		delete(r.mu.vecs, vec)
+		r.mu.Unlock()
+	}
+	return r.registry.Unregister(c)
+}
+
+// DeleteChangefeedSeries deletes all series of the changefeed, it returns the
+// number of deleted series.
+func (r *Registry) DeleteChangefeedSeries(namespace, changefeed string) int {
+	return r.deletePartialMatch(prometheus.Labels{
+		namespaceLabel:  namespace,
+		changefeedLabel: changefeed,
+	})
+}
+
+// DeleteTableSeries deletes all series of the table in the changefeed, it
+// returns the number of deleted series.
+func (r *Registry) DeleteTableSeries(namespace, changefeed, table string) int {
+	return r.deletePartialMatch(prometheus.Labels{
+		namespaceLabel:  namespace,
+		changefeedLabel: changefeed,
+		tableLabel:      table,
+	})
+}
+
+func (r *Registry) deletePartialMatch(labels prometheus.Labels) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	deleted := 0
+	for vec := range r.mu.vecs {
+		deleted += vec.DeletePartialMatch(labels)
+	}
+	return deleted
+}
+
+// Gather implements prometheus.Gatherer.
+func (r *Registry) Gather() ([]*dto.MetricFamily, error) {
+	families, err := r.registry.Gather()
+	maxSeries := int(r.maxSeries.Load())
+	if maxSeries <= 0 {
+		return families, err
+	}
+	for _, family := range families {
+		if len(family.Metric) <= maxSeries {
+			continue
+		}
+		r.warnLimited(family.GetName(), len(family.Metric), maxSeries)
+		family.Metric = family.Metric[:maxSeries]
+	}
+	return families, err
+}
+
+func (r *Registry) warnLimited(name string, series, maxSeries int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.mu.limited[name]; ok {
+		return
+	}
+	r.mu.limited[name] = struct{}{}
+	log.Warn("the number of series of the metric exceeds the limit, "+
+		"the exceeded series are dropped",
+		zap.String("metric", name),
+		zap.Int("series", series),
+		zap.Int("maxSeries", maxSeries))
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteChangefeedAndTableSeries(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+	changefeedGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "changefeed_gauge",
+	}, []string{"namespace", "changefeed"})
+	tableCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "table_counter",
+	}, []string{"namespace", "changefeed", "table"})
+	captureGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capture_gauge",
+	}, []string{"capture"})
+	r.MustRegister(changefeedGauge, tableCounter, captureGauge)
+
+	changefeedGauge.WithLabelValues("default", "cf1").Set(1)
+	changefeedGauge.WithLabelValues("default", "cf2").Set(1)
+	tableCounter.WithLabelValues("default", "cf1", "t1").Inc()
+	tableCounter.WithLabelValues("default", "cf1", "t2").Inc()
+	tableCounter.WithLabelValues("default", "cf2", "t1").Inc()
+	captureGauge.WithLabelValues("capture1").Set(1)
+
+	require.Equal(t, 1, r.DeleteTableSeries("default", "cf1", "t1"))
+	require.Equal(t, 2, testutil.CollectAndCount(tableCounter))
+	require.Equal(t, 2, testutil.CollectAndCount(changefeedGauge))
+
+	require.Equal(t, 2, r.DeleteChangefeedSeries("default", "cf1"))
+	require.Equal(t, 1, testutil.CollectAndCount(tableCounter))
+	require.Equal(t, 1, testutil.CollectAndCount(changefeedGauge))
+	require.Equal(t, 1, testutil.CollectAndCount(captureGauge))
+
+	// The unregistered vectors are not touched.
+	require.True(t, r.Unregister(changefeedGauge))
+	require.Equal(t, 1, r.DeleteChangefeedSeries("default", "cf2"))
+	require.Equal(t, 1, testutil.CollectAndCount(changefeedGauge))
+	require.Equal(t, 0, testutil.CollectAndCount(tableCounter))
+}
+
+func TestGatherWithMaxSeries(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "changefeed_gauge",
+	}, []string{"namespace", "changefeed"})
+	r.MustRegister(gauge)
+	for i := 0; i < 10; i++ {
+		gauge.WithLabelValues("default", fmt.Sprintf("cf%d", i)).Set(1)
+	}
+
+	families, err := r.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Len(t, families[0].Metric, 10)
+
+	r.SetMaxSeries(5)
+	families, err = r.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Len(t, families[0].Metric, 5)
+	// The series are kept in the vector.
+	require.Equal(t, 10, testutil.CollectAndCount(gauge))
+
+	r.SetMaxSeries(0)
+	families, err = r.Gather()
+	require.NoError(t, err)
+	require.Len(t, families[0].Metric, 10)
+}
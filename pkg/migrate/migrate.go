@@ -41,8 +41,13 @@ import (
 )
 
 const (
-	// cdcMetaVersion is hard code value indicate the metaVersion of TiCDC
-	cdcMetaVersion          = 1
+	// cdcMetaVersion is hard code value indicate the metaVersion of TiCDC,
+	// it must be the version of the last migration in metaMigrations.
+	cdcMetaVersion = layoutMetaVersion
+	// layoutMetaVersion is the version that the keys are moved to the
+	// cluster and namespace layout, the later changes of the metadata are
+	// registered in metaMigrations.
+	layoutMetaVersion       = 1
 	etcdSessionTTL          = 10
 	campaignTimeoutDuration = 1 * time.Minute
 	noMetaVersion           = -1
@@ -54,6 +59,21 @@ const (
 
 type keys map[string]string
 
+// metaMigration migrates the metadata from version-1 to version.
+type metaMigration struct {
+	version int
+	// prefixes are the key prefixes relative to the cluster base key, which
+	// are touched by the migration. The keys under them are backed up before
+	// the migration, and restored if the migration fails.
+	prefixes []string
+	migrate  func(ctx context.Context, cli etcd.CDCEtcdClient) error
+}
+
+// metaMigrations are the migrations after layoutMetaVersion, sorted by version.
+// To change the layout of the metadata, append a migration here and bump
+// cdcMetaVersion to its version.
+var metaMigrations []metaMigration
+
 func (k keys) addPair(old, new string) {
 	k[old] = new
 }
@@ -78,11 +98,16 @@ type migrator struct {
 	metaVersionKey string
 	// cdc old owner key
 	oldOwnerKey string
+	// migrationKey is the election key of the migrations after
+	// layoutMetaVersion, see runMigration.
+	migrationKey string
 	// etcd client
 	cli etcd.CDCEtcdClient
 	// all keyPrefixes needed to be migrated or update
 	// map from oldKeyPrefix to newKeyPrefix
 	keyPrefixes keys
+	// migrations are the migrations after layoutMetaVersion
+	migrations []metaMigration
 
 	done atomic.Bool
 
@@ -107,8 +132,10 @@ func NewMigrator(cli etcd.CDCEtcdClient,
 		newMetaVersion:     cdcMetaVersion,
 		metaVersionKey:     metaVersionCDCKey.String(),
 		oldOwnerKey:        "/ticdc/cdc/owner",
+		migrationKey:       "/ticdc/cdc/migration/" + cli.GetClusterID(),
 		cli:                cli,
 		keyPrefixes:        make(keys),
+		migrations:         metaMigrations,
 		pdEndpoints:        pdEndpoints,
 		config:             serverConfig,
 		createPDClientFunc: createPDClient,
@@ -180,9 +207,9 @@ func (m *migrator) migrate(ctx context.Context, etcdNoMetaVersion bool, oldVersi
 			zap.Int("etcdMetaVersion", metaVersion), zap.Int("cdcMetaVersion", m.newMetaVersion))
 	}
 
-	// if metaVersion in etcd is equal to m.newMetaVersion,
+	// if metaVersion in etcd is not less than layoutMetaVersion,
 	// it means that there is no need to migrate
-	if !etcdNoMetaVersion && metaVersion == m.newMetaVersion {
+	if !etcdNoMetaVersion && metaVersion >= layoutMetaVersion {
 		log.Warn("meta version no match, no need to migrate")
 		return nil
 	}
@@ -271,7 +298,7 @@ func (m *migrator) migrate(ctx context.Context, etcdNoMetaVersion bool, oldVersi
 	}
 
 	// 5. update metaVersion
-	_, err = m.cli.GetEtcdClient().Put(ctx, m.metaVersionKey, fmt.Sprintf("%d", m.newMetaVersion))
+	_, err = m.cli.GetEtcdClient().Put(ctx, m.metaVersionKey, fmt.Sprintf("%d", layoutMetaVersion))
 	if err != nil {
 		log.Error("update meta version failed, etcd meta data migration failed", zap.Error(err))
 		return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
@@ -412,20 +439,30 @@ func (m *migrator) migrateGcServiceSafePoint(ctx context.Context,
 }
 
 func (m *migrator) campaignOldOwner(ctx context.Context) error {
-	sess, err := concurrency.NewSession(m.cli.GetEtcdClient().Unwrap(),
-		concurrency.WithTTL(etcdSessionTTL))
+	sess, err := m.campaign(ctx, m.oldOwnerKey)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	election := concurrency.NewElection(sess, m.oldOwnerKey)
-	defer func() {
-		_ = sess.Close()
-	}()
+	_ = sess.Close()
+	return nil
+}
 
+// campaign campaigns the election of electionKey, the caller holds the
+// election until it closes the returned session.
+func (m *migrator) campaign(
+	ctx context.Context, electionKey string,
+) (*concurrency.Session, error) {
+	sess, err := concurrency.NewSession(m.cli.GetEtcdClient().Unwrap(),
+		concurrency.WithTTL(etcdSessionTTL))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	election := concurrency.NewElection(sess, electionKey)
 	if err := election.Campaign(ctx, migrationCampaignKey); err != nil {
-		return errors.Trace(err)
+		_ = sess.Close()
+		return nil, errors.Trace(err)
 	}
-	return nil
+	return sess, nil
 }
 
 // Migrate migrate etcd meta data
@@ -436,7 +473,7 @@ func (m *migrator) Migrate(ctx context.Context) error {
 	}
 
 	shouldMigrate := false
-	oldVersion, newVersion := 0, cdcMetaVersion
+	oldVersion, newVersion := 0, m.newMetaVersion
 
 	if version == noMetaVersion {
 		if m.cli.GetClusterID() != etcd.DefaultCDCClusterID {
@@ -471,12 +508,136 @@ func (m *migrator) Migrate(ctx context.Context) error {
 		return nil
 	}
 
-	m.keyPrefixes.addPair("/tidb/cdc/changefeed/info",
-		etcd.DefaultClusterAndNamespacePrefix+etcd.ChangefeedInfoKey)
-	m.keyPrefixes.addPair("/tidb/cdc/job",
-		etcd.DefaultClusterAndNamespacePrefix+etcd.ChangefeedStatusKey)
+	if oldVersion < layoutMetaVersion {
+		m.keyPrefixes.addPair("/tidb/cdc/changefeed/info",
+			etcd.DefaultClusterAndNamespacePrefix+etcd.ChangefeedInfoKey)
+		m.keyPrefixes.addPair("/tidb/cdc/job",
+			etcd.DefaultClusterAndNamespacePrefix+etcd.ChangefeedStatusKey)
 
-	return m.migrate(ctx, version == noMetaVersion, oldVersion)
+		if err := m.migrate(ctx, version == noMetaVersion, oldVersion); err != nil {
+			return errors.Trace(err)
+		}
+		oldVersion = layoutMetaVersion
+	}
+
+	for _, migration := range m.migrations {
+		if migration.version <= oldVersion {
+			continue
+		}
+		if err := m.runMigration(ctx, migration); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// runMigration runs a migration after layoutMetaVersion. The keys touched by
+// the migration are backed up under the backup prefix of the old version
+// first, and they are restored if the migration fails, so that the metadata
+// is either fully migrated or left as it was. The migration runs while holding
+// the migration election, and the meta version is bumped only if it is still
+// the old version.
+func (m *migrator) runMigration(ctx context.Context, migration metaMigration) error {
+	client := m.cli.GetEtcdClient()
+	baseKey := etcd.BaseKey(m.cli.GetClusterID())
+
+	campaignCtx, cancel := context.WithTimeout(ctx, campaignTimeoutDuration)
+	sess, err := m.campaign(campaignCtx, m.migrationKey)
+	cancel()
+	if err != nil {
+		log.Error("campaign migration failed, etcd meta data migration failed",
+			zap.Error(err))
+		return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
+	}
+	defer func() {
+		_ = sess.Close()
+	}()
+	// Another owner may have run the migration before the election is won.
+	metaVersion, err := getMetaVersion(ctx, client, m.cli.GetClusterID())
+	if err != nil {
+		log.Error("get meta version failed, etcd meta data migration failed", zap.Error(err))
+		return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
+	}
+	if metaVersion >= migration.version {
+		log.Info("etcd meta data has been migrated, skip the migration",
+			zap.Int("etcdMetaVersion", metaVersion),
+			zap.Int("toVersion", migration.version))
+		return nil
+	}
+
+	log.Info("etcd meta data migration start",
+		zap.Int("fromVersion", migration.version-1),
+		zap.Int("toVersion", migration.version))
+
+	backup := make(map[string][]byte)
+	for _, prefix := range migration.prefixes {
+		resp, err := client.Get(ctx, baseKey+prefix, clientV3.WithPrefix())
+		if err != nil {
+			log.Error("get meta data failed, etcd meta data migration failed",
+				zap.Error(err))
+			return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
+		}
+		for _, kv := range resp.Kvs {
+			key := string(kv.Key)
+			backup[key] = kv.Value
+			_, err := client.Put(ctx,
+				etcd.MigrateBackupKey(migration.version-1, key), string(kv.Value))
+			if err != nil {
+				log.Error("backup meta data failed, etcd meta data migration failed",
+					zap.String("key", key), zap.Error(err))
+				return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
+			}
+		}
+	}
+
+	if err := migration.migrate(ctx, m.cli); err != nil {
+		log.Error("etcd meta data migration failed, rollback the meta data",
+			zap.Int("toVersion", migration.version), zap.Error(err))
+		if rollbackErr := m.rollbackMigration(ctx, migration, backup); rollbackErr != nil {
+			log.Error("rollback meta data failed, please restore the meta data "+
+				"from the backup keys manually",
+				zap.String("backupPrefix",
+					etcd.MigrateBackupKey(migration.version-1, baseKey)),
+				zap.Error(rollbackErr))
+		}
+		return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
+	}
+
+	resp, err := client.Txn(ctx,
+		[]clientV3.Cmp{clientV3.Compare(clientV3.Value(m.metaVersionKey),
+			"=", fmt.Sprintf("%d", migration.version-1))},
+		[]clientV3.Op{clientV3.OpPut(m.metaVersionKey, fmt.Sprintf("%d", migration.version))},
+		nil)
+	if err == nil && !resp.Succeeded {
+		err = errors.Errorf("meta version is changed during the migration to %d",
+			migration.version)
+	}
+	if err != nil {
+		log.Error("update meta version failed, etcd meta data migration failed", zap.Error(err))
+		return cerror.WrapError(cerror.ErrEtcdMigrateFailed, err)
+	}
+	log.Info("etcd meta data migration successful",
+		zap.Int("version", migration.version))
+	return nil
+}
+
+// rollbackMigration restores the keys touched by a failed migration.
+func (m *migrator) rollbackMigration(
+	ctx context.Context, migration metaMigration, backup map[string][]byte,
+) error {
+	client := m.cli.GetEtcdClient()
+	baseKey := etcd.BaseKey(m.cli.GetClusterID())
+	for _, prefix := range migration.prefixes {
+		if _, err := client.Delete(ctx, baseKey+prefix, clientV3.WithPrefix()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for key, value := range backup {
+		if _, err := client.Put(ctx, key, string(value)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
 }
 
 // ShouldMigrate checks if we should migrate etcd metadata
@@ -485,7 +646,7 @@ func (m *migrator) ShouldMigrate(ctx context.Context) (bool, error) {
 	if err != nil {
 		return false, errors.Trace(err)
 	}
-	return version != cdcMetaVersion, nil
+	return version != m.newMetaVersion, nil
 }
 
 // WaitMetaVersionMatched checks and waits until the metaVersion in etcd
@@ -495,7 +656,7 @@ func (m *migrator) WaitMetaVersionMatched(ctx context.Context) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if version == cdcMetaVersion {
+	if version == m.newMetaVersion {
 		return nil
 	}
 
@@ -513,7 +674,7 @@ func (m *migrator) WaitMetaVersionMatched(ctx context.Context) error {
 			if err != nil {
 				return errors.Trace(err)
 			}
-			if version == cdcMetaVersion {
+			if version == m.newMetaVersion {
 				return nil
 			}
 		case <-warnLogTicker.C:
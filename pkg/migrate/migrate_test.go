@@ -462,6 +462,104 @@ func TestMigrationNonDefaultCluster(t *testing.T) {
 	require.True(t, m.IsMigrateDone())
 }
 
+func TestRunMigrationsAfterLayoutVersion(t *testing.T) {
+	s := &etcd.Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+	curl := s.ClientURL.String()
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{curl},
+		DialTimeout: 3 * time.Second,
+	})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cdcCli, err := etcd.NewCDCEtcdClient(ctx, cli, etcd.DefaultCDCClusterID)
+	require.Nil(t, err)
+
+	const key = "/tidb/cdc/default/default/test/key"
+	_, err = cli.Put(ctx, key, "v1")
+	require.NoError(t, err)
+
+	m := NewMigrator(cdcCli, []string{}, config.GetGlobalServerConfig()).(*migrator)
+	_, err = cli.Put(ctx, m.metaVersionKey, strconv.Itoa(layoutMetaVersion))
+	require.NoError(t, err)
+
+	getValue := func(key string) string {
+		resp, err := cli.Get(ctx, key)
+		require.NoError(t, err)
+		if len(resp.Kvs) == 0 {
+			return ""
+		}
+		return string(resp.Kvs[0].Value)
+	}
+
+	// The failed migration is rolled back.
+	m.newMetaVersion = layoutMetaVersion + 1
+	m.migrations = []metaMigration{{
+		version:  layoutMetaVersion + 1,
+		prefixes: []string{"/default/test"},
+		migrate: func(ctx context.Context, cli etcd.CDCEtcdClient) error {
+			_, err := cli.GetEtcdClient().Put(ctx, key, "v2")
+			require.NoError(t, err)
+			_, err = cli.GetEtcdClient().Put(ctx, key+"/new", "v2")
+			require.NoError(t, err)
+			return errors.New("injected error")
+		},
+	}}
+	should, err := m.ShouldMigrate(ctx)
+	require.NoError(t, err)
+	require.True(t, should)
+	require.Error(t, m.Migrate(ctx))
+	require.Equal(t, "v1", getValue(key))
+	require.Equal(t, "", getValue(key+"/new"))
+	require.Equal(t, "v1", getValue(etcd.MigrateBackupKey(layoutMetaVersion, key)))
+	version, err := getMetaVersion(ctx, cdcCli.GetEtcdClient(), cdcCli.GetClusterID())
+	require.NoError(t, err)
+	require.Equal(t, layoutMetaVersion, version)
+
+	// The migration waits for the migration election.
+	migrated := false
+	m.migrations[0].migrate = func(ctx context.Context, cli etcd.CDCEtcdClient) error {
+		migrated = true
+		return nil
+	}
+	sess, err := m.campaign(ctx, m.migrationKey)
+	require.NoError(t, err)
+	campaignCtx, campaignCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	require.Error(t, m.Migrate(campaignCtx))
+	campaignCancel()
+	require.False(t, migrated)
+	require.NoError(t, sess.Close())
+
+	// The meta version is not bumped if it's changed during the migration.
+	m.migrations[0].migrate = func(ctx context.Context, cli etcd.CDCEtcdClient) error {
+		_, err := cli.GetEtcdClient().Put(ctx, m.metaVersionKey, "0")
+		return err
+	}
+	require.Error(t, m.Migrate(ctx))
+	require.Equal(t, "0", getValue(m.metaVersionKey))
+	_, err = cli.Put(ctx, m.metaVersionKey, strconv.Itoa(layoutMetaVersion))
+	require.NoError(t, err)
+
+	// The successful migration updates the meta version.
+	m.migrations[0].migrate = func(ctx context.Context, cli etcd.CDCEtcdClient) error {
+		_, err := cli.GetEtcdClient().Put(ctx, key, "v2")
+		return err
+	}
+	require.NoError(t, m.Migrate(ctx))
+	require.Equal(t, "v2", getValue(key))
+	version, err = getMetaVersion(ctx, cdcCli.GetEtcdClient(), cdcCli.GetClusterID())
+	require.NoError(t, err)
+	require.Equal(t, layoutMetaVersion+1, version)
+	should, err = m.ShouldMigrate(ctx)
+	require.NoError(t, err)
+	require.False(t, should)
+	require.NoError(t, m.WaitMetaVersionMatched(ctx))
+}
+
 type mockPDClient struct {
 	pd.Client
 	testServer *httptest.Server
@@ -45,7 +45,7 @@ var (
 )
 
 // InitMetrics registers all metrics in this file
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(etcdTxnSize)
 	registry.MustRegister(etcdTxnExecDuration)
 	registry.MustRegister(etcdWorkerTickDuration)
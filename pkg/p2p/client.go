@@ -56,6 +56,17 @@ type MessageClientConfig struct {
 	ClientVersion string
 	// MaxRecvMsgSize is the maximum message size in bytes TiCDC can receive.
 	MaxRecvMsgSize int
+	// Compression is the compression of messages, it is either
+	// CompressionNone or CompressionSnappy, empty means CompressionNone.
+	Compression string
+	// TopicSendQuota is the maximum number of messages of a topic buffered
+	// in the sending channel, so that a flood of messages of one topic can
+	// not starve the other topics. 0 means unlimited.
+	TopicSendQuota int
+	// TopicPriority returns the priority of a topic. The messages of the
+	// topics of higher priority are sent first. Nil means all topics are of
+	// PriorityNormal.
+	TopicPriority func(topic Topic) Priority
 }
 
 // MessageClient is a client used to send peer messages.
@@ -85,6 +96,10 @@ type topicEntry struct {
 	sentMessageMu sync.Mutex
 	sentMessages  queue.ChunkQueue[*p2p.MessageEntry]
 
+	// priority is decided when the topic is created and never changes,
+	// so that all messages of the topic are sent in order.
+	priority Priority
+
 	nextSeq  atomic.Int64
 	ack      atomic.Int64
 	lastSent atomic.Int64
@@ -94,7 +109,7 @@ type topicEntry struct {
 // senderID is an identifier for the local node.
 func NewMessageClient(senderID NodeID, config *MessageClientConfig) *MessageClient {
 	return &MessageClient{
-		sendCh:   internal.NewSendChan(int64(config.SendChannelSize)),
+		sendCh:   internal.NewSendChan(int64(config.SendChannelSize), int64(config.TopicSendQuota)),
 		topics:   make(map[string]*topicEntry),
 		senderID: senderID,
 		closeCh:  make(chan struct{}),
@@ -115,6 +130,7 @@ func (c *MessageClient) Run(
 	defer func() {
 		c.isClosed.Store(true)
 		close(c.closeCh)
+		c.releasePendingMessageMetrics()
 
 		log.Info("peer message client exited",
 			zap.String("addr", addr),
@@ -147,6 +163,7 @@ func (c *MessageClient) Run(
 			credential:     credential,
 			timeout:        c.config.DialTimeout,
 			maxRecvMsgSize: c.config.MaxRecvMsgSize,
+			compression:    c.config.Compression,
 		})
 		if err != nil {
 			log.Warn("peer-message client: failed to connect to server",
@@ -265,6 +282,7 @@ func (c *MessageClient) runTx(ctx context.Context, stream clientStream) error {
 		tpk.sentMessages.Push(msg)
 		tpk.sentMessageMu.Unlock()
 
+		clientPendingMessageCount.WithLabelValues(msg.Topic).Dec()
 		metricsClientMessageCount.Inc()
 
 		log.Debug("Sending Message",
@@ -417,6 +435,10 @@ func (c *MessageClient) sendMessage(ctx context.Context, topic Topic, value inte
 	if !ok {
 		tpk = &topicEntry{
 			sentMessages: *queue.NewChunkQueue[*p2p.MessageEntry](),
+			priority:     PriorityNormal,
+		}
+		if c.config.TopicPriority != nil {
+			tpk.priority = c.config.TopicPriority(topic)
 		}
 		tpk.nextSeq.Store(0)
 		c.topicMu.Lock()
@@ -434,20 +456,36 @@ func (c *MessageClient) sendMessage(ctx context.Context, topic Topic, value inte
 	}
 
 	if nonblocking {
-		ok, seq := c.sendCh.SendAsync(topic, data, tpk.nextSeq.Inc)
+		ok, seq := c.sendCh.SendAsync(topic, tpk.priority, data, tpk.nextSeq.Inc)
 		if !ok {
+			clientDroppedMessageCount.WithLabelValues(topic).Inc()
 			return 0, cerrors.ErrPeerMessageSendTryAgain.GenWithStackByArgs()
 		}
+		clientPendingMessageCount.WithLabelValues(topic).Inc()
 		return seq, nil
 	}
 	// blocking
-	seq, err = c.sendCh.SendSync(ctx, topic, data, c.closeCh, tpk.nextSeq.Inc)
+	seq, err = c.sendCh.SendSync(ctx, topic, tpk.priority, data, c.closeCh, tpk.nextSeq.Inc)
 	if err != nil {
 		return 0, errors.Trace(err)
 	}
+	clientPendingMessageCount.WithLabelValues(topic).Inc()
 	return seq, nil
 }
 
+// releasePendingMessageMetrics subtracts the messages that will never be
+// sent from the pending message metrics after the client exits.
+func (c *MessageClient) releasePendingMessageMetrics() {
+	c.topicMu.RLock()
+	defer c.topicMu.RUnlock()
+
+	for topic := range c.topics {
+		if pending := c.sendCh.Pending(topic); pending > 0 {
+			clientPendingMessageCount.WithLabelValues(topic).Sub(float64(pending))
+		}
+	}
+}
+
 // CurrentAck returns (s, true) if all messages with sequence less than or
 // equal to s have been processed by the receiver. It returns (0, false) if
 // no message for `topic` has been sent.
@@ -37,6 +37,9 @@ type clientConnectOptions struct {
 	// timeout specifies the DialTimeout of the connection.
 	timeout        time.Duration
 	maxRecvMsgSize int
+	// compression is the name of the gRPC compressor, empty or
+	// CompressionNone means no compression.
+	compression string
 }
 
 type cancelFn = func()
@@ -61,10 +64,15 @@ func (c *clientConnectorImpl) Connect(opts clientConnectOptions) (proto.CDCPeerT
 		return nil, nil, errors.Trace(err)
 	}
 
+	callOptions := []grpc.CallOption{grpc.MaxCallRecvMsgSize(opts.maxRecvMsgSize)}
+	if opts.compression != "" && opts.compression != CompressionNone {
+		callOptions = append(callOptions, grpc.UseCompressor(opts.compression))
+	}
+
 	conn, err := grpc.Dial(
 		opts.addr,
 		securityOption,
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxRecvMsgSize)),
+		grpc.WithDefaultCallOptions(callOptions...),
 		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
 			return net.DialTimeout(opts.network, s, opts.timeout)
 		}),
@@ -79,3 +79,67 @@ func TestClientConnector(t *testing.T) {
 	grpcServer.Stop()
 	wg.Wait()
 }
+
+type recvService struct {
+	recvCh chan *p2p.MessagePacket
+}
+
+func (s *recvService) SendMessage(server p2p.CDCPeerToPeer_SendMessageServer) error {
+	packet, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	s.recvCh <- packet
+	return nil
+}
+
+func TestClientConnectorCompression(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	service := &recvService{recvCh: make(chan *p2p.MessagePacket, 1)}
+	grpcServer := grpc.NewServer()
+
+	port := freeport.GetPort()
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+	lis, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+
+	p2p.RegisterCDCPeerToPeerServer(grpcServer, service)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = grpcServer.Serve(lis)
+	}()
+
+	cc := newClientConnector()
+	client, release, err := cc.Connect(clientConnectOptions{
+		network:        "tcp",
+		addr:           addr,
+		credential:     &security.Credential{},
+		maxRecvMsgSize: 1024 * 1024,
+		compression:    CompressionSnappy,
+	})
+	require.NoError(t, err)
+	defer release()
+
+	stream, err := client.SendMessage(ctx)
+	require.NoError(t, err)
+	content := make([]byte, 64*1024)
+	packet := &p2p.MessagePacket{
+		Entries: []*p2p.MessageEntry{{Topic: "test-topic", Content: content, Sequence: 1}},
+	}
+	require.NoError(t, stream.Send(packet))
+
+	select {
+	case <-ctx.Done():
+		require.Fail(t, "the packet is not received")
+	case received := <-service.recvCh:
+		require.Equal(t, packet.Entries, received.Entries)
+	}
+
+	cancel()
+	grpcServer.Stop()
+	wg.Wait()
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"io"
+
+	"github.com/golang/snappy"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// CompressionNone sends peer messages uncompressed.
+	CompressionNone = "none"
+	// CompressionSnappy compresses peer messages with snappy.
+	CompressionSnappy = "snappy"
+)
+
+func init() {
+	// The compressor is registered by both the client and the server, so
+	// that the server is able to decompress messages sent by clients of the
+	// same version.
+	encoding.RegisterCompressor(snappyCompressor{})
+}
+
+// snappyCompressor implements encoding.Compressor.
+type snappyCompressor struct{}
+
+func (snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return snappy.NewBufferedWriter(w), nil
+}
+
+func (snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return snappy.NewReader(r), nil
+}
+
+func (snappyCompressor) Name() string {
+	return CompressionSnappy
+}
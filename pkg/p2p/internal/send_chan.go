@@ -25,14 +25,37 @@ import (
 	"go.uber.org/zap"
 )
 
+// Priority is the priority of the messages of a topic.
+type Priority int
+
+const (
+	// PriorityNormal is the default priority of a topic.
+	PriorityNormal Priority = iota
+	// PriorityHigh is the priority of the topics whose messages
+	// should be sent before the messages of normal priority.
+	PriorityHigh
+
+	numPriorities
+)
+
 // SendChan is a specialized channel used to implement
 // the asynchronous interface of the MessageClient.
 // SendChan is a MPSC channel.
+//
+// SendChan has a lane for each priority, and the messages of
+// the higher priority lane are always received first. Since all
+// messages of a topic go through the same lane, the order of
+// the messages within a topic is preserved.
 type SendChan struct {
-	mu      sync.Mutex
-	buf     []*proto.MessageEntry
-	sendIdx int64
-	recvIdx int64
+	mu    sync.Mutex
+	lanes [numPriorities]sendLane
+
+	// pending is the number of buffered messages of each topic.
+	pending map[string]int64
+	// topicQuota is the max number of buffered messages of a topic,
+	// 0 means unlimited. It prevents a flood of messages of a topic
+	// from occupying the whole channel.
+	topicQuota int64
 
 	// hasNewMsg is a buffered channel that the sending goroutine(s)
 	// use to notify the receiver end.
@@ -46,20 +69,33 @@ type SendChan struct {
 	cap int64
 }
 
-// NewSendChan returns a new SendChan.
-func NewSendChan(cap int64) *SendChan {
-	return &SendChan{
-		buf:        make([]*proto.MessageEntry, cap),
+type sendLane struct {
+	buf     []*proto.MessageEntry
+	sendIdx int64
+	recvIdx int64
+}
+
+// NewSendChan returns a new SendChan. topicQuota is the max number of
+// buffered messages of a topic, 0 means unlimited.
+func NewSendChan(cap int64, topicQuota int64) *SendChan {
+	c := &SendChan{
+		pending:    make(map[string]int64),
+		topicQuota: topicQuota,
 		hasNewMsg:  make(chan struct{}, 1),
 		notifyChan: make(chan struct{}),
 		cap:        cap,
 	}
+	for i := range c.lanes {
+		c.lanes[i].buf = make([]*proto.MessageEntry, cap)
+	}
+	return c
 }
 
 // SendSync sends a message synchronously.
 func (c *SendChan) SendSync(
 	ctx context.Context,
 	topic string,
+	priority Priority,
 	value []byte,
 	closeCh <-chan struct{},
 	nextSeq func() int64,
@@ -68,7 +104,7 @@ func (c *SendChan) SendSync(
 	defer ticker.Stop()
 
 	for {
-		if ok, seq := c.SendAsync(topic, value, nextSeq); ok {
+		if ok, seq := c.SendAsync(topic, priority, value, nextSeq); ok {
 			return seq, nil
 		}
 
@@ -85,27 +121,36 @@ func (c *SendChan) SendSync(
 
 // SendAsync tries to send a message. If the message is accepted, nextSeq will be called
 // once, and the returned value will be used as the Sequence number of the message.
-func (c *SendChan) SendAsync(topic string, value []byte, nextSeq func() int64) (ok bool, seq int64) {
+// The message is rejected if the lane of the priority is full or the topic has
+// used up its quota.
+func (c *SendChan) SendAsync(
+	topic string, priority Priority, value []byte, nextSeq func() int64,
+) (ok bool, seq int64) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.sendIdx-c.recvIdx > c.cap {
+	lane := &c.lanes[priority]
+	if lane.sendIdx-lane.recvIdx > c.cap {
 		log.Panic("unreachable",
-			zap.Int64("sendIdx", c.sendIdx),
-			zap.Int64("recvIndex", c.recvIdx))
+			zap.Int64("sendIdx", lane.sendIdx),
+			zap.Int64("recvIndex", lane.recvIdx))
 	}
 
-	if c.sendIdx-c.recvIdx == c.cap {
+	if lane.sendIdx-lane.recvIdx == c.cap {
+		return false, 0
+	}
+	if c.topicQuota > 0 && c.pending[topic] >= c.topicQuota {
 		return false, 0
 	}
 
 	seq = nextSeq()
-	c.buf[c.sendIdx%c.cap] = &proto.MessageEntry{
+	lane.buf[lane.sendIdx%c.cap] = &proto.MessageEntry{
 		Topic:    topic,
 		Content:  value,
 		Sequence: seq,
 	}
-	c.sendIdx++
+	lane.sendIdx++
+	c.pending[topic]++
 
 	select {
 	case c.hasNewMsg <- struct{}{}:
@@ -115,6 +160,14 @@ func (c *SendChan) SendAsync(topic string, value []byte, nextSeq func() int64) (
 	return true, seq
 }
 
+// Pending returns the number of buffered messages of the topic.
+func (c *SendChan) Pending(topic string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.pending[topic]
+}
+
 // Receive receives one message from the channel.
 // If a message is received from `tick`, the function will return
 // (nil, false, nil).
@@ -149,19 +202,27 @@ func (c *SendChan) doReceive() *proto.MessageEntry {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.sendIdx < c.recvIdx {
-		log.Panic("unreachable",
-			zap.Int64("sendIdx", c.sendIdx),
-			zap.Int64("recvIndex", c.recvIdx))
-	}
+	// Drains the lanes from the highest priority.
+	for i := len(c.lanes) - 1; i >= 0; i-- {
+		lane := &c.lanes[i]
+		if lane.sendIdx < lane.recvIdx {
+			log.Panic("unreachable",
+				zap.Int64("sendIdx", lane.sendIdx),
+				zap.Int64("recvIndex", lane.recvIdx))
+		}
 
-	if c.sendIdx == c.recvIdx {
-		return nil
-	}
+		if lane.sendIdx == lane.recvIdx {
+			continue
+		}
 
-	var ret *proto.MessageEntry
-	ret, c.buf[c.recvIdx%c.cap] = c.buf[c.recvIdx%c.cap], nil
-	c.recvIdx++
+		var ret *proto.MessageEntry
+		ret, lane.buf[lane.recvIdx%c.cap] = lane.buf[lane.recvIdx%c.cap], nil
+		lane.recvIdx++
 
-	return ret
+		if c.pending[ret.Topic]--; c.pending[ret.Topic] == 0 {
+			delete(c.pending, ret.Topic)
+		}
+		return ret
+	}
+	return nil
 }
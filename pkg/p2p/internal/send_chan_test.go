@@ -35,7 +35,7 @@ func TestSendChanBasics(t *testing.T) {
 	defer cancel()
 
 	seq := atomic.NewInt64(0)
-	c := NewSendChan(defaultSendChanCap)
+	c := NewSendChan(defaultSendChanCap, 0)
 
 	var wg sync.WaitGroup
 
@@ -48,6 +48,7 @@ func TestSendChanBasics(t *testing.T) {
 			for j := 0; j < numMsgPerProducer; {
 				ok, seq := c.SendAsync(
 					"test-topic",
+					PriorityNormal,
 					[]byte("test-value"),
 					func() int64 {
 						return seq.Inc()
@@ -96,7 +97,7 @@ func TestSendChanSendSync(t *testing.T) {
 	dummyCloseCh := make(chan struct{})
 
 	seq := atomic.NewInt64(0)
-	c := NewSendChan(defaultSendChanCap)
+	c := NewSendChan(defaultSendChanCap, 0)
 
 	var wg sync.WaitGroup
 
@@ -110,6 +111,7 @@ func TestSendChanSendSync(t *testing.T) {
 				seq, err := c.SendSync(
 					ctx,
 					"test-topic",
+					PriorityNormal,
 					[]byte("test-value"),
 					dummyCloseCh,
 					func() int64 {
@@ -149,17 +151,63 @@ func TestSendChanSendSync(t *testing.T) {
 	cancel()
 }
 
+func TestSendChanPriorityAndQuota(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := NewSendChan(defaultSendChanCap, 2)
+	nextSeq := make(map[string]*atomic.Int64)
+	send := func(topic string, priority Priority) bool {
+		if _, ok := nextSeq[topic]; !ok {
+			nextSeq[topic] = atomic.NewInt64(0)
+		}
+		ok, _ := c.SendAsync(topic, priority, []byte("test-value"), nextSeq[topic].Inc)
+		return ok
+	}
+
+	// The topic can not buffer more messages than its quota.
+	require.True(t, send("heartbeat", PriorityNormal))
+	require.True(t, send("heartbeat", PriorityNormal))
+	require.False(t, send("heartbeat", PriorityNormal))
+	require.Equal(t, int64(2), c.Pending("heartbeat"))
+
+	// The other topics are not affected.
+	require.True(t, send("dispatch", PriorityHigh))
+	require.True(t, send("dispatch", PriorityHigh))
+
+	// The messages of high priority are received first,
+	// and the order within a topic is preserved.
+	dummyTicker := make(chan time.Time)
+	for _, expected := range []struct {
+		topic string
+		seq   int64
+	}{
+		{"dispatch", 1}, {"dispatch", 2}, {"heartbeat", 1}, {"heartbeat", 2},
+	} {
+		msg, ok, err := c.Receive(ctx, dummyTicker)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, expected.topic, msg.Topic)
+		require.Equal(t, expected.seq, msg.Sequence)
+	}
+	require.Equal(t, int64(0), c.Pending("heartbeat"))
+	require.Equal(t, int64(0), c.Pending("dispatch"))
+
+	// The quota is released after the messages are received.
+	require.True(t, send("heartbeat", PriorityNormal))
+}
+
 func BenchmarkSendChanSendAsyncSPSC(b *testing.B) {
 	var wg sync.WaitGroup
 
 	seq := atomic.NewInt64(0)
-	c := NewSendChan(defaultSendChanCap)
+	c := NewSendChan(defaultSendChanCap, 0)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		for j := 0; j < b.N; {
-			ok, _ := c.SendAsync("test-topic", []byte("test-value"), func() int64 {
+			ok, _ := c.SendAsync("test-topic", PriorityNormal, []byte("test-value"), func() int64 {
 				return seq.Inc()
 			})
 			if !ok {
@@ -194,7 +242,7 @@ func BenchmarkSendChanSendSyncSPSC(b *testing.B) {
 	dummyCloseCh := make(chan struct{})
 
 	seq := atomic.NewInt64(0)
-	c := NewSendChan(defaultSendChanCap)
+	c := NewSendChan(defaultSendChanCap, 0)
 
 	wg.Add(1)
 	go func() {
@@ -203,6 +251,7 @@ func BenchmarkSendChanSendSyncSPSC(b *testing.B) {
 			_, _ = c.SendSync(
 				context.TODO(),
 				"test-topic",
+				PriorityNormal,
 				[]byte("test-value"),
 				dummyCloseCh, func() int64 {
 					return seq.Inc()
@@ -233,14 +282,14 @@ func BenchmarkSendChanSendAsyncMPSC8(b *testing.B) {
 	var wg sync.WaitGroup
 
 	seq := atomic.NewInt64(0)
-	c := NewSendChan(defaultSendChanCap)
+	c := NewSendChan(defaultSendChanCap, 0)
 
 	for i := 0; i < 8; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			for j := 0; j < b.N; {
-				ok, _ := c.SendAsync("test-topic", []byte("test-value"), func() int64 {
+				ok, _ := c.SendAsync("test-topic", PriorityNormal, []byte("test-value"), func() int64 {
 					return seq.Inc()
 				})
 				if !ok {
@@ -274,7 +323,7 @@ func BenchmarkSendChanSendSyncMPSC8(b *testing.B) {
 	var wg sync.WaitGroup
 
 	seq := atomic.NewInt64(0)
-	c := NewSendChan(defaultSendChanCap)
+	c := NewSendChan(defaultSendChanCap, 0)
 	dummyCloseCh := make(chan struct{})
 
 	for i := 0; i < 8; i++ {
@@ -285,6 +334,7 @@ func BenchmarkSendChanSendSyncMPSC8(b *testing.B) {
 				_, _ = c.SendSync(
 					context.TODO(),
 					"test-topic",
+					PriorityNormal,
 					[]byte("test-value"),
 					dummyCloseCh, func() int64 {
 						return seq.Inc()
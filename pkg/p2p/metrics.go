@@ -100,10 +100,24 @@ var (
 		Name:      "ack_count",
 		Help:      "count of ack messages received",
 	}, []string{"from"})
+
+	clientPendingMessageCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "message_client",
+		Name:      "pending_message_count",
+		Help:      "count of messages waiting in the sending queue",
+	}, []string{"topic"})
+
+	clientDroppedMessageCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ticdc",
+		Subsystem: "message_client",
+		Name:      "dropped_message_count",
+		Help:      "count of messages rejected by the sending queue",
+	}, []string{"topic"})
 )
 
 // InitMetrics initializes metrics used by pkg/p2p
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(serverStreamCount)
 	registry.MustRegister(serverMessageCount)
 	registry.MustRegister(serverMessageBatchHistogram)
@@ -115,4 +129,6 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(clientCount)
 	registry.MustRegister(clientMessageCount)
 	registry.MustRegister(clientAckCount)
+	registry.MustRegister(clientPendingMessageCount)
+	registry.MustRegister(clientDroppedMessageCount)
 }
@@ -13,7 +13,10 @@
 
 package p2p
 
-import "github.com/pingcap/tiflow/proto/p2p"
+import (
+	"github.com/pingcap/tiflow/pkg/p2p/internal"
+	"github.com/pingcap/tiflow/proto/p2p"
+)
 
 type (
 	// NodeID represents the identifier of a sender node.
@@ -25,4 +28,14 @@ type (
 	Seq = int64
 	// MessageServerStream is an alias for the protobuf-generated interface for the message service.
 	MessageServerStream = p2p.CDCPeerToPeer_SendMessageServer
+	// Priority represents the priority of the messages of a topic.
+	Priority = internal.Priority
+)
+
+const (
+	// PriorityNormal is the default priority of a topic.
+	PriorityNormal = internal.PriorityNormal
+	// PriorityHigh is the priority of the topics whose messages
+	// are sent before the messages of normal priority.
+	PriorityHigh = internal.PriorityHigh
 )
@@ -43,6 +43,7 @@ const (
 	gcServiceSafePointURL = "/pd/api/v1/gc/safepoint"
 	healthyAPI            = "/pd/api/v1/health"
 	scanRegionAPI         = "/pd/api/v1/regions/key"
+	regionStatsAPI        = "/pd/api/v1/stats/region"
 
 	// Split the default rule by following keys to keep metadata region isolated
 	// from the normal data area.
@@ -101,6 +102,7 @@ type PDAPIClient interface {
 	CollectMemberEndpoints(ctx context.Context) ([]string, error)
 	Healthy(ctx context.Context, endpoint string) error
 	ScanRegions(ctx context.Context, span tablepb.Span) ([]RegionInfo, error)
+	GetRegionStats(ctx context.Context) (*RegionStats, error)
 	Close()
 }
 
@@ -273,6 +275,45 @@ func (pc *pdAPIClient) scanRegions(
 	return regions, nil
 }
 
+// RegionStats is the statistics of all regions in the cluster.
+// NOTE: This type is a copy of github.com/tikv/pd/server/statistics.RegionStats.
+// To reduce dependency tree, we do not import the api package directly.
+type RegionStats struct {
+	Count int `json:"count"`
+	// StorageSize is the approximate size of all regions, in MiB.
+	StorageSize int64 `json:"storage_size"`
+	// StorageKeys is the approximate number of keys of all regions.
+	StorageKeys int64 `json:"storage_keys"`
+}
+
+// GetRegionStats returns the statistics of all regions from PD.
+func (pc *pdAPIClient) GetRegionStats(ctx context.Context) (*RegionStats, error) {
+	var resp *RegionStats
+	err := retry.Do(ctx, func() error {
+		ctx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+
+		url := pc.grpcClient.GetLeaderAddr() + regionStatsAPI
+		respData, err := pc.httpClient.DoRequest(ctx, url, http.MethodGet, nil, nil)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		stats := RegionStats{}
+		if err := json.Unmarshal(respData, &stats); err != nil {
+			return errors.Trace(err)
+		}
+		resp = &stats
+		return nil
+	}, retry.WithMaxTries(defaultMaxRetry), retry.WithIsRetryableErr(func(err error) bool {
+		switch errors.Cause(err) {
+		case context.Canceled:
+			return false
+		}
+		return true
+	}))
+	return resp, err
+}
+
 // ServiceSafePoint contains gc service safe point
 type ServiceSafePoint struct {
 	ServiceID string `json:"service_id"`
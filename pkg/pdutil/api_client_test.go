@@ -116,6 +116,28 @@ func TestListGcServiceSafePoint(t *testing.T) {
 	mockClient.testServer.Close()
 }
 
+func TestGetRegionStats(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockPDClient(true)
+	mockClient.testServer.Close()
+	mockClient.testServer = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, regionStatsAPI, r.URL.Path)
+			_, _ = w.Write([]byte(`{"count":3,"storage_size":1024,"storage_keys":4096}`))
+		},
+	))
+	mockClient.url = mockClient.testServer.URL
+	defer mockClient.testServer.Close()
+
+	pc, err := NewPDAPIClient(mockClient, nil)
+	require.NoError(t, err)
+	defer pc.Close()
+	stats, err := pc.GetRegionStats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &RegionStats{Count: 3, StorageSize: 1024, StorageKeys: 4096}, stats)
+}
+
 // LabelRulePatch is the patch to update the label rules.
 // NOTE: This type is exported by HTTP API. Please pay more attention when modifying it.
 // Copied from github.com/tikv/pd/server/schedule/labeler
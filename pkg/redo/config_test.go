@@ -187,3 +187,21 @@ func TestInitExternalStorage(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestValidateS3StorageOptions(t *testing.T) {
+	t.Parallel()
+
+	urls := []string{
+		"s3://bucket/prefix?sse=aes",
+		"s3://bucket/prefix?sse-kms-key-id=key",
+		"s3://bucket/prefix?acl=public",
+		"s3://bucket/prefix?storage-class=DEEP_ARCHIVE",
+		"s3://bucket/prefix?tagging=env=prod,env=test",
+	}
+	for _, urlStr := range urls {
+		url, err := storage.ParseRawURL(urlStr)
+		require.NoError(t, err)
+		err = ValidateStorage(url)
+		require.ErrorContains(t, err, "ErrExternalStorageInvalidConfig", urlStr)
+	}
+}
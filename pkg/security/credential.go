@@ -112,6 +112,10 @@ func (s *Credential) AddSelfCommonName() error {
 // ToTLSConfigWithVerify constructs a `*tls.Config` from the CA, certification and key
 // paths, and add verify for CN.
 //
+// The certificate and the key are reloaded once their files are modified. The
+// CA certificates used to verify clients are reloaded too, but the ones used
+// to verify servers are only loaded once.
+//
 // If the CA path is empty, returns nil.
 func ToTLSConfigWithVerify(
 	caPath, certPath, keyPath string, verifyCN []string,
@@ -121,15 +125,10 @@ func ToTLSConfigWithVerify(
 	}
 
 	// Create a certificate pool from CA
-	certPool := x509.NewCertPool()
-	ca, err := os.ReadFile(caPath)
+	ca := newCAReloader(caPath)
+	certPool, err := ca.load()
 	if err != nil {
-		return nil, errors.Annotate(err, "could not read ca certificate")
-	}
-
-	// Append the certificates from the CA
-	if !certPool.AppendCertsFromPEM(ca) {
-		return nil, errors.New("failed to append ca certs")
+		return nil, err
 	}
 
 	tlsCfg := &tls.Config{
@@ -140,22 +139,27 @@ func ToTLSConfigWithVerify(
 	}
 
 	if len(certPath) != 0 && len(keyPath) != 0 {
-		loadCert := func() (*tls.Certificate, error) {
-			cert, err := tls.LoadX509KeyPair(certPath, keyPath)
-			if err != nil {
-				return nil, errors.Annotate(err, "could not load client key pair")
-			}
-			return &cert, nil
-		}
+		keyPair := newKeyPairReloader(certPath, keyPath)
 		tlsCfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
-			return loadCert()
+			return keyPair.load()
 		}
 		tlsCfg.GetCertificate = func(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
-			return loadCert()
+			return keyPair.load()
 		}
 	}
 
 	addVerifyPeerCertificate(tlsCfg, verifyCN)
+	tlsCfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		latestPool, err := ca.load()
+		if err != nil || latestPool == certPool {
+			// Use tlsCfg as is.
+			return nil, nil
+		}
+		cfg := tlsCfg.Clone()
+		cfg.ClientCAs = latestPool
+		cfg.GetConfigForClient = nil
+		return cfg, nil
+	}
 	return tlsCfg, nil
 }
 
@@ -0,0 +1,172 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// fileStamp identifies a version of a file, the file is considered modified
+// if its modification time or size is changed.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(path string) (fileStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, errors.Trace(err)
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// keyPair is a loaded certificate and the versions of its files.
+type keyPair struct {
+	cert      *tls.Certificate
+	certStamp fileStamp
+	keyStamp  fileStamp
+}
+
+// keyPairReloader loads a certificate and its key from files, and reloads
+// them once the files are modified, so that certificates can be rotated
+// without restarting the server.
+type keyPairReloader struct {
+	certPath string
+	keyPath  string
+	current  atomic.Pointer[keyPair]
+}
+
+func newKeyPairReloader(certPath, keyPath string) *keyPairReloader {
+	return &keyPairReloader{certPath: certPath, keyPath: keyPath}
+}
+
+// load returns the latest certificate. If the files are modified but can't be
+// loaded, e.g., the certificate is replaced but the key is not yet, the
+// previous certificate is returned.
+func (r *keyPairReloader) load() (*tls.Certificate, error) {
+	current := r.current.Load()
+	next, err := r.reload(current)
+	if err != nil {
+		if current == nil {
+			return nil, errors.Annotate(err, "could not load client key pair")
+		}
+		log.Warn("failed to reload certificate, use the previous one",
+			zap.String("certPath", r.certPath), zap.String("keyPath", r.keyPath),
+			zap.Error(err))
+		return current.cert, nil
+	}
+	return next.cert, nil
+}
+
+// reload loads the key pair if the files are modified after current is loaded.
+func (r *keyPairReloader) reload(current *keyPair) (*keyPair, error) {
+	certStamp, err := statFile(r.certPath)
+	if err != nil {
+		return nil, err
+	}
+	keyStamp, err := statFile(r.keyPath)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && current.certStamp == certStamp && current.keyStamp == keyStamp {
+		return current, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	next := &keyPair{cert: &cert, certStamp: certStamp, keyStamp: keyStamp}
+	r.current.Store(next)
+	if current != nil {
+		log.Info("certificate reloaded",
+			zap.String("certPath", r.certPath), zap.String("keyPath", r.keyPath))
+	}
+	return next, nil
+}
+
+// certPool is a loaded CA certificate pool and the version of its file.
+type certPool struct {
+	pool  *x509.CertPool
+	stamp fileStamp
+}
+
+// caReloader loads CA certificates from a file, and reloads them once the
+// file is modified.
+type caReloader struct {
+	caPath  string
+	current atomic.Pointer[certPool]
+}
+
+func newCAReloader(caPath string) *caReloader {
+	return &caReloader{caPath: caPath}
+}
+
+// load returns the latest CA certificate pool. If the file is modified but
+// can't be loaded, the previous pool is returned.
+func (r *caReloader) load() (*x509.CertPool, error) {
+	current := r.current.Load()
+	next, err := r.reload(current)
+	if err != nil {
+		if current == nil {
+			return nil, err
+		}
+		log.Warn("failed to reload ca certificate, use the previous one",
+			zap.String("caPath", r.caPath), zap.Error(err))
+		return current.pool, nil
+	}
+	return next.pool, nil
+}
+
+// reload loads the CA certificates if the file is modified after current is
+// loaded.
+func (r *caReloader) reload(current *certPool) (*certPool, error) {
+	stamp, err := statFile(r.caPath)
+	if err != nil {
+		return nil, errors.Annotate(err, "could not read ca certificate")
+	}
+	if current != nil && current.stamp == stamp {
+		return current, nil
+	}
+	pool, err := loadCertPool(r.caPath)
+	if err != nil {
+		return nil, err
+	}
+	next := &certPool{pool: pool, stamp: stamp}
+	r.current.Store(next)
+	if current != nil {
+		log.Info("ca certificate reloaded", zap.String("caPath", r.caPath))
+	}
+	return next, nil
+}
+
+func loadCertPool(caPath string) (*x509.CertPool, error) {
+	ca, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, errors.Annotate(err, "could not read ca certificate")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("failed to append ca certs")
+	}
+	return pool, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rewriteFile replaces the content of the file and bumps its modification
+// time, so that the change is noticed even if the file size is not changed.
+func rewriteFile(t *testing.T, path string, content []byte, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestKeyPairReloader(t *testing.T) {
+	t.Parallel()
+
+	ca, err := NewCA()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+
+	certPEM, keyPEM, err := ca.GenerateCerts("server1")
+	require.NoError(t, err)
+	now := time.Now()
+	rewriteFile(t, certPath, certPEM, now)
+	rewriteFile(t, keyPath, keyPEM, now)
+
+	r := newKeyPairReloader(certPath, keyPath)
+	cert1, err := r.load()
+	require.NoError(t, err)
+	cert, err := r.load()
+	require.NoError(t, err)
+	require.Same(t, cert1, cert)
+
+	// The certificate is reloaded after the files are modified.
+	certPEM, keyPEM, err = ca.GenerateCerts("server2")
+	require.NoError(t, err)
+	rewriteFile(t, certPath, certPEM, now.Add(time.Second))
+	rewriteFile(t, keyPath, keyPEM, now.Add(time.Second))
+	cert2, err := r.load()
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert2.Certificate[0])
+	require.NoError(t, err)
+	require.Equal(t, "server2", leaf.Subject.CommonName)
+
+	// The previous certificate is used if the key doesn't match.
+	certPEM, _, err = ca.GenerateCerts("server3")
+	require.NoError(t, err)
+	rewriteFile(t, certPath, certPEM, now.Add(2*time.Second))
+	cert, err = r.load()
+	require.NoError(t, err)
+	require.Same(t, cert2, cert)
+
+	// It fails if the certificate can't be loaded at the first time.
+	_, err = newKeyPairReloader(certPath, keyPath).load()
+	require.ErrorContains(t, err, "could not load client key pair")
+}
+
+func TestTLSConfigReloadClientCA(t *testing.T) {
+	t.Parallel()
+
+	serverCA, err := NewCA()
+	require.NoError(t, err)
+	clientCA, err := NewCA()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+
+	certPEM, keyPEM, err := serverCA.GenerateCerts("server")
+	require.NoError(t, err)
+	now := time.Now()
+	rewriteFile(t, caPath, serverCA.CAPEM, now)
+	rewriteFile(t, certPath, certPEM, now)
+	rewriteFile(t, keyPath, keyPEM, now)
+	serverCfg, err := ToTLSConfigWithVerify(caPath, certPath, keyPath, []string{"client"})
+	require.NoError(t, err)
+
+	// The client certificate is issued by a CA which the server doesn't trust.
+	clientCertPEM, clientKeyPEM, err := clientCA.GenerateCerts("client")
+	require.NoError(t, err)
+	clientCert, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+	require.NoError(t, err)
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(serverCA.CAPEM))
+	clientCfg := &tls.Config{
+		RootCAs:      rootCAs,
+		Certificates: []tls.Certificate{clientCert},
+		ServerName:   "127.0.0.1",
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverCfg)
+	require.NoError(t, err)
+	defer l.Close()
+	handshake := func() error {
+		errCh := make(chan error, 1)
+		go func() {
+			conn, err := l.Accept()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			defer conn.Close()
+			errCh <- conn.(*tls.Conn).Handshake()
+		}()
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+		_ = tls.Client(conn, clientCfg).Handshake()
+		return <-errCh
+	}
+	require.ErrorContains(t, handshake(), "unknown authority")
+
+	// The server trusts the client after the CA file is updated.
+	rewriteFile(t, caPath, append(serverCA.CAPEM, clientCA.CAPEM...), now.Add(time.Second))
+	require.NoError(t, handshake())
+}
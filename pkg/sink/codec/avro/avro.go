@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
@@ -46,13 +47,19 @@ type BatchEncoder struct {
 	namespace          string
 	keySchemaManager   *SchemaManager
 	valueSchemaManager *SchemaManager
-	result             []*common.Message
+	// compatibilities records the compatibility of the schemas of tables,
+	// it is nil if the encoder is not built by a builder.
+	compatibilities *schemaCompatibilities
+	result          []*common.Message
 }
 
 // Options is used to initialize the encoder, control the encoding behavior.
 type Options struct {
 	EnableTiDBExtension bool
 	EnableRowChecksum   bool
+	// CorruptionHandleLevel is only used by the decoder, it decides how to
+	// handle the rows whose checksum mismatch or which are marked as corrupted.
+	CorruptionHandleLevel string
 
 	// EnableWatermarkEvent set to true, avro encode DDL and checkpoint event
 	// and send to the downstream kafka, they cannot be consumed by the confluent official consumer
@@ -96,6 +103,17 @@ func (a *BatchEncoder) AppendRowChangedEvent(
 	topic string,
 	e *model.RowChangedEvent,
 	callback func(),
+) error {
+	err := a.appendRowChangedEvent(ctx, topic, e, callback)
+	a.compatibilities.observe(e.Table.TableID, err)
+	return err
+}
+
+func (a *BatchEncoder) appendRowChangedEvent(
+	ctx context.Context,
+	topic string,
+	e *model.RowChangedEvent,
+	callback func(),
 ) error {
 	message := common.NewMsg(
 		config.ProtocolAvro,
@@ -977,6 +995,7 @@ type batchEncoderBuilder struct {
 	config             *common.Config
 	keySchemaManager   *SchemaManager
 	valueSchemaManager *SchemaManager
+	compatibilities    *schemaCompatibilities
 }
 
 const (
@@ -993,12 +1012,17 @@ func NewBatchEncoderBuilder(ctx context.Context,
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	for _, m := range []*SchemaManager{keySchemaManager, valueSchemaManager} {
+		m.subjectNameStrategy = config.AvroSubjectNameStrategy
+		m.compatibility = config.AvroSchemaCompatibility
+	}
 
 	return &batchEncoderBuilder{
 		namespace:          contextutil.ChangefeedIDFromCtx(ctx).Namespace,
 		config:             config,
 		keySchemaManager:   keySchemaManager,
 		valueSchemaManager: valueSchemaManager,
+		compatibilities:    newSchemaCompatibilities(),
 	}, nil
 }
 
@@ -1008,6 +1032,7 @@ func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
 		namespace:          b.namespace,
 		keySchemaManager:   b.keySchemaManager,
 		valueSchemaManager: b.valueSchemaManager,
+		compatibilities:    b.compatibilities,
 		result:             make([]*common.Message, 0, 1),
 		Options: &Options{
 			EnableTiDBExtension:        b.config.EnableTiDBExtension,
@@ -1019,3 +1044,10 @@ func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
 	}
 	return encoder
 }
+
+// SchemaCompatibility implements codec.SchemaCompatibilityReporter.
+func (b *batchEncoderBuilder) SchemaCompatibility(
+	tableID model.TableID,
+) tablepb.SchemaCompatibility {
+	return b.compatibilities.get(tableID)
+}
@@ -23,11 +23,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linkedin/goavro/v2"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
@@ -1022,3 +1024,54 @@ func TestArvoAppendRowChangedEventWithCallback(t *testing.T) {
 		require.Equal(t, expected, count, "expected one callback be called")
 	}
 }
+
+func TestAvroAppendRowChangedEventSchemaCompatibility(t *testing.T) {
+	o := &Options{
+		DecimalHandlingMode:        "precise",
+		BigintUnsignedHandlingMode: "long",
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	encoder, err := setupEncoderAndSchemaRegistry(
+		ctx, "http://127.0.0.1:8081", nil, o)
+	defer teardownEncoderAndSchemaRegistry()
+	require.NoError(t, err)
+	encoder.compatibilities = newSchemaCompatibilities()
+	builder := &batchEncoderBuilder{compatibilities: encoder.compatibilities}
+
+	newRow := func(tableID model.TableID, table string) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs:  1,
+			Table:     &model.TableName{Schema: "a", Table: table, TableID: tableID},
+			TableInfo: &model.TableInfo{TableName: model.TableName{Schema: "a", Table: table}},
+			Columns: []*model.Column{{
+				Name:  "col1",
+				Type:  mysql.TypeVarchar,
+				Value: []byte("aa"),
+			}},
+			ColInfos: []rowcodec.ColInfo{{
+				ID:            1000,
+				IsPKHandle:    true,
+				VirtualGenCol: false,
+				Ft:            types.NewFieldType(mysql.TypeVarchar),
+			}},
+		}
+	}
+
+	require.NoError(t, encoder.AppendRowChangedEvent(ctx, "b", newRow(1, "b"), nil))
+	require.Equal(t, tablepb.SchemaCompatibilityCompatible, builder.SchemaCompatibility(1))
+
+	httpmock.RegisterResponder("POST", `=~^http://127.0.0.1:8081/subjects/(.+)/versions`,
+		httpmock.NewStringResponder(409, `{"error_code":409}`))
+	err = encoder.AppendRowChangedEvent(ctx, "c", newRow(2, "c"), nil)
+	require.True(t, errors.ErrAvroIncompatibleSchema.Equal(err))
+	require.Equal(t, tablepb.SchemaCompatibilityIncompatible, builder.SchemaCompatibility(2))
+
+	httpmock.RegisterResponder("POST", `=~^http://127.0.0.1:8081/subjects/(.+)/versions`,
+		httpmock.NewStringResponder(422, `{"error_code":422}`))
+	err = encoder.AppendRowChangedEvent(ctx, "d", newRow(3, "d"), nil)
+	require.True(t, errors.ErrAvroSchemaAPIError.Equal(err))
+	require.Equal(t, tablepb.SchemaCompatibilityPendingRegistration, builder.SchemaCompatibility(3))
+	require.Equal(t, tablepb.SchemaCompatibilityCompatible, builder.SchemaCompatibility(1))
+}
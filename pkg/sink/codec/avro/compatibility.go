@@ -0,0 +1,65 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"sync"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// schemaCompatibilities records the compatibility of the schemas of tables
+// with the schema registry, it is shared by the encoders of a builder.
+type schemaCompatibilities struct {
+	mu     sync.RWMutex
+	tables map[model.TableID]tablepb.SchemaCompatibility
+}
+
+func newSchemaCompatibilities() *schemaCompatibilities {
+	return &schemaCompatibilities{
+		tables: make(map[model.TableID]tablepb.SchemaCompatibility),
+	}
+}
+
+// observe records the compatibility of the table by the result of encoding
+// an event of it. Errors which are not returned by the registry are ignored.
+func (c *schemaCompatibilities) observe(tableID model.TableID, err error) {
+	if c == nil {
+		return
+	}
+	var compatibility tablepb.SchemaCompatibility
+	switch {
+	case err == nil:
+		compatibility = tablepb.SchemaCompatibilityCompatible
+	case cerror.ErrAvroIncompatibleSchema.Equal(err):
+		compatibility = tablepb.SchemaCompatibilityIncompatible
+	case cerror.ErrAvroSchemaAPIError.Equal(err):
+		compatibility = tablepb.SchemaCompatibilityPendingRegistration
+	default:
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tables[tableID] = compatibility
+}
+
+// get returns the compatibility of the table, a table without any event
+// encoded yet is compatible.
+func (c *schemaCompatibilities) get(tableID model.TableID) tablepb.SchemaCompatibility {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.tables[tableID]
+}
@@ -30,7 +30,9 @@ import (
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"go.uber.org/zap"
 )
 
@@ -215,6 +217,7 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 	var (
 		operation string
 		commitTs  int64
+		checksum  *integrity.Checksum
 	)
 	if !isDelete {
 		o, ok := valueMap[tidbOp]
@@ -231,24 +234,25 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 
 		o, ok = valueMap[tidbRowLevelChecksum]
 		if ok {
-			checksum := o.(string)
-			expected, err := strconv.ParseUint(checksum, 10, 64)
+			expected, err := strconv.ParseUint(o.(string), 10, 32)
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
-
+			checksum = &integrity.Checksum{Current: uint32(expected)}
 			if o, ok := valueMap[tidbCorrupted]; ok {
-				corrupted := o.(bool)
-				if corrupted {
-					log.Warn("row data is corrupted",
-						zap.String("topic", d.topic),
-						zap.String("checksum", checksum))
-				}
+				checksum.Corrupted = o.(bool)
+			}
+			if o, ok := valueMap[tidbChecksumVersion]; ok {
+				checksum.Version = int(o.(int32))
 			}
 
-			if err := d.verifyChecksum(columns, expected); err != nil {
+			matched, err := d.verifyChecksum(columns, checksum.Current)
+			if err != nil {
 				return nil, errors.Trace(err)
 			}
+			if !matched {
+				checksum.Corrupted = true
+			}
 		}
 	}
 
@@ -264,11 +268,16 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 		Table:  tableName,
 	}
 
+	event.Checksum = checksum
+
 	if operation == insertOperation {
 		event.Columns = columns
 	} else {
 		event.PreColumns = columns
 	}
+	if err := common.HandleCorruptedRow(d.CorruptionHandleLevel, event); err != nil {
+		return nil, err
+	}
 	return event, nil
 }
 
@@ -361,7 +370,9 @@ func (d *decoder) decodeValue(ctx context.Context) (map[string]interface{}, stri
 	return result, codec.Schema(), nil
 }
 
-func (d *decoder) verifyChecksum(columns []*model.Column, expected uint64) error {
+// verifyChecksum returns true if the checksum calculated by the columns
+// matches the expected one.
+func (d *decoder) verifyChecksum(columns []*model.Column, expected uint32) (bool, error) {
 	calculator := rowcodec.RowData{
 		Cols: make([]rowcodec.ColData, 0, len(columns)),
 		Data: make([]byte, 0),
@@ -373,7 +384,7 @@ func (d *decoder) verifyChecksum(columns []*model.Column, expected uint64) error
 
 		data, err := d.buildDatum(col.Value, col.Type)
 		if err != nil {
-			return errors.Trace(err)
+			return false, errors.Trace(err)
 		}
 		calculator.Cols = append(calculator.Cols, rowcodec.ColData{
 			ColumnInfo: info,
@@ -382,20 +393,21 @@ func (d *decoder) verifyChecksum(columns []*model.Column, expected uint64) error
 	}
 	checksum, err := calculator.Checksum()
 	if err != nil {
-		return errors.Trace(err)
+		return false, errors.Trace(err)
 	}
 
-	if uint64(checksum) != expected {
+	if checksum != expected {
 		log.Error("checksum mismatch",
-			zap.Uint64("expected", expected),
+			zap.String("topic", d.topic),
+			zap.Uint32("expected", expected),
 			zap.Uint32("actual", checksum))
-		return errors.New("checksum mismatch")
+		return false, nil
 	}
 
 	log.Debug("checksum passed",
-		zap.Uint64("expected", expected), zap.Uint32("actual", checksum))
+		zap.Uint32("expected", expected), zap.Uint32("actual", checksum))
 
-	return nil
+	return true, nil
 }
 
 func (d *decoder) buildDatum(value interface{}, typ byte) (types.Datum, error) {
@@ -32,6 +32,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/httputil"
 	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"go.uber.org/zap"
 )
 
@@ -41,6 +42,15 @@ import (
 type SchemaManager struct {
 	registryURL   string
 	subjectSuffix string
+	// subjectNameStrategy decides the subject which a schema is registered to.
+	subjectNameStrategy string
+	// compatibility is set to a subject before the first schema is registered
+	// to it, empty means the global compatibility level of the registry is used.
+	compatibility string
+
+	compatibilityMu sync.Mutex
+	// compatibilitySet records the subjects whose compatibility level is set.
+	compatibilitySet map[string]struct{}
 
 	credential *security.Credential // placeholder, currently always nil
 
@@ -66,6 +76,10 @@ type registerRequest struct {
 	// SchemaType string `json:"schemaType"`
 }
 
+type compatibilityRequest struct {
+	Compatibility string `json:"compatibility"`
+}
+
 type registerResponse struct {
 	SchemaID int `json:"id"`
 }
@@ -132,9 +146,11 @@ func newAvroSchemaManager(
 	)
 
 	return &SchemaManager{
-		registryURL:   registryURL,
-		cache:         make(map[string]*schemaCacheEntry, 1),
-		subjectSuffix: subjectSuffix,
+		registryURL:         registryURL,
+		cache:               make(map[string]*schemaCacheEntry, 1),
+		subjectSuffix:       subjectSuffix,
+		subjectNameStrategy: common.AvroSubjectNameStrategyTopic,
+		compatibilitySet:    make(map[string]struct{}),
 	}, nil
 }
 
@@ -159,9 +175,14 @@ func (m *SchemaManager) Register(
 		log.Error("Could not marshal request to the Registry", zap.Error(err))
 		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
-	uri := m.registryURL + "/subjects/" + url.QueryEscape(
-		m.topicNameToSchemaSubject(topicName),
-	) + "/versions"
+	subject, err := m.schemaSubject(topicName, schema)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if err := m.setCompatibility(ctx, subject); err != nil {
+		return 0, errors.Trace(err)
+	}
+	uri := m.registryURL + "/subjects/" + url.QueryEscape(subject) + "/versions"
 	log.Info("Registering schema", zap.String("uri", uri), zap.ByteString("payload", payload))
 
 	req, err := http.NewRequestWithContext(ctx, "POST", uri, bytes.NewReader(payload))
@@ -187,10 +208,23 @@ func (m *SchemaManager) Register(
 		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
-	if resp.StatusCode != 200 {
+	if resp.StatusCode == http.StatusConflict {
 		// https://docs.confluent.io/platform/current/schema-registry/develop/api.html \
 		// #post--subjects-(string-%20subject)-versions
 		// 409 for incompatible schema
+		log.Error("Schema is incompatible with the registered schemas",
+			zap.String("subject", subject),
+			zap.String("compatibility", m.compatibility),
+			zap.ByteString("requestBody", payload),
+			zap.ByteString("responseBody", body))
+		compatibility := m.compatibility
+		if compatibility == "" {
+			compatibility = "of the registry"
+		}
+		return 0, cerror.ErrAvroIncompatibleSchema.GenWithStackByArgs(subject, compatibility)
+	}
+
+	if resp.StatusCode != 200 {
 		log.Error(
 			"Failed to register schema to the Registry, HTTP error",
 			zap.Int("status", resp.StatusCode),
@@ -481,6 +515,95 @@ func httpRetry(
 	return resp, nil
 }
 
+// setCompatibility sets the compatibility level of the subject once,
+// before the first schema is registered to it.
+func (m *SchemaManager) setCompatibility(ctx context.Context, subject string) error {
+	if m.compatibility == "" {
+		return nil
+	}
+	m.compatibilityMu.Lock()
+	defer m.compatibilityMu.Unlock()
+	if _, ok := m.compatibilitySet[subject]; ok {
+		return nil
+	}
+
+	payload, err := json.Marshal(&compatibilityRequest{Compatibility: m.compatibility})
+	if err != nil {
+		log.Error("Could not marshal request to the Registry", zap.Error(err))
+		return cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	uri := m.registryURL + "/config/" + url.QueryEscape(subject)
+	req, err := http.NewRequestWithContext(ctx, "PUT", uri, bytes.NewReader(payload))
+	if err != nil {
+		log.Error("Failed to NewRequestWithContext", zap.Error(err))
+		return cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	req.Header.Add(
+		"Accept",
+		"application/vnd.schemaregistry.v1+json, application/vnd.schemaregistry+json, "+
+			"application/json",
+	)
+	req.Header.Add("Content-Type", "application/vnd.schemaregistry.v1+json")
+	resp, err := httpRetry(ctx, m.credential, req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != 200 {
+		log.Error("Failed to set compatibility level to the Registry, HTTP error",
+			zap.Int("status", resp.StatusCode),
+			zap.String("uri", uri),
+			zap.ByteString("requestBody", payload))
+		return cerror.ErrAvroSchemaAPIError.GenWithStack(
+			"Failed to set compatibility level %s for subject %s, status = %d",
+			m.compatibility, subject, resp.StatusCode,
+		)
+	}
+	m.compatibilitySet[subject] = struct{}{}
+	log.Info("Set compatibility level successfully",
+		zap.String("subject", subject),
+		zap.String("compatibility", m.compatibility))
+	return nil
+}
+
+// schemaSubject returns the subject which the schema is registered to.
+// The key and value schemas of a table share the same record name, so the
+// subject suffix is kept for all strategies to tell them apart.
+func (m *SchemaManager) schemaSubject(topicName string, schema string) (string, error) {
+	switch m.subjectNameStrategy {
+	case common.AvroSubjectNameStrategyRecord, common.AvroSubjectNameStrategyTopicRecord:
+		recordName, err := getRecordFullName(schema)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if m.subjectNameStrategy == common.AvroSubjectNameStrategyRecord {
+			return recordName + m.subjectSuffix, nil
+		}
+		return topicName + "-" + recordName + m.subjectSuffix, nil
+	default:
+		return m.topicNameToSchemaSubject(topicName), nil
+	}
+}
+
+// getRecordFullName returns the fully-qualified name of the record schema.
+func getRecordFullName(schema string) (string, error) {
+	var record struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	}
+	if err := json.Unmarshal([]byte(schema), &record); err != nil {
+		return "", cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	if record.Namespace == "" || strings.Contains(record.Name, ".") {
+		return record.Name, nil
+	}
+	return record.Namespace + "." + record.Name, nil
+}
+
 // TopicNameStrategy, ksqlDB only supports this
 func (m *SchemaManager) topicNameToSchemaSubject(topicName string) string {
 	return topicName + m.subjectSuffix
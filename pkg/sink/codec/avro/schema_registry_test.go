@@ -25,6 +25,8 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linkedin/goavro/v2"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -391,3 +393,91 @@ func TestHTTPRetry(t *testing.T) {
 	require.Equal(t, 200, resp.StatusCode)
 	_ = resp.Body.Close()
 }
+
+func TestSchemaRegistrySubjectAndCompatibility(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var (
+		mu             sync.Mutex
+		compatibility  = make(map[string]string)
+		registered     []string
+		incompatible   bool
+		configRequests int
+	)
+	httpmock.RegisterResponder("GET", "http://127.0.0.1:8081",
+		httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterResponder("PUT", `=~^http://127.0.0.1:8081/config/(.+)`,
+		func(req *http.Request) (*http.Response, error) {
+			subject, err := httpmock.GetSubmatch(req, 1)
+			if err != nil {
+				return nil, err
+			}
+			var reqData compatibilityRequest
+			if err := json.NewDecoder(req.Body).Decode(&reqData); err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			configRequests++
+			compatibility[subject] = reqData.Compatibility
+			return httpmock.NewJsonResponse(200, &reqData)
+		})
+	httpmock.RegisterResponder("POST", `=~^http://127.0.0.1:8081/subjects/(.+)/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			subject, err := httpmock.GetSubmatch(req, 1)
+			if err != nil {
+				return nil, err
+			}
+			_, _ = io.ReadAll(req.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			if incompatible {
+				return httpmock.NewStringResponse(409, `{"error_code":409}`), nil
+			}
+			registered = append(registered, subject)
+			return httpmock.NewJsonResponse(200, &registerResponse{SchemaID: len(registered)})
+		})
+
+	manager, err := newAvroSchemaManager(
+		getTestingContext(), "http://127.0.0.1:8081", "-value", nil)
+	require.NoError(t, err)
+	manager.compatibility = "FULL"
+	schema := `{"type":"record","name":"t1","namespace":"default.test",` +
+		`"fields":[{"type":"string","name":"a"}]}`
+
+	// The default strategy is TopicNameStrategy.
+	_, err = manager.Register(getTestingContext(), "cdctest", schema)
+	require.NoError(t, err)
+	// The compatibility level is only set once per subject.
+	_, err = manager.Register(getTestingContext(), "cdctest", schema)
+	require.NoError(t, err)
+
+	manager.subjectNameStrategy = common.AvroSubjectNameStrategyRecord
+	_, err = manager.Register(getTestingContext(), "cdctest", schema)
+	require.NoError(t, err)
+
+	manager.subjectNameStrategy = common.AvroSubjectNameStrategyTopicRecord
+	_, err = manager.Register(getTestingContext(), "cdctest", schema)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"cdctest-value",
+		"cdctest-value",
+		"default.test.t1-value",
+		"cdctest-default.test.t1-value",
+	}, registered)
+	require.Equal(t, 3, configRequests)
+	require.Equal(t, map[string]string{
+		"cdctest-value":                 "FULL",
+		"default.test.t1-value":         "FULL",
+		"cdctest-default.test.t1-value": "FULL",
+	}, compatibility)
+
+	// An incompatible schema is reported clearly.
+	incompatible = true
+	_, err = manager.Register(getTestingContext(), "cdctest", schema)
+	require.True(t, cerror.ErrAvroIncompatibleSchema.Equal(err))
+	require.ErrorContains(t, err, "cdctest-default.test.t1-value")
+	require.ErrorContains(t, err, "compatibility level FULL")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/craft"
 	"github.com/pingcap/tiflow/pkg/sink/codec/csv"
+	"github.com/pingcap/tiflow/pkg/sink/codec/debezium"
 	"github.com/pingcap/tiflow/pkg/sink/codec/maxwell"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
 )
@@ -35,7 +36,7 @@ func NewRowEventEncoderBuilder(
 ) (codec.RowEventEncoderBuilder, error) {
 	switch c.Protocol {
 	case config.ProtocolDefault, config.ProtocolOpen:
-		return open.NewBatchEncoderBuilder(c), nil
+		return open.NewBatchEncoderBuilder(ctx, c)
 	case config.ProtocolCanal:
 		return canal.NewBatchEncoderBuilder(), nil
 	case config.ProtocolAvro:
@@ -43,9 +44,11 @@ func NewRowEventEncoderBuilder(
 	case config.ProtocolMaxwell:
 		return maxwell.NewBatchEncoderBuilder(), nil
 	case config.ProtocolCanalJSON:
-		return canal.NewJSONRowEventEncoderBuilder(c), nil
+		return canal.NewJSONRowEventEncoderBuilder(ctx, c)
 	case config.ProtocolCraft:
 		return craft.NewBatchEncoderBuilder(c), nil
+	case config.ProtocolDebezium:
+		return debezium.NewBatchEncoderBuilder(ctx, c), nil
 
 	default:
 		return nil, cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(c.Protocol)
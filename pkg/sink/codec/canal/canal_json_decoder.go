@@ -15,32 +15,51 @@ package canal
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 
 	"github.com/goccy/go-json"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/claimcheck"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"go.uber.org/zap"
 )
 
 // batchDecoder decodes the byte into the original message.
 type batchDecoder struct {
-	data                []byte
-	msg                 canalJSONMessageInterface
-	enableTiDBExtension bool
-	terminator          string
+	data []byte
+	msg  canalJSONMessageInterface
+
+	config *common.Config
+	// claimCheck is used to fetch the whole message of the large messages,
+	// it's nil if the claim check is not enabled.
+	claimCheck *claimcheck.ClaimCheck
+	// upstreamTiDB is used to fetch the whole row of the handle-key-only
+	// messages, it could be nil.
+	upstreamTiDB *sql.DB
 }
 
-// NewBatchDecoder return a decoder for canal-json
+// NewBatchDecoder return a decoder for canal-json, the upstreamTiDB is used to
+// fetch the whole row of the handle-key-only messages, it could be nil.
 func NewBatchDecoder(
-	enableTiDBExtension bool,
-	terminator string,
-) codec.RowEventDecoder {
-	return &batchDecoder{
-		enableTiDBExtension: enableTiDBExtension,
-		terminator:          terminator,
+	ctx context.Context, codecConfig *common.Config, upstreamTiDB *sql.DB,
+) (codec.RowEventDecoder, error) {
+	decoder := &batchDecoder{
+		config:       codecConfig,
+		upstreamTiDB: upstreamTiDB,
 	}
+	if codecConfig.LargeMessageHandle.EnableClaimCheck() {
+		claimCheck, err := claimcheck.New(ctx, codecConfig.LargeMessageHandle.ClaimCheckStorageURI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		decoder.claimCheck = claimCheck
+	}
+	return decoder, nil
 }
 
 // AddKeyValue implements the RowEventDecoder interface
@@ -56,17 +75,17 @@ func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
 		encodedData []byte
 	)
 
-	if b.enableTiDBExtension {
+	if b.config.EnableTiDBExtension {
 		msg = &canalJSONMessageWithTiDBExtension{
 			JSONMessage: &JSONMessage{},
 			Extensions:  &tidbExtension{},
 		}
 	}
-	if len(b.terminator) > 0 {
-		idx := bytes.IndexAny(b.data, b.terminator)
+	if len(b.config.Terminator) > 0 {
+		idx := bytes.IndexAny(b.data, b.config.Terminator)
 		if idx >= 0 {
 			encodedData = b.data[:idx]
-			b.data = b.data[idx+len(b.terminator):]
+			b.data = b.data[idx+len(b.config.Terminator):]
 		} else {
 			encodedData = b.data
 			b.data = nil
@@ -93,16 +112,88 @@ func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
 // NextRowChangedEvent implements the RowEventDecoder interface
 // `HasNext` should be called before this.
 func (b *batchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	row, err := b.nextRowChangedEvent()
+	if err != nil {
+		return nil, err
+	}
+	if err := common.HandleCorruptedRow(b.config.CorruptionHandleLevel, row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (b *batchDecoder) nextRowChangedEvent() (*model.RowChangedEvent, error) {
 	if b.msg == nil || b.msg.messageType() != model.MessageTypeRow {
 		return nil, cerror.ErrCanalDecodeFailed.
 			GenWithStack("not found row changed event message")
 	}
-	result, err := canalJSONMessage2RowChange(b.msg)
+	msg := b.msg
+	b.msg = nil
+	if withExtension, ok := msg.(*canalJSONMessageWithTiDBExtension); ok {
+		if withExtension.Extensions.ClaimCheckLocation != "" && b.claimCheck != nil {
+			return b.assembleClaimCheckEvent(withExtension.Extensions.ClaimCheckLocation)
+		}
+		if withExtension.Extensions.OnlyHandleKey {
+			result, err := canalJSONMessage2RowChange(msg)
+			if err != nil {
+				return nil, err
+			}
+			return b.assembleHandleKeyOnlyEvent(result)
+		}
+	}
+	return canalJSONMessage2RowChange(msg)
+}
+
+// assembleClaimCheckEvent fetches the whole message from the external storage
+// and decodes the row changed event from it.
+func (b *batchDecoder) assembleClaimCheckEvent(
+	claimCheckLocation string,
+) (*model.RowChangedEvent, error) {
+	_, value, err := b.claimCheck.ReadMessage(context.Background(), claimCheckLocation)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	decoder := &batchDecoder{config: b.config}
+	if err := decoder.AddKeyValue(nil, value); err != nil {
+		return nil, errors.Trace(err)
+	}
+	tp, hasNext, err := decoder.HasNext()
 	if err != nil {
-		return nil, err
+		return nil, errors.Trace(err)
 	}
-	b.msg = nil
-	return result, nil
+	if !hasNext || tp != model.MessageTypeRow {
+		return nil, cerror.ErrCanalDecodeFailed.GenWithStack(
+			"not found row changed event message in the claim check location %s",
+			claimCheckLocation)
+	}
+	return decoder.NextRowChangedEvent()
+}
+
+// assembleHandleKeyOnlyEvent fetches the whole row of the handle-key-only
+// event from the upstream TiDB.
+func (b *batchDecoder) assembleHandleKeyOnlyEvent(
+	e *model.RowChangedEvent,
+) (*model.RowChangedEvent, error) {
+	// The handle key columns are enough to delete the row.
+	if e.IsDelete() {
+		return e, nil
+	}
+
+	ctx := context.Background()
+	columns, err := common.SnapshotQuery(ctx, b.upstreamTiDB, e.CommitTs,
+		e.Table.Schema, e.Table.Table, e.Columns)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(e.PreColumns) != 0 {
+		e.PreColumns, err = common.SnapshotQuery(ctx, b.upstreamTiDB, e.CommitTs-1,
+			e.Table.Schema, e.Table.Table, e.PreColumns)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	e.Columns = columns
+	return e, nil
 }
 
 // NextDDLEvent implements the RowEventDecoder interface
@@ -19,6 +19,8 @@ import (
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +33,7 @@ func TestNewCanalJSONBatchDecoder4RowMessage(t *testing.T) {
 			EnableTiDBExtension: encodeEnable,
 			Terminator:          config.CRLF,
 			MaxMessageBytes:     config.DefaultMaxMessageBytes,
-		})
+		}, nil)
 		require.NotNil(t, encoder)
 
 		err := encoder.AppendRowChangedEvent(context.Background(), "", testCaseInsert, nil)
@@ -42,8 +44,11 @@ func TestNewCanalJSONBatchDecoder4RowMessage(t *testing.T) {
 		msg := messages[0]
 
 		for _, decodeEnable := range []bool{false, true} {
-			decoder := NewBatchDecoder(decodeEnable, "")
-			err := decoder.AddKeyValue(msg.Key, msg.Value)
+			decoder, err := NewBatchDecoder(context.Background(), &common.Config{
+				EnableTiDBExtension: decodeEnable,
+			}, nil)
+			require.NoError(t, err)
+			err = decoder.AddKeyValue(msg.Key, msg.Value)
 			require.NoError(t, err)
 
 			ty, hasNext, err := decoder.HasNext()
@@ -83,12 +88,74 @@ func TestNewCanalJSONBatchDecoder4RowMessage(t *testing.T) {
 	}
 }
 
+func TestCanalJSONBatchDecoderWithRowChecksum(t *testing.T) {
+	t.Parallel()
+	event := *testCaseInsert
+	event.Checksum = &integrity.Checksum{
+		Current:   0xffffffff,
+		Version:   1,
+		Corrupted: true,
+	}
+	for _, enableChecksum := range []bool{false, true} {
+		encoder := newJSONRowEventEncoder(&common.Config{
+			EnableTiDBExtension: true,
+			EnableRowChecksum:   enableChecksum,
+			MaxMessageBytes:     config.DefaultMaxMessageBytes,
+		}, nil)
+		err := encoder.AppendRowChangedEvent(context.Background(), "", &event, nil)
+		require.NoError(t, err)
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+
+		decoder, err := NewBatchDecoder(context.Background(), &common.Config{
+			EnableTiDBExtension: true,
+		}, nil)
+		require.NoError(t, err)
+		err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		ty, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, ty)
+
+		consumed, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, event.CommitTs, consumed.CommitTs)
+		if enableChecksum {
+			require.Equal(t, event.Checksum, consumed.Checksum)
+		} else {
+			require.Nil(t, consumed.Checksum)
+		}
+	}
+
+	// the corrupted row is rejected if the corruption handle level is error.
+	encoder := newJSONRowEventEncoder(&common.Config{
+		EnableTiDBExtension: true,
+		EnableRowChecksum:   true,
+		MaxMessageBytes:     config.DefaultMaxMessageBytes,
+	}, nil)
+	err := encoder.AppendRowChangedEvent(context.Background(), "", &event, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	decoder, err := NewBatchDecoder(context.Background(), &common.Config{
+		EnableTiDBExtension:   true,
+		CorruptionHandleLevel: integrity.CorruptionHandleLevelError,
+	}, nil)
+	require.NoError(t, err)
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, _, err = decoder.HasNext()
+	require.NoError(t, err)
+	_, err = decoder.NextRowChangedEvent()
+	require.True(t, cerror.ErrCorruptedDataReceived.Equal(err))
+}
+
 func TestNewCanalJSONBatchDecoder4DDLMessage(t *testing.T) {
 	t.Parallel()
 	for _, encodeEnable := range []bool{false, true} {
 		encoder := &JSONRowEventEncoder{
-			builder:             newCanalEntryBuilder(),
-			enableTiDBExtension: encodeEnable,
+			builder: newCanalEntryBuilder(),
+			config:  &common.Config{EnableTiDBExtension: encodeEnable},
 		}
 		require.NotNil(t, encoder)
 
@@ -97,8 +164,11 @@ func TestNewCanalJSONBatchDecoder4DDLMessage(t *testing.T) {
 		require.NotNil(t, result)
 
 		for _, decodeEnable := range []bool{false, true} {
-			decoder := NewBatchDecoder(decodeEnable, "")
-			err := decoder.AddKeyValue(nil, result.Value)
+			decoder, err := NewBatchDecoder(context.Background(), &common.Config{
+				EnableTiDBExtension: decodeEnable,
+			}, nil)
+			require.NoError(t, err)
+			err = decoder.AddKeyValue(nil, result.Value)
 			require.NoError(t, err)
 
 			ty, hasNext, err := decoder.HasNext()
@@ -134,8 +204,11 @@ func TestCanalJSONBatchDecoderWithTerminator(t *testing.T) {
 	encodedValue := `{"id":0,"database":"test","table":"employee","pkNames":["id"],"isDdl":false,"type":"INSERT","es":1668067205238,"ts":1668067206650,"sql":"","sqlType":{"FirstName":12,"HireDate":91,"LastName":12,"OfficeLocation":12,"id":4},"mysqlType":{"FirstName":"varchar","HireDate":"date","LastName":"varchar","OfficeLocation":"varchar","id":"int"},"data":[{"FirstName":"Bob","HireDate":"2014-06-04","LastName":"Smith","OfficeLocation":"New York","id":"101"}],"old":null}
 {"id":0,"database":"test","table":"employee","pkNames":["id"],"isDdl":false,"type":"UPDATE","es":1668067229137,"ts":1668067230720,"sql":"","sqlType":{"FirstName":12,"HireDate":91,"LastName":12,"OfficeLocation":12,"id":4},"mysqlType":{"FirstName":"varchar","HireDate":"date","LastName":"varchar","OfficeLocation":"varchar","id":"int"},"data":[{"FirstName":"Bob","HireDate":"2015-10-08","LastName":"Smith","OfficeLocation":"Los Angeles","id":"101"}],"old":[{"FirstName":"Bob","HireDate":"2014-06-04","LastName":"Smith","OfficeLocation":"New York","id":"101"}]}
 {"id":0,"database":"test","table":"employee","pkNames":["id"],"isDdl":false,"type":"DELETE","es":1668067230388,"ts":1668067231725,"sql":"","sqlType":{"FirstName":12,"HireDate":91,"LastName":12,"OfficeLocation":12,"id":4},"mysqlType":{"FirstName":"varchar","HireDate":"date","LastName":"varchar","OfficeLocation":"varchar","id":"int"},"data":[{"FirstName":"Bob","HireDate":"2015-10-08","LastName":"Smith","OfficeLocation":"Los Angeles","id":"101"}],"old":null}`
-	decoder := NewBatchDecoder(false, "\n")
-	err := decoder.AddKeyValue(nil, []byte(encodedValue))
+	decoder, err := NewBatchDecoder(context.Background(), &common.Config{
+		Terminator: "\n",
+	}, nil)
+	require.NoError(t, err)
+	err = decoder.AddKeyValue(nil, []byte(encodedValue))
 	require.NoError(t, err)
 
 	cnt := 0
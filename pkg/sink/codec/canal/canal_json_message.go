@@ -15,12 +15,14 @@ package canal
 
 import (
 	"sort"
+	"strconv"
 	"strings"
 
 	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/types"
 	"github.com/pingcap/tiflow/cdc/model"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
 	canal "github.com/pingcap/tiflow/proto/canal"
 )
@@ -133,14 +135,27 @@ func (c *JSONMessage) pkNameSet() map[string]struct{} {
 type tidbExtension struct {
 	CommitTs    uint64 `json:"commitTs,omitempty"`
 	WatermarkTs uint64 `json:"watermarkTs,omitempty"`
+
+	// OnlyHandleKey is true if only the handle key columns of the row are
+	// carried by the message, since the whole message is too large.
+	OnlyHandleKey bool `json:"onlyHandleKey,omitempty"`
+	// ClaimCheckLocation is the location of the whole message in the external
+	// storage, it's set if the claim check is enabled.
+	ClaimCheckLocation string `json:"claimCheckLocation,omitempty"`
+
+	// the row level checksum, only set if the row checksum is enabled.
+	ChecksumVersion int    `json:"checksumVersion,omitempty"`
+	Checksum        string `json:"checksum,omitempty"`
+	Corrupted       bool   `json:"corrupted,omitempty"`
 }
 
 type canalJSONMessageWithTiDBExtension struct {
 	*JSONMessage
 	// Extensions is a TiCDC custom field that different from official Canal-JSON format.
 	// It would be useful to store something for special usage.
-	// At the moment, only store the `tso` of each event,
-	// which is useful if the message consumer needs to restore the original transactions.
+	// At the moment, it stores the `tso` of each event, which is useful if the message
+	// consumer needs to restore the original transactions, and the row level checksum,
+	// which is useful if the message consumer needs to verify the integrity of rows.
 	Extensions *tidbExtension `json:"_tidb"`
 }
 
@@ -148,9 +163,33 @@ func (c *canalJSONMessageWithTiDBExtension) getCommitTs() uint64 {
 	return c.Extensions.CommitTs
 }
 
+// getChecksum returns the row level checksum carried by the message,
+// nil is returned if the message does not have it.
+func getChecksum(msg canalJSONMessageInterface) (*integrity.Checksum, error) {
+	withExtension, ok := msg.(*canalJSONMessageWithTiDBExtension)
+	if !ok || withExtension.Extensions == nil || withExtension.Extensions.Checksum == "" {
+		return nil, nil
+	}
+	extension := withExtension.Extensions
+	checksum, err := strconv.ParseUint(extension.Checksum, 10, 32)
+	if err != nil {
+		return nil, cerrors.WrapError(cerrors.ErrCanalDecodeFailed, err)
+	}
+	return &integrity.Checksum{
+		Current:   uint32(checksum),
+		Version:   extension.ChecksumVersion,
+		Corrupted: extension.Corrupted,
+	}, nil
+}
+
 func canalJSONMessage2RowChange(msg canalJSONMessageInterface) (*model.RowChangedEvent, error) {
 	result := new(model.RowChangedEvent)
 	result.CommitTs = msg.getCommitTs()
+	checksum, err := getChecksum(msg)
+	if err != nil {
+		return nil, err
+	}
+	result.Checksum = checksum
 	result.Table = &model.TableName{
 		Schema: *msg.getSchema(),
 		Table:  *msg.getTable(),
@@ -159,7 +198,6 @@ func canalJSONMessage2RowChange(msg canalJSONMessageInterface) (*model.RowChange
 	mysqlType := msg.getMySQLType()
 	javaSQLType := msg.getJavaSQLType()
 
-	var err error
 	if msg.eventType() == canal.EventType_DELETE {
 		// for `DELETE` event, `data` contain the old data, set it as the `PreColumns`
 		result.PreColumns, err = canalJSONColumnMap2RowChangeColumns(
@@ -15,6 +15,7 @@ package canal
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/goccy/go-json"
@@ -25,15 +26,20 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"go.uber.org/zap"
 )
 
+// newJSONMessageForDML encodes the row changed event, only the handle key
+// columns are encoded if onlyHandleKey is true, the claimCheckLocation is
+// the location of the whole message if the claim check is enabled.
 func newJSONMessageForDML(
 	builder *canalEntryBuilder,
-	enableTiDBExtension bool,
 	e *model.RowChangedEvent,
-	onlyOutputUpdatedColumns bool,
+	config *common.Config,
+	onlyHandleKey bool,
+	claimCheckLocation string,
 ) ([]byte, error) {
 	isDelete := e.IsDelete()
 	mysqlTypeMap := make(map[string]string, len(e.Columns))
@@ -55,6 +61,9 @@ func newJSONMessageForDML(
 				if onlyOutputUpdatedColumn && shouldIgnoreColumn(col, newColumnMap) {
 					continue
 				}
+				if onlyHandleKey && !col.Flag.IsHandleKey() {
+					continue
+				}
 				if isFirst {
 					isFirst = false
 				} else {
@@ -152,6 +161,9 @@ func newJSONMessageForDML(
 		emptyColumn := true
 		for _, col := range columns {
 			if col != nil {
+				if onlyHandleKey && !col.Flag.IsHandleKey() {
+					continue
+				}
 				if emptyColumn {
 					out.RawByte('{')
 					emptyColumn = false
@@ -211,14 +223,14 @@ func newJSONMessageForDML(
 		}
 	} else if e.IsUpdate() {
 		var newColsMap map[string]*model.Column
-		if onlyOutputUpdatedColumns {
+		if config.OnlyOutputUpdatedColumns {
 			newColsMap = make(map[string]*model.Column, len(e.Columns))
 			for _, col := range e.Columns {
 				newColsMap[col.Name] = col
 			}
 		}
 		out.RawString(",\"old\":")
-		if err := filling(e.PreColumns, out, onlyOutputUpdatedColumns, newColsMap); err != nil {
+		if err := filling(e.PreColumns, out, config.OnlyOutputUpdatedColumns, newColsMap); err != nil {
 			return nil, err
 		}
 		out.RawString(",\"data\":")
@@ -229,12 +241,27 @@ func newJSONMessageForDML(
 		log.Panic("unreachable event type", zap.Any("event", e))
 	}
 
-	if enableTiDBExtension {
+	if config.EnableTiDBExtension {
 		const prefix string = ",\"_tidb\":"
 		out.RawString(prefix)
 		out.RawByte('{')
 		out.RawString("\"commitTs\":")
 		out.Uint64(e.CommitTs)
+		if onlyHandleKey {
+			out.RawString(",\"onlyHandleKey\":true")
+		}
+		if claimCheckLocation != "" {
+			out.RawString(",\"claimCheckLocation\":")
+			out.String(claimCheckLocation)
+		}
+		if config.EnableRowChecksum && e.Checksum != nil {
+			out.RawString(",\"checksumVersion\":")
+			out.Int(e.Checksum.Version)
+			out.RawString(",\"checksum\":")
+			out.String(strconv.FormatUint(uint64(e.Checksum.Current), 10))
+			out.RawString(",\"corrupted\":")
+			out.Bool(e.Checksum.Corrupted)
+		}
 		out.RawByte('}')
 	}
 	out.RawByte('}')
@@ -254,25 +281,26 @@ func eventTypeString(e *model.RowChangedEvent) string {
 
 // JSONRowEventEncoder encodes row event in JSON format
 type JSONRowEventEncoder struct {
-	builder *canalEntryBuilder
+	builder  *canalEntryBuilder
+	messages []*common.Message
 
-	// When it is true, canal-json would generate TiDB extension information
-	// which, at the moment, only includes `tidbWaterMarkType` and `_tidb` fields.
-	enableTiDBExtension bool
-	maxMessageBytes     int
-	messages            []*common.Message
-
-	onlyOutputUpdatedColumns bool
+	// When `EnableTiDBExtension` is true, canal-json would generate TiDB extension
+	// information, which includes `tidbWaterMarkType` and `_tidb` fields.
+	config *common.Config
+	// claimCheck is used to store the large messages, it's nil if the claim
+	// check is not enabled.
+	claimCheck *claimcheck.ClaimCheck
 }
 
 // newJSONRowEventEncoder creates a new JSONRowEventEncoder
-func newJSONRowEventEncoder(config *common.Config) codec.RowEventEncoder {
+func newJSONRowEventEncoder(
+	config *common.Config, claimCheck *claimcheck.ClaimCheck,
+) codec.RowEventEncoder {
 	encoder := &JSONRowEventEncoder{
-		builder:                  newCanalEntryBuilder(),
-		enableTiDBExtension:      config.EnableTiDBExtension,
-		onlyOutputUpdatedColumns: config.OnlyOutputUpdatedColumns,
-		messages:                 make([]*common.Message, 0, 1),
-		maxMessageBytes:          config.MaxMessageBytes,
+		builder:    newCanalEntryBuilder(),
+		messages:   make([]*common.Message, 0, 1),
+		config:     config,
+		claimCheck: claimCheck,
 	}
 	return encoder
 }
@@ -289,7 +317,7 @@ func (c *JSONRowEventEncoder) newJSONMessageForDDL(e *model.DDLEvent) canalJSONM
 		Query:         e.Query,
 	}
 
-	if !c.enableTiDBExtension {
+	if !c.config.EnableTiDBExtension {
 		return msg
 	}
 
@@ -316,7 +344,7 @@ func (c *JSONRowEventEncoder) newJSONMessage4CheckpointEvent(
 
 // EncodeCheckpointEvent implements the RowEventEncoder interface
 func (c *JSONRowEventEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
-	if !c.enableTiDBExtension {
+	if !c.config.EnableTiDBExtension {
 		return nil, nil
 	}
 
@@ -330,26 +358,53 @@ func (c *JSONRowEventEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message,
 
 // AppendRowChangedEvent implements the interface EventJSONBatchEncoder
 func (c *JSONRowEventEncoder) AppendRowChangedEvent(
-	_ context.Context,
+	ctx context.Context,
 	_ string,
 	e *model.RowChangedEvent,
 	callback func(),
 ) error {
-	value, err := newJSONMessageForDML(c.builder,
-		c.enableTiDBExtension, e, c.onlyOutputUpdatedColumns)
+	value, err := newJSONMessageForDML(c.builder, e, c.config, false, "")
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	length := len(value) + common.MaxRecordOverhead
 	// for single message that is longer than max-message-bytes, do not send it.
-	if length > c.maxMessageBytes {
-		log.Warn("Single message is too large for canal-json",
-			zap.Int("maxMessageBytes", c.maxMessageBytes),
-			zap.Int("length", length),
+	if length > c.config.MaxMessageBytes {
+		if c.config.LargeMessageHandle.Disabled() {
+			log.Warn("Single message is too large for canal-json",
+				zap.Int("maxMessageBytes", c.config.MaxMessageBytes),
+				zap.Int("length", length),
+				zap.Any("table", e.Table),
+				zap.Any("value", value))
+			return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+		}
+
+		var claimCheckLocation string
+		if c.config.LargeMessageHandle.EnableClaimCheck() {
+			claimCheckLocation = claimcheck.NewFileName()
+			if err := c.claimCheck.WriteMessage(ctx, nil, value, claimCheckLocation); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		value, err = newJSONMessageForDML(c.builder, e, c.config, true, claimCheckLocation)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		length = len(value) + common.MaxRecordOverhead
+		if length > c.config.MaxMessageBytes {
+			log.Warn("Single message is still too large for canal-json "+
+				"when only the handle key columns are sent",
+				zap.Int("maxMessageBytes", c.config.MaxMessageBytes),
+				zap.Int("length", length),
+				zap.Any("table", e.Table))
+			return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+		}
+		log.Debug("Single message is too large for canal-json, "+
+			"only the handle key columns are sent",
 			zap.Any("table", e.Table),
-			zap.Any("value", value))
-		return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+			zap.Uint64("commitTs", e.CommitTs),
+			zap.String("claimCheckLocation", claimCheckLocation))
 	}
 	m := &common.Message{
 		Key:      nil,
@@ -389,17 +444,28 @@ func (c *JSONRowEventEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message
 }
 
 type jsonRowEventEncoderBuilder struct {
-	config *common.Config
+	config     *common.Config
+	claimCheck *claimcheck.ClaimCheck
 }
 
 // NewJSONRowEventEncoderBuilder creates a canal-json batchEncoderBuilder.
-func NewJSONRowEventEncoderBuilder(config *common.Config) codec.RowEventEncoderBuilder {
-	return &jsonRowEventEncoderBuilder{config: config}
+func NewJSONRowEventEncoderBuilder(
+	ctx context.Context, config *common.Config,
+) (codec.RowEventEncoderBuilder, error) {
+	builder := &jsonRowEventEncoderBuilder{config: config}
+	if config.LargeMessageHandle.EnableClaimCheck() {
+		claimCheck, err := claimcheck.New(ctx, config.LargeMessageHandle.ClaimCheckStorageURI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		builder.claimCheck = claimCheck
+	}
+	return builder, nil
 }
 
 // Build a `jsonRowEventEncoderBuilder`
 func (b *jsonRowEventEncoderBuilder) Build() codec.RowEventEncoder {
-	return newJSONRowEventEncoder(b.config)
+	return newJSONRowEventEncoder(b.config, b.claimCheck)
 }
 
 func shouldIgnoreColumn(col *model.Column,
@@ -16,11 +16,13 @@ package canal
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/encoding/charmap"
@@ -33,13 +35,13 @@ func TestBuildCanalJSONRowEventEncoder(t *testing.T) {
 	builder := &jsonRowEventEncoderBuilder{config: cfg}
 	encoder, ok := builder.Build().(*JSONRowEventEncoder)
 	require.True(t, ok)
-	require.False(t, encoder.enableTiDBExtension)
+	require.False(t, encoder.config.EnableTiDBExtension)
 
 	cfg.EnableTiDBExtension = true
 	builder = &jsonRowEventEncoderBuilder{config: cfg}
 	encoder, ok = builder.Build().(*JSONRowEventEncoder)
 	require.True(t, ok)
-	require.True(t, encoder.enableTiDBExtension)
+	require.True(t, encoder.config.EnableTiDBExtension)
 }
 
 func TestNewCanalJSONMessage4DML(t *testing.T) {
@@ -47,14 +49,14 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	e := newJSONRowEventEncoder(&common.Config{
 		EnableTiDBExtension: false,
 		Terminator:          "",
-	})
+	}, nil)
 	require.NotNil(t, e)
 
 	encoder, ok := e.(*JSONRowEventEncoder)
 	require.True(t, ok)
 
 	data, err := newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseInsert, false)
+		testCaseInsert, encoder.config, false, "")
 	require.Nil(t, err)
 	var msg canalJSONMessageInterface = &JSONMessage{}
 	err = json.Unmarshal(data, msg)
@@ -98,7 +100,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	}
 
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseUpdate, false)
+		testCaseUpdate, encoder.config, false, "")
 	require.Nil(t, err)
 	jsonMsg = &JSONMessage{}
 	err = json.Unmarshal(data, jsonMsg)
@@ -108,7 +110,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	require.Equal(t, "UPDATE", jsonMsg.EventType)
 
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseDelete, false)
+		testCaseDelete, encoder.config, false, "")
 	require.Nil(t, err)
 	jsonMsg = &JSONMessage{}
 	err = json.Unmarshal(data, jsonMsg)
@@ -120,13 +122,13 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	e = newJSONRowEventEncoder(&common.Config{
 		EnableTiDBExtension: true,
 		Terminator:          "",
-	})
+	}, nil)
 	require.NotNil(t, e)
 
 	encoder, ok = e.(*JSONRowEventEncoder)
 	require.True(t, ok)
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseUpdate, false)
+		testCaseUpdate, encoder.config, false, "")
 	require.Nil(t, err)
 
 	withExtension := &canalJSONMessageWithTiDBExtension{}
@@ -138,8 +140,10 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 
 	encoder, ok = e.(*JSONRowEventEncoder)
 	require.True(t, ok)
-	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseUpdate, true)
+	data, err = newJSONMessageForDML(encoder.builder, testCaseUpdate, &common.Config{
+		EnableTiDBExtension:      true,
+		OnlyOutputUpdatedColumns: true,
+	}, false, "")
 	require.Nil(t, err)
 
 	withExtension = &canalJSONMessageWithTiDBExtension{}
@@ -153,7 +157,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 
 func TestNewCanalJSONMessageFromDDL(t *testing.T) {
 	t.Parallel()
-	encoder := &JSONRowEventEncoder{builder: newCanalEntryBuilder()}
+	encoder := &JSONRowEventEncoder{builder: newCanalEntryBuilder(), config: &common.Config{}}
 	require.NotNil(t, encoder)
 
 	message := encoder.newJSONMessageForDDL(testCaseDDL)
@@ -168,7 +172,10 @@ func TestNewCanalJSONMessageFromDDL(t *testing.T) {
 	require.Equal(t, testCaseDDL.Query, msg.Query)
 	require.Equal(t, "CREATE", msg.EventType)
 
-	encoder = &JSONRowEventEncoder{builder: newCanalEntryBuilder(), enableTiDBExtension: true}
+	encoder = &JSONRowEventEncoder{
+		builder: newCanalEntryBuilder(),
+		config:  &common.Config{EnableTiDBExtension: true},
+	}
 	require.NotNil(t, encoder)
 
 	message = encoder.newJSONMessageForDDL(testCaseDDL)
@@ -187,7 +194,7 @@ func TestBatching(t *testing.T) {
 		EnableTiDBExtension: false,
 		Terminator:          "",
 		MaxMessageBytes:     config.DefaultMaxMessageBytes,
-	})
+	}, nil)
 	require.NotNil(t, encoder)
 
 	updateCase := *testCaseUpdate
@@ -221,8 +228,8 @@ func TestEncodeCheckpointEvent(t *testing.T) {
 	var watermark uint64 = 2333
 	for _, enable := range []bool{false, true} {
 		encoder := &JSONRowEventEncoder{
-			builder:             newCanalEntryBuilder(),
-			enableTiDBExtension: enable,
+			builder: newCanalEntryBuilder(),
+			config:  &common.Config{EnableTiDBExtension: enable},
 		}
 
 		require.NotNil(t, encoder)
@@ -236,7 +243,10 @@ func TestEncodeCheckpointEvent(t *testing.T) {
 		}
 
 		require.NotNil(t, msg)
-		decoder := NewBatchDecoder(enable, "")
+		decoder, err := NewBatchDecoder(context.Background(), &common.Config{
+			EnableTiDBExtension: enable,
+		}, nil)
+		require.NoError(t, err)
 
 		err = decoder.AddKeyValue(msg.Key, msg.Value)
 		require.NoError(t, err)
@@ -265,8 +275,8 @@ func TestCheckpointEventValueMarshal(t *testing.T) {
 	t.Parallel()
 	var watermark uint64 = 1024
 	encoder := &JSONRowEventEncoder{
-		builder:             newCanalEntryBuilder(),
-		enableTiDBExtension: true,
+		builder: newCanalEntryBuilder(),
+		config:  &common.Config{EnableTiDBExtension: true},
 	}
 	require.NotNil(t, encoder)
 	msg, err := encoder.EncodeCheckpointEvent(watermark)
@@ -311,7 +321,10 @@ func TestCheckpointEventValueMarshal(t *testing.T) {
 
 func TestDDLEventWithExtensionValueMarshal(t *testing.T) {
 	t.Parallel()
-	encoder := &JSONRowEventEncoder{builder: newCanalEntryBuilder(), enableTiDBExtension: true}
+	encoder := &JSONRowEventEncoder{
+		builder: newCanalEntryBuilder(),
+		config:  &common.Config{EnableTiDBExtension: true},
+	}
 	require.NotNil(t, encoder)
 
 	message := encoder.newJSONMessageForDDL(testCaseDDL)
@@ -352,7 +365,7 @@ func TestCanalJSONAppendRowChangedEventWithCallback(t *testing.T) {
 		EnableTiDBExtension: true,
 		Terminator:          "",
 		MaxMessageBytes:     config.DefaultMaxMessageBytes,
-	})
+	}, nil)
 	require.NotNil(t, encoder)
 
 	count := 0
@@ -446,13 +459,89 @@ func TestMaxMessageBytes(t *testing.T) {
 	// the test message length is smaller than max-message-bytes
 	maxMessageBytes := 300
 	cfg := common.NewConfig(config.ProtocolCanalJSON).WithMaxMessageBytes(maxMessageBytes)
-	encoder := NewJSONRowEventEncoderBuilder(cfg).Build()
+	encoder := newJSONRowEventEncoder(cfg, nil)
 	err := encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.Nil(t, err)
 
 	// the test message length is larger than max-message-bytes
 	cfg = cfg.WithMaxMessageBytes(100)
-	encoder = NewJSONRowEventEncoderBuilder(cfg).Build()
+	encoder = newJSONRowEventEncoder(cfg, nil)
 	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.NotNil(t, err)
 }
+
+func TestCanalJSONLargeMessageHandle(t *testing.T) {
+	t.Parallel()
+
+	largeEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			{
+				Name:  "id",
+				Type:  mysql.TypeLong,
+				Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+				Value: int64(1),
+			},
+			{
+				Name:  "col1",
+				Type:  mysql.TypeVarchar,
+				Value: []byte(strings.Repeat("a", 1024)),
+			},
+		},
+	}
+
+	ctx := context.Background()
+	for _, option := range []string{
+		config.LargeMessageHandleOptionHandleKeyOnly,
+		config.LargeMessageHandleOptionClaimCheck,
+	} {
+		cfg := common.NewConfig(config.ProtocolCanalJSON).WithMaxMessageBytes(512)
+		cfg.EnableTiDBExtension = true
+		cfg.LargeMessageHandle = &config.LargeMessageHandleConfig{
+			LargeMessageHandleOption: option,
+			ClaimCheckStorageURI:     "file://" + t.TempDir(),
+		}
+		builder, err := NewJSONRowEventEncoderBuilder(ctx, cfg)
+		require.NoError(t, err)
+		encoder := builder.Build()
+		err = encoder.AppendRowChangedEvent(ctx, "", largeEvent, nil)
+		require.NoError(t, err)
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+		require.LessOrEqual(t, messages[0].Length(), 512)
+
+		msg := &canalJSONMessageWithTiDBExtension{}
+		require.NoError(t, json.Unmarshal(messages[0].Value, msg))
+		require.True(t, msg.Extensions.OnlyHandleKey)
+		require.Len(t, msg.Data[0], 1)
+		require.Contains(t, msg.Data[0], "id")
+
+		decoder, err := NewBatchDecoder(ctx, cfg, nil)
+		require.NoError(t, err)
+		err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		tp, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+
+		if option == config.LargeMessageHandleOptionHandleKeyOnly {
+			require.Empty(t, msg.Extensions.ClaimCheckLocation)
+			// the whole row can't be fetched without the upstream TiDB.
+			_, err = decoder.NextRowChangedEvent()
+			require.True(t, cerror.ErrCodecDecode.Equal(err))
+			continue
+		}
+		require.NotEmpty(t, msg.Extensions.ClaimCheckLocation)
+		decoded, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, largeEvent.CommitTs, decoded.CommitTs)
+		require.Len(t, decoded.Columns, 2)
+		for _, col := range decoded.Columns {
+			if col.Name == "col1" {
+				require.Equal(t, strings.Repeat("a", 1024), col.Value)
+			}
+		}
+	}
+}
@@ -30,11 +30,10 @@ import (
 type JSONTxnEventEncoder struct {
 	builder *canalEntryBuilder
 
-	// When it is true, canal-json would generate TiDB extension information
-	// which, at the moment, only includes `tidbWaterMarkType` and `_tidb` fields.
-	enableTiDBExtension bool
+	// When `EnableTiDBExtension` is true, canal-json would generate TiDB extension
+	// information, which includes `tidbWaterMarkType` and `_tidb` fields.
+	config *common.Config
 
-	onlyOutputUpdatedColumns bool
 	// the symbol separating two lines
 	terminator      []byte
 	maxMessageBytes int
@@ -54,8 +53,7 @@ func (j *JSONTxnEventEncoder) AppendTxnEvent(
 	callback func(),
 ) error {
 	for _, row := range txn.Rows {
-		value, err := newJSONMessageForDML(j.builder,
-			j.enableTiDBExtension, row, j.onlyOutputUpdatedColumns)
+		value, err := newJSONMessageForDML(j.builder, row, j.config, false, "")
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -103,12 +101,11 @@ func (j *JSONTxnEventEncoder) Build() []*common.Message {
 // newJSONTxnEventEncoder creates a new JSONTxnEventEncoder
 func newJSONTxnEventEncoder(config *common.Config) codec.TxnEventEncoder {
 	encoder := &JSONTxnEventEncoder{
-		builder:                  newCanalEntryBuilder(),
-		enableTiDBExtension:      config.EnableTiDBExtension,
-		onlyOutputUpdatedColumns: config.OnlyOutputUpdatedColumns,
-		valueBuf:                 &bytes.Buffer{},
-		terminator:               []byte(config.Terminator),
-		maxMessageBytes:          config.MaxMessageBytes,
+		builder:         newCanalEntryBuilder(),
+		config:          config,
+		valueBuf:        &bytes.Buffer{},
+		terminator:      []byte(config.Terminator),
+		maxMessageBytes: config.MaxMessageBytes,
 	}
 	return encoder
 }
@@ -30,13 +30,13 @@ func TestBuildCanalJSONTxnEventEncoder(t *testing.T) {
 	builder := NewJSONTxnEventEncoderBuilder(cfg)
 	encoder, ok := builder.Build().(*JSONTxnEventEncoder)
 	require.True(t, ok)
-	require.False(t, encoder.enableTiDBExtension)
+	require.False(t, encoder.config.EnableTiDBExtension)
 
 	cfg.EnableTiDBExtension = true
 	builder = NewJSONTxnEventEncoderBuilder(cfg)
 	encoder, ok = builder.Build().(*JSONTxnEventEncoder)
 	require.True(t, ok)
-	require.True(t, encoder.enableTiDBExtension)
+	require.True(t, encoder.config.EnableTiDBExtension)
 }
 
 func TestCanalJSONTxnEventEncoderMaxMessageBytes(t *testing.T) {
@@ -0,0 +1,80 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimcheck
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// ClaimCheck stores the messages which exceed the max-message-bytes of the
+// MQ sinks in the external storage, only the location of the stored message
+// is sent to the MQ, and the consumers fetch the message by the location.
+type ClaimCheck struct {
+	storage storage.ExternalStorage
+}
+
+// message is the format of the messages stored in the external storage.
+type message struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// New creates a new ClaimCheck which stores the messages in the storage.
+func New(ctx context.Context, storageURI string) (*ClaimCheck, error) {
+	s, err := util.GetExternalStorageFromURI(ctx, storageURI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	log.Info("claim check enabled", zap.String("storage", s.URI()))
+	return &ClaimCheck{storage: s}, nil
+}
+
+// WriteMessage writes the key and the value of a message to the file.
+func (c *ClaimCheck) WriteMessage(ctx context.Context, key, value []byte, fileName string) error {
+	data, err := json.Marshal(&message{Key: key, Value: value})
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+	if err := c.storage.WriteFile(ctx, fileName, data); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// ReadMessage reads the key and the value of a message from the file.
+func (c *ClaimCheck) ReadMessage(ctx context.Context, fileName string) ([]byte, []byte, error) {
+	data, err := c.storage.ReadFile(ctx, fileName)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	m := &message{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+	}
+	return m.Key, m.Value, nil
+}
+
+// NewFileName returns a new unique file name to store a message.
+func NewFileName() string {
+	return uuid.NewString() + ".json"
+}
@@ -16,6 +16,7 @@ package common
 import (
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/gin-gonic/gin/binding"
 	"github.com/imdario/mergo"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
 	"go.uber.org/zap"
 )
 
@@ -39,11 +41,16 @@ type Config struct {
 
 	EnableTiDBExtension bool
 	EnableRowChecksum   bool
+	// CorruptionHandleLevel decides how the decoders handle the rows whose
+	// checksum mismatch, or which are marked as corrupted by the encoder.
+	CorruptionHandleLevel string
 
 	// avro only
 	AvroSchemaRegistry             string
 	AvroDecimalHandlingMode        string
 	AvroBigintUnsignedHandlingMode string
+	AvroSubjectNameStrategy        string
+	AvroSchemaCompatibility        string
 
 	AvroEnableWatermark bool
 
@@ -56,6 +63,10 @@ type Config struct {
 
 	// for open protocol
 	OnlyOutputUpdatedColumns bool
+
+	// for open protocol and canal-json, it decides how to handle the
+	// messages which exceed the max-message-bytes.
+	LargeMessageHandle *config.LargeMessageHandleConfig
 }
 
 // NewConfig return a Config for codec
@@ -66,15 +77,20 @@ func NewConfig(protocol config.Protocol) *Config {
 		MaxMessageBytes: config.DefaultMaxMessageBytes,
 		MaxBatchSize:    defaultMaxBatchSize,
 
-		EnableTiDBExtension: false,
-		EnableRowChecksum:   false,
+		EnableTiDBExtension:   false,
+		EnableRowChecksum:     false,
+		CorruptionHandleLevel: integrity.CorruptionHandleLevelWarn,
 
 		AvroSchemaRegistry:             "",
 		AvroDecimalHandlingMode:        "precise",
 		AvroBigintUnsignedHandlingMode: "long",
+		AvroSubjectNameStrategy:        AvroSubjectNameStrategyTopic,
+		AvroSchemaCompatibility:        "",
 		AvroEnableWatermark:            false,
 
 		OnlyOutputUpdatedColumns: false,
+
+		LargeMessageHandle: config.NewDefaultLargeMessageHandleConfig(),
 	}
 }
 
@@ -82,6 +98,8 @@ const (
 	codecOPTEnableTiDBExtension            = "enable-tidb-extension"
 	codecOPTAvroDecimalHandlingMode        = "avro-decimal-handling-mode"
 	codecOPTAvroBigintUnsignedHandlingMode = "avro-bigint-unsigned-handling-mode"
+	codecOPTAvroSubjectNameStrategy        = "avro-subject-name-strategy"
+	codecOPTAvroSchemaCompatibility        = "avro-schema-compatibility"
 	codecOPTAvroSchemaRegistry             = "schema-registry"
 
 	codecOPTOnlyOutputUpdatedColumns = "only-output-updated-columns"
@@ -96,14 +114,44 @@ const (
 	BigintUnsignedHandlingModeString = "string"
 	// BigintUnsignedHandlingModeLong is the long mode for unsigned bigint handling
 	BigintUnsignedHandlingModeLong = "long"
+
+	// AvroSubjectNameStrategyTopic names the subject of a schema after the topic,
+	// it is the TopicNameStrategy of the Confluent Schema Registry.
+	AvroSubjectNameStrategyTopic = "topic-name"
+	// AvroSubjectNameStrategyRecord names the subject of a schema after the
+	// fully-qualified name of the record, it is the RecordNameStrategy.
+	AvroSubjectNameStrategyRecord = "record-name"
+	// AvroSubjectNameStrategyTopicRecord names the subject of a schema after both
+	// the topic and the record, it is the TopicRecordNameStrategy.
+	AvroSubjectNameStrategyTopicRecord = "topic-record-name"
 )
 
+// avroSchemaCompatibilityLevels are the compatibility levels supported by
+// the Confluent Schema Registry.
+var avroSchemaCompatibilityLevels = []string{
+	"BACKWARD", "BACKWARD_TRANSITIVE",
+	"FORWARD", "FORWARD_TRANSITIVE",
+	"FULL", "FULL_TRANSITIVE",
+	"NONE",
+}
+
+func isValidAvroSchemaCompatibility(level string) bool {
+	for _, l := range avroSchemaCompatibilityLevels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
 type urlConfig struct {
 	EnableTiDBExtension            *bool   `form:"enable-tidb-extension"`
 	MaxBatchSize                   *int    `form:"max-batch-size"`
 	MaxMessageBytes                *int    `form:"max-message-bytes"`
 	AvroDecimalHandlingMode        *string `form:"avro-decimal-handling-mode"`
 	AvroBigintUnsignedHandlingMode *string `form:"avro-bigint-unsigned-handling-mode"`
+	AvroSubjectNameStrategy        *string `form:"avro-subject-name-strategy"`
+	AvroSchemaCompatibility        *string `form:"avro-schema-compatibility"`
 
 	// AvroEnableWatermark is the option for enabling watermark in avro protocol
 	// only used for internal testing, do not set this in the production environment since the
@@ -146,6 +194,13 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 		*urlParameter.AvroBigintUnsignedHandlingMode != "" {
 		c.AvroBigintUnsignedHandlingMode = *urlParameter.AvroBigintUnsignedHandlingMode
 	}
+	if urlParameter.AvroSubjectNameStrategy != nil &&
+		*urlParameter.AvroSubjectNameStrategy != "" {
+		c.AvroSubjectNameStrategy = *urlParameter.AvroSubjectNameStrategy
+	}
+	if urlParameter.AvroSchemaCompatibility != nil {
+		c.AvroSchemaCompatibility = strings.ToUpper(*urlParameter.AvroSchemaCompatibility)
+	}
 	if urlParameter.AvroEnableWatermark != nil {
 		if c.EnableTiDBExtension && c.Protocol == config.ProtocolAvro {
 			c.AvroEnableWatermark = *urlParameter.AvroEnableWatermark
@@ -177,6 +232,12 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 
 	if replicaConfig.Integrity != nil {
 		c.EnableRowChecksum = replicaConfig.Integrity.Enabled()
+		c.CorruptionHandleLevel = replicaConfig.Integrity.CorruptionHandleLevel
+	}
+
+	if replicaConfig.Sink != nil && replicaConfig.Sink.KafkaConfig != nil &&
+		replicaConfig.Sink.KafkaConfig.LargeMessageHandle != nil {
+		c.LargeMessageHandle = replicaConfig.Sink.KafkaConfig.LargeMessageHandle
 	}
 
 	return nil
@@ -199,6 +260,8 @@ func mergeConfig(
 				dest.AvroEnableWatermark = codecConfig.AvroEnableWatermark
 				dest.AvroDecimalHandlingMode = codecConfig.AvroDecimalHandlingMode
 				dest.AvroBigintUnsignedHandlingMode = codecConfig.AvroBigintUnsignedHandlingMode
+				dest.AvroSubjectNameStrategy = codecConfig.AvroSubjectNameStrategy
+				dest.AvroSchemaCompatibility = codecConfig.AvroSchemaCompatibility
 			}
 		}
 	}
@@ -252,6 +315,27 @@ func (c *Config) Validate() error {
 			)
 		}
 
+		if c.AvroSubjectNameStrategy != AvroSubjectNameStrategyTopic &&
+			c.AvroSubjectNameStrategy != AvroSubjectNameStrategyRecord &&
+			c.AvroSubjectNameStrategy != AvroSubjectNameStrategyTopicRecord {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s value could only be "%s", "%s" or "%s"`,
+				codecOPTAvroSubjectNameStrategy,
+				AvroSubjectNameStrategyTopic,
+				AvroSubjectNameStrategyRecord,
+				AvroSubjectNameStrategyTopicRecord,
+			)
+		}
+
+		if c.AvroSchemaCompatibility != "" &&
+			!isValidAvroSchemaCompatibility(c.AvroSchemaCompatibility) {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s value could only be one of %s`,
+				codecOPTAvroSchemaCompatibility,
+				strings.Join(avroSchemaCompatibilityLevels, ", "),
+			)
+		}
+
 		if c.EnableRowChecksum {
 			if !(c.EnableTiDBExtension && c.AvroDecimalHandlingMode == DecimalHandlingModeString &&
 				c.AvroBigintUnsignedHandlingMode == BigintUnsignedHandlingModeString) {
@@ -265,6 +349,25 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if !c.LargeMessageHandle.Disabled() {
+		switch c.Protocol {
+		case config.ProtocolOpen, config.ProtocolDefault:
+		case config.ProtocolCanalJSON:
+			if !c.EnableTiDBExtension {
+				return cerror.ErrCodecInvalidConfig.GenWithStack(
+					`large message handle of canal-json protocol requires "%s" to be "true"`,
+					codecOPTEnableTiDBExtension)
+			}
+		default:
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				"large message handle only supports open-protocol and canal-json, but got %s",
+				c.Protocol.String())
+		}
+		if err := c.LargeMessageHandle.AdjustAndValidate(); err != nil {
+			return cerror.WrapError(cerror.ErrCodecInvalidConfig, err)
+		}
+	}
+
 	if c.MaxMessageBytes <= 0 {
 		return cerror.ErrCodecInvalidConfig.Wrap(
 			errors.Errorf("invalid max-message-bytes %d", c.MaxMessageBytes),
@@ -245,6 +245,47 @@ func TestConfigApplyValidate(t *testing.T) {
 		`bigint-unsigned-handling-mode value could only be "long" or "string"`,
 	)
 
+	// avro-subject-name-strategy and avro-schema-compatibility
+	c = NewConfig(config.ProtocolAvro)
+	require.Equal(t, AvroSubjectNameStrategyTopic, c.AvroSubjectNameStrategy)
+	require.Equal(t, "", c.AvroSchemaCompatibility)
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=avro&" +
+		"avro-subject-name-strategy=record-name&avro-schema-compatibility=full"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	require.Equal(t, AvroSubjectNameStrategyRecord, c.AvroSubjectNameStrategy)
+	require.Equal(t, "FULL", c.AvroSchemaCompatibility)
+
+	err = c.Validate()
+	require.NoError(t, err)
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=avro&avro-subject-name-strategy=invalid"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	err = c.Validate()
+	require.ErrorContains(
+		t,
+		err,
+		`avro-subject-name-strategy value could only be "topic-name", "record-name" or "topic-record-name"`,
+	)
+
+	c = NewConfig(config.ProtocolAvro)
+	uri = "kafka://127.0.0.1:9092/abc?protocol=avro&avro-schema-compatibility=invalid"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	err = c.Validate()
+	require.ErrorContains(t, err, "avro-schema-compatibility value could only be one of")
+
 	// Illegal max-message-bytes.
 	uri = "kafka://127.0.0.1:9092/abc?kafka-version=2.6.0&max-message-bytes=a"
 	sinkURI, err = url.Parse(uri)
@@ -364,3 +405,46 @@ func TestMergeConfig(t *testing.T) {
 	require.Equal(t, 123, c.MaxMessageBytes)
 	require.Equal(t, 456, c.MaxBatchSize)
 }
+
+func TestConfigValidate4LargeMessageHandle(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.KafkaConfig = &config.KafkaConfig{
+		LargeMessageHandle: &config.LargeMessageHandleConfig{
+			LargeMessageHandleOption: config.LargeMessageHandleOptionHandleKeyOnly,
+		},
+	}
+
+	cases := []struct {
+		uri string
+		err string
+	}{
+		{uri: "kafka://127.0.0.1:9092/abc?protocol=open-protocol"},
+		{uri: "kafka://127.0.0.1:9092/abc?protocol=canal-json&enable-tidb-extension=true"},
+		{
+			uri: "kafka://127.0.0.1:9092/abc?protocol=canal-json",
+			err: "requires \"enable-tidb-extension\" to be \"true\"",
+		},
+		{
+			uri: "kafka://127.0.0.1:9092/abc?protocol=maxwell",
+			err: "only supports open-protocol and canal-json",
+		},
+	}
+	for _, cs := range cases {
+		sinkURI, err := url.Parse(cs.uri)
+		require.NoError(t, err)
+		p, err := config.ParseSinkProtocolFromString(sinkURI.Query().Get("protocol"))
+		require.NoError(t, err)
+
+		c := NewConfig(p)
+		require.NoError(t, c.Apply(sinkURI, replicaConfig))
+		require.True(t, c.LargeMessageHandle.HandleKeyOnly())
+		err = c.Validate()
+		if cs.err == "" {
+			require.NoError(t, err)
+		} else {
+			require.ErrorContains(t, err, cs.err)
+		}
+	}
+}
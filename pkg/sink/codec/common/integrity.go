@@ -0,0 +1,42 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
+	"go.uber.org/zap"
+)
+
+// HandleCorruptedRow is used by the decoders to apply the corruption handle
+// level to the decoded row. The corrupted row is returned as is if the level
+// is warn, so the consumer can decide what to do with it by the checksum.
+func HandleCorruptedRow(level string, row *model.RowChangedEvent) error {
+	if row == nil || row.Checksum == nil || !row.Checksum.Corrupted {
+		return nil
+	}
+	log.Warn("corrupted row data received",
+		zap.String("schema", row.Table.Schema),
+		zap.String("table", row.Table.Table),
+		zap.Uint64("commitTs", row.CommitTs),
+		zap.Uint32("checksum", row.Checksum.Current),
+		zap.String("corruptionHandleLevel", level))
+	if level == integrity.CorruptionHandleLevelError {
+		return cerror.ErrCorruptedDataReceived.GenWithStackByArgs(
+			row.Table.Schema, row.Table.Table, row.CommitTs)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCorruptedRow(t *testing.T) {
+	t.Parallel()
+
+	row := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+	}
+	require.NoError(t, HandleCorruptedRow(integrity.CorruptionHandleLevelError, row))
+
+	row.Checksum = &integrity.Checksum{Current: 1}
+	require.NoError(t, HandleCorruptedRow(integrity.CorruptionHandleLevelError, row))
+
+	row.Checksum.Corrupted = true
+	require.NoError(t, HandleCorruptedRow(integrity.CorruptionHandleLevelWarn, row))
+	err := HandleCorruptedRow(integrity.CorruptionHandleLevelError, row)
+	require.True(t, cerror.ErrCorruptedDataReceived.Equal(err))
+}
@@ -40,6 +40,9 @@ type Message struct {
 	Protocol  config.Protocol   // protocol
 	rowsCount int               // rows in one Message
 	Callback  func()            // Callback function will be called when the message is sent to the sink.
+	// Offset is the offset of the message in the partition of a MQ downstream,
+	// it is set by the producer before calling Callback.
+	Offset int64
 }
 
 // Length returns the expected size of the Kafka message
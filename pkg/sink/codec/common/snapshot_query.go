@@ -0,0 +1,129 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+// SnapshotQuery queries the row identified by the handle key columns from the
+// upstream TiDB at the snapshot of the ts, it's used by the consumers to fetch
+// the whole row of a message which only carries the handle key columns.
+func SnapshotQuery(
+	ctx context.Context, db *sql.DB, ts uint64,
+	schema, table string, handleKeyColumns []*model.Column,
+) ([]*model.Column, error) {
+	if db == nil {
+		return nil, cerror.ErrCodecDecode.GenWithStack(
+			"the upstream TiDB is required to fetch the row of the handle-key-only message, "+
+				"table: %s.%s, ts: %d", schema, table, ts)
+	}
+	if len(handleKeyColumns) == 0 {
+		return nil, cerror.ErrCodecDecode.GenWithStack(
+			"no handle key column found in the message, table: %s.%s, ts: %d", schema, table, ts)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		// The session variable is kept by the connection, reset it before
+		// the connection is put back to the pool.
+		if _, err := conn.ExecContext(ctx, "SET @@tidb_snapshot = ''"); err != nil {
+			log.Warn("reset tidb_snapshot failed", zap.Error(err))
+		}
+		_ = conn.Close()
+	}()
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET @@tidb_snapshot = %d", ts)); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	conditions := make([]string, 0, len(handleKeyColumns))
+	args := make([]interface{}, 0, len(handleKeyColumns))
+	for _, col := range handleKeyColumns {
+		conditions = append(conditions, quotes.QuoteName(col.Name)+" = ?")
+		args = append(args, col.Value)
+	}
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s LIMIT 1",
+		quotes.QuoteSchema(schema, table), strings.Join(conditions, " AND "))
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return nil, cerror.ErrCodecDecode.GenWithStack(
+			"row not found in the upstream TiDB, query: %s, ts: %d", query, ts)
+	}
+	holders := make([]sql.RawBytes, len(columnTypes))
+	dest := make([]interface{}, len(columnTypes))
+	for i := range holders {
+		dest[i] = &holders[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	flags := make(map[string]model.ColumnFlagType, len(handleKeyColumns))
+	for _, col := range handleKeyColumns {
+		flags[col.Name] = col.Flag
+	}
+	result := make([]*model.Column, 0, len(columnTypes))
+	for i, columnType := range columnTypes {
+		col := &model.Column{Name: columnType.Name()}
+		typeName := strings.ToLower(columnType.DatabaseTypeName())
+		if strings.HasPrefix(typeName, "unsigned ") {
+			typeName = strings.TrimPrefix(typeName, "unsigned ")
+			col.Flag.SetIsUnsigned()
+		}
+		col.Type = types.StrToType(typeName)
+		if col.Type == mysql.TypeBlob || col.Type == mysql.TypeTinyBlob ||
+			col.Type == mysql.TypeMediumBlob || col.Type == mysql.TypeLongBlob {
+			col.Flag.SetIsBinary()
+		}
+		if flag, ok := flags[col.Name]; ok {
+			col.Flag |= flag
+		}
+		if holders[i] != nil {
+			value := make([]byte, len(holders[i]))
+			copy(value, holders[i])
+			col.Value = value
+		}
+		result = append(result, col)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"go.uber.org/zap"
+)
+
+// BatchEncoder encodes row changed events in the Debezium format,
+// each event is encoded into a single message.
+type BatchEncoder struct {
+	// name is the logical name of the source, it is the changefeed ID.
+	name            string
+	maxMessageBytes int
+	messages        []*common.Message
+}
+
+// EncodeCheckpointEvent implements the RowEventEncoder interface.
+// Debezium has no corresponding event, so the event is ignored.
+func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	return nil, nil
+}
+
+// AppendRowChangedEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	keyMsg, valueMsg := rowChangeToDebeziumMsg(e, d.name)
+	value, err := valueMsg.encode()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var key []byte
+	if len(keyMsg) != 0 {
+		key, err = json.Marshal(keyMsg)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+		}
+	}
+
+	length := len(key) + len(value) + common.MaxRecordOverhead
+	// for single message that is longer than max-message-bytes, do not send it.
+	if length > d.maxMessageBytes {
+		log.Warn("Single message is too large for debezium",
+			zap.Int("maxMessageBytes", d.maxMessageBytes),
+			zap.Int("length", length),
+			zap.Any("table", e.Table))
+		return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+	}
+	m := common.NewMsg(config.ProtocolDebezium, key, value, e.CommitTs,
+		model.MessageTypeRow, &e.Table.Schema, &e.Table.Table)
+	m.Callback = callback
+	m.IncRowsCount()
+	d.messages = append(d.messages, m)
+	return nil
+}
+
+// EncodeDDLEvent implements the RowEventEncoder interface.
+// Debezium sends schema changes to a dedicated schema history topic rather
+// than the data topics, so DDL events are ignored.
+func (d *BatchEncoder) EncodeDDLEvent(_ *model.DDLEvent) (*common.Message, error) {
+	return nil, nil
+}
+
+// Build implements the RowEventEncoder interface
+func (d *BatchEncoder) Build() []*common.Message {
+	if len(d.messages) == 0 {
+		return nil
+	}
+	result := d.messages
+	d.messages = nil
+	return result
+}
+
+type batchEncoderBuilder struct {
+	name   string
+	config *common.Config
+}
+
+// NewBatchEncoderBuilder creates a debezium batchEncoderBuilder.
+func NewBatchEncoderBuilder(
+	ctx context.Context, config *common.Config,
+) codec.RowEventEncoderBuilder {
+	return &batchEncoderBuilder{
+		name:   contextutil.ChangefeedIDFromCtx(ctx).ID,
+		config: config,
+	}
+}
+
+// Build a debezium BatchEncoder
+func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
+	return &BatchEncoder{
+		name:            b.name,
+		maxMessageBytes: b.config.MaxMessageBytes,
+		messages:        make([]*common.Message, 0, 1),
+	}
+}
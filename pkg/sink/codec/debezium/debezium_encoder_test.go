@@ -0,0 +1,178 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func newTestEncoder(maxMessageBytes int) *BatchEncoder {
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(),
+		model.DefaultChangeFeedID("test-cf"))
+	codecConfig := common.NewConfig(config.ProtocolDebezium).
+		WithMaxMessageBytes(maxMessageBytes)
+	return NewBatchEncoderBuilder(ctx, codecConfig).Build().(*BatchEncoder)
+}
+
+func TestDebeziumEncodeRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	commitTs := oracle.ComposeTS(1680000000000, 0)
+	table := &model.TableName{Schema: "test", Table: "t"}
+	idCol := &model.Column{
+		Name: "id", Type: mysql.TypeLong, Value: int64(1),
+		Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+	}
+	insert := &model.RowChangedEvent{
+		StartTs: commitTs - 1, CommitTs: commitTs, Table: table,
+		Columns: []*model.Column{
+			idCol,
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("alice")},
+			{Name: "data", Type: mysql.TypeBlob, Value: []byte{0x1}, Flag: model.BinaryFlag},
+		},
+	}
+	update := &model.RowChangedEvent{
+		StartTs: commitTs - 1, CommitTs: commitTs, Table: table,
+		PreColumns: []*model.Column{
+			idCol, {Name: "name", Type: mysql.TypeVarchar, Value: []byte("alice")},
+		},
+		Columns: []*model.Column{
+			idCol, {Name: "name", Type: mysql.TypeVarchar, Value: []byte("bob")},
+		},
+	}
+	del := &model.RowChangedEvent{
+		StartTs: commitTs - 1, CommitTs: commitTs, Table: table,
+		PreColumns: []*model.Column{
+			idCol, {Name: "name", Type: mysql.TypeVarchar, Value: nil},
+		},
+	}
+
+	encoder := newTestEncoder(config.DefaultMaxMessageBytes)
+	called := 0
+	for _, e := range []*model.RowChangedEvent{insert, update, del} {
+		err := encoder.AppendRowChangedEvent(context.Background(), "", e, func() { called++ })
+		require.NoError(t, err)
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 3)
+	require.Nil(t, encoder.Build())
+
+	expected := []struct {
+		op     string
+		before map[string]interface{}
+		after  map[string]interface{}
+	}{
+		{
+			op:     "c",
+			before: nil,
+			after:  map[string]interface{}{"id": float64(1), "name": "alice", "data": "AQ=="},
+		},
+		{
+			op:     "u",
+			before: map[string]interface{}{"id": float64(1), "name": "alice"},
+			after:  map[string]interface{}{"id": float64(1), "name": "bob"},
+		},
+		{
+			op:     "d",
+			before: map[string]interface{}{"id": float64(1), "name": nil},
+			after:  nil,
+		},
+	}
+	for i, msg := range messages {
+		require.Equal(t, config.ProtocolDebezium, msg.Protocol)
+		require.Equal(t, 1, msg.GetRowsCount())
+		require.JSONEq(t, `{"id":1}`, string(msg.Key))
+
+		var value struct {
+			Before      map[string]interface{} `json:"before"`
+			After       map[string]interface{} `json:"after"`
+			Source      map[string]interface{} `json:"source"`
+			Op          string                 `json:"op"`
+			TsMs        int64                  `json:"ts_ms"`
+			Transaction map[string]interface{} `json:"transaction"`
+		}
+		require.NoError(t, json.Unmarshal(msg.Value, &value))
+		require.Equal(t, expected[i].op, value.Op)
+		require.Equal(t, expected[i].before, value.Before)
+		require.Equal(t, expected[i].after, value.After)
+		require.Equal(t, "tidb", value.Source["connector"])
+		require.Equal(t, "test-cf", value.Source["name"])
+		require.Equal(t, "test", value.Source["db"])
+		require.Equal(t, "t", value.Source["table"])
+		require.Equal(t, float64(1680000000000), value.Source["ts_ms"])
+		require.Equal(t, "false", value.Source["snapshot"])
+		require.Greater(t, value.TsMs, int64(0))
+		require.Equal(t, map[string]interface{}{"id": strconv.FormatUint(commitTs-1, 10)}, value.Transaction)
+
+		msg.Callback()
+	}
+	require.Equal(t, 3, called)
+}
+
+func TestDebeziumEncodeIgnoredEvents(t *testing.T) {
+	t.Parallel()
+
+	encoder := newTestEncoder(config.DefaultMaxMessageBytes)
+	msg, err := encoder.EncodeCheckpointEvent(1)
+	require.NoError(t, err)
+	require.Nil(t, msg)
+
+	msg, err = encoder.EncodeDDLEvent(&model.DDLEvent{
+		CommitTs: 1,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "a", Table: "b"},
+			TableInfo: &timodel.TableInfo{},
+		},
+		Query: "create table a",
+		Type:  timodel.ActionCreateTable,
+	})
+	require.NoError(t, err)
+	require.Nil(t, msg)
+
+	// A table without handle key has no message key.
+	err = encoder.AppendRowChangedEvent(context.Background(), "", &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: mysql.TypeLong, Value: int64(10)}},
+	}, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	require.Nil(t, messages[0].Key)
+}
+
+func TestDebeziumEncodeMessageTooLarge(t *testing.T) {
+	t.Parallel()
+
+	encoder := newTestEncoder(100)
+	err := encoder.AppendRowChangedEvent(context.Background(), "", &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: mysql.TypeLong, Value: int64(10)}},
+	}, nil)
+	require.True(t, cerror.ErrMessageTooLarge.Equal(err))
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/version"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+const (
+	connectorName = "tidb"
+
+	createOperation = "c"
+	updateOperation = "u"
+	deleteOperation = "d"
+)
+
+// message is the payload of a Debezium change event, which is the same as
+// the output of the Kafka Connect JsonConverter with `schemas.enable=false`.
+type message struct {
+	Before      map[string]interface{} `json:"before"`
+	After       map[string]interface{} `json:"after"`
+	Source      *source                `json:"source"`
+	Op          string                 `json:"op"`
+	TsMs        int64                  `json:"ts_ms"`
+	Transaction *transaction           `json:"transaction"`
+}
+
+// source is the source metadata of a change event.
+type source struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	DB        string `json:"db"`
+	Table     string `json:"table"`
+	CommitTs  uint64 `json:"commit_ts"`
+}
+
+// transaction is the transaction metadata of a change event. The start ts
+// is used as the transaction id, since it uniquely identifies a transaction
+// in TiDB.
+type transaction struct {
+	ID string `json:"id"`
+}
+
+func (m *message) encode() ([]byte, error) {
+	data, err := json.Marshal(m)
+	return data, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+}
+
+// rowChangeToDebeziumMsg converts a row changed event to the key and value of
+// a Debezium message. The key contains the handle key columns of the row.
+func rowChangeToDebeziumMsg(
+	e *model.RowChangedEvent, name string,
+) (map[string]interface{}, *message) {
+	value := &message{
+		Source: &source{
+			Version:   version.ReleaseVersion,
+			Connector: connectorName,
+			Name:      name,
+			TsMs:      oracle.ExtractPhysical(e.CommitTs),
+			Snapshot:  "false",
+			DB:        e.Table.Schema,
+			Table:     e.Table.Table,
+			CommitTs:  e.CommitTs,
+		},
+		TsMs:        time.Now().UnixMilli(),
+		Transaction: &transaction{ID: strconv.FormatUint(e.StartTs, 10)},
+	}
+
+	var keyColumns []*model.Column
+	switch {
+	case e.IsDelete():
+		value.Op = deleteOperation
+		value.Before = columnsToMap(e.PreColumns)
+		keyColumns = e.PreColumns
+	case e.IsUpdate():
+		value.Op = updateOperation
+		value.Before = columnsToMap(e.PreColumns)
+		value.After = columnsToMap(e.Columns)
+		keyColumns = e.Columns
+	default:
+		value.Op = createOperation
+		value.After = columnsToMap(e.Columns)
+		keyColumns = e.Columns
+	}
+
+	key := make(map[string]interface{})
+	for _, col := range keyColumns {
+		if col != nil && col.Flag.IsHandleKey() {
+			key[col.Name] = columnValue(col)
+		}
+	}
+	return key, value
+}
+
+func columnsToMap(columns []*model.Column) map[string]interface{} {
+	result := make(map[string]interface{}, len(columns))
+	for _, col := range columns {
+		if col != nil {
+			result[col.Name] = columnValue(col)
+		}
+	}
+	return result
+}
+
+// columnValue returns the JSON value of the column. Strings are encoded as
+// text, and binary values are encoded in base64 like Debezium does.
+func columnValue(col *model.Column) interface{} {
+	switch col.Type {
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if col.Value == nil {
+			return nil
+		}
+		if b, ok := col.Value.([]byte); ok && !col.Flag.IsBinary() {
+			return string(b)
+		}
+		return col.Value
+	default:
+		return col.Value
+	}
+}
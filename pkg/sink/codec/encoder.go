@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 )
 
@@ -55,6 +56,14 @@ type RowEventEncoderBuilder interface {
 	Build() RowEventEncoder
 }
 
+// SchemaCompatibilityReporter is implemented by the RowEventEncoderBuilders
+// which register the schemas of tables to a schema registry, e.g. Avro.
+type SchemaCompatibilityReporter interface {
+	// SchemaCompatibility returns the compatibility of the schema of the
+	// table with the schema registry.
+	SchemaCompatibility(tableID model.TableID) tablepb.SchemaCompatibility
+}
+
 // TxnEventEncoder is an abstraction for txn events encoder.
 type TxnEventEncoder interface {
 	// AppendTxnEvent append a txn event into the buffer.
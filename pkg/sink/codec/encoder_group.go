@@ -22,7 +22,9 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -32,6 +34,9 @@ const (
 	defaultEncoderGroupSize = 16
 	defaultInputChanSize    = 256
 	defaultMetricInterval   = 15 * time.Second
+	// haltedEventsCheckInterval is the interval to check whether the tables
+	// of the halted events are stopped, see encoderGroup.runEncoder.
+	haltedEventsCheckInterval = time.Second
 )
 
 // EncoderGroup manages a group of encoders
@@ -54,6 +59,9 @@ type encoderGroup struct {
 	inputCh []chan *future
 	index   uint64
 
+	// compatibility is nil if the builder does not register schemas.
+	compatibility SchemaCompatibilityReporter
+
 	outputCh chan *future
 }
 
@@ -70,6 +78,7 @@ func NewEncoderGroup(builder RowEventEncoderBuilder,
 		inputCh[i] = make(chan *future, defaultInputChanSize)
 	}
 
+	compatibility, _ := builder.(SchemaCompatibilityReporter)
 	return &encoderGroup{
 		changefeedID: changefeedID,
 
@@ -78,6 +87,8 @@ func NewEncoderGroup(builder RowEventEncoderBuilder,
 		inputCh:  inputCh,
 		index:    0,
 		outputCh: make(chan *future, defaultInputChanSize*count),
+
+		compatibility: compatibility,
 	}
 }
 
@@ -105,15 +116,36 @@ func (g *encoderGroup) runEncoder(ctx context.Context, idx int) error {
 		WithLabelValues(g.changefeedID.Namespace, g.changefeedID.ID, strconv.Itoa(idx))
 	ticker := time.NewTicker(defaultMetricInterval)
 	defer ticker.Stop()
+	haltedTicker := time.NewTicker(haltedEventsCheckInterval)
+	defer haltedTicker.Stop()
+	// halted are the events of the tables whose schemas are incompatible with
+	// the schema registry, they are held until their tables are stopped.
+	var halted []*dmlsink.RowChangeCallbackableEvent
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
 			metric.Set(float64(len(inputCh)))
+		case <-haltedTicker.C:
+			halted = releaseHaltedEvents(halted)
 		case future := <-inputCh:
 			for _, event := range future.events {
+				if g.isIncompatible(event) {
+					halted = append(halted, event)
+					continue
+				}
 				err := encoder.AppendRowChangedEvent(ctx, future.Topic, event.Event, event.Callback)
+				if err != nil && g.isIncompatible(event) {
+					// Only halt the table instead of failing the whole sink.
+					log.Warn("schema of table is incompatible with the schema registry, halt it",
+						zap.String("namespace", g.changefeedID.Namespace),
+						zap.String("changefeed", g.changefeedID.ID),
+						zap.Int64("tableID", event.Event.Table.TableID),
+						zap.Error(err))
+					halted = append(halted, event)
+					continue
+				}
 				if err != nil {
 					return errors.Trace(err)
 				}
@@ -124,6 +156,31 @@ func (g *encoderGroup) runEncoder(ctx context.Context, idx int) error {
 	}
 }
 
+// isIncompatible returns true if the schema of the table of the event is
+// incompatible with the schema registry.
+func (g *encoderGroup) isIncompatible(event *dmlsink.RowChangeCallbackableEvent) bool {
+	return g.compatibility != nil &&
+		g.compatibility.SchemaCompatibility(event.Event.Table.TableID) ==
+			tablepb.SchemaCompatibilityIncompatible
+}
+
+// releaseHaltedEvents acknowledges the halted events whose tables are
+// stopping, it's safe as the progress of a stopping table is frozen.
+// It returns the events which are still halted.
+func releaseHaltedEvents(
+	halted []*dmlsink.RowChangeCallbackableEvent,
+) []*dmlsink.RowChangeCallbackableEvent {
+	remaining := halted[:0]
+	for _, event := range halted {
+		if event.GetTableSinkState() != state.TableSinkSinking {
+			event.Callback()
+			continue
+		}
+		remaining = append(remaining, event)
+	}
+	return remaining
+}
+
 func (g *encoderGroup) AddEvents(
 	ctx context.Context,
 	topic string,
@@ -0,0 +1,127 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+// incompatibleEncoderBuilder builds encoders which fail to encode the events
+// of the incompatible tables.
+type incompatibleEncoderBuilder struct {
+	mu           sync.Mutex
+	incompatible map[model.TableID]bool
+}
+
+func (b *incompatibleEncoderBuilder) Build() RowEventEncoder {
+	return &incompatibleEncoder{builder: b}
+}
+
+func (b *incompatibleEncoderBuilder) SchemaCompatibility(
+	tableID model.TableID,
+) tablepb.SchemaCompatibility {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.incompatible[tableID] {
+		return tablepb.SchemaCompatibilityIncompatible
+	}
+	return tablepb.SchemaCompatibilityCompatible
+}
+
+type incompatibleEncoder struct {
+	builder *incompatibleEncoderBuilder
+	result  []*common.Message
+}
+
+func (e *incompatibleEncoder) AppendRowChangedEvent(
+	_ context.Context, _ string, event *model.RowChangedEvent, callback func(),
+) error {
+	if e.builder.SchemaCompatibility(event.Table.TableID) ==
+		tablepb.SchemaCompatibilityIncompatible {
+		return errors.New("incompatible schema")
+	}
+	e.result = append(e.result, &common.Message{Callback: callback})
+	return nil
+}
+
+func (e *incompatibleEncoder) EncodeCheckpointEvent(uint64) (*common.Message, error) {
+	return nil, nil
+}
+
+func (e *incompatibleEncoder) EncodeDDLEvent(*model.DDLEvent) (*common.Message, error) {
+	return nil, nil
+}
+
+func (e *incompatibleEncoder) Build() []*common.Message {
+	result := e.result
+	e.result = nil
+	return result
+}
+
+func TestEncoderGroupHaltIncompatibleTable(t *testing.T) {
+	t.Parallel()
+
+	builder := &incompatibleEncoderBuilder{
+		incompatible: map[model.TableID]bool{1: true},
+	}
+	group := NewEncoderGroup(builder, 1, model.DefaultChangeFeedID("test"))
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- group.Run(ctx)
+	}()
+
+	newEvent := func(tableID model.TableID, acked *atomic.Bool) *dmlsink.RowChangeCallbackableEvent {
+		sinkState := state.TableSinkSinking
+		return &dmlsink.RowChangeCallbackableEvent{
+			Event: &model.RowChangedEvent{
+				Table: &model.TableName{TableID: tableID},
+			},
+			Callback:  func() { acked.Store(true) },
+			SinkState: &sinkState,
+		}
+	}
+	var haltedAcked, acked atomic.Bool
+	halted := newEvent(1, &haltedAcked)
+	require.NoError(t, group.AddEvents(ctx, "topic", 0, halted, newEvent(2, &acked)))
+
+	// Only the event of the compatible table is encoded, and the group keeps
+	// running.
+	future := <-group.Output()
+	require.NoError(t, future.Ready(ctx))
+	require.Len(t, future.Messages, 1)
+	future.Messages[0].Callback()
+	require.True(t, acked.Load())
+	time.Sleep(2 * haltedEventsCheckInterval)
+	require.False(t, haltedAcked.Load())
+
+	// The halted event is acknowledged once its table is stopping.
+	halted.SinkState.Store(state.TableSinkStopping)
+	require.Eventually(t, haltedAcked.Load, 5*time.Second, 100*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
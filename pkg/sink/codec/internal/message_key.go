@@ -28,6 +28,13 @@ type MessageKey struct {
 	RowID     int64             `json:"rid,omitempty"`
 	Partition *int64            `json:"ptn,omitempty"`
 	Type      model.MessageType `json:"t"`
+
+	// OnlyHandleKey is true if only the handle key columns of the row are
+	// carried by the message, since the whole message is too large.
+	OnlyHandleKey bool `json:"ohk,omitempty"`
+	// ClaimCheckLocation is the location of the whole message in the external
+	// storage, it's set if the claim check is enabled.
+	ClaimCheckLocation string `json:"ccl,omitempty"`
 }
 
 // Encode encodes the message key to a byte slice.
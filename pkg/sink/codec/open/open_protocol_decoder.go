@@ -14,12 +14,16 @@
 package open
 
 import (
+	"context"
+	"database/sql"
 	"encoding/binary"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/claimcheck"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
 )
 
@@ -129,6 +133,13 @@ type BatchDecoder struct {
 	valueBytes []byte
 	nextKey    *internal.MessageKey
 	nextKeyLen uint64
+
+	// claimCheck is used to fetch the whole message of the large messages,
+	// it's nil if the claim check is not enabled.
+	claimCheck *claimcheck.ClaimCheck
+	// upstreamTiDB is used to fetch the whole row of the handle-key-only
+	// messages, it could be nil.
+	upstreamTiDB *sql.DB
 }
 
 // HasNext implements the RowEventDecoder interface
@@ -178,11 +189,70 @@ func (b *BatchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 	if err := rowMsg.decode(value); err != nil {
 		return nil, errors.Trace(err)
 	}
-	rowEvent := msgToRowChange(b.nextKey, rowMsg)
+	key := b.nextKey
 	b.nextKey = nil
+	if key.ClaimCheckLocation != "" && b.claimCheck != nil {
+		return b.assembleClaimCheckEvent(key)
+	}
+	rowEvent := msgToRowChange(key, rowMsg)
+	if key.OnlyHandleKey {
+		return b.assembleHandleKeyOnlyEvent(rowEvent)
+	}
 	return rowEvent, nil
 }
 
+// assembleClaimCheckEvent fetches the whole message from the external storage
+// and decodes the row changed event from it.
+func (b *BatchDecoder) assembleClaimCheckEvent(
+	key *internal.MessageKey,
+) (*model.RowChangedEvent, error) {
+	ctx := context.Background()
+	keyBytes, valueBytes, err := b.claimCheck.ReadMessage(ctx, key.ClaimCheckLocation)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	decoder := &BatchDecoder{}
+	if err := decoder.AddKeyValue(keyBytes, valueBytes); err != nil {
+		return nil, errors.Trace(err)
+	}
+	tp, hasNext, err := decoder.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || tp != model.MessageTypeRow {
+		return nil, cerror.ErrOpenProtocolCodecInvalidData.GenWithStack(
+			"not found row event message in the claim check location %s", key.ClaimCheckLocation)
+	}
+	return decoder.NextRowChangedEvent()
+}
+
+// assembleHandleKeyOnlyEvent fetches the whole row of the handle-key-only
+// event from the upstream TiDB.
+func (b *BatchDecoder) assembleHandleKeyOnlyEvent(
+	e *model.RowChangedEvent,
+) (*model.RowChangedEvent, error) {
+	// The handle key columns are enough to delete the row.
+	if e.IsDelete() {
+		return e, nil
+	}
+
+	ctx := context.Background()
+	columns, err := common.SnapshotQuery(ctx, b.upstreamTiDB, e.CommitTs,
+		e.Table.Schema, e.Table.Table, e.Columns)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(e.PreColumns) != 0 {
+		e.PreColumns, err = common.SnapshotQuery(ctx, b.upstreamTiDB, e.CommitTs-1,
+			e.Table.Schema, e.Table.Table, e.PreColumns)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	e.Columns = columns
+	return e, nil
+}
+
 // NextDDLEvent implements the RowEventDecoder interface
 func (b *BatchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
 	if b.nextKey == nil {
@@ -223,10 +293,20 @@ func (b *BatchDecoder) decodeNextKey() error {
 	return nil
 }
 
-// NewBatchDecoder creates a new BatchDecoder.
-func NewBatchDecoder() codec.RowEventDecoder {
-	return &BatchDecoder{}
-
+// NewBatchDecoder creates a new BatchDecoder, the upstreamTiDB is used to
+// fetch the whole row of the handle-key-only messages, it could be nil.
+func NewBatchDecoder(
+	ctx context.Context, config *common.Config, upstreamTiDB *sql.DB,
+) (codec.RowEventDecoder, error) {
+	decoder := &BatchDecoder{upstreamTiDB: upstreamTiDB}
+	if config.LargeMessageHandle.EnableClaimCheck() {
+		claimCheck, err := claimcheck.New(ctx, config.LargeMessageHandle.ClaimCheckStorageURI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		decoder.claimCheck = claimCheck
+	}
+	return decoder, nil
 }
 
 // AddKeyValue implements the RowEventDecoder interface
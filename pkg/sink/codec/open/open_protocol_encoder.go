@@ -24,7 +24,9 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
 	"go.uber.org/zap"
 )
 
@@ -38,23 +40,63 @@ type BatchEncoder struct {
 	MaxMessageBytes          int
 	MaxBatchSize             int
 	OnlyOutputUpdatedColumns bool
+	LargeMessageHandle       *config.LargeMessageHandleConfig
+
+	claimCheck *claimcheck.ClaimCheck
 }
 
 // AppendRowChangedEvent implements the RowEventEncoder interface
 func (d *BatchEncoder) AppendRowChangedEvent(
-	_ context.Context,
+	ctx context.Context,
 	_ string,
 	e *model.RowChangedEvent,
 	callback func(),
 ) error {
-	keyMsg, valueMsg := rowChangeToMsg(e)
-	key, err := keyMsg.Encode()
+	keyMsg, valueMsg := rowChangeToMsg(e, false)
+	key, value, err := d.encodeKeyValue(keyMsg, valueMsg)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	value, err := valueMsg.encode(d.OnlyOutputUpdatedColumns)
-	if err != nil {
-		return errors.Trace(err)
+
+	// for single message that is longer than max-message-bytes, do not send it.
+	if messageLength(key, value) > d.MaxMessageBytes {
+		if d.LargeMessageHandle.Disabled() {
+			log.Warn("Single message is too large for open-protocol",
+				zap.Int("maxMessageBytes", d.MaxMessageBytes),
+				zap.Int("length", messageLength(key, value)),
+				zap.Any("table", e.Table),
+				zap.Any("key", key),
+				zap.Any("value", value))
+			return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+		}
+
+		keyMsg, valueMsg = rowChangeToMsg(e, true)
+		if d.LargeMessageHandle.EnableClaimCheck() {
+			fileName := claimcheck.NewFileName()
+			if err := d.claimCheck.WriteMessage(ctx,
+				encodeSingleKey(key), encodeSingleValue(value), fileName); err != nil {
+				return errors.Trace(err)
+			}
+			keyMsg.ClaimCheckLocation = fileName
+		}
+		key, value, err = d.encodeKeyValue(keyMsg, valueMsg)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if messageLength(key, value) > d.MaxMessageBytes {
+			log.Warn("Single message is still too large for open-protocol "+
+				"when only the handle key columns are sent",
+				zap.Int("maxMessageBytes", d.MaxMessageBytes),
+				zap.Int("length", messageLength(key, value)),
+				zap.Any("table", e.Table),
+				zap.Any("key", key))
+			return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+		}
+		log.Debug("Single message is too large for open-protocol, "+
+			"only the handle key columns are sent",
+			zap.Any("table", e.Table),
+			zap.Uint64("commitTs", e.CommitTs),
+			zap.String("claimCheckLocation", keyMsg.ClaimCheckLocation))
 	}
 
 	var keyLenByte [8]byte
@@ -62,19 +104,6 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	var valueLenByte [8]byte
 	binary.BigEndian.PutUint64(valueLenByte[:], uint64(len(value)))
 
-	// for single message that is longer than max-message-bytes, do not send it.
-	// 16 is the length of `keyLenByte` and `valueLenByte`, 8 is the length of `versionHead`
-	length := len(key) + len(value) + common.MaxRecordOverhead + 16 + 8
-	if length > d.MaxMessageBytes {
-		log.Warn("Single message is too large for open-protocol",
-			zap.Int("maxMessageBytes", d.MaxMessageBytes),
-			zap.Int("length", length),
-			zap.Any("table", e.Table),
-			zap.Any("key", key),
-			zap.Any("value", value))
-		return cerror.ErrMessageTooLarge.GenWithStackByArgs()
-	}
-
 	if len(d.messageBuf) == 0 ||
 		d.curBatchSize >= d.MaxBatchSize ||
 		d.messageBuf[len(d.messageBuf)-1].Length()+len(key)+len(value)+16 > d.MaxMessageBytes {
@@ -106,6 +135,50 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	return nil
 }
 
+func (d *BatchEncoder) encodeKeyValue(
+	keyMsg *internal.MessageKey, valueMsg *messageRow,
+) ([]byte, []byte, error) {
+	key, err := keyMsg.Encode()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	value, err := valueMsg.encode(d.OnlyOutputUpdatedColumns)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return key, value, nil
+}
+
+// messageLength returns the length of a message which only contains the key
+// and the value, 16 is the length of the length of the key and the value,
+// 8 is the length of `versionHead`.
+func messageLength(key, value []byte) int {
+	return len(key) + len(value) + common.MaxRecordOverhead + 16 + 8
+}
+
+// encodeSingleKey encodes the key of a message which only contains one event.
+func encodeSingleKey(key []byte) []byte {
+	keyBuf := new(bytes.Buffer)
+	var versionByte [8]byte
+	binary.BigEndian.PutUint64(versionByte[:], codec.BatchVersion1)
+	keyBuf.Write(versionByte[:])
+	var keyLenByte [8]byte
+	binary.BigEndian.PutUint64(keyLenByte[:], uint64(len(key)))
+	keyBuf.Write(keyLenByte[:])
+	keyBuf.Write(key)
+	return keyBuf.Bytes()
+}
+
+// encodeSingleValue encodes the value of a message which only contains one event.
+func encodeSingleValue(value []byte) []byte {
+	valueBuf := new(bytes.Buffer)
+	var valueLenByte [8]byte
+	binary.BigEndian.PutUint64(valueLenByte[:], uint64(len(value)))
+	valueBuf.Write(valueLenByte[:])
+	valueBuf.Write(value)
+	return valueBuf.Bytes()
+}
+
 // EncodeDDLEvent implements the RowEventEncoder interface
 func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
 	keyMsg, valueMsg := ddlEventToMsg(e)
@@ -188,7 +261,8 @@ func (d *BatchEncoder) tryBuildCallback() {
 }
 
 type batchEncoderBuilder struct {
-	config *common.Config
+	config     *common.Config
+	claimCheck *claimcheck.ClaimCheck
 }
 
 // Build a BatchEncoder
@@ -197,13 +271,25 @@ func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
 	encoder.(*BatchEncoder).MaxMessageBytes = b.config.MaxMessageBytes
 	encoder.(*BatchEncoder).MaxBatchSize = b.config.MaxBatchSize
 	encoder.(*BatchEncoder).OnlyOutputUpdatedColumns = b.config.OnlyOutputUpdatedColumns
+	encoder.(*BatchEncoder).LargeMessageHandle = b.config.LargeMessageHandle
+	encoder.(*BatchEncoder).claimCheck = b.claimCheck
 
 	return encoder
 }
 
 // NewBatchEncoderBuilder creates an open-protocol batchEncoderBuilder.
-func NewBatchEncoderBuilder(config *common.Config) codec.RowEventEncoderBuilder {
-	return &batchEncoderBuilder{config: config}
+func NewBatchEncoderBuilder(
+	ctx context.Context, config *common.Config,
+) (codec.RowEventEncoderBuilder, error) {
+	builder := &batchEncoderBuilder{config: config}
+	if config.LargeMessageHandle.EnableClaimCheck() {
+		claimCheck, err := claimcheck.New(ctx, config.LargeMessageHandle.ClaimCheckStorageURI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		builder.claimCheck = claimCheck
+	}
+	return builder, nil
 }
 
 // NewBatchEncoder creates a new BatchEncoder.
@@ -15,11 +15,13 @@ package open
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
@@ -54,19 +56,25 @@ func TestMaxMessageBytes(t *testing.T) {
 	// for a single message, the overhead is 36(maxRecordOverhead) + 8(versionHea) = 44, just can hold it.
 	a := 88 + 44
 	config := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(a)
-	encoder := NewBatchEncoderBuilder(config).Build()
-	err := encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
+	builder, err := NewBatchEncoderBuilder(ctx, config)
+	require.NoError(t, err)
+	encoder := builder.Build()
+	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.Nil(t, err)
 
 	// cannot hold a single message
 	config = config.WithMaxMessageBytes(a - 1)
-	encoder = NewBatchEncoderBuilder(config).Build()
+	builder, err = NewBatchEncoderBuilder(ctx, config)
+	require.NoError(t, err)
+	encoder = builder.Build()
 	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.NotNil(t, err)
 
 	// make sure each batch's `Length` not greater than `max-message-bytes`
 	config = config.WithMaxMessageBytes(256)
-	encoder = NewBatchEncoderBuilder(config).Build()
+	builder, err = NewBatchEncoderBuilder(ctx, config)
+	require.NoError(t, err)
+	encoder = builder.Build()
 	for i := 0; i < 10000; i++ {
 		err := encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 		require.Nil(t, err)
@@ -82,7 +90,9 @@ func TestMaxBatchSize(t *testing.T) {
 	t.Parallel()
 	config := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(1048576)
 	config.MaxBatchSize = 64
-	encoder := NewBatchEncoderBuilder(config).Build()
+	builder, err := NewBatchEncoderBuilder(context.Background(), config)
+	require.NoError(t, err)
+	encoder := builder.Build()
 
 	testEvent := &model.RowChangedEvent{
 		CommitTs: 1,
@@ -100,7 +110,8 @@ func TestMaxBatchSize(t *testing.T) {
 	}
 
 	messages := encoder.Build()
-	decoder := NewBatchDecoder()
+	decoder, err := NewBatchDecoder(context.Background(), config, nil)
+	require.NoError(t, err)
 	sum := 0
 	for _, msg := range messages {
 		err := decoder.AddKeyValue(msg.Key, msg.Value)
@@ -208,10 +219,87 @@ func TestOpenProtocolBatchCodec(t *testing.T) {
 	config := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(8192)
 	config.MaxBatchSize = 64
 	tester := internal.NewDefaultBatchTester()
-	tester.TestBatchCodec(t, NewBatchEncoderBuilder(config),
+	builder, err := NewBatchEncoderBuilder(context.Background(), config)
+	require.NoError(t, err)
+	tester.TestBatchCodec(t, builder,
 		func(key []byte, value []byte) (codec.RowEventDecoder, error) {
-			decoder := NewBatchDecoder()
-			err := decoder.AddKeyValue(key, value)
+			decoder, err := NewBatchDecoder(context.Background(), config, nil)
+			if err != nil {
+				return nil, err
+			}
+			err = decoder.AddKeyValue(key, value)
 			return decoder, err
 		})
 }
+
+func TestOpenProtocolLargeMessageHandle(t *testing.T) {
+	t.Parallel()
+
+	largeEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			{
+				Name:  "id",
+				Type:  mysql.TypeLong,
+				Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+				Value: int64(1),
+			},
+			{
+				Name:  "col1",
+				Type:  mysql.TypeVarchar,
+				Value: []byte(strings.Repeat("a", 1024)),
+			},
+		},
+	}
+
+	ctx := context.Background()
+	codecConfig := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(512)
+	builder, err := NewBatchEncoderBuilder(ctx, codecConfig)
+	require.NoError(t, err)
+	err = builder.Build().AppendRowChangedEvent(ctx, "", largeEvent, nil)
+	require.True(t, cerror.ErrMessageTooLarge.Equal(err))
+
+	for _, option := range []string{
+		config.LargeMessageHandleOptionHandleKeyOnly,
+		config.LargeMessageHandleOptionClaimCheck,
+	} {
+		codecConfig.LargeMessageHandle = &config.LargeMessageHandleConfig{
+			LargeMessageHandleOption: option,
+			ClaimCheckStorageURI:     "file://" + t.TempDir(),
+		}
+		builder, err := NewBatchEncoderBuilder(ctx, codecConfig)
+		require.NoError(t, err)
+		encoder := builder.Build()
+		err = encoder.AppendRowChangedEvent(ctx, "", largeEvent, nil)
+		require.NoError(t, err)
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+		require.LessOrEqual(t, messages[0].Length(), 512)
+
+		decoder, err := NewBatchDecoder(ctx, codecConfig, nil)
+		require.NoError(t, err)
+		err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		tp, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+
+		if option == config.LargeMessageHandleOptionHandleKeyOnly {
+			// the whole row can't be fetched without the upstream TiDB.
+			_, err = decoder.NextRowChangedEvent()
+			require.True(t, cerror.ErrCodecDecode.Equal(err))
+			continue
+		}
+		decoded, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, largeEvent.CommitTs, decoded.CommitTs)
+		require.Len(t, decoded.Columns, 2)
+		for _, col := range decoded.Columns {
+			if col.Name == "col1" {
+				require.Equal(t, largeEvent.Columns[1].Value, col.Value)
+			}
+		}
+	}
+}
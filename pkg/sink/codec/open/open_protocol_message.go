@@ -94,7 +94,11 @@ func newResolvedMessage(ts uint64) *internal.MessageKey {
 	}
 }
 
-func rowChangeToMsg(e *model.RowChangedEvent) (*internal.MessageKey, *messageRow) {
+// rowChangeToMsg converts a row changed event to the message, only the handle
+// key columns are kept if onlyHandleKey is true.
+func rowChangeToMsg(
+	e *model.RowChangedEvent, onlyHandleKey bool,
+) (*internal.MessageKey, *messageRow) {
 	var partition *int64
 	if e.Table.IsPartition {
 		partition = &e.Table.TableID
@@ -106,13 +110,15 @@ func rowChangeToMsg(e *model.RowChangedEvent) (*internal.MessageKey, *messageRow
 		RowID:     e.RowID,
 		Partition: partition,
 		Type:      model.MessageTypeRow,
+
+		OnlyHandleKey: onlyHandleKey,
 	}
 	value := &messageRow{}
 	if e.IsDelete() {
-		value.Delete = rowChangeColumns2CodecColumns(e.PreColumns)
+		value.Delete = rowChangeColumns2CodecColumns(e.PreColumns, onlyHandleKey)
 	} else {
-		value.Update = rowChangeColumns2CodecColumns(e.Columns)
-		value.PreColumns = rowChangeColumns2CodecColumns(e.PreColumns)
+		value.Update = rowChangeColumns2CodecColumns(e.Columns, onlyHandleKey)
+		value.PreColumns = rowChangeColumns2CodecColumns(e.PreColumns, onlyHandleKey)
 	}
 	return key, value
 }
@@ -141,12 +147,17 @@ func msgToRowChange(key *internal.MessageKey, value *messageRow) *model.RowChang
 	return e
 }
 
-func rowChangeColumns2CodecColumns(cols []*model.Column) map[string]internal.Column {
+func rowChangeColumns2CodecColumns(
+	cols []*model.Column, onlyHandleKey bool,
+) map[string]internal.Column {
 	jsonCols := make(map[string]internal.Column, len(cols))
 	for _, col := range cols {
 		if col == nil {
 			continue
 		}
+		if onlyHandleKey && !col.Flag.IsHandleKey() {
+			continue
+		}
 		c := internal.Column{}
 		c.FromRowChangeColumn(col)
 		jsonCols[col.Name] = c
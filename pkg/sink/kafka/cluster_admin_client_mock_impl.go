@@ -115,7 +115,11 @@ func (c *ClusterAdminClientMockImpl) GetAllTopicsMeta(
 
 // GetAllBrokers implement the ClusterAdminClient interface
 func (c *ClusterAdminClientMockImpl) GetAllBrokers(context.Context) ([]Broker, error) {
-	return nil, nil
+	brokers := make([]Broker, 0, defaultReplicationFactor)
+	for i := 0; i < defaultReplicationFactor; i++ {
+		brokers = append(brokers, Broker{ID: int32(c.controllerID + i)})
+	}
+	return brokers, nil
 }
 
 // GetCoordinator implement the ClusterAdminClient interface
@@ -175,7 +179,7 @@ func (c *ClusterAdminClientMockImpl) GetTopicsMeta(
 func (c *ClusterAdminClientMockImpl) CreateTopic(
 	_ context.Context,
 	detail *TopicDetail,
-	_ bool,
+	validateOnly bool,
 ) error {
 	if detail.ReplicationFactor > defaultReplicationFactor {
 		return sarama.ErrInvalidReplicationFactor
@@ -189,6 +193,10 @@ func (c *ClusterAdminClientMockImpl) CreateTopic(
 		return sarama.ErrPolicyViolation
 	}
 
+	if validateOnly {
+		return nil
+	}
+
 	c.topics[detail.Name] = &topicDetail{
 		TopicDetail: *detail,
 	}
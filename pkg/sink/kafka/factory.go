@@ -74,10 +74,11 @@ type AsyncProducer interface {
 	Close()
 
 	// AsyncSend is the input channel for the user to write messages to that they
-	// wish to send.
+	// wish to send. The callback is called with the offset of the message in
+	// the partition after the message is sent.
 	AsyncSend(ctx context.Context, topic string,
 		partition int32, key []byte, value []byte,
-		callback func()) error
+		callback func(offset int64)) error
 
 	// AsyncRunCallback process the messages that has sent to kafka,
 	// and run tha attached callback. the caller should call this
@@ -239,9 +240,9 @@ func (p *saramaAsyncProducer) AsyncRunCallback(
 			return errors.Trace(err)
 		case ack := <-p.producer.Successes():
 			if ack != nil {
-				callback := ack.Metadata.(func())
+				callback := ack.Metadata.(func(int64))
 				if callback != nil {
-					callback()
+					callback(ack.Offset)
 				}
 			}
 		case err := <-p.producer.Errors():
@@ -265,7 +266,7 @@ func (p *saramaAsyncProducer) AsyncSend(ctx context.Context,
 	partition int32,
 	key []byte,
 	value []byte,
-	callback func(),
+	callback func(offset int64),
 ) error {
 	msg := &sarama.ProducerMessage{
 		Topic:     topic,
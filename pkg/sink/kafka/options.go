@@ -585,6 +585,44 @@ func AdjustOptions(
 		log.Warn("partition-num is not set, use the default partition count",
 			zap.String("topic", topic), zap.Int32("partitions", options.PartitionNum))
 	}
+
+	if options.AutoCreate {
+		return validateTopicCreation(ctx, admin, options, topic)
+	}
+	return nil
+}
+
+// validateTopicCreation makes sure that the topics can be created by the
+// `partition-num` and `replication-factor`, so that the changefeed fails at
+// creation time instead of failing when the topics are created on demand,
+// e.g. the per-table topics dispatched by the topic expressions.
+func validateTopicCreation(
+	ctx context.Context,
+	admin ClusterAdminClient,
+	options *Options,
+	topic string,
+) error {
+	brokers, err := admin.GetAllBrokers(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if int(options.ReplicationFactor) > len(brokers) {
+		return cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"`replication-factor` %d cannot be larger than the number of brokers %d",
+			options.ReplicationFactor, len(brokers))
+	}
+
+	// Let the brokers validate the topic creation, the topic is not created
+	// actually, so the broker side limits such as the topic policies are
+	// checked as well.
+	err = admin.CreateTopic(ctx, &TopicDetail{
+		Name:              topic,
+		NumPartitions:     options.PartitionNum,
+		ReplicationFactor: options.ReplicationFactor,
+	}, true)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrKafkaCreateTopic, err)
+	}
 	return nil
 }
 
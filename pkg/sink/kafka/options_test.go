@@ -334,8 +334,10 @@ func TestAdjustConfigMinInsyncReplicas(t *testing.T) {
 	// topic not exist, and `min.insync.replicas` not found in broker's configuration
 	adminClient.DropBrokerConfig(MinInsyncReplicasConfigName)
 	topicName := "no-topic-no-min-insync-replicas"
+	// the topic cannot be created by the policy of the broker, which is
+	// validated when adjusting the options.
 	err = AdjustOptions(ctx, adminClient, options, "no-topic-no-min-insync-replicas")
-	require.Nil(t, err)
+	require.ErrorIs(t, err, sarama.ErrPolicyViolation)
 	err = adminClient.CreateTopic(context.Background(), &TopicDetail{
 		Name:              topicName,
 		ReplicationFactor: 1,
@@ -365,6 +367,34 @@ func TestAdjustConfigMinInsyncReplicas(t *testing.T) {
 	)
 }
 
+func TestAdjustConfigValidateTopicCreation(t *testing.T) {
+	adminClient := NewClusterAdminClientMockImpl()
+	defer adminClient.Close()
+
+	ctx := context.Background()
+	options := NewOptions()
+	options.BrokerEndpoints = []string{"127.0.0.1:9092"}
+	options.PartitionNum = 2
+
+	// The topic is only validated, not created.
+	err := AdjustOptions(ctx, adminClient, options, "validate-only")
+	require.NoError(t, err)
+	topics, err := adminClient.GetAllTopicsMeta(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, topics, "validate-only")
+
+	// replication-factor is larger than the number of brokers.
+	options.ReplicationFactor = 4
+	err = AdjustOptions(ctx, adminClient, options, "too-many-replicas")
+	require.Regexp(t, ".*`replication-factor` 4 cannot be larger than "+
+		"the number of brokers 3.*", err)
+
+	// The topic is not validated if it's not created automatically.
+	options.AutoCreate = false
+	err = AdjustOptions(ctx, adminClient, options, "too-many-replicas")
+	require.NoError(t, err)
+}
+
 func TestSkipAdjustConfigMinInsyncReplicasWhenRequiredAcksIsNotWailAll(t *testing.T) {
 	adminClient := NewClusterAdminClientMockImpl()
 	defer adminClient.Close()
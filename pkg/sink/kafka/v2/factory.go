@@ -247,9 +247,9 @@ func (f *factory) AsyncProducer(
 		}
 
 		for _, msg := range messages {
-			callback := msg.WriterData.(func())
+			callback := msg.WriterData.(func(int64))
 			if callback != nil {
-				callback()
+				callback(msg.Offset)
 			}
 		}
 	}
@@ -364,7 +364,7 @@ func (a *asyncWriter) Close() {
 // wish to send.
 func (a *asyncWriter) AsyncSend(ctx context.Context, topic string,
 	partition int32, key []byte, value []byte,
-	callback func(),
+	callback func(offset int64),
 ) error {
 	select {
 	case <-ctx.Done():
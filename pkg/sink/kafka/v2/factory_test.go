@@ -230,19 +230,24 @@ func TestAsyncCompletetion(t *testing.T) {
 	require.True(t, ok)
 	w := asyncP.w.(*kafka.Writer)
 	acked := 0
-	callback := func() {
+	var offsets []int64
+	callback := func(offset int64) {
 		acked++
+		offsets = append(offsets, offset)
 	}
 	msgs := []kafka.Message{
 		{
+			Offset:     10,
 			WriterData: callback,
 		},
 		{
+			Offset:     11,
 			WriterData: callback,
 		},
 	}
 	w.Completion(msgs, nil)
 	require.Equal(t, 2, acked)
+	require.Equal(t, []int64{10, 11}, offsets)
 	asyncP.errorsChan = make(chan error, 2)
 	w.Completion(msgs, errors.New("fake"))
 	require.Equal(t, 1, len(asyncP.errorsChan))
@@ -337,7 +342,7 @@ func TestAsyncWriterAsyncSend(t *testing.T) {
 	closedCh := make(chan struct{}, 2)
 	closedCh <- struct{}{}
 	w.closedChan = closedCh
-	callback := func() {}
+	callback := func(int64) {}
 	err := w.AsyncSend(context.Background(), "topic", 1, []byte{'1'}, []byte{}, callback)
 	require.Nil(t, err)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -74,11 +74,16 @@ const (
 	// BackoffMaxDelay indicates the max delay time for retrying.
 	BackoffMaxDelay = 60 * time.Second
 
-	defaultBatchDMLEnable  = true
-	defaultMultiStmtEnable = true
+	defaultBatchDMLEnable      = true
+	defaultMultiStmtEnable     = true
+	defaultDMLCompactionEnable = false
+	defaultAsyncDDLEnable      = false
 
 	// defaultcachePrepStmts is the default value of cachePrepStmts
 	defaultCachePrepStmts = true
+	// defaultMaxCachedStmts is the default max number of cached prepared
+	// statements of a changefeed.
+	defaultMaxCachedStmts = 16 * 1024
 )
 
 type urlConfig struct {
@@ -98,6 +103,11 @@ type urlConfig struct {
 	EnableBatchDML               *bool   `form:"batch-dml-enable"`
 	EnableMultiStatement         *bool   `form:"multi-stmt-enable"`
 	EnableCachePreparedStatement *bool   `form:"cache-prep-stmts"`
+	EnableDMLCompaction          *bool   `form:"dml-compaction-enable"`
+	EnableAsyncDDL               *bool   `form:"async-ddl-enable"`
+	MaxCachedStmts               *int    `form:"max-cached-stmts"`
+	ConnPoolSize                 *int    `form:"conn-pool-size"`
+	TxnIsolation                 *string `form:"transaction-isolation"`
 }
 
 // Config is the configs for MySQL backend.
@@ -121,6 +131,18 @@ type Config struct {
 	BatchDMLEnable  bool
 	MultiStmtEnable bool
 	CachePrepStmts  bool
+	// MaxCachedStmts is the max number of cached prepared statements.
+	MaxCachedStmts int
+	// ConnPoolSize is the size of the connection pool, it is worker-count + 1
+	// if it's not specified.
+	ConnPoolSize int
+	// TxnIsolation is the transaction isolation level of the connections.
+	TxnIsolation string
+	// DMLCompactionEnable merges changes of the same row in a flush.
+	DMLCompactionEnable bool
+	// AsyncDDLEnable executes slow DDLs, e.g. adding indexes, asynchronously
+	// in the downstream, only tables touched by the DDLs are blocked.
+	AsyncDDLEnable bool
 }
 
 // NewConfig returns the default mysql backend config.
@@ -138,6 +160,10 @@ func NewConfig() *Config {
 		BatchDMLEnable:         defaultBatchDMLEnable,
 		MultiStmtEnable:        defaultMultiStmtEnable,
 		CachePrepStmts:         defaultCachePrepStmts,
+		MaxCachedStmts:         defaultMaxCachedStmts,
+		TxnIsolation:           defaultTxnIsolationRC,
+		DMLCompactionEnable:    defaultDMLCompactionEnable,
+		AsyncDDLEnable:         defaultAsyncDDLEnable,
 	}
 }
 
@@ -196,6 +222,17 @@ func (c *Config) Apply(
 	getBatchDMLEnable(urlParameter, &c.BatchDMLEnable)
 	getMultiStmtEnable(urlParameter, &c.MultiStmtEnable)
 	getCachePrepStmts(urlParameter, &c.CachePrepStmts)
+	if err = getMaxCachedStmts(urlParameter, &c.MaxCachedStmts); err != nil {
+		return err
+	}
+	if err = getConnPoolSize(urlParameter, c.WorkerCount, &c.ConnPoolSize); err != nil {
+		return err
+	}
+	if err = getTxnIsolation(urlParameter, &c.TxnIsolation); err != nil {
+		return err
+	}
+	getDMLCompactionEnable(urlParameter, &c.DMLCompactionEnable)
+	getAsyncDDLEnable(urlParameter, &c.AsyncDDLEnable)
 	c.EnableOldValue = replicaConfig.EnableOldValue
 	c.ForceReplicate = replicaConfig.ForceReplicate
 	c.SourceID = replicaConfig.Sink.TiDBSourceID
@@ -226,6 +263,11 @@ func mergeConfig(
 		dest.EnableBatchDML = mConfig.EnableBatchDML
 		dest.EnableMultiStatement = mConfig.EnableMultiStatement
 		dest.EnableCachePreparedStatement = mConfig.EnableCachePreparedStatement
+		dest.EnableDMLCompaction = mConfig.EnableDMLCompaction
+		dest.EnableAsyncDDL = mConfig.EnableAsyncDDL
+		dest.MaxCachedStmts = mConfig.MaxCachedPreparedStatements
+		dest.ConnPoolSize = mConfig.ConnPoolSize
+		dest.TxnIsolation = mConfig.TransactionIsolation
 	}
 	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
 		return nil, err
@@ -437,3 +479,62 @@ func getCachePrepStmts(values *urlConfig, cachePrepStmts *bool) {
 		*cachePrepStmts = *values.EnableCachePreparedStatement
 	}
 }
+
+func getDMLCompactionEnable(values *urlConfig, dmlCompactionEnable *bool) {
+	if values.EnableDMLCompaction != nil {
+		*dmlCompactionEnable = *values.EnableDMLCompaction
+	}
+}
+
+func getAsyncDDLEnable(values *urlConfig, asyncDDLEnable *bool) {
+	if values.EnableAsyncDDL != nil {
+		*asyncDDLEnable = *values.EnableAsyncDDL
+	}
+}
+
+func getMaxCachedStmts(values *urlConfig, maxCachedStmts *int) error {
+	if values.MaxCachedStmts == nil {
+		return nil
+	}
+	c := *values.MaxCachedStmts
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrMySQLInvalidConfig,
+			fmt.Errorf("invalid max-cached-stmts %d, which must be greater than 0", c))
+	}
+	*maxCachedStmts = c
+	return nil
+}
+
+// getConnPoolSize gets the size of the connection pool. An extra connection
+// is required by each worker to prepare statements, so the pool size must be
+// greater than worker-count.
+func getConnPoolSize(values *urlConfig, workerCount int, connPoolSize *int) error {
+	if values.ConnPoolSize == nil {
+		*connPoolSize = workerCount + 1
+		return nil
+	}
+	c := *values.ConnPoolSize
+	if c <= workerCount {
+		return cerror.WrapError(cerror.ErrMySQLInvalidConfig,
+			fmt.Errorf("invalid conn-pool-size %d, which must be greater than "+
+				"worker-count %d", c, workerCount))
+	}
+	*connPoolSize = c
+	return nil
+}
+
+func getTxnIsolation(values *urlConfig, txnIsolation *string) error {
+	if values.TxnIsolation == nil || len(*values.TxnIsolation) == 0 {
+		return nil
+	}
+	s := strings.ToUpper(*values.TxnIsolation)
+	switch s {
+	case "READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE":
+		*txnIsolation = s
+		return nil
+	default:
+		return cerror.WrapError(cerror.ErrMySQLInvalidConfig,
+			fmt.Errorf("invalid transaction-isolation %s, which must be one of "+
+				"READ-UNCOMMITTED, READ-COMMITTED, REPEATABLE-READ and SERIALIZABLE", s))
+	}
+}
@@ -171,6 +171,31 @@ func TestGenerateDSNByConfig(t *testing.T) {
 		for _, param := range expectedCfg {
 			require.True(t, strings.Contains(dsnStr, param))
 		}
+
+		// simulate configured transaction_isolation
+		mock.ExpectQuery("show session variables like 'allow_auto_random_explicit_insert';").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("allow_auto_random_explicit_insert", "0"),
+		)
+		mock.ExpectQuery("show session variables like 'tidb_txn_mode';").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("tidb_txn_mode", "pessimistic"),
+		)
+		mock.ExpectQuery("show session variables like 'transaction_isolation';").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("transaction_isolation", "READ-COMMITTED"),
+		)
+		mock.ExpectQuery("show session variables like 'tidb_placement_mode';").
+			WillReturnRows(
+				sqlmock.NewRows(columns).
+					AddRow("tidb_placement_mode", "IGNORE"),
+			)
+		mock.ExpectQuery("show session variables like 'tidb_enable_external_ts_read';").
+			WillReturnRows(
+				sqlmock.NewRows(columns).
+					AddRow("tidb_enable_external_ts_read", "OFF"),
+			)
+		cfg.TxnIsolation = "REPEATABLE-READ"
+		dsnStr, err = generateDSNByConfig(context.TODO(), dsn, cfg, db)
+		require.Nil(t, err)
+		require.True(t, strings.Contains(dsnStr, "transaction_isolation=%22REPEATABLE-READ%22"))
 	}
 
 	testDefaultConfig()
@@ -192,12 +217,16 @@ func TestApplySinkURIParamsToConfig(t *testing.T) {
 	expected.tidbTxnMode = "pessimistic"
 	expected.EnableOldValue = true
 	expected.CachePrepStmts = true
+	expected.MaxCachedStmts = 128
+	expected.ConnPoolSize = 65
+	expected.TxnIsolation = "REPEATABLE-READ"
 	uriStr := "mysql://127.0.0.1:3306/?worker-count=64&max-txn-row=20" +
 		"&max-multi-update-row=80&max-multi-update-row-size=512" +
 		"&safe-mode=false" +
 		"&tidb-txn-mode=pessimistic" +
 		"&test-some-deprecated-config=true&test-deprecated-size-config=100" +
-		"&cache-prep-stmts=true&prep-stmt-cache-size=1000000"
+		"&cache-prep-stmts=true&prep-stmt-cache-size=1000000" +
+		"&max-cached-stmts=128&transaction-isolation=repeatable-read"
 	uri, err := url.Parse(uriStr)
 	require.Nil(t, err)
 	cfg := NewConfig()
@@ -242,6 +271,17 @@ func TestParseSinkURIOverride(t *testing.T) {
 		checker: func(sp *Config) {
 			require.EqualValues(t, sp.CachePrepStmts, false)
 		},
+	}, {
+		uri: "mysql://127.0.0.1:3306/?worker-count=4",
+		checker: func(sp *Config) {
+			require.EqualValues(t, sp.ConnPoolSize, 5)
+			require.EqualValues(t, sp.TxnIsolation, defaultTxnIsolationRC)
+		},
+	}, {
+		uri: "mysql://127.0.0.1:3306/?worker-count=4&conn-pool-size=10",
+		checker: func(sp *Config) {
+			require.EqualValues(t, sp.ConnPoolSize, 10)
+		},
 	}}
 	ctx := context.TODO()
 	var uri *url.URL
@@ -280,6 +320,9 @@ func TestParseSinkURIBadQueryString(t *testing.T) {
 		"mysql://127.0.0.1:3306/?write-timeout=badduration",
 		"mysql://127.0.0.1:3306/?read-timeout=badduration",
 		"mysql://127.0.0.1:3306/?timeout=badduration",
+		"mysql://127.0.0.1:3306/?max-cached-stmts=0",
+		"mysql://127.0.0.1:3306/?worker-count=4&conn-pool-size=4",
+		"mysql://127.0.0.1:3306/?transaction-isolation=badlevel",
 	}
 	ctx := context.TODO()
 	var uri *url.URL
@@ -430,6 +473,11 @@ func TestMergeConfig(t *testing.T) {
 		EnableBatchDML:               aws.Bool(true),
 		EnableMultiStatement:         aws.Bool(true),
 		EnableCachePreparedStatement: aws.Bool(true),
+		EnableDMLCompaction:          aws.Bool(true),
+		EnableAsyncDDL:               aws.Bool(true),
+		MaxCachedPreparedStatements:  aws.Int(1000),
+		ConnPoolSize:                 aws.Int(20),
+		TransactionIsolation:         aws.String("serializable"),
 	}
 	c := NewConfig()
 	tz, _ := time.LoadLocation("Asia/Shanghai")
@@ -448,6 +496,11 @@ func TestMergeConfig(t *testing.T) {
 	require.Equal(t, true, c.BatchDMLEnable)
 	require.Equal(t, true, c.MultiStmtEnable)
 	require.Equal(t, true, c.CachePrepStmts)
+	require.Equal(t, true, c.DMLCompactionEnable)
+	require.Equal(t, true, c.AsyncDDLEnable)
+	require.Equal(t, 1000, c.MaxCachedStmts)
+	require.Equal(t, 20, c.ConnPoolSize)
+	require.Equal(t, "SERIALIZABLE", c.TxnIsolation)
 
 	uri = "mysql://topic?" +
 		"worker-count=13&" +
@@ -461,7 +514,9 @@ func TestMergeConfig(t *testing.T) {
 		"timeout=1m3s&" +
 		"batch-dml-enable=true&" +
 		"multi-stmt-enable=true&" +
-		"cache-prep-stmts=true"
+		"cache-prep-stmts=true&" +
+		"dml-compaction-enable=true&" +
+		"async-ddl-enable=true"
 	sinkURI, err = url.Parse(uri)
 	require.NoError(t, err)
 	replicaConfig = config.GetDefaultReplicaConfig()
@@ -478,6 +533,8 @@ func TestMergeConfig(t *testing.T) {
 		EnableBatchDML:               aws.Bool(false),
 		EnableMultiStatement:         aws.Bool(false),
 		EnableCachePreparedStatement: aws.Bool(false),
+		EnableDMLCompaction:          aws.Bool(false),
+		EnableAsyncDDL:               aws.Bool(false),
 	}
 	c = NewConfig()
 	ctx = contextutil.PutTimezoneInCtx(context.Background(), tz)
@@ -495,4 +552,6 @@ func TestMergeConfig(t *testing.T) {
 	require.Equal(t, true, c.BatchDMLEnable)
 	require.Equal(t, true, c.MultiStmtEnable)
 	require.Equal(t, true, c.CachePrepStmts)
+	require.Equal(t, true, c.DMLCompactionEnable)
+	require.Equal(t, true, c.AsyncDDLEnable)
 }
@@ -137,16 +137,16 @@ func generateDSNByConfig(
 		dsnCfg.Params["tidb_txn_mode"] = txnMode
 	}
 
-	// Since we don't need select, just set default isolation level to read-committed
+	// Since we don't need select, the default isolation level is read-committed
 	// transaction_isolation is mysql newly introduced variable and will vary from MySQL5.7/MySQL8.0/Mariadb
-	isolation, err := checkTiDBVariable(ctx, testDB, "transaction_isolation", defaultTxnIsolationRC)
+	isolation, err := checkTiDBVariable(ctx, testDB, "transaction_isolation", cfg.TxnIsolation)
 	if err != nil {
 		return "", err
 	}
 	if isolation != "" {
-		dsnCfg.Params["transaction_isolation"] = fmt.Sprintf(`"%s"`, defaultTxnIsolationRC)
+		dsnCfg.Params["transaction_isolation"] = fmt.Sprintf(`"%s"`, cfg.TxnIsolation)
 	} else {
-		dsnCfg.Params["tx_isolation"] = fmt.Sprintf(`"%s"`, defaultTxnIsolationRC)
+		dsnCfg.Params["tx_isolation"] = fmt.Sprintf(`"%s"`, cfg.TxnIsolation)
 	}
 
 	// equals to executing "SET NAMES utf8mb4"
@@ -47,7 +47,7 @@ var (
 )
 
 // InitMetrics registers all metrics in this file.
-func InitMetrics(registry *prometheus.Registry) {
+func InitMetrics(registry prometheus.Registerer) {
 	registry.MustRegister(tidbConnIdleDurationGauge)
 	registry.MustRegister(tidbConnCountGauge)
 	registry.MustRegister(tidbQueryDurationGauge)
@@ -0,0 +1,244 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"go.uber.org/zap"
+)
+
+// TranslateDDL translates a DDL event to the Oracle statements. The common
+// table DDLs are supported, and the changes are computed by comparing the
+// table infos before and after the DDL, so the TiDB dialect of the query is
+// not parsed. An empty result means the DDL is skipped.
+func TranslateDDL(ddl *model.DDLEvent) []string {
+	var stmts []string
+	switch ddl.Type {
+	case timodel.ActionCreateTable:
+		stmts = createTable(ddl.TableInfo)
+	case timodel.ActionDropTable:
+		stmts = []string{"DROP TABLE " + quoteTableInfo(ddl.TableInfo) + " PURGE"}
+	case timodel.ActionTruncateTable:
+		stmts = []string{"TRUNCATE TABLE " + quoteTableInfo(ddl.TableInfo)}
+	case timodel.ActionRenameTable, timodel.ActionRenameTables:
+		stmts = renameTable(ddl.PreTableInfo, ddl.TableInfo)
+	case timodel.ActionAddColumn, timodel.ActionDropColumn:
+		stmts = alterColumns(ddl.PreTableInfo, ddl.TableInfo)
+	case timodel.ActionAddIndex, timodel.ActionDropIndex,
+		timodel.ActionAddPrimaryKey, timodel.ActionDropPrimaryKey:
+		stmts = alterIndices(ddl.PreTableInfo, ddl.TableInfo)
+	}
+	if len(stmts) == 0 {
+		log.Warn("DDL is not supported by the Oracle sink, skip it",
+			zap.String("query", ddl.Query),
+			zap.Stringer("type", ddl.Type),
+			zap.Uint64("commitTs", ddl.CommitTs))
+	}
+	return stmts
+}
+
+func quoteTableInfo(info *model.TableInfo) string {
+	return QuoteTable(info.TableName.Schema, info.TableName.Table)
+}
+
+// isReplicatedColumn returns false for the columns which are not replicated,
+// i.e. the hidden columns and the generated columns.
+func isReplicatedColumn(col *timodel.ColumnInfo) bool {
+	return !col.Hidden && !col.IsGenerated() && col.State == timodel.StatePublic
+}
+
+func columnDefinition(col *timodel.ColumnInfo, withNotNull bool) string {
+	def := QuoteName(col.Name.O) + " " + ColumnType(&col.FieldType)
+	if withNotNull && mysql.HasNotNullFlag(col.GetFlag()) {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// primaryKeyColumns returns the names of the primary key columns.
+func primaryKeyColumns(info *model.TableInfo) []string {
+	if info.PKIsHandle {
+		for _, col := range info.Columns {
+			if mysql.HasPriKeyFlag(col.GetFlag()) {
+				return []string{col.Name.O}
+			}
+		}
+	}
+	for _, idx := range info.Indices {
+		if idx.Primary {
+			return indexColumns(idx)
+		}
+	}
+	return nil
+}
+
+func indexColumns(idx *timodel.IndexInfo) []string {
+	cols := make([]string, 0, len(idx.Columns))
+	for _, col := range idx.Columns {
+		cols = append(cols, col.Name.O)
+	}
+	return cols
+}
+
+func quoteNames(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, QuoteName(name))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// indexName returns the name of an index. The index names are unique in an
+// Oracle schema, so they are prefixed by the table names.
+func indexName(info *model.TableInfo, idx *timodel.IndexInfo) string {
+	return QuoteTable(info.TableName.Schema, info.TableName.Table+"_"+idx.Name.O)
+}
+
+func createIndex(info *model.TableInfo, idx *timodel.IndexInfo) string {
+	unique := ""
+	if idx.Unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s ON %s (%s)", unique,
+		indexName(info, idx), quoteTableInfo(info), quoteNames(indexColumns(idx)))
+}
+
+func createTable(info *model.TableInfo) []string {
+	if info == nil || info.TableInfo == nil {
+		return nil
+	}
+	defs := make([]string, 0, len(info.Columns)+1)
+	for _, col := range info.Columns {
+		if isReplicatedColumn(col) {
+			defs = append(defs, columnDefinition(col, true))
+		}
+	}
+	if pk := primaryKeyColumns(info); len(pk) > 0 {
+		defs = append(defs, "PRIMARY KEY ("+quoteNames(pk)+")")
+	}
+	stmts := []string{fmt.Sprintf("CREATE TABLE %s (%s)",
+		quoteTableInfo(info), strings.Join(defs, ", "))}
+	for _, idx := range info.Indices {
+		if !idx.Primary && idx.State == timodel.StatePublic {
+			stmts = append(stmts, createIndex(info, idx))
+		}
+	}
+	return stmts
+}
+
+func renameTable(pre, info *model.TableInfo) []string {
+	if pre == nil || info == nil {
+		return nil
+	}
+	// Oracle can not move a table to another schema by renaming.
+	if pre.TableName.Schema != info.TableName.Schema {
+		return nil
+	}
+	return []string{fmt.Sprintf("ALTER TABLE %s RENAME TO %s",
+		quoteTableInfo(pre), QuoteName(info.TableName.Table))}
+}
+
+func alterColumns(pre, info *model.TableInfo) []string {
+	if pre == nil || pre.TableInfo == nil || info == nil || info.TableInfo == nil {
+		return nil
+	}
+	preCols := make(map[int64]*timodel.ColumnInfo, len(pre.Columns))
+	for _, col := range pre.Columns {
+		if isReplicatedColumn(col) {
+			preCols[col.ID] = col
+		}
+	}
+	var added []string
+	for _, col := range info.Columns {
+		if !isReplicatedColumn(col) {
+			continue
+		}
+		if _, ok := preCols[col.ID]; ok {
+			delete(preCols, col.ID)
+			continue
+		}
+		// The added columns are nullable, as adding a NOT NULL column
+		// without a default value fails if the table is not empty.
+		added = append(added, columnDefinition(col, false))
+	}
+
+	var stmts []string
+	table := quoteTableInfo(info)
+	if len(added) > 0 {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD (%s)",
+			table, strings.Join(added, ", ")))
+	}
+	// Keep the order of the dropped columns stable.
+	for _, col := range pre.Columns {
+		if _, ok := preCols[col.ID]; ok {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s",
+				table, QuoteName(col.Name.O)))
+		}
+	}
+	return stmts
+}
+
+func alterIndices(pre, info *model.TableInfo) []string {
+	if pre == nil || pre.TableInfo == nil || info == nil || info.TableInfo == nil {
+		return nil
+	}
+	preIndices := make(map[int64]*timodel.IndexInfo, len(pre.Indices))
+	for _, idx := range pre.Indices {
+		if idx.State == timodel.StatePublic {
+			preIndices[idx.ID] = idx
+		}
+	}
+	indices := make(map[int64]struct{}, len(info.Indices))
+	for _, idx := range info.Indices {
+		if idx.State == timodel.StatePublic {
+			indices[idx.ID] = struct{}{}
+		}
+	}
+
+	var stmts []string
+	table := quoteTableInfo(info)
+	// Drop the indices first, as the new indices may reuse the names.
+	for _, idx := range pre.Indices {
+		if _, ok := preIndices[idx.ID]; !ok {
+			continue
+		}
+		if _, ok := indices[idx.ID]; ok {
+			continue
+		}
+		if idx.Primary {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", table))
+		} else {
+			stmts = append(stmts, "DROP INDEX "+indexName(pre, idx))
+		}
+	}
+	for _, idx := range info.Indices {
+		_, existed := preIndices[idx.ID]
+		if _, ok := indices[idx.ID]; !ok || existed {
+			continue
+		}
+		if idx.Primary {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)",
+				table, quoteNames(indexColumns(idx))))
+		} else {
+			stmts = append(stmts, createIndex(info, idx))
+		}
+	}
+	return stmts
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newColumnInfo(id int64, name string, tp byte, flag uint) *timodel.ColumnInfo {
+	ft := types.NewFieldType(tp)
+	ft.SetFlag(flag)
+	return &timodel.ColumnInfo{
+		ID:        id,
+		Name:      timodel.NewCIStr(name),
+		FieldType: *ft,
+		State:     timodel.StatePublic,
+	}
+}
+
+func newIndexInfo(id int64, name string, unique bool, cols ...string) *timodel.IndexInfo {
+	idx := &timodel.IndexInfo{
+		ID:     id,
+		Name:   timodel.NewCIStr(name),
+		Unique: unique,
+		State:  timodel.StatePublic,
+	}
+	for _, col := range cols {
+		idx.Columns = append(idx.Columns, &timodel.IndexColumn{Name: timodel.NewCIStr(col)})
+	}
+	return idx
+}
+
+func newTableInfo(table string, cols []*timodel.ColumnInfo, indices ...*timodel.IndexInfo) *model.TableInfo {
+	info := &timodel.TableInfo{
+		ID:      100,
+		Name:    timodel.NewCIStr(table),
+		Columns: cols,
+		Indices: indices,
+	}
+	for _, col := range cols {
+		if mysql.HasPriKeyFlag(col.GetFlag()) {
+			info.PKIsHandle = true
+		}
+	}
+	return model.WrapTableInfo(1, "test", 1, info)
+}
+
+func TestTranslateDDL(t *testing.T) {
+	t.Parallel()
+
+	id := newColumnInfo(1, "id", mysql.TypeLong, mysql.PriKeyFlag|mysql.NotNullFlag)
+	name := newColumnInfo(2, "name", mysql.TypeVarchar, 0)
+	name.SetFlen(32)
+	age := newColumnInfo(3, "age", mysql.TypeLong, mysql.NotNullFlag)
+	nameIdx := newIndexInfo(1, "idx_name", true, "name")
+
+	t1 := newTableInfo("t1", []*timodel.ColumnInfo{id, name}, nameIdx)
+	require.Equal(t, []string{
+		`CREATE TABLE "TEST"."T1" ("ID" NUMBER(10) NOT NULL, "NAME" VARCHAR2(32 CHAR), PRIMARY KEY ("ID"))`,
+		`CREATE UNIQUE INDEX "TEST"."T1_IDX_NAME" ON "TEST"."T1" ("NAME")`,
+	}, TranslateDDL(&model.DDLEvent{Type: timodel.ActionCreateTable, TableInfo: t1}))
+
+	withAge := newTableInfo("t1", []*timodel.ColumnInfo{id, name, age}, nameIdx)
+	require.Equal(t, []string{`ALTER TABLE "TEST"."T1" ADD ("AGE" NUMBER(10))`},
+		TranslateDDL(&model.DDLEvent{
+			Type: timodel.ActionAddColumn, PreTableInfo: t1, TableInfo: withAge,
+		}))
+	require.Equal(t, []string{`ALTER TABLE "TEST"."T1" DROP COLUMN "AGE"`},
+		TranslateDDL(&model.DDLEvent{
+			Type: timodel.ActionDropColumn, PreTableInfo: withAge, TableInfo: t1,
+		}))
+
+	noIdx := newTableInfo("t1", []*timodel.ColumnInfo{id, name})
+	require.Equal(t, []string{`DROP INDEX "TEST"."T1_IDX_NAME"`},
+		TranslateDDL(&model.DDLEvent{
+			Type: timodel.ActionDropIndex, PreTableInfo: t1, TableInfo: noIdx,
+		}))
+
+	t2 := newTableInfo("t2", []*timodel.ColumnInfo{id, name}, nameIdx)
+	require.Equal(t, []string{`ALTER TABLE "TEST"."T1" RENAME TO "T2"`},
+		TranslateDDL(&model.DDLEvent{
+			Type: timodel.ActionRenameTable, PreTableInfo: t1, TableInfo: t2,
+		}))
+
+	require.Equal(t, []string{`TRUNCATE TABLE "TEST"."T1"`},
+		TranslateDDL(&model.DDLEvent{Type: timodel.ActionTruncateTable, TableInfo: t1}))
+	require.Equal(t, []string{`DROP TABLE "TEST"."T1" PURGE`},
+		TranslateDDL(&model.DDLEvent{Type: timodel.ActionDropTable, TableInfo: t1}))
+
+	// Unsupported DDLs are skipped.
+	require.Empty(t, TranslateDDL(&model.DDLEvent{
+		Type: timodel.ActionCreateSchema, Query: "create database test",
+	}))
+}
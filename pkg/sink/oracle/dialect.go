@@ -0,0 +1,206 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+const (
+	// The max length of CHAR and RAW columns in Oracle.
+	maxCharLength = 2000
+	// The max length of VARCHAR2 columns in Oracle, longer strings are
+	// stored as CLOB.
+	maxVarcharLength = 4000
+	// The max precision of NUMBER columns in Oracle.
+	maxNumberPrecision = 38
+)
+
+// QuoteName quotes an identifier. Oracle folds the unquoted identifiers to
+// upper case, so the name is converted to upper case to let the replicated
+// objects be accessed without quotes.
+func QuoteName(name string) string {
+	return `"` + strings.ReplaceAll(strings.ToUpper(name), `"`, `""`) + `"`
+}
+
+// QuoteTable quotes a table name, the TiDB database is mapped to the
+// Oracle schema with the same name.
+func QuoteTable(schema, table string) string {
+	if schema == "" {
+		return QuoteName(table)
+	}
+	return QuoteName(schema) + "." + QuoteName(table)
+}
+
+// isBinary returns true if the string column stores binary data.
+func isBinary(ft *types.FieldType) bool {
+	return ft.GetCharset() == charset.CharsetBin || mysql.HasBinaryFlag(ft.GetFlag())
+}
+
+// ColumnType maps a TiDB column type to an Oracle column type.
+// ENUM and SET are replicated as their numeric values, and TIME is
+// replicated as a string, as Oracle has no equivalent types.
+func ColumnType(ft *types.FieldType) string {
+	unsigned := mysql.HasUnsignedFlag(ft.GetFlag())
+	switch ft.GetType() {
+	case mysql.TypeTiny:
+		return "NUMBER(3)"
+	case mysql.TypeShort:
+		return "NUMBER(5)"
+	case mysql.TypeInt24:
+		return "NUMBER(8)"
+	case mysql.TypeLong:
+		return "NUMBER(10)"
+	case mysql.TypeLonglong:
+		if unsigned {
+			return "NUMBER(20)"
+		}
+		return "NUMBER(19)"
+	case mysql.TypeYear:
+		return "NUMBER(4)"
+	case mysql.TypeBit, mysql.TypeEnum, mysql.TypeSet:
+		return "NUMBER(20)"
+	case mysql.TypeFloat:
+		return "BINARY_FLOAT"
+	case mysql.TypeDouble:
+		return "BINARY_DOUBLE"
+	case mysql.TypeNewDecimal:
+		flen, decimal := ft.GetFlen(), ft.GetDecimal()
+		if flen <= 0 || flen > maxNumberPrecision {
+			return "NUMBER"
+		}
+		if decimal < 0 {
+			decimal = 0
+		}
+		return fmt.Sprintf("NUMBER(%d,%d)", flen, decimal)
+	case mysql.TypeDate:
+		return "DATE"
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		return fmt.Sprintf("TIMESTAMP(%d)", fsp(ft))
+	case mysql.TypeDuration:
+		return "VARCHAR2(20)"
+	case mysql.TypeString:
+		flen := ft.GetFlen()
+		if flen <= 0 {
+			flen = 1
+		}
+		if isBinary(ft) {
+			if flen > maxCharLength {
+				return "BLOB"
+			}
+			return fmt.Sprintf("RAW(%d)", flen)
+		}
+		if flen > maxCharLength {
+			return "CLOB"
+		}
+		return fmt.Sprintf("CHAR(%d CHAR)", flen)
+	case mysql.TypeVarchar, mysql.TypeVarString:
+		flen := ft.GetFlen()
+		if flen <= 0 {
+			flen = 1
+		}
+		if isBinary(ft) {
+			if flen > maxCharLength {
+				return "BLOB"
+			}
+			return fmt.Sprintf("RAW(%d)", flen)
+		}
+		if flen > maxVarcharLength {
+			return "CLOB"
+		}
+		return fmt.Sprintf("VARCHAR2(%d CHAR)", flen)
+	case mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		if isBinary(ft) {
+			return "BLOB"
+		}
+		return "CLOB"
+	case mysql.TypeJSON:
+		return "CLOB"
+	default:
+		return "VARCHAR2(4000 CHAR)"
+	}
+}
+
+func fsp(ft *types.FieldType) int {
+	decimal := ft.GetDecimal()
+	if decimal < 0 {
+		return 0
+	}
+	return decimal
+}
+
+// isLOB returns true if the column is mapped to CLOB or BLOB, which can not
+// be compared in the WHERE clause.
+func isLOB(col *model.Column) bool {
+	switch col.Type {
+	case mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob,
+		mysql.TypeLongBlob, mysql.TypeJSON:
+		return true
+	}
+	return false
+}
+
+// The layouts of the DATE, DATETIME and TIMESTAMP values produced by the
+// mounter.
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "2006-01-02 15:04:05.999999"
+)
+
+// bindValue converts the column value to a value accepted by the Oracle
+// driver.
+func bindValue(col *model.Column) interface{} {
+	if col.Value == nil {
+		return nil
+	}
+	switch v := col.Value.(type) {
+	case []byte:
+		if col.Flag.IsBinary() {
+			return v
+		}
+		return string(v)
+	case uint64:
+		// database/sql can not bind the uint64 values which overflow int64,
+		// Oracle converts the string to NUMBER implicitly.
+		if v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10)
+		}
+		return int64(v)
+	case string:
+		switch col.Type {
+		case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+			layout := timeLayout
+			if col.Type == mysql.TypeDate {
+				layout = dateLayout
+			}
+			t, err := time.ParseInLocation(layout, v, time.UTC)
+			if err != nil {
+				// Zero dates can not be stored in Oracle.
+				return nil
+			}
+			return t
+		}
+		return v
+	}
+	return col.Value
+}
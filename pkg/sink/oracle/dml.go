@@ -0,0 +1,196 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// placeholder returns the i-th (0-based) bind variable of a statement.
+func placeholder(i int) string {
+	return fmt.Sprintf(":%d", i+1)
+}
+
+// validColumns returns the non-nil and non-generated columns.
+func validColumns(cols []*model.Column) []*model.Column {
+	res := make([]*model.Column, 0, len(cols))
+	for _, col := range cols {
+		if col == nil || col.Flag.IsGeneratedColumn() {
+			continue
+		}
+		res = append(res, col)
+	}
+	return res
+}
+
+func handleKeyColumns(cols []*model.Column) []*model.Column {
+	res := make([]*model.Column, 0, 1)
+	for _, col := range cols {
+		if col.Flag.IsHandleKey() {
+			res = append(res, col)
+		}
+	}
+	return res
+}
+
+// whereColumns returns the columns used to identify a row. The handle key
+// columns are used if there is any, otherwise all columns except the LOB
+// columns are used.
+func whereColumns(cols []*model.Column) (whereCols []*model.Column, hasHandleKey bool) {
+	if keys := handleKeyColumns(cols); len(keys) > 0 {
+		return keys, true
+	}
+	res := make([]*model.Column, 0, len(cols))
+	for _, col := range cols {
+		if !isLOB(col) {
+			res = append(res, col)
+		}
+	}
+	return res, false
+}
+
+// PrepareDMLs builds the statements and the arguments to replicate a row
+// change. The rows with handle keys are written by MERGE statements, so
+// that the replication is idempotent.
+func PrepareDMLs(row *model.RowChangedEvent) ([]string, [][]interface{}) {
+	table := QuoteTable(row.Table.Schema, row.Table.Table)
+	preCols := validColumns(row.PreColumns)
+	cols := validColumns(row.Columns)
+
+	var sqls []string
+	var args [][]interface{}
+	appendDML := func(sql string, arg []interface{}) {
+		sqls = append(sqls, sql)
+		args = append(args, arg)
+	}
+
+	switch {
+	case row.IsDelete():
+		appendDML(prepareDelete(table, preCols))
+	case row.IsInsert():
+		if _, hasHandleKey := whereColumns(cols); hasHandleKey {
+			appendDML(prepareMerge(table, cols))
+		} else {
+			appendDML(prepareInsert(table, cols))
+		}
+	case row.IsUpdate():
+		_, hasHandleKey := whereColumns(cols)
+		if hasHandleKey && !handleKeyUpdated(preCols, cols) {
+			appendDML(prepareMerge(table, cols))
+			break
+		}
+		appendDML(prepareDelete(table, preCols))
+		if hasHandleKey {
+			appendDML(prepareMerge(table, cols))
+		} else {
+			appendDML(prepareInsert(table, cols))
+		}
+	}
+	return sqls, args
+}
+
+func handleKeyUpdated(preCols, cols []*model.Column) bool {
+	preKeys := handleKeyColumns(preCols)
+	keys := handleKeyColumns(cols)
+	if len(preKeys) != len(keys) {
+		return true
+	}
+	for i := range keys {
+		if fmt.Sprint(bindValue(preKeys[i])) != fmt.Sprint(bindValue(keys[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+// prepareMerge builds a statement like:
+//
+//	MERGE INTO "S"."T" t USING (SELECT :1 "ID", :2 "V" FROM DUAL) s
+//	ON (t."ID" = s."ID")
+//	WHEN MATCHED THEN UPDATE SET t."V" = s."V"
+//	WHEN NOT MATCHED THEN INSERT ("ID", "V") VALUES (s."ID", s."V")
+func prepareMerge(table string, cols []*model.Column) (string, []interface{}) {
+	args := make([]interface{}, 0, len(cols))
+	selects := make([]string, 0, len(cols))
+	names := make([]string, 0, len(cols))
+	values := make([]string, 0, len(cols))
+	var on, sets []string
+	for i, col := range cols {
+		name := QuoteName(col.Name)
+		args = append(args, bindValue(col))
+		selects = append(selects, placeholder(i)+" "+name)
+		names = append(names, name)
+		values = append(values, "s."+name)
+		if col.Flag.IsHandleKey() {
+			on = append(on, "t."+name+" = s."+name)
+		} else {
+			sets = append(sets, "t."+name+" = s."+name)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("MERGE INTO " + table + " t USING (SELECT ")
+	b.WriteString(strings.Join(selects, ", "))
+	b.WriteString(" FROM DUAL) s ON (")
+	b.WriteString(strings.Join(on, " AND "))
+	b.WriteString(")")
+	// Oracle does not allow to update the columns referenced in the ON clause.
+	if len(sets) > 0 {
+		b.WriteString(" WHEN MATCHED THEN UPDATE SET ")
+		b.WriteString(strings.Join(sets, ", "))
+	}
+	b.WriteString(" WHEN NOT MATCHED THEN INSERT (")
+	b.WriteString(strings.Join(names, ", "))
+	b.WriteString(") VALUES (")
+	b.WriteString(strings.Join(values, ", "))
+	b.WriteString(")")
+	return b.String(), args
+}
+
+func prepareInsert(table string, cols []*model.Column) (string, []interface{}) {
+	args := make([]interface{}, 0, len(cols))
+	names := make([]string, 0, len(cols))
+	values := make([]string, 0, len(cols))
+	for i, col := range cols {
+		args = append(args, bindValue(col))
+		names = append(names, QuoteName(col.Name))
+		values = append(values, placeholder(i))
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table,
+		strings.Join(names, ", "), strings.Join(values, ", ")), args
+}
+
+func prepareDelete(table string, preCols []*model.Column) (string, []interface{}) {
+	whereCols, hasHandleKey := whereColumns(preCols)
+	args := make([]interface{}, 0, len(whereCols))
+	conds := make([]string, 0, len(whereCols))
+	for _, col := range whereCols {
+		value := bindValue(col)
+		if value == nil {
+			conds = append(conds, QuoteName(col.Name)+" IS NULL")
+			continue
+		}
+		conds = append(conds, QuoteName(col.Name)+" = "+placeholder(len(args)))
+		args = append(args, value)
+	}
+	sql := fmt.Sprintf("DELETE FROM %s WHERE %s", table, strings.Join(conds, " AND "))
+	if !hasHandleKey {
+		// Only delete one row if there are duplicated rows.
+		sql += " AND ROWNUM = 1"
+	}
+	return sql, args
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareDMLs(t *testing.T) {
+	t.Parallel()
+
+	table := &model.TableName{Schema: "test", Table: "t"}
+	keyCol := func(v interface{}) *model.Column {
+		return &model.Column{
+			Name: "id", Type: mysql.TypeLong, Value: v,
+			Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+		}
+	}
+	valCol := func(v interface{}) *model.Column {
+		return &model.Column{Name: "v", Type: mysql.TypeVarchar, Value: v}
+	}
+	dtCol := &model.Column{Name: "dt", Type: mysql.TypeDatetime, Value: "2023-01-02 03:04:05"}
+	genCol := &model.Column{Name: "g", Type: mysql.TypeLong, Value: 1, Flag: model.GeneratedColumnFlag}
+
+	testCases := []struct {
+		row  *model.RowChangedEvent
+		sqls []string
+		args [][]interface{}
+	}{
+		{
+			row: &model.RowChangedEvent{
+				Table:   table,
+				Columns: []*model.Column{keyCol(1), valCol([]byte("a")), dtCol, genCol},
+			},
+			sqls: []string{`MERGE INTO "TEST"."T" t USING (SELECT :1 "ID", :2 "V", :3 "DT" FROM DUAL) s ` +
+				`ON (t."ID" = s."ID") WHEN MATCHED THEN UPDATE SET t."V" = s."V", t."DT" = s."DT" ` +
+				`WHEN NOT MATCHED THEN INSERT ("ID", "V", "DT") VALUES (s."ID", s."V", s."DT")`},
+			args: [][]interface{}{{1, "a", time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)}},
+		},
+		{
+			row: &model.RowChangedEvent{
+				Table:      table,
+				PreColumns: []*model.Column{keyCol(1), valCol("a")},
+				Columns:    []*model.Column{keyCol(2), valCol("b")},
+			},
+			sqls: []string{
+				`DELETE FROM "TEST"."T" WHERE "ID" = :1`,
+				`MERGE INTO "TEST"."T" t USING (SELECT :1 "ID", :2 "V" FROM DUAL) s ` +
+					`ON (t."ID" = s."ID") WHEN MATCHED THEN UPDATE SET t."V" = s."V" ` +
+					`WHEN NOT MATCHED THEN INSERT ("ID", "V") VALUES (s."ID", s."V")`,
+			},
+			args: [][]interface{}{{1}, {2, "b"}},
+		},
+		{
+			row: &model.RowChangedEvent{
+				Table:      table,
+				PreColumns: []*model.Column{valCol("a"), {Name: "n", Type: mysql.TypeLong}},
+				Columns:    []*model.Column{valCol("b"), {Name: "n", Type: mysql.TypeLong}},
+			},
+			sqls: []string{
+				`DELETE FROM "TEST"."T" WHERE "V" = :1 AND "N" IS NULL AND ROWNUM = 1`,
+				`INSERT INTO "TEST"."T" ("V", "N") VALUES (:1, :2)`,
+			},
+			args: [][]interface{}{{"a"}, {"b", nil}},
+		},
+		{
+			row: &model.RowChangedEvent{
+				Table:      table,
+				PreColumns: []*model.Column{keyCol(1), valCol("a")},
+			},
+			sqls: []string{`DELETE FROM "TEST"."T" WHERE "ID" = :1`},
+			args: [][]interface{}{{1}},
+		},
+	}
+	for _, tc := range testCases {
+		sqls, args := PrepareDMLs(tc.row)
+		require.Equal(t, tc.sqls, sqls)
+		require.Equal(t, tc.args, args)
+	}
+}
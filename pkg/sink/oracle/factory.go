@@ -0,0 +1,50 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	_ "github.com/sijms/go-ora/v2" // Oracle driver
+	"go.uber.org/zap"
+)
+
+// DriverName is the name of the database/sql driver used to connect to
+// Oracle, it is registered by github.com/sijms/go-ora/v2.
+const DriverName = "oracle"
+
+// Factory is the factory for creating db connection.
+type Factory func(ctx context.Context, dsnStr string) (*sql.DB, error)
+
+// CreateOracleDBConn creates a oracle database connection with the given dsn.
+func CreateOracleDBConn(ctx context.Context, dsnStr string) (*sql.DB, error) {
+	db, err := sql.Open(DriverName, dsnStr)
+	if err != nil {
+		return nil, cerror.ErrOracleConnectionError.Wrap(err).GenWithStack("fail to open Oracle connection")
+	}
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		// close db to recycle resources
+		if closeErr := db.Close(); closeErr != nil {
+			log.Warn("close db failed", zap.Error(err))
+		}
+		return nil, cerror.ErrOracleConnectionError.Wrap(err).GenWithStack("fail to open Oracle connection")
+	}
+
+	return db, nil
+}
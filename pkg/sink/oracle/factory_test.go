@@ -0,0 +1,49 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriverRegistered(t *testing.T) {
+	t.Parallel()
+
+	require.Contains(t, sql.Drivers(), DriverName)
+}
+
+func TestCreateOracleDBConnUnreachable(t *testing.T) {
+	t.Parallel()
+
+	// Reserve a port and close it, so that the driver fails to dial.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	addr := l.Addr().String()
+	require.Nil(t, l.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err = CreateOracleDBConn(ctx, fmt.Sprintf("oracle://user:pass@%s/ORCL", addr))
+	require.ErrorContains(t, err, string(cerror.ErrOracleConnectionError.RFCCode()))
+	// The driver is linked and dials the server.
+	require.ErrorContains(t, err, "dial tcp")
+}
@@ -0,0 +1,220 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+const (
+	defaultConnectionTimeout       = 5 * time.Second
+	defaultOperationTimeout        = 30 * time.Second
+	defaultSendTimeout             = 30 * time.Second
+	defaultBatchingMaxMessages     = 1000
+	defaultBatchingMaxPublishDelay = 10 * time.Millisecond
+)
+
+type urlConfig struct {
+	MaxMessageBytes         *int    `form:"max-message-bytes"`
+	Compression             *string `form:"compression"`
+	ConnectionTimeout       *string `form:"connection-timeout"`
+	OperationTimeout        *string `form:"operation-timeout"`
+	SendTimeout             *string `form:"send-timeout"`
+	BatchingMaxMessages     *uint   `form:"batching-max-messages"`
+	BatchingMaxPublishDelay *string `form:"batching-max-publish-delay"`
+	AuthToken               *string `form:"auth-token"`
+	AuthTokenFile           *string `form:"auth-token-file"`
+	BasicUser               *string `form:"basic-user"`
+	BasicPassword           *string `form:"basic-password"`
+	CA                      *string `form:"ca"`
+	Cert                    *string `form:"cert"`
+	Key                     *string `form:"key"`
+	InsecureSkipVerify      *bool   `form:"insecure-skip-verify"`
+}
+
+// Config stores the user specified configurations of the pulsar sink.
+type Config struct {
+	// BrokerURL is the service url of the pulsar cluster,
+	// e.g. pulsar://127.0.0.1:6650 or pulsar+ssl://127.0.0.1:6651.
+	BrokerURL       string
+	MaxMessageBytes int
+	// Compression is one of none, lz4, zlib and zstd.
+	Compression string
+
+	ConnectionTimeout       time.Duration
+	OperationTimeout        time.Duration
+	SendTimeout             time.Duration
+	BatchingMaxMessages     uint
+	BatchingMaxPublishDelay time.Duration
+
+	// Only one kind of authentication can be used at a time.
+	AuthToken     string
+	AuthTokenFile string
+	BasicUser     string
+	BasicPassword string
+
+	// TLSTrustCertsFilePath is used to verify the brokers, and
+	// TLSCertFile together with TLSKeyFile enables TLS authentication.
+	TLSTrustCertsFilePath      string
+	TLSCertFile                string
+	TLSKeyFile                 string
+	TLSAllowInsecureConnection bool
+}
+
+// NewConfig returns a default pulsar configuration.
+func NewConfig() *Config {
+	return &Config{
+		MaxMessageBytes:         config.DefaultMaxMessageBytes,
+		Compression:             "none",
+		ConnectionTimeout:       defaultConnectionTimeout,
+		OperationTimeout:        defaultOperationTimeout,
+		SendTimeout:             defaultSendTimeout,
+		BatchingMaxMessages:     defaultBatchingMaxMessages,
+		BatchingMaxPublishDelay: defaultBatchingMaxPublishDelay,
+	}
+}
+
+// Apply the sinkURI to update Config.
+func (c *Config) Apply(sinkURI *url.URL) error {
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if !sink.IsPulsarScheme(scheme) {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"unsupported scheme %s", sinkURI.Scheme)
+	}
+	if sinkURI.Host == "" {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack("broker address is empty")
+	}
+	c.BrokerURL = scheme + "://" + sinkURI.Host
+
+	req := &http.Request{URL: sinkURI}
+	params := &urlConfig{}
+	if err := binding.Query.Bind(req, params); err != nil {
+		return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+	}
+
+	if params.MaxMessageBytes != nil {
+		if *params.MaxMessageBytes <= 0 {
+			return cerror.ErrPulsarInvalidConfig.GenWithStack(
+				"invalid max-message-bytes %d", *params.MaxMessageBytes)
+		}
+		c.MaxMessageBytes = *params.MaxMessageBytes
+	}
+
+	if params.Compression != nil {
+		compression := strings.ToLower(*params.Compression)
+		switch compression {
+		case "none", "lz4", "zlib", "zstd":
+		default:
+			return cerror.ErrPulsarInvalidConfig.GenWithStack(
+				"unsupported compression %s", *params.Compression)
+		}
+		c.Compression = compression
+	}
+
+	if params.BatchingMaxMessages != nil {
+		c.BatchingMaxMessages = *params.BatchingMaxMessages
+	}
+
+	for _, d := range []struct {
+		value  *string
+		target *time.Duration
+	}{
+		{params.ConnectionTimeout, &c.ConnectionTimeout},
+		{params.OperationTimeout, &c.OperationTimeout},
+		{params.SendTimeout, &c.SendTimeout},
+		{params.BatchingMaxPublishDelay, &c.BatchingMaxPublishDelay},
+	} {
+		if d.value == nil {
+			continue
+		}
+		duration, err := time.ParseDuration(*d.value)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+		}
+		*d.target = duration
+	}
+
+	if err := c.applyAuth(params); err != nil {
+		return errors.Trace(err)
+	}
+	return c.applyTLS(params)
+}
+
+func (c *Config) applyAuth(params *urlConfig) error {
+	configured := 0
+	if params.AuthToken != nil && *params.AuthToken != "" {
+		c.AuthToken = *params.AuthToken
+		configured++
+	}
+	if params.AuthTokenFile != nil && *params.AuthTokenFile != "" {
+		c.AuthTokenFile = *params.AuthTokenFile
+		configured++
+	}
+	if params.BasicUser != nil && *params.BasicUser != "" {
+		c.BasicUser = *params.BasicUser
+		if params.BasicPassword != nil {
+			c.BasicPassword = *params.BasicPassword
+		}
+		configured++
+	}
+	if configured > 1 {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"auth-token, auth-token-file and basic-user can not be used together")
+	}
+	return nil
+}
+
+func (c *Config) applyTLS(params *urlConfig) error {
+	if params.CA != nil {
+		c.TLSTrustCertsFilePath = *params.CA
+	}
+	if params.Cert != nil {
+		c.TLSCertFile = *params.Cert
+	}
+	if params.Key != nil {
+		c.TLSKeyFile = *params.Key
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"cert and key files should be supplied together")
+	}
+	if c.TLSCertFile != "" && (c.AuthToken != "" || c.AuthTokenFile != "" || c.BasicUser != "") {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"tls authentication can not be used together with other authentications")
+	}
+	if params.InsecureSkipVerify != nil {
+		c.TLSAllowInsecureConnection = *params.InsecureSkipVerify
+	}
+	return nil
+}
+
+// CheckProtocol returns an error if the protocol is not supported by the pulsar sink.
+func CheckProtocol(protocol config.Protocol) error {
+	switch protocol {
+	case config.ProtocolCanalJSON, config.ProtocolAvro:
+		return nil
+	default:
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"protocol %s is not supported by pulsar sink, use canal-json or avro", protocol)
+	}
+}
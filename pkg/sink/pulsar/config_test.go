@@ -0,0 +1,94 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigApply(t *testing.T) {
+	t.Parallel()
+
+	uri := "pulsar+ssl://127.0.0.1:6651/test?max-message-bytes=4096" +
+		"&compression=ZSTD&connection-timeout=3s&operation-timeout=10s" +
+		"&send-timeout=5s&batching-max-messages=100&batching-max-publish-delay=5ms" +
+		"&auth-token=token&ca=/tmp/ca.pem&insecure-skip-verify=true"
+	sinkURI, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	c := NewConfig()
+	require.NoError(t, c.Apply(sinkURI))
+	require.Equal(t, "pulsar+ssl://127.0.0.1:6651", c.BrokerURL)
+	require.Equal(t, 4096, c.MaxMessageBytes)
+	require.Equal(t, "zstd", c.Compression)
+	require.Equal(t, pulsar.ZSTD, c.compressionType())
+	require.Equal(t, 3*time.Second, c.ConnectionTimeout)
+	require.Equal(t, 10*time.Second, c.OperationTimeout)
+	require.Equal(t, 5*time.Second, c.SendTimeout)
+	require.Equal(t, uint(100), c.BatchingMaxMessages)
+	require.Equal(t, 5*time.Millisecond, c.BatchingMaxPublishDelay)
+	require.Equal(t, "token", c.AuthToken)
+	require.Equal(t, "/tmp/ca.pem", c.TLSTrustCertsFilePath)
+	require.True(t, c.TLSAllowInsecureConnection)
+
+	options := c.ProducerOptions("persistent://public/default/test")
+	require.Equal(t, "persistent://public/default/test", options.Topic)
+	require.Equal(t, 5*time.Second, options.SendTimeout)
+	require.Equal(t, pulsar.ZSTD, options.CompressionType)
+
+	// The default config.
+	sinkURI, err = url.Parse("pulsar://127.0.0.1:6650,127.0.0.1:6651/test")
+	require.NoError(t, err)
+	c = NewConfig()
+	require.NoError(t, c.Apply(sinkURI))
+	require.Equal(t, "pulsar://127.0.0.1:6650,127.0.0.1:6651", c.BrokerURL)
+	require.Equal(t, config.DefaultMaxMessageBytes, c.MaxMessageBytes)
+	require.Equal(t, pulsar.NoCompression, c.compressionType())
+	require.Equal(t, defaultSendTimeout, c.SendTimeout)
+}
+
+func TestConfigApplyInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, uri := range []string{
+		"kafka://127.0.0.1:9092/test",
+		"pulsar:///test",
+		"pulsar://127.0.0.1:6650/test?max-message-bytes=0",
+		"pulsar://127.0.0.1:6650/test?max-message-bytes=a",
+		"pulsar://127.0.0.1:6650/test?compression=gzip",
+		"pulsar://127.0.0.1:6650/test?send-timeout=a",
+		"pulsar://127.0.0.1:6650/test?auth-token=a&basic-user=b",
+		"pulsar://127.0.0.1:6650/test?cert=/tmp/cert.pem",
+		"pulsar://127.0.0.1:6650/test?cert=/tmp/cert.pem&key=/tmp/key.pem&auth-token=a",
+	} {
+		sinkURI, err := url.Parse(uri)
+		require.NoError(t, err)
+		require.Error(t, NewConfig().Apply(sinkURI), uri)
+	}
+}
+
+func TestCheckProtocol(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, CheckProtocol(config.ProtocolCanalJSON))
+	require.NoError(t, CheckProtocol(config.ProtocolAvro))
+	require.Error(t, CheckProtocol(config.ProtocolOpen))
+	require.Error(t, CheckProtocol(config.ProtocolCanal))
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// FactoryCreator defines the type of pulsar client creator.
+type FactoryCreator func(*Config, model.ChangeFeedID) (pulsar.Client, error)
+
+// NewCreatorFactory creates a pulsar client with the given config.
+func NewCreatorFactory(c *Config, changefeedID model.ChangeFeedID) (pulsar.Client, error) {
+	option := pulsar.ClientOptions{
+		URL:                        c.BrokerURL,
+		ConnectionTimeout:          c.ConnectionTimeout,
+		OperationTimeout:           c.OperationTimeout,
+		TLSTrustCertsFilePath:      c.TLSTrustCertsFilePath,
+		TLSAllowInsecureConnection: c.TLSAllowInsecureConnection,
+		CustomMetricsLabels: map[string]string{
+			"namespace":  changefeedID.Namespace,
+			"changefeed": changefeedID.ID,
+		},
+	}
+
+	switch {
+	case c.AuthToken != "":
+		option.Authentication = pulsar.NewAuthenticationToken(c.AuthToken)
+	case c.AuthTokenFile != "":
+		option.Authentication = pulsar.NewAuthenticationTokenFromFile(c.AuthTokenFile)
+	case c.BasicUser != "":
+		auth, err := pulsar.NewAuthenticationBasic(c.BasicUser, c.BasicPassword)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+		}
+		option.Authentication = auth
+	case c.TLSCertFile != "":
+		option.Authentication = pulsar.NewAuthenticationTLS(c.TLSCertFile, c.TLSKeyFile)
+	}
+
+	client, err := pulsar.NewClient(option)
+	if err != nil {
+		log.Error("Cannot create pulsar client",
+			zap.String("namespace", changefeedID.Namespace),
+			zap.String("changefeed", changefeedID.ID),
+			zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrPulsarNewClient, err)
+	}
+	return client, nil
+}
+
+// ProducerOptions returns the options of a producer writing to the topic.
+func (c *Config) ProducerOptions(topic string) pulsar.ProducerOptions {
+	return pulsar.ProducerOptions{
+		Topic:                   topic,
+		SendTimeout:             c.SendTimeout,
+		CompressionType:         c.compressionType(),
+		BatchingMaxMessages:     c.BatchingMaxMessages,
+		BatchingMaxPublishDelay: c.BatchingMaxPublishDelay,
+	}
+}
+
+func (c *Config) compressionType() pulsar.CompressionType {
+	switch c.Compression {
+	case "lz4":
+		return pulsar.LZ4
+	case "zlib":
+		return pulsar.ZLib
+	case "zstd":
+		return pulsar.ZSTD
+	default:
+		return pulsar.NoCompression
+	}
+}
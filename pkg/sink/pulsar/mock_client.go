@@ -0,0 +1,189 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// MockClient is a mock implementation of pulsar.Client. Every topic
+// has DefaultPartitionNum partitions unless specified by SetPartitionNum.
+type MockClient struct {
+	pulsar.Client
+
+	// DefaultPartitionNum is the number of partitions of a new topic.
+	DefaultPartitionNum int
+
+	mu sync.Mutex
+	// partitionNums is the number of partitions of each topic.
+	partitionNums map[string]int
+	producers     map[string]*MockProducer
+	sendErr       error
+	closed        bool
+}
+
+// NewMockClient creates a MockClient.
+func NewMockClient() *MockClient {
+	return &MockClient{
+		DefaultPartitionNum: 1,
+		partitionNums:       make(map[string]int),
+		producers:           make(map[string]*MockProducer),
+	}
+}
+
+// NewMockCreatorFactory returns a FactoryCreator which always returns the client.
+func NewMockCreatorFactory(client *MockClient) FactoryCreator {
+	return func(_ *Config, _ model.ChangeFeedID) (pulsar.Client, error) {
+		return client, nil
+	}
+}
+
+// SetPartitionNum sets the number of partitions of the topic.
+func (c *MockClient) SetPartitionNum(topic string, num int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.partitionNums[topic] = num
+}
+
+// SetSendError makes all the following sends fail with err.
+func (c *MockClient) SetSendError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sendErr = err
+}
+
+// TopicPartitions implements pulsar.Client.
+func (c *MockClient) TopicPartitions(topic string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	num, ok := c.partitionNums[topic]
+	if !ok {
+		num = c.DefaultPartitionNum
+	}
+	if num == 1 {
+		return []string{topic}, nil
+	}
+	partitions := make([]string, 0, num)
+	for i := 0; i < num; i++ {
+		partitions = append(partitions, fmt.Sprintf("%s-partition-%d", topic, i))
+	}
+	return partitions, nil
+}
+
+// CreateProducer implements pulsar.Client.
+func (c *MockClient) CreateProducer(options pulsar.ProducerOptions) (pulsar.Producer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("pulsar client closed")
+	}
+	producer := &MockProducer{client: c, topic: options.Topic}
+	c.producers[options.Topic] = producer
+	return producer, nil
+}
+
+// Close implements pulsar.Client.
+func (c *MockClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+}
+
+// IsClosed returns whether the client is closed.
+func (c *MockClient) IsClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// GetMessages returns the messages sent to the partition, the name of which
+// is returned by TopicPartitions.
+func (c *MockClient) GetMessages(partition string) []*pulsar.ProducerMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	producer, ok := c.producers[partition]
+	if !ok {
+		return nil
+	}
+	return append([]*pulsar.ProducerMessage(nil), producer.messages...)
+}
+
+// MockProducer is a mock implementation of pulsar.Producer, which
+// acknowledges every message as soon as it is sent.
+type MockProducer struct {
+	pulsar.Producer
+
+	client   *MockClient
+	topic    string
+	messages []*pulsar.ProducerMessage
+	closed   bool
+}
+
+// Topic implements pulsar.Producer.
+func (p *MockProducer) Topic() string {
+	return p.topic
+}
+
+// Send implements pulsar.Producer.
+func (p *MockProducer) Send(
+	_ context.Context, msg *pulsar.ProducerMessage,
+) (pulsar.MessageID, error) {
+	if err := p.send(msg); err != nil {
+		return nil, err
+	}
+	return pulsar.EarliestMessageID(), nil
+}
+
+// SendAsync implements pulsar.Producer.
+func (p *MockProducer) SendAsync(
+	_ context.Context, msg *pulsar.ProducerMessage,
+	callback func(pulsar.MessageID, *pulsar.ProducerMessage, error),
+) {
+	if err := p.send(msg); err != nil {
+		callback(nil, msg, err)
+		return
+	}
+	callback(pulsar.EarliestMessageID(), msg, nil)
+}
+
+func (p *MockProducer) send(msg *pulsar.ProducerMessage) error {
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	if p.closed {
+		return errors.New("pulsar producer closed")
+	}
+	if p.client.sendErr != nil {
+		return p.client.sendErr
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+// Flush implements pulsar.Producer.
+func (p *MockProducer) Flush() error {
+	return nil
+}
+
+// Close implements pulsar.Producer.
+func (p *MockProducer) Close() {
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	p.closed = true
+}
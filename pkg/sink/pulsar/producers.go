@@ -0,0 +1,143 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Producers manages the pulsar producers of a changefeed. Pulsar producers
+// are bound to a topic, so one producer is created for each partition of
+// each topic, which keeps the partition picked by the event dispatcher.
+type Producers struct {
+	id     model.ChangeFeedID
+	client pulsar.Client
+	config *Config
+
+	mu sync.Mutex
+	// partitions caches the partition names of each topic.
+	partitions map[string][]string
+	// producers is keyed by the partition name.
+	producers map[string]pulsar.Producer
+}
+
+// NewProducers creates a Producers with the given client.
+func NewProducers(
+	id model.ChangeFeedID, client pulsar.Client, config *Config,
+) *Producers {
+	return &Producers{
+		id:         id,
+		client:     client,
+		config:     config,
+		partitions: make(map[string][]string),
+		producers:  make(map[string]pulsar.Producer),
+	}
+}
+
+// GetPartitionNum returns the number of partitions of the topic.
+// A non-partitioned topic has only one partition.
+func (p *Producers) GetPartitionNum(topic string) (int32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	partitions, err := p.getPartitions(topic)
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(partitions)), nil
+}
+
+// GetProducer returns the producer of the given partition of the topic,
+// and creates it if it does not exist.
+func (p *Producers) GetProducer(topic string, partition int32) (pulsar.Producer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	partitions, err := p.getPartitions(topic)
+	if err != nil {
+		return nil, err
+	}
+	if partition < 0 || int(partition) >= len(partitions) {
+		return nil, cerror.ErrPulsarSendMessage.GenWithStack(
+			"partition %d of topic %s does not exist", partition, topic)
+	}
+
+	name := partitions[partition]
+	if producer, ok := p.producers[name]; ok {
+		return producer, nil
+	}
+	producer, err := p.client.CreateProducer(p.config.ProducerOptions(name))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	log.Info("Pulsar producer created",
+		zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID),
+		zap.String("topic", name))
+	p.producers[name] = producer
+	return producer, nil
+}
+
+func (p *Producers) getPartitions(topic string) ([]string, error) {
+	if partitions, ok := p.partitions[topic]; ok {
+		return partitions, nil
+	}
+	partitions, err := p.client.TopicPartitions(topic)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarGetTopicPartitions, err)
+	}
+	if len(partitions) == 0 {
+		return nil, cerror.ErrPulsarGetTopicPartitions.GenWithStack(
+			"topic %s has no partitions", topic)
+	}
+	p.partitions[topic] = partitions
+	return partitions, nil
+}
+
+// Flush flushes all the messages buffered in the producers.
+func (p *Producers) Flush() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, producer := range p.producers {
+		if err := producer.Flush(); err != nil {
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+		}
+	}
+	return nil
+}
+
+// Close flushes and closes all the producers, and then closes the client.
+func (p *Producers) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for name, producer := range p.producers {
+		// Messages that are not acknowledged never run their callbacks, so
+		// they are sent again after the changefeed restarts. Flushing here
+		// only reduces the number of such duplicates.
+		if err := producer.Flush(); err != nil {
+			log.Warn("Flush pulsar producer failed",
+				zap.String("namespace", p.id.Namespace),
+				zap.String("changefeed", p.id.ID),
+				zap.String("topic", name),
+				zap.Error(err))
+		}
+		producer.Close()
+		delete(p.producers, name)
+	}
+	p.client.Close()
+}
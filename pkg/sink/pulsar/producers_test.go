@@ -0,0 +1,56 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProducers(t *testing.T) {
+	t.Parallel()
+
+	client := NewMockClient()
+	client.SetPartitionNum("test", 3)
+	producers := NewProducers(model.DefaultChangeFeedID("test"), client, NewConfig())
+
+	num, err := producers.GetPartitionNum("test")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), num)
+	num, err = producers.GetPartitionNum("non-partitioned")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), num)
+
+	// Each partition has its own producer, which is reused.
+	producer, err := producers.GetProducer("test", 2)
+	require.NoError(t, err)
+	require.Equal(t, "test-partition-2", producer.Topic())
+	again, err := producers.GetProducer("test", 2)
+	require.NoError(t, err)
+	require.Same(t, producer, again)
+	producer, err = producers.GetProducer("non-partitioned", 0)
+	require.NoError(t, err)
+	require.Equal(t, "non-partitioned", producer.Topic())
+
+	_, err = producers.GetProducer("test", 3)
+	require.Error(t, err)
+	_, err = producers.GetProducer("test", -1)
+	require.Error(t, err)
+
+	require.NoError(t, producers.Flush())
+	producers.Close()
+	require.True(t, client.IsClosed())
+}
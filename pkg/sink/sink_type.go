@@ -39,6 +39,10 @@ const (
 	KafkaScheme = "kafka"
 	// KafkaSSLScheme indicates the scheme is kafka+ssl.
 	KafkaSSLScheme = "kafka+ssl"
+	// PulsarScheme indicates the scheme is pulsar.
+	PulsarScheme = "pulsar"
+	// PulsarSSLScheme indicates the scheme is pulsar+ssl.
+	PulsarSSLScheme = "pulsar+ssl"
 	// BlackHoleScheme indicates the scheme is blackhole.
 	BlackHoleScheme = "blackhole"
 	// MySQLScheme indicates the scheme is MySQL.
@@ -49,6 +53,8 @@ const (
 	TiDBScheme = "tidb"
 	// TiDBSSLScheme indicates the scheme is TiDB+ssl.
 	TiDBSSLScheme = "tidb+ssl"
+	// OracleScheme indicates the scheme is Oracle.
+	OracleScheme = "oracle"
 	// S3Scheme indicates the scheme is s3.
 	S3Scheme = "s3"
 	// FileScheme indicates the scheme is local fs or NFS.
@@ -63,11 +69,19 @@ const (
 	AzureScheme = "azure"
 	// CloudStorageNoopScheme indicates the scheme is noop.
 	CloudStorageNoopScheme = "noop"
+	// SubscriptionScheme indicates the scheme is subscription, the events are
+	// pulled by the consumers through the gRPC subscription service.
+	SubscriptionScheme = "subscription"
 )
 
 // IsMQScheme returns true if the scheme belong to mq scheme.
 func IsMQScheme(scheme string) bool {
-	return scheme == KafkaScheme || scheme == KafkaSSLScheme
+	return scheme == KafkaScheme || scheme == KafkaSSLScheme || IsPulsarScheme(scheme)
+}
+
+// IsPulsarScheme returns true if the scheme belong to pulsar scheme.
+func IsPulsarScheme(scheme string) bool {
+	return scheme == PulsarScheme || scheme == PulsarSSLScheme
 }
 
 // IsMySQLCompatibleScheme returns true if the scheme is compatible with MySQL.
@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// AggregationStrategy aggregates checkpoints of changefeeds to a safepoint.
+type AggregationStrategy interface {
+	// Aggregate returns the safepoint, checkpoints is not empty and it must
+	// not be modified.
+	Aggregate(checkpoints []model.Ts) model.Ts
+}
+
+// MinAggregation takes the minimum checkpoint, it is the default strategy.
+type MinAggregation struct{}
+
+// Aggregate implements AggregationStrategy.
+func (MinAggregation) Aggregate(checkpoints []model.Ts) model.Ts {
+	min := checkpoints[0]
+	for _, ts := range checkpoints[1:] {
+		if ts < min {
+			min = ts
+		}
+	}
+	return min
+}
+
+// PercentileAggregation takes the checkpoint at the percentile by nearest
+// rank, so that extreme laggards below the percentile are treated as failed
+// and do not hold back the safepoint.
+type PercentileAggregation struct {
+	// Percentile is in [0, 1], 0 means the minimum.
+	Percentile float64
+}
+
+// Aggregate implements AggregationStrategy.
+func (p PercentileAggregation) Aggregate(checkpoints []model.Ts) model.Ts {
+	sorted := make([]model.Ts, len(checkpoints))
+	copy(sorted, checkpoints)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p.Percentile * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// WithAggregationStrategy sets the strategy used by AggregateSafePoint.
+func WithAggregationStrategy(s AggregationStrategy) ManagerOption {
+	return func(m *gcManager) {
+		m.aggregation = s
+	}
+}
+
+func (m *gcManager) AggregateSafePoint(checkpoints []model.Ts) model.Ts {
+	if len(checkpoints) == 0 {
+		return 0
+	}
+	return m.aggregation.Aggregate(checkpoints)
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateSafePoint(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	// An extreme laggard.
+	checkpoints := []model.Ts{100, 10, 105, 110, 103}
+
+	manager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock)
+	require.Equal(t, model.Ts(0), manager.AggregateSafePoint(nil))
+	require.Equal(t, model.Ts(10), manager.AggregateSafePoint(checkpoints))
+
+	manager = NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock,
+		WithAggregationStrategy(PercentileAggregation{Percentile: 0.25}))
+	require.Equal(t, model.Ts(100), manager.AggregateSafePoint(checkpoints))
+	// Checkpoints are not modified.
+	require.Equal(t, []model.Ts{100, 10, 105, 110, 103}, checkpoints)
+
+	require.Equal(t, model.Ts(10), PercentileAggregation{}.Aggregate(checkpoints))
+	require.Equal(t, model.Ts(110),
+		PercentileAggregation{Percentile: 1}.Aggregate(checkpoints))
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// Aggregator tracks checkpoints of changefeeds and pushes their minimum as
+// the service GC safepoint through a Manager.
+// Checkpoints of failed changefeeds are skipped once
+// Manager.IgnoreFailedChangeFeed ignores them, so that a stuck failed
+// changefeed does not hold back GC forever.
+//
+// Note that Aggregator is not thread-safe.
+type Aggregator struct {
+	manager  Manager
+	feeds    map[model.ChangeFeedID]*aggregatedFeed
+	healthy  aggregatedFeedHeap
+	failures map[model.ChangeFeedID]*aggregatedFeed
+}
+
+type aggregatedFeed struct {
+	id           model.ChangeFeedID
+	checkpointTs model.Ts
+	failed       bool
+	// index is the index in aggregatedFeedHeap, it is -1 if failed.
+	index int
+}
+
+// NewAggregator creates a new Aggregator which pushes safepoints through
+// manager.
+func NewAggregator(manager Manager) *Aggregator {
+	return &Aggregator{
+		manager:  manager,
+		feeds:    make(map[model.ChangeFeedID]*aggregatedFeed),
+		failures: make(map[model.ChangeFeedID]*aggregatedFeed),
+	}
+}
+
+// Update updates the checkpoint of the changefeed, it adds the changefeed if
+// it is not tracked yet.
+func (a *Aggregator) Update(changefeedID model.ChangeFeedID, checkpointTs model.Ts) {
+	feed, ok := a.feeds[changefeedID]
+	if !ok {
+		feed = &aggregatedFeed{id: changefeedID, checkpointTs: checkpointTs}
+		a.feeds[changefeedID] = feed
+		heap.Push(&a.healthy, feed)
+		return
+	}
+	feed.checkpointTs = checkpointTs
+	if !feed.failed {
+		heap.Fix(&a.healthy, feed.index)
+	}
+}
+
+// SetFailed marks whether the changefeed is failed. It is a no-op if the
+// changefeed is not tracked.
+func (a *Aggregator) SetFailed(changefeedID model.ChangeFeedID, failed bool) {
+	feed, ok := a.feeds[changefeedID]
+	if !ok || feed.failed == failed {
+		return
+	}
+	feed.failed = failed
+	if failed {
+		heap.Remove(&a.healthy, feed.index)
+		a.failures[changefeedID] = feed
+	} else {
+		delete(a.failures, changefeedID)
+		heap.Push(&a.healthy, feed)
+	}
+}
+
+// Remove stops tracking the changefeed.
+func (a *Aggregator) Remove(changefeedID model.ChangeFeedID) {
+	feed, ok := a.feeds[changefeedID]
+	if !ok {
+		return
+	}
+	delete(a.feeds, changefeedID)
+	if feed.failed {
+		delete(a.failures, changefeedID)
+	} else {
+		heap.Remove(&a.healthy, feed.index)
+	}
+}
+
+// MinCheckpointTs returns the minimum checkpoint of tracked changefeeds, it
+// returns false if there is no changefeed to protect.
+func (a *Aggregator) MinCheckpointTs() (model.Ts, bool) {
+	var minTs model.Ts
+	found := false
+	if len(a.healthy) > 0 {
+		minTs, found = a.healthy[0].checkpointTs, true
+	}
+	for _, feed := range a.failures {
+		if a.manager.IgnoreFailedChangeFeed(feed.checkpointTs, 0) {
+			continue
+		}
+		if !found || feed.checkpointTs < minTs {
+			minTs, found = feed.checkpointTs, true
+		}
+	}
+	return minTs, found
+}
+
+// Tick pushes the minimum checkpoint through Manager.TryUpdateGCSafePoint,
+// it does nothing if there is no changefeed to protect.
+func (a *Aggregator) Tick(ctx context.Context, forceUpdate bool) error {
+	minTs, ok := a.MinCheckpointTs()
+	if !ok {
+		return nil
+	}
+	return a.manager.TryUpdateGCSafePoint(ctx, minTs, forceUpdate)
+}
+
+// aggregatedFeedHeap is a min-heap of checkpoints, it implements
+// heap.Interface.
+type aggregatedFeedHeap []*aggregatedFeed
+
+func (h aggregatedFeedHeap) Len() int { return len(h) }
+
+func (h aggregatedFeedHeap) Less(i, j int) bool {
+	return h[i].checkpointTs < h[j].checkpointTs
+}
+
+func (h aggregatedFeedHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *aggregatedFeedHeap) Push(x any) {
+	feed := x.(*aggregatedFeed)
+	feed.index = len(*h)
+	*h = append(*h, feed)
+}
+
+func (h *aggregatedFeedHeap) Pop() any {
+	old := *h
+	n := len(old)
+	feed := old[n-1]
+	old[n-1] = nil
+	feed.index = -1
+	*h = old[:n-1]
+	return feed
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestAggregator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pushes []uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushes = append(pushes, safePoint)
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	manager.clock = clock.NewMock()
+	a := NewAggregator(manager)
+
+	// Nothing to protect.
+	_, ok := a.MinCheckpointTs()
+	require.False(t, ok)
+	require.Nil(t, a.Tick(ctx, true))
+	require.Empty(t, pushes)
+
+	now := time.Now()
+	ts := func(ago time.Duration) model.Ts {
+		return oracle.GoTimeToTS(now.Add(-ago))
+	}
+	cf1 := model.DefaultChangeFeedID("changefeed-1")
+	cf2 := model.DefaultChangeFeedID("changefeed-2")
+	cf3 := model.DefaultChangeFeedID("changefeed-3")
+	a.Update(cf1, ts(3*time.Minute))
+	a.Update(cf2, ts(2*time.Minute))
+	a.Update(cf3, ts(time.Minute))
+	require.Nil(t, a.Tick(ctx, true))
+	require.Equal(t, []uint64{ts(3 * time.Minute)}, pushes)
+
+	// The minimum moves when the slowest changefeed advances.
+	a.Update(cf1, ts(30*time.Second))
+	minTs, ok := a.MinCheckpointTs()
+	require.True(t, ok)
+	require.Equal(t, ts(2*time.Minute), minTs)
+	a.Remove(cf2)
+	minTs, _ = a.MinCheckpointTs()
+	require.Equal(t, ts(time.Minute), minTs)
+	a.Remove(cf2)
+
+	// A failed changefeed still holds back GC within the retention.
+	a.Update(cf2, ts(time.Hour))
+	a.SetFailed(cf2, true)
+	minTs, _ = a.MinCheckpointTs()
+	require.Equal(t, ts(time.Hour), minTs)
+	// It is ignored once its checkpoint is too old.
+	a.Update(cf2, ts(gcTTL+time.Hour))
+	minTs, _ = a.MinCheckpointTs()
+	require.Equal(t, ts(time.Minute), minTs)
+	// A healthy changefeed with the same checkpoint is never ignored.
+	a.SetFailed(cf2, false)
+	minTs, _ = a.MinCheckpointTs()
+	require.Equal(t, ts(gcTTL+time.Hour), minTs)
+
+	// Only ignored failed changefeeds left, there is nothing to protect.
+	a.SetFailed(cf2, true)
+	a.Remove(cf1)
+	a.Remove(cf3)
+	_, ok = a.MinCheckpointTs()
+	require.False(t, ok)
+	a.Remove(cf2)
+	require.Empty(t, a.feeds)
+	require.Empty(t, a.failures)
+	require.Empty(t, a.healthy)
+}
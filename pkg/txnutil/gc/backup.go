@@ -0,0 +1,87 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"go.uber.org/zap"
+)
+
+// backupServiceIDPrefixes are prefixes of service IDs used by BR and
+// Lightning when they set service GC safepoints.
+var backupServiceIDPrefixes = []string{"br-", "lightning-"}
+
+// ServiceSafePointLister lists service GC safepoints in PD.
+// pdutil.PDAPIClient implements it.
+type ServiceSafePointLister interface {
+	ListGcServiceSafePoint(ctx context.Context) (*pdutil.ListServiceGCSafepoint, error)
+}
+
+// WithBackupCoordination makes the Manager detect active BR and Lightning
+// service safepoints in PD, and defer advancing its own safepoint while they
+// are alive, so that it does not race with backup or import tasks.
+func WithBackupCoordination(lister ServiceSafePointLister) ManagerOption {
+	return func(m *gcManager) {
+		m.serviceSafePoints = lister
+	}
+}
+
+// activeBackupServiceID returns the service ID of an active BR or Lightning
+// service safepoint, it returns an empty string if there is none.
+func (m *gcManager) activeBackupServiceID(ctx context.Context) string {
+	if m.serviceSafePoints == nil {
+		return ""
+	}
+	list, err := m.serviceSafePoints.ListGcServiceSafePoint(ctx)
+	if err != nil {
+		log.Warn("failed to list service gc safe points",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Error(err))
+		return ""
+	}
+	now := m.clock.Now().Unix()
+	for _, sp := range list.ServiceGCSafepoints {
+		if sp.ExpiredAt <= now {
+			continue
+		}
+		for _, prefix := range backupServiceIDPrefixes {
+			if strings.HasPrefix(sp.ServiceID, prefix) {
+				return sp.ServiceID
+			}
+		}
+	}
+	return ""
+}
+
+// deferForBackup returns the safepoint to push while a backup is active, the
+// last pushed safepoint is kept alive instead of advancing it.
+func (m *gcManager) deferForBackup(ctx context.Context, safePoint uint64) uint64 {
+	if m.lastSafePointTs == 0 || safePoint <= m.lastSafePointTs {
+		return safePoint
+	}
+	serviceID := m.activeBackupServiceID(ctx)
+	if serviceID == "" {
+		return safePoint
+	}
+	log.Info("backup or import is running, defer advancing gc safe point",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.String("backupServiceID", serviceID),
+		zap.Uint64("safePointTs", safePoint),
+		zap.Uint64("lastSafePointTs", m.lastSafePointTs))
+	return m.lastSafePointTs
+}
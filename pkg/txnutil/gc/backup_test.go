@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+type mockServiceSafePointLister struct {
+	safePoints []*pdutil.ServiceSafePoint
+}
+
+func (l *mockServiceSafePointLister) ListGcServiceSafePoint(
+	ctx context.Context,
+) (*pdutil.ListServiceGCSafepoint, error) {
+	return &pdutil.ListServiceGCSafepoint{ServiceGCSafepoints: l.safePoints}, nil
+}
+
+func TestUpdateGCSafePointDeferForBackup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pushed []uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed = append(pushed, safePoint)
+			return safePoint, nil
+		},
+	}
+	lister := &mockServiceSafePointLister{}
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), WithBackupCoordination(lister)).(*gcManager)
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Now())
+	manager.clock = mockClock
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+
+	// An expired BR safepoint and a safepoint of other services.
+	lister.safePoints = []*pdutil.ServiceSafePoint{
+		{ServiceID: "br-1", ExpiredAt: mockClock.Now().Unix() - 1, SafePoint: 50},
+		{ServiceID: "gc_worker", ExpiredAt: math.MaxInt64, SafePoint: 50},
+	}
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 110, true))
+
+	// BR is running, the safepoint is not advanced but kept alive.
+	lister.safePoints = append(lister.safePoints, &pdutil.ServiceSafePoint{
+		ServiceID: "br-2", ExpiredAt: mockClock.Now().Unix() + 60, SafePoint: 60,
+	})
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 120, true))
+	require.Equal(t, uint64(110), manager.lastSafePointTs)
+
+	// Lightning is running.
+	lister.safePoints = []*pdutil.ServiceSafePoint{
+		{ServiceID: "lightning-1", ExpiredAt: math.MaxInt64, SafePoint: 60},
+	}
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 130, true))
+
+	// The import is done.
+	lister.safePoints = nil
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 140, true))
+	require.Equal(t, []uint64{100, 110, 110, 110, 140}, pushed)
+}
@@ -15,14 +15,19 @@ package gc
 
 import (
 	"context"
+	"math/rand"
+	"sync"
 	"time"
 
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
 	"go.uber.org/zap"
@@ -33,19 +38,188 @@ import (
 // by garbage collection.
 const gcTTL = 24 * time.Hour
 
-// gcSafepointUpdateInterval is the minimum interval that CDC can update gc safepoint
-var gcSafepointUpdateInterval = 1 * time.Minute
+// defaultGCSafepointUpdateInterval is the default minimum interval that CDC
+// can update gc safepoint.
+const defaultGCSafepointUpdateInterval = 1 * time.Minute
 
-// Manager is an interface for gc manager
+// defaultPDCallTimeout is the default timeout of an attempt of a PD call made
+// by the Manager, so that a wedged PD leader does not stall an attempt.
+const defaultPDCallTimeout = 5 * time.Second
+
+// maxIntervalJitterRatio is the maximum ratio of the update interval by which
+// a Manager shortens its interval, see gcManager.jitter.
+const maxIntervalJitterRatio = 0.1
+
+// failureLogInterval is the minimum interval of logging sustained failures of
+// updating gc safepoint.
+const failureLogInterval = 5 * time.Minute
+
+// initialSafePointTimeout is the timeout of loading the initial safepoint.
+const initialSafePointTimeout = 10 * time.Second
+
+// safePointFloorAlertHeadroom is the headroom between the safepoint and the
+// floor, below which the Manager alerts that the safepoint approaches the
+// floor, see WithSafePointFloor.
+const safePointFloorAlertHeadroom = time.Hour
+
+// Manager is an interface for gc manager, it is safe for concurrent use.
 type Manager interface {
 	// TryUpdateGCSafePoint tries to update TiCDC service GC safepoint.
-	// Manager may skip update when it thinks it is too frequent.
+	// Manager may skip update when it thinks it is too frequent, that is
+	// within the update interval shortened by a per-manager jitter of at most
+	// maxIntervalJitterRatio of the interval, so that managers do not write
+	// PD at the same instant. The jitter never lengthens the interval.
 	// Set `forceUpdate` to force Manager update.
 	TryUpdateGCSafePoint(ctx context.Context, checkpointTs model.Ts, forceUpdate bool) error
+	// TryUpdateGCSafePointDetailed is like TryUpdateGCSafePoint, but it also
+	// returns whether the safepoint is pushed, see UpdateResult.
+	TryUpdateGCSafePointDetailed(
+		ctx context.Context, checkpointTs model.Ts, forceUpdate bool,
+	) (UpdateResult, error)
 	CheckStaleCheckpointTs(ctx context.Context, changefeedID model.ChangeFeedID, checkpointTs model.Ts) error
 	// IgnoreFailedChangeFeed verifies whether a failed changefeed should be
 	// disregarded. When calculating the GC safepoint of the related upstream,
-	IgnoreFailedChangeFeed(checkpointTs uint64) bool
+	// a failed changefeed is disregarded if its checkpoint is older than the
+	// retention, 0 retention means the default of 24 hours.
+	IgnoreFailedChangeFeed(checkpointTs uint64, retention time.Duration) bool
+	// IgnoreFailedChangeFeedByID is like IgnoreFailedChangeFeed, but it
+	// never ignores a changefeed within the retry protection window since it
+	// starts retrying, see SetRetrying.
+	IgnoreFailedChangeFeedByID(
+		changefeedID model.ChangeFeedID, checkpointTs uint64, retention time.Duration,
+	) bool
+	// SetRetrying marks whether a changefeed is in an error-retry loop.
+	SetRetrying(changefeedID model.ChangeFeedID, retrying bool)
+	// SetAutoResumeDeadline sets the time until which a failed changefeed
+	// may still be resumed automatically, the changefeed is never ignored
+	// by IgnoreFailedChangeFeedByID before the deadline. A zero deadline
+	// clears it.
+	SetAutoResumeDeadline(changefeedID model.ChangeFeedID, deadline time.Time)
+	// RemoveChangefeed forgets the retrying and auto resume states of a
+	// changefeed, it's called when the changefeed is removed.
+	RemoveChangefeed(changefeedID model.ChangeFeedID)
+	// HandoffTo transfers the GC duties to the successor, so that the
+	// successor continues pushing the service GC safepoint without a gap.
+	// The successor pushes the last safepoint of the Manager with a forced
+	// update, and the Manager stops pushing after a successful handoff.
+	HandoffTo(ctx context.Context, successor Manager) error
+	// Unregister removes the service GC safepoint from PD, so that it stops
+	// holding back GC immediately instead of waiting for the TTL to expire.
+	// It does nothing if the safepoint has never been set.
+	Unregister(ctx context.Context) error
+	// AssessTTLSafety assesses whether the configured gc TTL gives enough
+	// headroom for a changefeed to recover, see TTLAssessment.
+	AssessTTLSafety(ctx context.Context) (TTLAssessment, error)
+	// LastPushKind returns the kind of the most recent TryUpdateGCSafePoint.
+	LastPushKind() PushKind
+	// LastSafePointTs returns the service safepoint last written to or
+	// loaded from PD, it is 0 if there is none.
+	LastSafePointTs() uint64
+	// LastUpdatedTime returns the time of the last successful write of the
+	// service safepoint, it is zero if there is none.
+	LastUpdatedTime() time.Time
+	// MergeSafepoints returns the minimum non-zero LastSafePointTs among the
+	// sources, it never regresses below the local high-water mark, which is
+	// the maximum of the last pushed safepoint and previous merge results.
+	MergeSafepoints(sources ...Manager) uint64
+	// SafePointWithPausedChangefeeds returns the minimum of checkpointTs and
+	// the checkpoints of paused changefeeds, so that paused changefeeds
+	// still protect their data from GC, see WithExcludePausedChangefeeds.
+	SafePointWithPausedChangefeeds(
+		checkpointTs model.Ts, paused map[model.ChangeFeedID]model.Ts,
+	) model.Ts
+	// AcquireSafepointLease pushes the safepoint under a service ID of its
+	// own and returns a lease which keeps it alive in background, see Lease.
+	AcquireSafepointLease(ctx context.Context, checkpointTs model.Ts) (Lease, error)
+	// StatusJSON marshals the current status of the Manager, see
+	// ManagerStatus.
+	StatusJSON() ([]byte, error)
+	// Run pushes the safepoint returned by source every update interval,
+	// until the context is done or Stop is called. Its pushes are serialized
+	// with TryUpdateGCSafePoint called from other goroutines.
+	Run(ctx context.Context, source SafePointSource) error
+	// Stop stops Run and waits for it to exit. It is idempotent.
+	Stop()
+	// IsRunning returns true if Run is running.
+	IsRunning() bool
+	// AggregateSafePoint aggregates checkpoints of changefeeds to the
+	// safepoint with the AggregationStrategy, see WithAggregationStrategy.
+	AggregateSafePoint(checkpoints []model.Ts) model.Ts
+	// SuppressStalenessCheck makes CheckStaleCheckpointTs return nil until
+	// the given time, e.g. when GC is advanced deliberately by an operator.
+	SuppressStalenessCheck(until time.Time)
+	// VerifyPropagation checks whether the GC safepoints of all TiKV stores
+	// reach target within timeout, it returns the IDs of lagging stores if
+	// not, see WithStoreSafePointProvider.
+	VerifyPropagation(
+		ctx context.Context, target uint64, timeout time.Duration,
+	) (propagated bool, laggingStores []uint64, err error)
+	// SetSafepointIfUnchanged pushes the safepoint to newSafePoint only if the
+	// current service safepoint in PD equals expected. It returns the
+	// safepoint returned by PD if pushed, or the current service safepoint
+	// if not, see WithSetIfUnchangedLister. The check and the push are not
+	// atomic, since PD does not support conditional updates.
+	SetSafepointIfUnchanged(
+		ctx context.Context, expected, newSafePoint uint64,
+	) (pushed bool, actual uint64, err error)
+	// PinSafePoint pins the service safepoint at ts, TryUpdateGCSafePoint
+	// does not advance the safepoint past ts until UnpinSafePoint is called.
+	PinSafePoint(ctx context.Context, ts uint64) error
+	// UnpinSafePoint unpins the service safepoint, the next
+	// TryUpdateGCSafePoint pushes the checkpoint without being throttled.
+	UnpinSafePoint(ctx context.Context) error
+	// SetBarrierTs sets the barrier ts that TryUpdateGCSafePoint never
+	// advances the safepoint past, 0 clears the barrier.
+	SetBarrierTs(barrierTs uint64)
+	// SetChangefeedGCTTL sets the largest GC TTL in seconds required by
+	// changefeeds, the service safepoint is pushed with the larger one of it
+	// and the server GC TTL, 0 means no changefeed requires a longer TTL.
+	SetChangefeedGCTTL(ttl int64)
+}
+
+// UpdateResult is the result of a TryUpdateGCSafePointDetailed.
+type UpdateResult struct {
+	// Updated is true if the safepoint is pushed to PD.
+	Updated bool
+	// Actual is the minimum service safepoint returned by PD if Updated, it
+	// is larger than the checkpoint if data needed may have been GC'd.
+	Actual uint64
+	// Throttled is true if the update is skipped because it is too frequent.
+	Throttled bool
+}
+
+// PushKind describes the outcome of a TryUpdateGCSafePoint.
+type PushKind int
+
+const (
+	// PushKindNone means there is no push yet.
+	PushKindNone PushKind = iota
+	// PushKindSkipped means the push is skipped, e.g. it is too frequent.
+	PushKindSkipped
+	// PushKindForced means the safepoint is pushed by a forced update.
+	PushKindForced
+	// PushKindNatural means the safepoint is pushed after the update interval.
+	PushKindNatural
+	// PushKindFailed means the safepoint failed to be pushed to PD.
+	PushKindFailed
+)
+
+// String implements fmt.Stringer.
+func (k PushKind) String() string {
+	switch k {
+	case PushKindNone:
+		return "none"
+	case PushKindSkipped:
+		return "skipped"
+	case PushKindForced:
+		return "forced"
+	case PushKindNatural:
+		return "natural"
+	case PushKindFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
 }
 
 type gcManager struct {
@@ -53,45 +227,417 @@ type gcManager struct {
 	pdClient    pd.Client
 	pdClock     pdutil.Clock
 	gcTTL       int64
+	// changefeedGCTTL is the GC TTL required by changefeeds, see
+	// SetChangefeedGCTTL.
+	changefeedGCTTL int64
+	// clock is the local clock, it can be mocked in tests.
+	clock clock.Clock
+	// interval is the minimum interval of updating gc safepoint.
+	interval time.Duration
+	// jitter is a non-positive offset applied to interval when throttling
+	// updates, it is chosen once per manager to spread out PD writes.
+	jitter time.Duration
+	// pdCallTimeout is the timeout of a PD call, see WithPDCallTimeout.
+	pdCallTimeout time.Duration
+
+	// mu guards the mutable state below. It is held during a whole push, so
+	// that pushes from the owner, Run and other callers are serialized.
+	mu sync.Mutex
 
 	lastUpdatedTime   time.Time
 	lastSucceededTime time.Time
 	lastSafePointTs   uint64
+	// lastWrittenTime is the time when lastSafePointTs is written to PD,
+	// unlike lastSucceededTime, it is zero before the first write.
+	lastWrittenTime time.Time
+
+	// clockUncertainty enables pushing a conservative safepoint, see
+	// WithClockUncertainty.
+	clockUncertainty bool
+	clockSkew        clockSkew
+
+	// safePointFloor is the absolute floor of the safepoint, see
+	// WithSafePointFloor.
+	safePointFloor uint64
+
+	// handedOff is true if GC duties have been handed off to a successor.
+	handedOff bool
+
+	// regionStats provides region statistics for AssessTTLSafety.
+	regionStats RegionStatsProvider
+	// advanceRate tracks how fast the safepoint advances.
+	advanceRate advanceRate
+	// tidbGCState provides the state of TiDB GC worker, see
+	// WithTiDBGCStateProvider.
+	tidbGCState TiDBGCStateProvider
+
+	lastPushKind PushKind
+	// mergedHighWater is the high-water mark of MergeSafepoints.
+	mergedHighWater uint64
+	// ready gates safepoint pushes, see WithReadinessPredicate.
+	ready ReadinessPredicate
+	// excludePaused is true if paused changefeeds do not pin the safepoint.
+	excludePaused bool
+
+	// failureLog rate-limits logs of sustained failures.
+	failureLog failureLogLimiter
+
+	// intents logs in-flight pushes, see WithIntentStore.
+	intents         IntentStore
+	intentsReplayed bool
+
+	// serviceSafePoints lists service safepoints, see WithBackupCoordination.
+	serviceSafePoints ServiceSafePointLister
+	// initialSafePoints lists service safepoints to initialize
+	// lastSafePointTs, see WithInitialSafePointFromPD.
+	initialSafePoints ServiceSafePointLister
+
+	// cold is the cold retention tier, see WithColdRetention.
+	cold *coldRetention
+
+	run runState
+
+	aggregation AggregationStrategy
+
+	// suppressStalenessUntil is the time until which staleness checks are
+	// suppressed, see SuppressStalenessCheck.
+	suppressStalenessUntil time.Time
+
+	// safepoints sets service safepoints, see WithSafepointService.
+	safepoints SafepointService
+
+	// storeSafePoints provides GC safepoints of stores for VerifyPropagation.
+	storeSafePoints StoreSafePointProvider
+
+	// retryingSince is the time when changefeeds start retrying, see
+	// SetRetrying.
+	retryingSince         map[model.ChangeFeedID]time.Time
+	retryProtectionWindow time.Duration
+	// autoResumeDeadlines are the deadlines of resuming failed changefeeds
+	// automatically, see SetAutoResumeDeadline.
+	autoResumeDeadlines map[model.ChangeFeedID]time.Time
+
+	// ifUnchangedSafePoints lists service safepoints for SetSafepointIfUnchanged.
+	ifUnchangedSafePoints ServiceSafePointLister
+	// onRegression is called when PD's safepoint is ahead of the requested
+	// one, see WithSafePointRegressionHandler.
+	onRegression         SafePointRegressionHandler
+	lastRegressionActual uint64
+	// pinnedTs is the pinned service safepoint, see PinSafePoint.
+	pinnedTs uint64
+	pinned   bool
+	// barrierTs is the barrier ts, see SetBarrierTs.
+	barrierTs uint64
+	// leaseSeq is the sequence number of the last safepoint lease, it makes
+	// service IDs of leases unique.
+	leaseSeq uint64
+}
+
+// coldRetention is a longer retention enforced by a separate service
+// safepoint.
+type coldRetention struct {
+	serviceID string
+	ttl       int64
+	lag       time.Duration
+}
+
+// failureLogLimiter rate-limits logs of sustained failures. It allows the first
+// failure, then once per interval, and the recovery.
+type failureLogLimiter struct {
+	interval   time.Duration
+	failing    bool
+	lastLogged time.Time
+	// suppressed is the number of failures not logged since lastLogged.
+	suppressed int
+	// failures is the number of failures since the first failure.
+	failures int
+}
+
+// onFailure returns true if the failure should be logged, with the number of
+// failures suppressed since the last log.
+func (l *failureLogLimiter) onFailure(now time.Time) (bool, int) {
+	l.failures++
+	if !l.failing || now.Sub(l.lastLogged) >= l.interval {
+		l.failing = true
+		l.lastLogged = now
+		suppressed := l.suppressed
+		l.suppressed = 0
+		return true, suppressed
+	}
+	l.suppressed++
+	return false, 0
+}
+
+// onSuccess returns true if it recovers from failures, with the number of
+// failures in total.
+func (l *failureLogLimiter) onSuccess() (bool, int) {
+	if !l.failing {
+		return false, 0
+	}
+	failures := l.failures
+	*l = failureLogLimiter{interval: l.interval}
+	return true, failures
+}
+
+// ManagerOption is an option of gc Manager.
+type ManagerOption func(m *gcManager)
+
+// WithClockUncertainty makes the Manager subtract an uncertainty window from
+// the safepoint it pushes. The window is the range of skews between PD clock
+// and the local clock observed so far, so that a noisy PD clock does not make
+// the Manager push the safepoint too aggressively.
+func WithClockUncertainty() ManagerOption {
+	return func(m *gcManager) {
+		m.clockUncertainty = true
+	}
+}
+
+// WithSafePointFloor sets an absolute floor of the safepoint, e.g. a "do not
+// GC before" timestamp required by a retention policy. The Manager raises an
+// alert when the safepoint approaches the floor. It refuses to advance the
+// safepoint while the checkpoint is below the floor, the current safepoint
+// is kept alive instead. The floor itself is never pushed, as it's above the
+// checkpoint that changefeeds still need.
+func WithSafePointFloor(floor uint64) ManagerOption {
+	return func(m *gcManager) {
+		m.safePointFloor = floor
+	}
+}
+
+// WithPDCallTimeout sets the timeout of every attempt of a PD call made by
+// the Manager, a non-positive timeout is ignored.
+func WithPDCallTimeout(timeout time.Duration) ManagerOption {
+	return func(m *gcManager) {
+		if timeout > 0 {
+			m.pdCallTimeout = timeout
+		}
+	}
 }
 
-// NewManager creates a new Manager.
-func NewManager(gcServiceID string, pdClient pd.Client, pdClock pdutil.Clock) Manager {
+// ReadinessPredicate returns true if the Manager is ready to push safepoints.
+type ReadinessPredicate func() bool
+
+// WithReadinessPredicate gates safepoint pushes behind the predicate, pushes
+// are deferred until it returns true, e.g. the capture is fully initialized.
+func WithReadinessPredicate(ready ReadinessPredicate) ManagerOption {
+	return func(m *gcManager) {
+		m.ready = ready
+	}
+}
+
+// WithExcludePausedChangefeeds makes paused changefeeds not pin the safepoint,
+// data of a paused changefeed may be garbage collected.
+func WithExcludePausedChangefeeds() ManagerOption {
+	return func(m *gcManager) {
+		m.excludePaused = true
+	}
+}
+
+// WithInitialSafePointFromPD makes NewManager initialize the last safepoint
+// from the existing service safepoint in PD, so that the high-water mark and
+// staleness checks are correct right after a restart.
+func WithInitialSafePointFromPD(lister ServiceSafePointLister) ManagerOption {
+	return func(m *gcManager) {
+		m.initialSafePoints = lister
+	}
+}
+
+// WithColdRetention makes the Manager push a second "cold" safepoint, which
+// lags behind the pushed safepoint by lag, under serviceID with a TTL of ttl
+// seconds. The cold safepoint gives a longer retention than the warm one.
+func WithColdRetention(serviceID string, ttl int64, lag time.Duration) ManagerOption {
+	return func(m *gcManager) {
+		m.cold = &coldRetention{serviceID: serviceID, ttl: ttl, lag: lag}
+	}
+}
+
+// NewManager creates a new Manager with the default update interval.
+func NewManager(
+	gcServiceID string, pdClient pd.Client, pdClock pdutil.Clock, opts ...ManagerOption,
+) Manager {
+	return NewManagerWithInterval(
+		gcServiceID, pdClient, pdClock, defaultGCSafepointUpdateInterval, opts...)
+}
+
+// NewManagerWithInterval creates a new Manager which updates gc safepoint at
+// most once per interval, unless the update is forced.
+func NewManagerWithInterval(
+	gcServiceID string, pdClient pd.Client, pdClock pdutil.Clock,
+	interval time.Duration, opts ...ManagerOption,
+) Manager {
 	serverConfig := config.GetGlobalServerConfig()
+	jitter := newIntervalJitter(interval)
 	failpoint.Inject("InjectGcSafepointUpdateInterval", func(val failpoint.Value) {
-		gcSafepointUpdateInterval = time.Duration(val.(int) * int(time.Millisecond))
+		interval = time.Duration(val.(int) * int(time.Millisecond))
+		// Keep the injected interval deterministic.
+		jitter = 0
 	})
-	return &gcManager{
-		gcServiceID:       gcServiceID,
-		pdClient:          pdClient,
-		pdClock:           pdClock,
-		lastSucceededTime: time.Now(),
-		gcTTL:             serverConfig.GcTTL,
+	m := &gcManager{
+		gcServiceID: gcServiceID,
+		pdClient:    pdClient,
+		pdClock:     pdClock,
+		clock:       clock.New(),
+		interval:    interval,
+		jitter:      jitter,
+		gcTTL:       serverConfig.GcTTL,
+		failureLog:  failureLogLimiter{interval: failureLogInterval},
+		aggregation: MinAggregation{},
+
+		retryProtectionWindow: defaultRetryProtectionWindow,
+		pdCallTimeout:         defaultPDCallTimeout,
+		safepoints:            NewPDSafepointService(pdClient),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.lastSucceededTime = m.clock.Now()
+	if m.initialSafePoints != nil {
+		m.loadInitialSafePoint()
+	}
+	return m
+}
+
+// newIntervalJitter returns a random offset in
+// [-interval*maxIntervalJitterRatio, 0].
+func newIntervalJitter(interval time.Duration) time.Duration {
+	bound := int64(float64(interval) * maxIntervalJitterRatio)
+	if bound <= 0 {
+		return 0
+	}
+	return -time.Duration(rand.Int63n(bound + 1))
+}
+
+// loadInitialSafePoint initializes lastSafePointTs from the service
+// safepoint in PD, it keeps the default if the safepoint can not be read.
+func (m *gcManager) loadInitialSafePoint() {
+	ctx, cancel := context.WithTimeout(context.Background(), initialSafePointTimeout)
+	defer cancel()
+	list, err := m.initialSafePoints.ListGcServiceSafePoint(ctx)
+	if err != nil {
+		log.Warn("failed to load the initial gc safe point from pd",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Error(err))
+		return
+	}
+	for _, sp := range list.ServiceGCSafepoints {
+		if sp.ServiceID == m.gcServiceID {
+			m.lastSafePointTs = sp.SafePoint
+			log.Info("load the initial gc safe point from pd",
+				zap.String("GcManagerID", m.gcServiceID),
+				zap.Uint64("safePointTs", sp.SafePoint))
+			return
+		}
+	}
+}
+
+func (m *gcManager) SetBarrierTs(barrierTs uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if barrierTs != m.barrierTs {
+		log.Debug("gc safe point barrier changed",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("oldBarrierTs", m.barrierTs),
+			zap.Uint64("barrierTs", barrierTs))
+	}
+	m.barrierTs = barrierTs
+}
+
+func (m *gcManager) SetChangefeedGCTTL(ttl int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changefeedGCTTL = ttl
+}
+
+// serviceGCTTL returns the TTL in seconds of the service safepoint.
+func (m *gcManager) serviceGCTTL() int64 {
+	if m.changefeedGCTTL > m.gcTTL {
+		return m.changefeedGCTTL
 	}
+	return m.gcTTL
 }
 
 func (m *gcManager) TryUpdateGCSafePoint(
 	ctx context.Context, checkpointTs model.Ts, forceUpdate bool,
 ) error {
-	if time.Since(m.lastUpdatedTime) < gcSafepointUpdateInterval && !forceUpdate {
-		return nil
+	_, err := m.TryUpdateGCSafePointDetailed(ctx, checkpointTs, forceUpdate)
+	return err
+}
+
+func (m *gcManager) TryUpdateGCSafePointDetailed(
+	ctx context.Context, checkpointTs model.Ts, forceUpdate bool,
+) (UpdateResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastPushKind = PushKindSkipped
+	if m.handedOff {
+		log.Debug("gc duties have been handed off, skip updating gc safe point",
+			zap.String("GcManagerID", m.gcServiceID))
+		return UpdateResult{}, nil
+	}
+	if m.ready != nil && !m.ready() {
+		log.Info("gc manager is not ready, defer updating gc safe point",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("checkpointTs", checkpointTs))
+		return UpdateResult{}, nil
+	}
+	if m.clock.Since(m.lastUpdatedTime) < m.interval+m.jitter && !forceUpdate {
+		return UpdateResult{Throttled: true}, nil
+	}
+	if m.shouldDeferForTiDBGC(ctx) {
+		return UpdateResult{}, nil
+	}
+
+	if m.clockUncertainty {
+		checkpointTs = m.conservativeSafePoint(checkpointTs)
+	}
+	checkpointTs = m.deferForBackup(ctx, checkpointTs)
+	if m.pinned && checkpointTs > m.pinnedTs {
+		checkpointTs = m.pinnedTs
+	}
+	if m.barrierTs != 0 && checkpointTs > m.barrierTs {
+		checkpointTs = m.barrierTs
+	}
+	if m.checkSafePointFloor(checkpointTs) &&
+		m.lastSafePointTs != 0 && m.lastSafePointTs < checkpointTs {
+		// Refuse to advance the safepoint below the floor, only refresh the
+		// TTL of the current one.
+		checkpointTs = m.lastSafePointTs
 	}
-	m.lastUpdatedTime = time.Now()
 
-	actual, err := SetServiceGCSafepoint(
-		ctx, m.pdClient, m.gcServiceID, m.gcTTL, checkpointTs)
+	m.replayIntent(ctx, checkpointTs)
+	if err := m.writeIntent(ctx, checkpointTs); err != nil {
+		return UpdateResult{}, errors.Trace(err)
+	}
+	m.lastUpdatedTime = m.clock.Now()
+	actual, attempts, err := m.pushServiceGCSafepoint(ctx, checkpointTs)
 	if err != nil {
-		log.Warn("updateGCSafePoint failed",
-			zap.Uint64("safePointTs", checkpointTs),
-			zap.Error(err))
-		if time.Since(m.lastSucceededTime) >= time.Second*time.Duration(m.gcTTL) {
-			return cerror.ErrUpdateServiceSafepointFailed.Wrap(err)
+		m.lastPushKind = PushKindFailed
+		updateSafePointFailureCounter.WithLabelValues(m.gcServiceID).Inc()
+		if ok, suppressed := m.failureLog.onFailure(m.clock.Now()); ok {
+			log.Warn("updateGCSafePoint failed",
+				zap.Uint64("safePointTs", checkpointTs),
+				zap.Int("attempts", attempts),
+				zap.Int("suppressedFailures", suppressed),
+				zap.Error(err))
 		}
-		return nil
+		// The caller is canceled, return the error instead of tolerating it,
+		// so that the caller can react promptly. Timeouts are tolerated like
+		// other failures until the TTL elapses.
+		if ctx.Err() != nil {
+			return UpdateResult{}, errors.Trace(err)
+		}
+		if m.clock.Since(m.lastSucceededTime) >= time.Second*time.Duration(m.serviceGCTTL()) {
+			return UpdateResult{}, cerror.ErrUpdateServiceSafepointFailed.Wrap(err)
+		}
+		return UpdateResult{}, nil
+	}
+	if err := m.confirmIntent(ctx, checkpointTs); err != nil {
+		return UpdateResult{}, errors.Trace(err)
+	}
+	if ok, failures := m.failureLog.onSuccess(); ok {
+		log.Info("updateGCSafePoint recovered",
+			zap.Uint64("safePointTs", checkpointTs),
+			zap.Int("failures", failures))
 	}
 	failpoint.Inject("InjectActualGCSafePoint", func(val failpoint.Value) {
 		actual = uint64(val.(int))
@@ -103,15 +649,320 @@ func (m *gcManager) TryUpdateGCSafePoint(
 		log.Warn("update gc safe point failed, the gc safe point is larger than checkpointTs",
 			zap.Uint64("actual", actual), zap.Uint64("checkpointTs", checkpointTs))
 	}
+	m.observeActualSafePoint(checkpointTs, actual)
 	m.lastSafePointTs = actual
-	m.lastSucceededTime = time.Now()
+	m.lastSucceededTime = m.clock.Now()
+	m.lastWrittenTime = m.lastSucceededTime
+	m.advanceRate.observe(actual, m.lastSucceededTime)
+	m.updateSafePointMetrics(actual)
+	m.pushColdSafePoint(ctx, checkpointTs)
+	if forceUpdate {
+		m.lastPushKind = PushKindForced
+	} else {
+		m.lastPushKind = PushKindNatural
+	}
+	return UpdateResult{Updated: true, Actual: actual}, nil
+}
+
+// checkSafePointFloor alerts if the safepoint approaches or falls below the
+// floor, it returns true if the safepoint is below the floor.
+func (m *gcManager) checkSafePointFloor(safePoint uint64) (violated bool) {
+	if m.safePointFloor == 0 {
+		return false
+	}
+	headroom := oracle.GetTimeFromTS(safePoint).Sub(oracle.GetTimeFromTS(m.safePointFloor))
+	safePointFloorHeadroomGauge.WithLabelValues(m.gcServiceID).Set(headroom.Seconds())
+	if safePoint < m.safePointFloor {
+		safePointFloorViolationCounter.WithLabelValues(m.gcServiceID).Inc()
+		log.Warn("gc safe point is below the configured floor, refuse to advance it",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("safePointTs", safePoint),
+			zap.Uint64("currentSafePointTs", m.lastSafePointTs),
+			zap.Uint64("safePointFloor", m.safePointFloor))
+		return true
+	}
+	if headroom < safePointFloorAlertHeadroom {
+		log.Warn("gc safe point is approaching the configured floor",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("safePointTs", safePoint),
+			zap.Uint64("safePointFloor", m.safePointFloor),
+			zap.Duration("headroom", headroom))
+	}
+	return false
+}
+
+// updateSafePointMetrics updates the metrics after the service gc safepoint is
+// pushed successfully.
+func (m *gcManager) updateSafePointMetrics(safePoint uint64) {
+	updateSafePointSuccessCounter.WithLabelValues(m.gcServiceID).Inc()
+	phySafePoint := oracle.ExtractPhysical(safePoint)
+	safePointGauge.WithLabelValues(m.gcServiceID).Set(float64(phySafePoint))
+	safePointLastUpdateTimeGauge.WithLabelValues(m.gcServiceID).
+		Set(float64(m.clock.Now().Unix()))
+	pdTime, err := m.pdClock.CurrentTime()
+	if err != nil {
+		log.Warn("get pd time failed, skip updating gc safe point lag",
+			zap.String("GcManagerID", m.gcServiceID), zap.Error(err))
+		return
+	}
+	lag := pdTime.Sub(oracle.GetTimeFromTS(safePoint)).Seconds()
+	safePointLagGauge.WithLabelValues(m.gcServiceID).Set(lag)
+}
+
+// pushServiceGCSafepoint pushes the service gc safepoint with at most
+// gcServiceMaxRetries attempts to ride out PD leader elections, it returns
+// the actual safepoint and the number of attempts made. The retries do not
+// touch lastUpdatedTime, so the throttle of the next update is not affected.
+func (m *gcManager) pushServiceGCSafepoint(
+	ctx context.Context, safePoint model.Ts,
+) (actual uint64, attempts int, err error) {
+	err = retry.Do(ctx,
+		func() error {
+			attempts++
+			var err1 error
+			actual, err1 = m.setServiceGCSafepoint(ctx, m.gcServiceID, m.serviceGCTTL(), safePoint)
+			return err1
+		},
+		retry.WithBackoffBaseDelay(gcServiceBackoffDelay),
+		retry.WithMaxTries(gcServiceMaxRetries),
+		// A timed out attempt is retried too, PD may be electing a leader.
+		retry.WithIsRetryableErr(cerror.IsRetryableError))
+	return
+}
+
+// callPD calls f with a context which times out after pdCallTimeout, it
+// returns ErrUpdateServiceSafepointTimeout if the call times out while ctx
+// is not done.
+func (m *gcManager) callPD(
+	ctx context.Context, f func(ctx context.Context) error,
+) error {
+	callCtx, cancel := context.WithTimeout(ctx, m.pdCallTimeout)
+	defer cancel()
+	err := f(callCtx)
+	if err != nil && ctx.Err() == nil && callCtx.Err() == context.DeadlineExceeded {
+		return cerror.ErrUpdateServiceSafepointTimeout.GenWithStackByArgs(m.pdCallTimeout)
+	}
+	return err
+}
+
+// pushColdSafePoint pushes the cold safepoint, a failure does not affect the
+// warm safepoint, so it is only logged.
+func (m *gcManager) pushColdSafePoint(ctx context.Context, safePoint model.Ts) {
+	if m.cold == nil {
+		return
+	}
+	physical := oracle.ExtractPhysical(safePoint) - m.cold.lag.Milliseconds()
+	if physical <= 0 {
+		return
+	}
+	coldSafePoint := oracle.ComposeTS(physical, 0)
+	_, err := m.setServiceGCSafepoint(
+		ctx, m.cold.serviceID, m.cold.ttl, coldSafePoint)
+	if err != nil {
+		log.Warn("update cold gc safe point failed",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.String("coldServiceID", m.cold.serviceID),
+			zap.Uint64("safePointTs", coldSafePoint),
+			zap.Error(err))
+	}
+}
+
+func (m *gcManager) Unregister(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lastSafePointTs == 0 {
+		return nil
+	}
+	if err := m.removeServiceGCSafepoint(ctx, m.gcServiceID); err != nil {
+		return errors.Trace(err)
+	}
+	log.Info("gc safe point unregistered",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Uint64("lastSafePointTs", m.lastSafePointTs))
+	m.lastSafePointTs = 0
+	m.lastWrittenTime = time.Time{}
+	m.lastRegressionActual = 0
 	return nil
 }
 
+func (m *gcManager) LastPushKind() PushKind {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastPushKind
+}
+
+func (m *gcManager) LastSafePointTs() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastSafePointTs
+}
+
+func (m *gcManager) LastUpdatedTime() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastWrittenTime
+}
+
+func (m *gcManager) MergeSafepoints(sources ...Manager) uint64 {
+	merged := uint64(0)
+	for _, source := range sources {
+		safePoint := source.LastSafePointTs()
+		if safePoint == 0 {
+			continue
+		}
+		if merged == 0 || safePoint < merged {
+			merged = safePoint
+		}
+	}
+
+	// Read the sources before locking, a source may be the Manager itself.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lastSafePointTs > m.mergedHighWater {
+		m.mergedHighWater = m.lastSafePointTs
+	}
+	if merged < m.mergedHighWater {
+		log.Debug("merged safepoint is below the high-water mark",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("merged", merged),
+			zap.Uint64("highWater", m.mergedHighWater))
+		return m.mergedHighWater
+	}
+	m.mergedHighWater = merged
+	return merged
+}
+
+func (m *gcManager) HandoffTo(ctx context.Context, successor Manager) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.handedOff {
+		return cerror.ErrGCHandoffFailed.GenWithStackByArgs("already handed off")
+	}
+	if successor == nil {
+		return cerror.ErrGCHandoffFailed.GenWithStackByArgs("successor is nil")
+	}
+	if successor == Manager(m) {
+		return cerror.ErrGCHandoffFailed.GenWithStackByArgs("cannot hand off to itself")
+	}
+
+	// The successor may use another service ID, let it push the safepoint
+	// under its own service ID before we stop pushing.
+	if m.lastSafePointTs != 0 {
+		res, err := successor.TryUpdateGCSafePointDetailed(ctx, m.lastSafePointTs, true)
+		if err != nil {
+			return cerror.ErrGCHandoffFailed.Wrap(err).GenWithStackByArgs(
+				"push the safepoint by the successor")
+		}
+		if !res.Updated {
+			return cerror.ErrGCHandoffFailed.GenWithStackByArgs(
+				"the successor does not push the safepoint")
+		}
+	}
+	m.handedOff = true
+	log.Info("gc duties handed off",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Uint64("lastSafePointTs", m.lastSafePointTs))
+	return nil
+}
+
+// clockSkew tracks the range of skews between PD clock and the local clock.
+type clockSkew struct {
+	observed bool
+	min      time.Duration
+	max      time.Duration
+}
+
+func (s *clockSkew) observe(skew time.Duration) {
+	if !s.observed {
+		s.observed = true
+		s.min, s.max = skew, skew
+		return
+	}
+	if skew < s.min {
+		s.min = skew
+	}
+	if skew > s.max {
+		s.max = skew
+	}
+}
+
+// window returns the uncertainty window of PD clock.
+func (s *clockSkew) window() time.Duration {
+	return s.max - s.min
+}
+
+// conservativeSafePoint samples the skew of PD clock and returns the given
+// safepoint minus the uncertainty window observed so far.
+func (m *gcManager) conservativeSafePoint(safePoint model.Ts) model.Ts {
+	pdTime, err := m.pdClock.CurrentTime()
+	if err != nil {
+		log.Warn("failed to get pd time, use the observed clock uncertainty",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Error(err))
+	} else {
+		m.clockSkew.observe(pdTime.Sub(m.clock.Now()))
+	}
+
+	window := m.clockSkew.window()
+	if window <= 0 {
+		return safePoint
+	}
+	physical := oracle.ExtractPhysical(safePoint) - window.Milliseconds()
+	if physical <= 0 {
+		return 0
+	}
+	conservative := oracle.ComposeTS(physical, oracle.ExtractLogical(safePoint))
+	log.Debug("apply clock uncertainty to gc safepoint",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Uint64("safePointTs", safePoint),
+		zap.Uint64("conservativeSafePointTs", conservative),
+		zap.Duration("window", window))
+	return conservative
+}
+
+func (m *gcManager) SafePointWithPausedChangefeeds(
+	checkpointTs model.Ts, paused map[model.ChangeFeedID]model.Ts,
+) model.Ts {
+	if m.excludePaused {
+		return checkpointTs
+	}
+	for changefeedID, ts := range paused {
+		if ts < checkpointTs {
+			log.Debug("paused changefeed pins gc safe point",
+				zap.String("GcManagerID", m.gcServiceID),
+				zap.String("namespace", changefeedID.Namespace),
+				zap.String("changefeed", changefeedID.ID),
+				zap.Uint64("checkpointTs", ts))
+			checkpointTs = ts
+		}
+	}
+	return checkpointTs
+}
+
+func (m *gcManager) SuppressStalenessCheck(until time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	log.Info("suppress gc staleness check",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Time("until", until))
+	m.suppressStalenessUntil = until
+}
+
 func (m *gcManager) CheckStaleCheckpointTs(
 	ctx context.Context, changefeedID model.ChangeFeedID, checkpointTs model.Ts,
 ) error {
-	gcSafepointUpperBound := checkpointTs - 1
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.clock.Now().Before(m.suppressStalenessUntil) {
+		log.Info("gc staleness check is suppressed",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.String("namespace", changefeedID.Namespace),
+			zap.String("changefeed", changefeedID.ID),
+			zap.Uint64("checkpointTs", checkpointTs),
+			zap.Time("until", m.suppressStalenessUntil))
+		return nil
+	}
+	gcSafepointUpperBound := safePointUpperBound(checkpointTs)
 	// if there is another service gc point less than the min checkpoint ts.
 	if gcSafepointUpperBound < m.lastSafePointTs {
 		return cerror.ErrSnapshotLostByGC.
@@ -123,9 +974,21 @@ func (m *gcManager) CheckStaleCheckpointTs(
 	return nil
 }
 
+// safePointUpperBound returns the largest safepoint that keeps the data at
+// checkpointTs, it is 0 if checkpointTs is 0.
+func safePointUpperBound(checkpointTs uint64) uint64 {
+	if checkpointTs == 0 {
+		return 0
+	}
+	return checkpointTs - 1
+}
+
 func (m *gcManager) IgnoreFailedChangeFeed(
-	checkpointTs uint64,
+	checkpointTs uint64, retention time.Duration,
 ) bool {
+	if retention == 0 {
+		retention = gcTTL
+	}
 	pdTime, err := m.pdClock.CurrentTime()
 	if err != nil {
 		log.Warn("failed to get ts",
@@ -136,8 +999,8 @@ func (m *gcManager) IgnoreFailedChangeFeed(
 	}
 	// ignore the changefeed if its current checkpoint TS is earlier
 	// than the (currentPDTso - failedFeedDataRetentionTime).
-	gcSafepointUpperBound := checkpointTs - 1
+	gcSafepointUpperBound := safePointUpperBound(checkpointTs)
 	return pdTime.Sub(
 		oracle.GetTimeFromTS(gcSafepointUpperBound),
-	) > gcTTL
+	) > retention
 }
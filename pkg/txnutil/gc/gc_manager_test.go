@@ -15,17 +15,24 @@ package gc
 
 import (
 	"context"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/etcd"
 	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestUpdateGCSafePoint(t *testing.T) {
@@ -53,8 +60,8 @@ func TestUpdateGCSafePoint(t *testing.T) {
 	err = gcManager.TryUpdateGCSafePoint(ctx, startTs, false /* forceUpdate */)
 	require.Nil(t, err)
 
-	// Assume that the gc safe point updated gcSafepointUpdateInterval ago.
-	gcManager.lastUpdatedTime = time.Now().Add(-gcSafepointUpdateInterval)
+	// Assume that the gc safe point updated defaultGCSafepointUpdateInterval ago.
+	gcManager.lastUpdatedTime = time.Now().Add(-defaultGCSafepointUpdateInterval)
 	startTs++
 	mockPDClient.UpdateServiceGCSafePointFunc = func(ctx context.Context, serviceID string, ttl int64, safePoint uint64) (uint64, error) {
 		require.Equal(t, startTs, safePoint)
@@ -84,6 +91,232 @@ func TestUpdateGCSafePoint(t *testing.T) {
 	}
 }
 
+func TestNewManagerWithInterval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newManager := func(interval time.Duration) *gcManager {
+		mockPDClient := &MockPDClient{
+			UpdateServiceGCSafePointFunc: func(
+				ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+			) (uint64, error) {
+				return safePoint, nil
+			},
+		}
+		return NewManagerWithInterval(etcd.GcServiceIDForTest(),
+			mockPDClient, pdutil.NewClock4Test(), interval).(*gcManager)
+	}
+
+	// Managers are constructed concurrently, each keeps its own interval.
+	hotCh, coldCh := make(chan *gcManager, 1), make(chan *gcManager, 1)
+	go func() { hotCh <- newManager(10 * time.Second) }()
+	go func() { coldCh <- newManager(10 * time.Minute) }()
+	hot, cold := <-hotCh, <-coldCh
+	require.Equal(t, 10*time.Second, hot.interval)
+	require.Equal(t, 10*time.Minute, cold.interval)
+	require.Equal(t, defaultGCSafepointUpdateInterval,
+		NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+			pdutil.NewClock4Test()).(*gcManager).interval)
+
+	mockClock := clock.NewMock()
+	hot.clock, cold.clock = mockClock, mockClock
+	startTs := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, hot.TryUpdateGCSafePoint(ctx, startTs, true))
+	require.Nil(t, cold.TryUpdateGCSafePoint(ctx, startTs, true))
+	require.Equal(t, PushKindForced, hot.LastPushKind())
+	require.Equal(t, PushKindForced, cold.LastPushKind())
+
+	mockClock.Add(time.Minute)
+	require.Nil(t, hot.TryUpdateGCSafePoint(ctx, startTs+1, false))
+	require.Nil(t, cold.TryUpdateGCSafePoint(ctx, startTs+1, false))
+	require.Equal(t, PushKindNatural, hot.LastPushKind())
+	require.Equal(t, PushKindSkipped, cold.LastPushKind())
+}
+
+func TestUpdateIntervalJitter(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, time.Duration(0), newIntervalJitter(0))
+	for i := 0; i < 100; i++ {
+		jitter := newIntervalJitter(time.Minute)
+		require.LessOrEqual(t, jitter, time.Duration(0))
+		require.GreaterOrEqual(t, jitter, -6*time.Second)
+	}
+
+	ctx := context.Background()
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+	manager.jitter = -6 * time.Second
+
+	startTs := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, startTs, true))
+	mockClock.Add(53 * time.Second)
+	res, err := manager.TryUpdateGCSafePointDetailed(ctx, startTs+1, false)
+	require.Nil(t, err)
+	require.True(t, res.Throttled)
+	mockClock.Add(time.Second)
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, startTs+1, false)
+	require.Nil(t, err)
+	require.True(t, res.Updated)
+}
+
+func TestTryUpdateGCSafePointDetailed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pdSafePoint uint64
+	var pushErr error
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			if pushErr != nil {
+				return 0, pushErr
+			}
+			if safePoint > pdSafePoint {
+				return safePoint, nil
+			}
+			return pdSafePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+	manager.lastSucceededTime = mockClock.Now()
+
+	res, err := manager.TryUpdateGCSafePointDetailed(ctx, 100, true)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{Updated: true, Actual: 100}, res)
+
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, 110, false)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{Throttled: true}, res)
+
+	// PD's safepoint is already ahead of the checkpoint.
+	pdSafePoint = 200
+	mockClock.Add(manager.interval)
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, 120, false)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{Updated: true, Actual: 200}, res)
+
+	// Failures are tolerated within the TTL.
+	pushErr = context.DeadlineExceeded
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, 130, true)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{}, res)
+	mockClock.Add(time.Duration(manager.gcTTL) * time.Second)
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, 130, true)
+	require.ErrorContains(t, err, string(cerror.ErrUpdateServiceSafepointFailed.RFCCode()))
+	require.Equal(t, UpdateResult{}, res)
+}
+
+func TestTryUpdateGCSafePointRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	calls := 0
+	unavailable := false
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			calls++
+			// The push only succeeds in the last attempt.
+			if calls < gcServiceMaxRetries || unavailable {
+				return 0, errors.New("not leader")
+			}
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+
+	res, err := manager.TryUpdateGCSafePointDetailed(ctx, 100, false)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{Updated: true, Actual: 100}, res)
+	require.Equal(t, gcServiceMaxRetries, calls)
+
+	// The retries do not bypass the throttle of the next update.
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, 110, false)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{Throttled: true}, res)
+	require.Equal(t, gcServiceMaxRetries, calls)
+
+	// The retries are not stacked, a failed push makes at most
+	// gcServiceMaxRetries calls.
+	calls = 0
+	unavailable = true
+	_, attempts, err := manager.pushServiceGCSafepoint(ctx, 110)
+	require.NotNil(t, err)
+	require.Equal(t, gcServiceMaxRetries, attempts)
+	require.Equal(t, gcServiceMaxRetries, calls)
+
+	// A canceled context stops retrying, and the cancellation is returned.
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	calls = 0
+	res, err = manager.TryUpdateGCSafePointDetailed(cctx, 120, true)
+	require.Equal(t, context.Canceled, errors.Cause(err))
+	require.Equal(t, UpdateResult{}, res)
+	require.Equal(t, 0, calls)
+}
+
+func TestTryUpdateGCSafePointTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	calls := 0
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			calls++
+			// A wedged PD blocks until the deadline.
+			<-ctx.Done()
+			return 0, ctx.Err()
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test(),
+		WithPDCallTimeout(100*time.Millisecond)).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+	manager.lastSucceededTime = mockClock.Now()
+
+	// Every attempt times out on its own, the timeouts are tolerated until
+	// the TTL elapses.
+	res, err := manager.TryUpdateGCSafePointDetailed(ctx, 100, true)
+	require.Nil(t, err)
+	require.Equal(t, UpdateResult{}, res)
+	require.Equal(t, gcServiceMaxRetries, calls)
+	require.Equal(t, PushKindFailed, manager.LastPushKind())
+
+	mockClock.Add(time.Duration(manager.gcTTL) * time.Second)
+	res, err = manager.TryUpdateGCSafePointDetailed(ctx, 110, true)
+	require.ErrorContains(t, err, string(cerror.ErrUpdateServiceSafepointFailed.RFCCode()))
+	require.Equal(t, UpdateResult{}, res)
+
+	// A canceled caller gets the error immediately.
+	cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	calls = 0
+	_, err = manager.TryUpdateGCSafePointDetailed(cctx, 120, true)
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+	require.Equal(t, 1, calls)
+}
+
 func TestCheckStaleCheckpointTs(t *testing.T) {
 	t.Parallel()
 
@@ -105,6 +338,32 @@ func TestCheckStaleCheckpointTs(t *testing.T) {
 	require.True(t, cerror.IsChangefeedFastFailError(err))
 }
 
+func TestCheckStaleCheckpointTsSmallCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		&MockPDClient{}, pdutil.NewClock4Test()).(*gcManager)
+	ctx := context.Background()
+	cfID := model.DefaultChangeFeedID("cfID")
+
+	// Nothing has been pushed.
+	require.Nil(t, manager.CheckStaleCheckpointTs(ctx, cfID, 0))
+	require.Nil(t, manager.CheckStaleCheckpointTs(ctx, cfID, 1))
+
+	manager.lastSafePointTs = 1
+	err := manager.CheckStaleCheckpointTs(ctx, cfID, 0)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(errors.Cause(err)))
+	err = manager.CheckStaleCheckpointTs(ctx, cfID, 1)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(errors.Cause(err)))
+	require.Nil(t, manager.CheckStaleCheckpointTs(ctx, cfID, 2))
+
+	ts := oracle.GoTimeToTS(time.Now())
+	manager.lastSafePointTs = ts
+	err = manager.CheckStaleCheckpointTs(ctx, cfID, ts)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(errors.Cause(err)))
+	require.Nil(t, manager.CheckStaleCheckpointTs(ctx, cfID, ts+1))
+}
+
 func TestIgnoreFailedFeed(t *testing.T) {
 	t.Parallel()
 
@@ -115,16 +374,807 @@ func TestIgnoreFailedFeed(t *testing.T) {
 
 	// 5 hours ago
 	ts1 := oracle.GoTimeToTS(time.Now().Add(-time.Hour * 5))
-	ret1 := gcManager.IgnoreFailedChangeFeed(ts1)
+	ret1 := gcManager.IgnoreFailedChangeFeed(ts1, 0)
 	require.False(t, ret1)
 
 	// 20 hours ago
 	ts2 := oracle.GoTimeToTS(time.Now().Add(-time.Hour * 20))
-	ret2 := gcManager.IgnoreFailedChangeFeed(ts2)
+	ret2 := gcManager.IgnoreFailedChangeFeed(ts2, 0)
 	require.False(t, ret2)
 
 	// 25 hours ago
 	ts3 := oracle.GoTimeToTS(time.Now().Add(-time.Hour * 25))
-	ret3 := gcManager.IgnoreFailedChangeFeed(ts3)
+	ret3 := gcManager.IgnoreFailedChangeFeed(ts3, 0)
 	require.True(t, ret3)
 }
+
+func TestIgnoreFailedFeedWithRetention(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		&MockPDClient{}, pdClock).(*gcManager)
+	pdTime, err := pdClock.CurrentTime()
+	require.Nil(t, err)
+	// The checkpoint is at the given time before PD time, the safepoint
+	// upper bound is 1 tick earlier.
+	checkpointBefore := func(d time.Duration) uint64 {
+		return oracle.GoTimeToTS(pdTime.Add(-d))
+	}
+
+	// The default retention.
+	require.False(t, manager.IgnoreFailedChangeFeed(checkpointBefore(gcTTL-time.Minute), 0))
+	require.True(t, manager.IgnoreFailedChangeFeed(checkpointBefore(gcTTL+time.Minute), 0))
+
+	// A custom retention.
+	retention := 72 * time.Hour
+	require.False(t, manager.IgnoreFailedChangeFeed(checkpointBefore(gcTTL+time.Minute), retention))
+	require.False(t, manager.IgnoreFailedChangeFeed(checkpointBefore(retention-time.Minute), retention))
+	require.True(t, manager.IgnoreFailedChangeFeed(checkpointBefore(retention+time.Minute), retention))
+}
+
+func TestIgnoreFailedFeedSmallCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		&MockPDClient{}, pdutil.NewClock4Test()).(*gcManager)
+
+	// Checkpoints of 0 and 1 are far before the retention.
+	require.True(t, manager.IgnoreFailedChangeFeed(0, 0))
+	require.True(t, manager.IgnoreFailedChangeFeed(1, 0))
+	require.False(t, manager.IgnoreFailedChangeFeed(oracle.GoTimeToTS(time.Now()), 0))
+}
+
+// mockPDClock returns the local time shifted by the given skews in turn.
+type mockPDClock struct {
+	pdutil.Clock
+	clock clock.Clock
+	skews []time.Duration
+}
+
+func (c *mockPDClock) CurrentTime() (time.Time, error) {
+	skew := c.skews[0]
+	if len(c.skews) > 1 {
+		c.skews = c.skews[1:]
+	}
+	return c.clock.Now().Add(skew), nil
+}
+
+func TestUpdateGCSafePointWithClockUncertainty(t *testing.T) {
+	t.Parallel()
+
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Now())
+	pdClock := &mockPDClock{
+		clock: mockClock,
+		// Every successful push samples PD clock twice, once for the clock
+		// uncertainty and once for the safepoint lag metric.
+		skews: []time.Duration{
+			0, 0,
+			300 * time.Millisecond, 300 * time.Millisecond,
+			-200 * time.Millisecond, -200 * time.Millisecond,
+			100 * time.Millisecond,
+		},
+	}
+	mockPDClient := &MockPDClient{}
+	gcManager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdClock, WithClockUncertainty()).(*gcManager)
+	gcManager.clock = mockClock
+	ctx := context.Background()
+
+	var pushed uint64
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		pushed = safePoint
+		return 0, nil
+	}
+
+	checkpointTs := oracle.ComposeTS(oracle.GetPhysical(mockClock.Now()), 1)
+	shift := func(ts uint64, d time.Duration) uint64 {
+		return oracle.ComposeTS(
+			oracle.ExtractPhysical(ts)-d.Milliseconds(), oracle.ExtractLogical(ts))
+	}
+
+	// The first sample has no uncertainty.
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, checkpointTs, pushed)
+
+	// skews: [0, 300ms], window 300ms.
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, shift(checkpointTs, 300*time.Millisecond), pushed)
+
+	// skews: [-200ms, 300ms], window 500ms.
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, shift(checkpointTs, 500*time.Millisecond), pushed)
+
+	// A skew within the observed range does not shrink the window.
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, shift(checkpointTs, 500*time.Millisecond), pushed)
+	require.Equal(t, -200*time.Millisecond, gcManager.clockSkew.min)
+	require.Equal(t, 300*time.Millisecond, gcManager.clockSkew.max)
+
+	// It never underflows.
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, 1, true))
+	require.Equal(t, uint64(0), pushed)
+}
+
+func TestUpdateGCSafePointWithFloor(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{}
+	pdClock := pdutil.NewClock4Test()
+	floor := oracle.GoTimeToTS(time.Now())
+	serviceID := etcd.GcServiceIDForTest() + t.Name()
+	gcManager := NewManager(serviceID,
+		mockPDClient, pdClock, WithSafePointFloor(floor)).(*gcManager)
+	ctx := context.Background()
+
+	var pushed uint64
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		pushed = safePoint
+		return safePoint, nil
+	}
+	violations := safePointFloorViolationCounter.WithLabelValues(serviceID)
+	headroom := safePointFloorHeadroomGauge.WithLabelValues(serviceID)
+
+	// Below the floor, the checkpoint is pushed instead of the floor and the
+	// alert fires.
+	belowFloor := oracle.GoTimeToTS(oracle.GetTimeFromTS(floor).Add(-time.Minute))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, belowFloor, true))
+	require.Equal(t, belowFloor, pushed)
+	require.Equal(t, belowFloor, gcManager.LastSafePointTs())
+	require.Equal(t, float64(1), testutil.ToFloat64(violations))
+	require.Equal(t, float64(-60), testutil.ToFloat64(headroom))
+
+	// The safepoint is not advanced while it's below the floor.
+	stillBelowFloor := oracle.GoTimeToTS(oracle.GetTimeFromTS(floor).Add(-time.Second))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, stillBelowFloor, true))
+	require.Equal(t, belowFloor, pushed)
+	require.Equal(t, float64(2), testutil.ToFloat64(violations))
+
+	// Approaching the floor, the checkpoint is pushed and the headroom is
+	// reported.
+	approaching := oracle.GoTimeToTS(oracle.GetTimeFromTS(floor).Add(time.Minute))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, approaching, true))
+	require.Equal(t, approaching, pushed)
+	require.Equal(t, float64(2), testutil.ToFloat64(violations))
+	require.Equal(t, float64(60), testutil.ToFloat64(headroom))
+}
+
+func TestUpdateGCSafePointWithFloorPinAndBarrier(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{}
+	floor := oracle.GoTimeToTS(time.Now())
+	serviceID := etcd.GcServiceIDForTest() + t.Name()
+	gcManager := NewManager(serviceID,
+		mockPDClient, pdutil.NewClock4Test(), WithSafePointFloor(floor)).(*gcManager)
+	ctx := context.Background()
+
+	var pushed []uint64
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		pushed = append(pushed, safePoint)
+		return safePoint, nil
+	}
+	shift := func(d time.Duration) uint64 {
+		return oracle.GoTimeToTS(oracle.GetTimeFromTS(floor).Add(d))
+	}
+	checkpointTs := shift(time.Hour)
+	violations := safePointFloorViolationCounter.WithLabelValues(serviceID)
+
+	// The pin below the floor is respected, the floor is never pushed.
+	pinnedTs := shift(-2 * time.Minute)
+	require.Nil(t, gcManager.PinSafePoint(ctx, pinnedTs))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, []uint64{pinnedTs, pinnedTs}, pushed)
+	require.Equal(t, float64(1), testutil.ToFloat64(violations))
+
+	// So is the barrier, the safepoint is not advanced to the barrier as
+	// it's still below the floor.
+	require.Nil(t, gcManager.UnpinSafePoint(ctx))
+	gcManager.SetBarrierTs(shift(-time.Minute))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, []uint64{pinnedTs, pinnedTs, pinnedTs}, pushed)
+	require.Equal(t, float64(2), testutil.ToFloat64(violations))
+
+	// The safepoint advances once the barrier is above the floor.
+	gcManager.SetBarrierTs(shift(time.Minute))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, checkpointTs, true))
+	require.Equal(t, shift(time.Minute), pushed[len(pushed)-1])
+	require.Equal(t, float64(2), testutil.ToFloat64(violations))
+}
+
+func TestUpdateGCSafePointBelowFloorKeepsTTLEscalation(t *testing.T) {
+	t.Parallel()
+
+	floor := oracle.GoTimeToTS(time.Now())
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return 0, context.DeadlineExceeded
+		},
+	}
+	gcManager := NewManager(etcd.GcServiceIDForTest()+t.Name(),
+		mockPDClient, pdutil.NewClock4Test(), WithSafePointFloor(floor)).(*gcManager)
+	mockClock := clock.NewMock()
+	gcManager.clock = mockClock
+	gcManager.lastSucceededTime = mockClock.Now()
+
+	// A checkpoint stuck below the floor still refreshes the safepoint, so
+	// failures to keep it alive escalate once the TTL elapses.
+	ctx := context.Background()
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, floor-1, true))
+	mockClock.Add(time.Duration(gcManager.serviceGCTTL()) * time.Second)
+	err := gcManager.TryUpdateGCSafePoint(ctx, floor-1, true)
+	require.ErrorContains(t, err, string(cerror.ErrUpdateServiceSafepointFailed.RFCCode()))
+}
+
+func TestUpdateGCSafePointWithBarrier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pushed uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed = safePoint
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	manager.clock = clock.NewMock()
+
+	// The safepoint is clamped to the barrier.
+	manager.SetBarrierTs(100)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 1000000, true))
+	require.Equal(t, uint64(100), pushed)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 90, true))
+	require.Equal(t, uint64(90), pushed)
+
+	// Clearing the barrier lets the safepoint advance again.
+	manager.SetBarrierTs(0)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 1000000, true))
+	require.Equal(t, uint64(1000000), pushed)
+}
+
+func TestSetChangefeedGCTTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pushedTTL int64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushedTTL = ttl
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	manager.clock = clock.NewMock()
+	manager.gcTTL = 3600
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, int64(3600), pushedTTL)
+
+	// A changefeed requires a longer TTL.
+	manager.SetChangefeedGCTTL(7200)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 110, true))
+	require.Equal(t, int64(7200), pushedTTL)
+
+	// A shorter TTL never shortens the server GC TTL.
+	manager.SetChangefeedGCTTL(60)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 120, true))
+	require.Equal(t, int64(3600), pushedTTL)
+	manager.SetChangefeedGCTTL(0)
+	require.Equal(t, int64(3600), manager.serviceGCTTL())
+}
+
+func TestUpdateGCSafePointMetrics(t *testing.T) {
+	t.Parallel()
+
+	var pushErr error
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, pushErr
+		},
+	}
+	serviceID := etcd.GcServiceIDForTest() + t.Name()
+	manager := NewManager(serviceID,
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	manager.clock = clock.NewMock()
+	ctx := context.Background()
+
+	successes := updateSafePointSuccessCounter.WithLabelValues(serviceID)
+	failures := updateSafePointFailureCounter.WithLabelValues(serviceID)
+	safePoint := oracle.GoTimeToTS(time.Now().Add(-10 * time.Minute))
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, safePoint, true))
+	require.Equal(t, float64(1), testutil.ToFloat64(successes))
+	require.Equal(t, float64(0), testutil.ToFloat64(failures))
+	require.Equal(t, float64(oracle.ExtractPhysical(safePoint)),
+		testutil.ToFloat64(safePointGauge.WithLabelValues(serviceID)))
+	lag := testutil.ToFloat64(safePointLagGauge.WithLabelValues(serviceID))
+	require.GreaterOrEqual(t, lag, (10 * time.Minute).Seconds())
+	require.Less(t, lag, (11 * time.Minute).Seconds())
+
+	pushErr = context.DeadlineExceeded
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, safePoint+1, true))
+	require.Equal(t, float64(1), testutil.ToFloat64(successes))
+	require.Equal(t, float64(1), testutil.ToFloat64(failures))
+	require.Equal(t, float64(oracle.ExtractPhysical(safePoint)),
+		testutil.ToFloat64(safePointGauge.WithLabelValues(serviceID)))
+}
+
+func TestHandoffTo(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{}
+	pdClock := pdutil.NewClock4Test()
+	predecessor := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdClock).(*gcManager)
+	successor := NewManager(etcd.GcServiceIDForTest()+"-successor",
+		mockPDClient, pdClock).(*gcManager)
+	ctx := context.Background()
+
+	pushes := make(map[string][]uint64)
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		pushes[serviceID] = append(pushes[serviceID], safePoint)
+		return safePoint, nil
+	}
+
+	startTs := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, predecessor.TryUpdateGCSafePoint(ctx, startTs, true))
+
+	// The successor is not necessarily a *gcManager.
+	require.Nil(t, predecessor.HandoffTo(ctx, &wrappedManager{successor}))
+	require.Equal(t, startTs, successor.LastSafePointTs())
+	require.False(t, successor.LastUpdatedTime().IsZero())
+	// The successor registers the safepoint under its own service ID.
+	require.Equal(t, []uint64{startTs}, pushes[successor.gcServiceID])
+
+	// The predecessor stops pushing.
+	require.Nil(t, predecessor.TryUpdateGCSafePoint(ctx, startTs+1, true))
+	require.Equal(t, []uint64{startTs}, pushes[predecessor.gcServiceID])
+	require.Nil(t, successor.TryUpdateGCSafePoint(ctx, startTs+1, true))
+	require.Equal(t, []uint64{startTs, startTs + 1}, pushes[successor.gcServiceID])
+
+	err := predecessor.HandoffTo(ctx, successor)
+	require.True(t, cerror.ErrGCHandoffFailed.Equal(errors.Cause(err)))
+	err = successor.HandoffTo(ctx, successor)
+	require.True(t, cerror.ErrGCHandoffFailed.Equal(errors.Cause(err)))
+
+	// The handoff fails if the successor does not take the safepoint.
+	another := NewManager(etcd.GcServiceIDForTest()+"-another",
+		mockPDClient, pdClock).(*gcManager)
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		return 0, context.Canceled
+	}
+	err = successor.HandoffTo(ctx, another)
+	require.True(t, cerror.ErrGCHandoffFailed.Equal(errors.Cause(err)))
+	require.Zero(t, another.LastSafePointTs())
+	// The successor keeps pushing after the failed handoff.
+	require.False(t, successor.handedOff)
+}
+
+// wrappedManager wraps a Manager, so that it is not a *gcManager.
+type wrappedManager struct {
+	Manager
+}
+
+func TestUnregister(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	type push struct {
+		ttl       int64
+		safePoint uint64
+	}
+	var pushes []push
+	var pushErr error
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			require.Equal(t, etcd.GcServiceIDForTest(), serviceID)
+			if pushErr != nil {
+				return 0, pushErr
+			}
+			pushes = append(pushes, push{ttl: ttl, safePoint: safePoint})
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	manager.gcTTL = 3600
+
+	// The safepoint has never been set.
+	require.Nil(t, manager.Unregister(ctx))
+	require.Empty(t, pushes)
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, []push{{ttl: 3600, safePoint: 100}}, pushes)
+
+	// Failed to remove, the safepoint is kept.
+	pushErr = context.Canceled
+	require.NotNil(t, manager.Unregister(ctx))
+	require.Equal(t, uint64(100), manager.LastSafePointTs())
+
+	pushErr = nil
+	require.Nil(t, manager.Unregister(ctx))
+	require.Equal(t, push{ttl: 0, safePoint: math.MaxUint64}, pushes[1])
+	require.Zero(t, manager.LastSafePointTs())
+	require.True(t, manager.LastUpdatedTime().IsZero())
+
+	// Unregister again does nothing.
+	require.Nil(t, manager.Unregister(ctx))
+	require.Len(t, pushes, 2)
+}
+
+func TestLastPushKind(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{}
+	pdClock := pdutil.NewClock4Test()
+	gcManager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdClock).(*gcManager)
+	mockClock := clock.NewMock()
+	gcManager.clock = mockClock
+	gcManager.lastSucceededTime = mockClock.Now()
+	ctx := context.Background()
+	require.Equal(t, PushKindNone, gcManager.LastPushKind())
+
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		return safePoint, nil
+	}
+	startTs := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, true))
+	require.Equal(t, PushKindForced, gcManager.LastPushKind())
+
+	// Too frequent.
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs+1, false))
+	require.Equal(t, PushKindSkipped, gcManager.LastPushKind())
+
+	mockClock.Add(defaultGCSafepointUpdateInterval)
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs+1, false))
+	require.Equal(t, PushKindNatural, gcManager.LastPushKind())
+
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		return 0, errors.New("unknown")
+	}
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs+2, true))
+	require.Equal(t, PushKindFailed, gcManager.LastPushKind())
+	require.Equal(t, "failed", gcManager.LastPushKind().String())
+}
+
+func TestLastSafePointTs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return 0, context.DeadlineExceeded
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+
+	// Never updated.
+	require.Zero(t, manager.LastSafePointTs())
+	require.True(t, manager.LastUpdatedTime().IsZero())
+
+	// Failed updates are not reported.
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, PushKindFailed, manager.LastPushKind())
+	require.Zero(t, manager.LastSafePointTs())
+	require.True(t, manager.LastUpdatedTime().IsZero())
+
+	mockPDClient.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		return safePoint, nil
+	}
+	mockClock.Add(time.Minute)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, uint64(100), manager.LastSafePointTs())
+	require.True(t, mockClock.Now().Equal(manager.LastUpdatedTime()))
+
+	// Skipped updates keep the last written one.
+	updatedTime := mockClock.Now()
+	mockClock.Add(time.Second)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 200, false))
+	require.Equal(t, PushKindSkipped, manager.LastPushKind())
+	require.Equal(t, uint64(100), manager.LastSafePointTs())
+	require.True(t, updatedTime.Equal(manager.LastUpdatedTime()))
+}
+
+func TestLastSafePointTsConcurrentRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test())
+
+	// The safepoint is read by API goroutines while the owner pushes it.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			safePoint := manager.LastSafePointTs()
+			updatedTime := manager.LastUpdatedTime()
+			if safePoint != 0 {
+				require.False(t, updatedTime.IsZero())
+			}
+		}
+	}()
+	for i := uint64(1); i <= 100; i++ {
+		require.Nil(t, manager.TryUpdateGCSafePoint(ctx, i, true))
+	}
+	close(done)
+	wg.Wait()
+	require.Equal(t, uint64(100), manager.LastSafePointTs())
+}
+
+func TestMergeSafepoints(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	newManager := func(safePoint uint64) *gcManager {
+		m := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock).(*gcManager)
+		m.lastSafePointTs = safePoint
+		return m
+	}
+	top := newManager(0)
+
+	// No sources.
+	require.Equal(t, uint64(0), top.MergeSafepoints())
+
+	// Zero safepoints are ignored.
+	s1, s2, s3 := newManager(0), newManager(30), newManager(20)
+	require.Equal(t, uint64(20), top.MergeSafepoints(s1, s2, s3))
+
+	s3.lastSafePointTs = 40
+	require.Equal(t, uint64(30), top.MergeSafepoints(s1, s2, s3))
+
+	// Never regress below the high-water mark.
+	s2.lastSafePointTs = 10
+	require.Equal(t, uint64(30), top.MergeSafepoints(s1, s2, s3))
+	require.Equal(t, uint64(30), top.MergeSafepoints(s1))
+
+	// The last pushed safepoint of the top-level manager is also respected.
+	top.lastSafePointTs = 35
+	require.Equal(t, uint64(35), top.MergeSafepoints(s1, s2))
+
+	s2.lastSafePointTs, s3.lastSafePointTs = 60, 70
+	require.Equal(t, uint64(60), top.MergeSafepoints(s2, s3))
+
+	// Sources which are not *gcManager are merged too.
+	s4 := newManager(65)
+	require.Equal(t, uint64(60), top.MergeSafepoints(s2, &wrappedManager{s4}))
+	s2.lastSafePointTs = 80
+	require.Equal(t, uint64(65), top.MergeSafepoints(s2, &wrappedManager{s4}))
+}
+
+func TestUpdateGCSafePointWithReadinessPredicate(t *testing.T) {
+	t.Parallel()
+
+	pushed := 0
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed++
+			return safePoint, nil
+		},
+	}
+	ready := false
+	gcManager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), WithReadinessPredicate(func() bool {
+			return ready
+		})).(*gcManager)
+	ctx := context.Background()
+
+	startTs := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, false))
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, true))
+	require.Equal(t, 0, pushed)
+	require.Equal(t, PushKindSkipped, gcManager.LastPushKind())
+
+	ready = true
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, false))
+	require.Equal(t, 1, pushed)
+	require.Equal(t, startTs, gcManager.lastSafePointTs)
+}
+
+func TestSafePointWithPausedChangefeeds(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	paused := map[model.ChangeFeedID]model.Ts{
+		model.DefaultChangeFeedID("paused-1"): 30,
+		model.DefaultChangeFeedID("paused-2"): 20,
+	}
+
+	// Paused changefeeds pin the safepoint by default.
+	gcManager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock)
+	require.Equal(t, model.Ts(20), gcManager.SafePointWithPausedChangefeeds(100, paused))
+	require.Equal(t, model.Ts(10), gcManager.SafePointWithPausedChangefeeds(10, paused))
+	require.Equal(t, model.Ts(100), gcManager.SafePointWithPausedChangefeeds(100, nil))
+
+	gcManager = NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock,
+		WithExcludePausedChangefeeds())
+	require.Equal(t, model.Ts(100), gcManager.SafePointWithPausedChangefeeds(100, paused))
+}
+
+func TestUpdateGCSafePointFailureLogRateLimit(t *testing.T) {
+	// Do not run in parallel, it replaces the global logger.
+	core, logs := observer.New(zap.InfoLevel)
+	conf := &log.Config{Level: "info", File: log.FileLogConfig{}}
+	_, r, _ := log.InitLogger(conf)
+	restoreFn := log.ReplaceGlobals(zap.New(core), r)
+	defer restoreFn()
+
+	var pdErr error = context.DeadlineExceeded
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, pdErr
+		},
+	}
+	serviceID := etcd.GcServiceIDForTest() + t.Name()
+	gcManager := NewManager(serviceID, mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	gcManager.clock = mockClock
+	gcManager.lastSucceededTime = mockClock.Now()
+	gcManager.gcTTL = 24 * 3600
+	ctx := context.Background()
+	failures := updateSafePointFailureCounter.WithLabelValues(serviceID)
+
+	startTs := oracle.GoTimeToTS(time.Now())
+	// Fail once per minute in 6 minutes.
+	for i := 0; i < 6; i++ {
+		require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, false))
+		mockClock.Add(time.Minute)
+	}
+	require.Equal(t, float64(6), testutil.ToFloat64(failures))
+	failedLogs := logs.FilterMessage("updateGCSafePoint failed").All()
+	require.Len(t, failedLogs, 2)
+	require.Equal(t, int64(0), failedLogs[0].ContextMap()["suppressedFailures"])
+	require.Equal(t, int64(4), failedLogs[1].ContextMap()["suppressedFailures"])
+
+	// Recover.
+	pdErr = nil
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, false))
+	recoveredLogs := logs.FilterMessage("updateGCSafePoint recovered").All()
+	require.Len(t, recoveredLogs, 1)
+	require.Equal(t, int64(6), recoveredLogs[0].ContextMap()["failures"])
+
+	// Fail again, the first failure is logged.
+	pdErr = context.DeadlineExceeded
+	mockClock.Add(time.Minute)
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(ctx, startTs, false))
+	require.Equal(t, 3, logs.FilterMessage("updateGCSafePoint failed").Len())
+	require.Equal(t, float64(7), testutil.ToFloat64(failures))
+}
+
+func TestNewManagerWithInitialSafePointFromPD(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	lister := &mockServiceSafePointLister{
+		safePoints: []*pdutil.ServiceSafePoint{
+			{ServiceID: "other", SafePoint: 50},
+			{ServiceID: etcd.GcServiceIDForTest(), SafePoint: 100},
+		},
+	}
+
+	manager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock,
+		WithInitialSafePointFromPD(lister)).(*gcManager)
+	require.Equal(t, uint64(100), manager.lastSafePointTs)
+	// Staleness checks take effect immediately.
+	require.Error(t, manager.CheckStaleCheckpointTs(
+		context.Background(), model.DefaultChangeFeedID("test"), 100))
+
+	// The service safepoint does not exist.
+	manager = NewManager("not-exist", &MockPDClient{}, pdClock,
+		WithInitialSafePointFromPD(lister)).(*gcManager)
+	require.Equal(t, uint64(0), manager.lastSafePointTs)
+
+	// Without the option.
+	manager = NewManager(etcd.GcServiceIDForTest(), &MockPDClient{}, pdClock).(*gcManager)
+	require.Equal(t, uint64(0), manager.lastSafePointTs)
+}
+
+func TestUpdateGCSafePointWithColdRetention(t *testing.T) {
+	t.Parallel()
+
+	type push struct {
+		serviceID string
+		ttl       int64
+		safePoint uint64
+	}
+	var pushes []push
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushes = append(pushes, push{serviceID: serviceID, ttl: ttl, safePoint: safePoint})
+			return safePoint, nil
+		},
+	}
+	gcManager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), WithColdRetention("cold", 7*24*3600, time.Hour)).(*gcManager)
+	gcManager.gcTTL = 3600
+
+	now := time.Now()
+	startTs := oracle.GoTimeToTS(now)
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(context.Background(), startTs, true))
+	require.Equal(t, []push{
+		{serviceID: etcd.GcServiceIDForTest(), ttl: 3600, safePoint: startTs},
+		{
+			serviceID: "cold", ttl: 7 * 24 * 3600,
+			safePoint: oracle.ComposeTS(oracle.ExtractPhysical(startTs)-time.Hour.Milliseconds(), 0),
+		},
+	}, pushes)
+	require.Equal(t, startTs, gcManager.lastSafePointTs)
+}
+
+func TestSuppressStalenessCheck(t *testing.T) {
+	t.Parallel()
+
+	gcManager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+		pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	gcManager.clock = mockClock
+	gcManager.lastSafePointTs = 20
+	ctx := context.Background()
+	cfID := model.DefaultChangeFeedID("cfID")
+
+	err := gcManager.CheckStaleCheckpointTs(ctx, cfID, 10)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(errors.Cause(err)))
+
+	gcManager.SuppressStalenessCheck(mockClock.Now().Add(time.Minute))
+	require.Nil(t, gcManager.CheckStaleCheckpointTs(ctx, cfID, 10))
+
+	// The suppression expires.
+	mockClock.Add(time.Minute)
+	err = gcManager.CheckStaleCheckpointTs(ctx, cfID, 10)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(errors.Cause(err)))
+}
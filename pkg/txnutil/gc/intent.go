@@ -0,0 +1,104 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// IntentStore is a write-ahead log of safepoint pushes. The Manager writes an
+// intent before pushing a safepoint to PD, and confirms it after PD acks, so
+// that an in-flight push is not lost if the process crashes.
+type IntentStore interface {
+	// WriteIntent records a pending push of the safepoint.
+	WriteIntent(ctx context.Context, serviceID string, safePoint uint64) error
+	// ConfirmIntent clears the pending push of the safepoint.
+	ConfirmIntent(ctx context.Context, serviceID string, safePoint uint64) error
+	// PendingIntent returns the pending push, ok is false if there is none.
+	PendingIntent(ctx context.Context, serviceID string) (safePoint uint64, ok bool, err error)
+}
+
+// WithIntentStore makes the Manager log pushes to the store, a pending intent
+// is replayed before the first push after restart.
+func WithIntentStore(store IntentStore) ManagerOption {
+	return func(m *gcManager) {
+		m.intents = store
+	}
+}
+
+// replayIntent pushes the pending intent left by the previous process, if any.
+// Only an intent at or below checkpointTs is replayed, a higher one is stale
+// and is dropped. A failed replay does not fail the update, it is only logged
+// and retried by the next update.
+func (m *gcManager) replayIntent(ctx context.Context, checkpointTs uint64) {
+	if m.intents == nil || m.intentsReplayed {
+		return
+	}
+	safePoint, ok, err := m.intents.PendingIntent(ctx, m.gcServiceID)
+	if err != nil {
+		log.Warn("load pending gc safe point intent failed",
+			zap.String("GcManagerID", m.gcServiceID), zap.Error(err))
+		return
+	}
+	if !ok {
+		m.intentsReplayed = true
+		return
+	}
+	if safePoint > checkpointTs {
+		log.Info("drop stale gc safe point intent, it is above the checkpoint",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("safePointTs", safePoint),
+			zap.Uint64("checkpointTs", checkpointTs))
+		m.intentsReplayed = true
+		return
+	}
+	log.Info("replay pending gc safe point intent",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Uint64("safePointTs", safePoint))
+	actual, err := m.setServiceGCSafepoint(
+		ctx, m.gcServiceID, m.serviceGCTTL(), safePoint)
+	if err != nil {
+		log.Warn("replay pending gc safe point intent failed",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("safePointTs", safePoint), zap.Error(err))
+		return
+	}
+	if err := m.intents.ConfirmIntent(ctx, m.gcServiceID, safePoint); err != nil {
+		log.Warn("confirm replayed gc safe point intent failed",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("safePointTs", safePoint), zap.Error(err))
+	}
+	m.lastSafePointTs = actual
+	m.lastSucceededTime = m.clock.Now()
+	m.lastWrittenTime = m.lastSucceededTime
+	m.intentsReplayed = true
+}
+
+func (m *gcManager) writeIntent(ctx context.Context, safePoint uint64) error {
+	if m.intents == nil {
+		return nil
+	}
+	return errors.Trace(m.intents.WriteIntent(ctx, m.gcServiceID, safePoint))
+}
+
+func (m *gcManager) confirmIntent(ctx context.Context, safePoint uint64) error {
+	if m.intents == nil {
+		return nil
+	}
+	return errors.Trace(m.intents.ConfirmIntent(ctx, m.gcServiceID, safePoint))
+}
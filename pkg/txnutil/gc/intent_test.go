@@ -0,0 +1,162 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+type memIntentStore struct {
+	intents map[string]uint64
+	loadErr error
+}
+
+func (s *memIntentStore) WriteIntent(
+	ctx context.Context, serviceID string, safePoint uint64,
+) error {
+	s.intents[serviceID] = safePoint
+	return nil
+}
+
+func (s *memIntentStore) ConfirmIntent(
+	ctx context.Context, serviceID string, safePoint uint64,
+) error {
+	if s.intents[serviceID] == safePoint {
+		delete(s.intents, serviceID)
+	}
+	return nil
+}
+
+func (s *memIntentStore) PendingIntent(
+	ctx context.Context, serviceID string,
+) (uint64, bool, error) {
+	if s.loadErr != nil {
+		return 0, false, s.loadErr
+	}
+	safePoint, ok := s.intents[serviceID]
+	return safePoint, ok, nil
+}
+
+func TestUpdateGCSafePointReplayIntent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := &memIntentStore{intents: map[string]uint64{}}
+	var pushed []uint64
+	crash := true
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			if crash {
+				// The process crashes before PD acks.
+				return 0, context.Canceled
+			}
+			pushed = append(pushed, safePoint)
+			return safePoint, nil
+		},
+	}
+	pdClock := pdutil.NewClock4Test()
+
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient, pdClock,
+		WithIntentStore(store)).(*gcManager)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	safePoint, ok, err := store.PendingIntent(ctx, etcd.GcServiceIDForTest())
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(100), safePoint)
+
+	// Restart, the pending intent is replayed before the first push.
+	crash = false
+	manager = NewManager(etcd.GcServiceIDForTest(), mockPDClient, pdClock,
+		WithIntentStore(store)).(*gcManager)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 110, true))
+	require.Equal(t, []uint64{100, 110}, pushed)
+	_, ok, err = store.PendingIntent(ctx, etcd.GcServiceIDForTest())
+	require.Nil(t, err)
+	require.False(t, ok)
+	require.Equal(t, uint64(110), manager.lastSafePointTs)
+	require.False(t, manager.lastSucceededTime.IsZero())
+
+	// Intents are replayed only once.
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 120, true))
+	require.Equal(t, []uint64{100, 110, 120}, pushed)
+}
+
+func TestUpdateGCSafePointDropStaleIntent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serviceID := etcd.GcServiceIDForTest()
+	store := &memIntentStore{intents: map[string]uint64{serviceID: 200}}
+	var pushed []uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed = append(pushed, safePoint)
+			return safePoint, nil
+		},
+	}
+
+	// The pending intent is above the checkpoint, it must not be pushed.
+	manager := NewManager(serviceID, mockPDClient, pdutil.NewClock4Test(),
+		WithIntentStore(store)).(*gcManager)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, []uint64{100}, pushed)
+	require.Equal(t, uint64(100), manager.lastSafePointTs)
+	_, ok, err := store.PendingIntent(ctx, serviceID)
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+func TestUpdateGCSafePointReplayIntentFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serviceID := etcd.GcServiceIDForTest()
+	store := &memIntentStore{
+		intents: map[string]uint64{serviceID: 90},
+		loadErr: errors.New("intent store unavailable"),
+	}
+	var pushed []uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed = append(pushed, safePoint)
+			return safePoint, nil
+		},
+	}
+
+	// A failing replay does not fail the update.
+	manager := NewManager(serviceID, mockPDClient, pdutil.NewClock4Test(),
+		WithIntentStore(store)).(*gcManager)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, []uint64{100}, pushed)
+	require.False(t, manager.intentsReplayed)
+
+	// The replay is retried by the next update.
+	store.loadErr = nil
+	store.intents[serviceID] = 90
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 110, true))
+	require.Equal(t, []uint64{100, 90, 110}, pushed)
+	require.True(t, manager.intentsReplayed)
+}
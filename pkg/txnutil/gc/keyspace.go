@@ -0,0 +1,90 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"math"
+
+	"github.com/pingcap/log"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	"go.uber.org/zap"
+)
+
+// KeyspaceGCClient sets service safepoints scoped to a keyspace, it is
+// served by PD of keyspace-enabled clusters.
+type KeyspaceGCClient interface {
+	UpdateServiceSafePointV2(
+		ctx context.Context, keyspaceID uint32, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error)
+}
+
+// keyspaceSafepointService sets service safepoints of a keyspace.
+type keyspaceSafepointService struct {
+	id     uint32
+	client KeyspaceGCClient
+}
+
+// NewKeyspaceSafepointService returns a SafepointService which sets service
+// safepoints of the keyspace keyspaceID via cli.
+func NewKeyspaceSafepointService(keyspaceID uint32, cli KeyspaceGCClient) SafepointService {
+	return &keyspaceSafepointService{id: keyspaceID, client: cli}
+}
+
+// WithKeyspace makes the Manager push service safepoints to the keyspace
+// keyspaceID via cli, instead of the cluster-wide service safepoints.
+func WithKeyspace(keyspaceID uint32, cli KeyspaceGCClient) ManagerOption {
+	return WithSafepointService(NewKeyspaceSafepointService(keyspaceID, cli))
+}
+
+// SetServiceGCSafepoint implements SafepointService.
+func (s *keyspaceSafepointService) SetServiceGCSafepoint(
+	ctx context.Context, serviceID string, TTL int64, safePoint uint64,
+) (uint64, error) {
+	return s.client.UpdateServiceSafePointV2(ctx, s.id, serviceID, TTL, safePoint)
+}
+
+// RemoveServiceGCSafepoint implements SafepointService.
+func (s *keyspaceSafepointService) RemoveServiceGCSafepoint(
+	ctx context.Context, serviceID string,
+) error {
+	// Set TTL to 0 second to delete the service safe point.
+	_, err := SetServiceGCSafepointWithKeyspace(
+		ctx, s.client, s.id, serviceID, 0, math.MaxUint64)
+	return err
+}
+
+// SetServiceGCSafepointWithKeyspace set a service safepoint of a keyspace
+// to PD.
+func SetServiceGCSafepointWithKeyspace(
+	ctx context.Context, cli KeyspaceGCClient, keyspaceID uint32,
+	serviceID string, TTL int64, safePoint uint64,
+) (minServiceGCTs uint64, err error) {
+	err = retry.Do(ctx,
+		func() error {
+			var err1 error
+			minServiceGCTs, err1 = cli.UpdateServiceSafePointV2(
+				ctx, keyspaceID, serviceID, TTL, safePoint)
+			if err1 != nil {
+				log.Warn("Set keyspace GC safepoint failed, retry later",
+					zap.Uint32("keyspaceID", keyspaceID), zap.Error(err1))
+			}
+			return err1
+		},
+		retry.WithBackoffBaseDelay(gcServiceBackoffDelay),
+		retry.WithMaxTries(gcServiceMaxRetries),
+		retry.WithIsRetryableErr(cerrors.IsRetryableError))
+	return
+}
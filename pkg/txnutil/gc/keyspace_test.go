@@ -0,0 +1,106 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+type keyspacePush struct {
+	keyspaceID uint32
+	serviceID  string
+	safePoint  uint64
+}
+
+// mockKeyspacePDClient is a fake PD that serves both the cluster-wide and
+// the keyspace-scoped service safepoints.
+type mockKeyspacePDClient struct {
+	MockPDClient
+	pushes         []uint64
+	keyspacePushes []keyspacePush
+}
+
+func newMockKeyspacePDClient() *mockKeyspacePDClient {
+	c := &mockKeyspacePDClient{}
+	c.UpdateServiceGCSafePointFunc = func(
+		ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error) {
+		c.pushes = append(c.pushes, safePoint)
+		return safePoint, nil
+	}
+	return c
+}
+
+func (c *mockKeyspacePDClient) UpdateServiceSafePointV2(
+	ctx context.Context, keyspaceID uint32, serviceID string, ttl int64, safePoint uint64,
+) (uint64, error) {
+	c.keyspacePushes = append(c.keyspacePushes, keyspacePush{
+		keyspaceID: keyspaceID, serviceID: serviceID, safePoint: safePoint,
+	})
+	return safePoint, nil
+}
+
+func TestUpdateGCSafePointWithoutKeyspace(t *testing.T) {
+	t.Parallel()
+
+	pdClient := newMockKeyspacePDClient()
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		pdClient, pdutil.NewClock4Test()).(*gcManager)
+
+	ts := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, manager.TryUpdateGCSafePoint(context.Background(), ts, true))
+	require.Equal(t, []uint64{ts}, pdClient.pushes)
+	require.Empty(t, pdClient.keyspacePushes)
+}
+
+func TestUpdateGCSafePointWithKeyspace(t *testing.T) {
+	t.Parallel()
+
+	pdClient := newMockKeyspacePDClient()
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		pdClient, pdutil.NewClock4Test(), WithKeyspace(7, pdClient)).(*gcManager)
+
+	ts := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, manager.TryUpdateGCSafePoint(context.Background(), ts, true))
+	require.Empty(t, pdClient.pushes)
+	require.Equal(t, []keyspacePush{{
+		keyspaceID: 7, serviceID: etcd.GcServiceIDForTest(), safePoint: ts,
+	}}, pdClient.keyspacePushes)
+	require.Equal(t, ts, manager.lastSafePointTs)
+}
+
+func TestUnregisterWithKeyspace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pdClient := newMockKeyspacePDClient()
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		pdClient, pdutil.NewClock4Test(), WithKeyspace(7, pdClient)).(*gcManager)
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Nil(t, manager.Unregister(ctx))
+	require.Empty(t, pdClient.pushes)
+	require.Equal(t, keyspacePush{
+		keyspaceID: 7, serviceID: etcd.GcServiceIDForTest(), safePoint: math.MaxUint64,
+	}, pdClient.keyspacePushes[1])
+	require.Zero(t, manager.LastSafePointTs())
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// leaseRenewRatio is the ratio of the lease TTL to the renew interval.
+const leaseRenewRatio = 3
+
+// Lease is a renewable service GC safepoint lease. It is renewed in
+// background until it is released or lost.
+//
+// Every lease holds a service safepoint under its own service ID, which is
+// the service ID of the Manager with a "-lease-<n>" suffix, so that it never
+// overwrites the safepoint pushed by TryUpdateGCSafePoint or other leases.
+type Lease interface {
+	// SafePoint returns the safepoint held by the lease.
+	SafePoint() model.Ts
+	// Update moves the safepoint held by the lease forward, it takes effect
+	// in the next renewal.
+	Update(safePoint model.Ts)
+	// Lost returns a channel that is closed when the lease is lost.
+	Lost() <-chan struct{}
+	// Err returns the reason why the lease is lost, it is nil if the lease
+	// is not lost.
+	Err() error
+	// Release stops renewing the lease and removes its service safepoint.
+	Release()
+}
+
+type safepointLease struct {
+	m         *gcManager
+	serviceID string
+
+	mu        sync.Mutex
+	safePoint model.Ts
+	err       error
+
+	lost   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (m *gcManager) AcquireSafepointLease(
+	ctx context.Context, checkpointTs model.Ts,
+) (Lease, error) {
+	m.mu.Lock()
+	m.leaseSeq++
+	serviceID := fmt.Sprintf("%s-lease-%d", m.gcServiceID, m.leaseSeq)
+	gcTTL := m.serviceGCTTL()
+	m.mu.Unlock()
+
+	actual, err := m.setServiceGCSafepoint(ctx, serviceID, gcTTL, checkpointTs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if actual > checkpointTs {
+		if err := m.removeServiceGCSafepoint(ctx, serviceID); err != nil {
+			log.Warn("remove gc safepoint lease failed",
+				zap.String("GcManagerID", m.gcServiceID),
+				zap.String("leaseServiceID", serviceID),
+				zap.Error(err))
+		}
+		return nil, cerror.ErrSnapshotLostByGC.GenWithStackByArgs(checkpointTs, actual)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	l := &safepointLease{
+		m:         m,
+		serviceID: serviceID,
+		safePoint: checkpointTs,
+		lost:      make(chan struct{}),
+		cancel:    cancel,
+	}
+	ttl := time.Duration(gcTTL) * time.Second
+	// Create the ticker before starting the renewer, so that a mocked clock
+	// can drive it right after the lease is acquired.
+	ticker := m.clock.Ticker(ttl / leaseRenewRatio)
+	acquired := m.clock.Now()
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer ticker.Stop()
+		l.renewLoop(ctx, ticker.C, acquired, ttl)
+	}()
+	return l, nil
+}
+
+func (l *safepointLease) renewLoop(
+	ctx context.Context, tick <-chan time.Time, lastRenewed time.Time, ttl time.Duration,
+) {
+	for {
+		var now time.Time
+		select {
+		case <-ctx.Done():
+			return
+		case now = <-tick:
+		}
+		safePoint := l.SafePoint()
+		l.m.mu.Lock()
+		gcTTL := l.m.serviceGCTTL()
+		l.m.mu.Unlock()
+		actual, err := l.m.setServiceGCSafepoint(ctx, l.serviceID, gcTTL, safePoint)
+		if err == nil && actual > safePoint {
+			l.lose(cerror.ErrGCSafepointLeaseLost.GenWithStackByArgs(
+				"the safepoint has been garbage collected"))
+			return
+		}
+		if err == nil {
+			lastRenewed = now
+			continue
+		}
+		if errors.Cause(err) == context.Canceled {
+			return
+		}
+		log.Warn("renew gc safepoint lease failed",
+			zap.String("GcManagerID", l.m.gcServiceID),
+			zap.String("leaseServiceID", l.serviceID),
+			zap.Uint64("safePointTs", safePoint),
+			zap.Error(err))
+		if now.Sub(lastRenewed) >= ttl {
+			l.lose(cerror.ErrGCSafepointLeaseLost.Wrap(err).GenWithStackByArgs(
+				"the lease expires"))
+			return
+		}
+	}
+}
+
+func (l *safepointLease) lose(err error) {
+	l.mu.Lock()
+	l.err = err
+	l.mu.Unlock()
+	close(l.lost)
+	log.Warn("gc safepoint lease lost",
+		zap.String("GcManagerID", l.m.gcServiceID),
+		zap.String("leaseServiceID", l.serviceID),
+		zap.Error(err))
+}
+
+func (l *safepointLease) SafePoint() model.Ts {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.safePoint
+}
+
+func (l *safepointLease) Update(safePoint model.Ts) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if safePoint > l.safePoint {
+		l.safePoint = safePoint
+	}
+}
+
+func (l *safepointLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *safepointLease) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+func (l *safepointLease) Release() {
+	l.cancel()
+	l.wg.Wait()
+	// Do not hold back GC until the TTL expires.
+	err := l.m.removeServiceGCSafepoint(context.Background(), l.serviceID)
+	if err != nil {
+		log.Warn("remove gc safepoint lease failed",
+			zap.String("GcManagerID", l.m.gcServiceID),
+			zap.String("leaseServiceID", l.serviceID),
+			zap.Error(err))
+	}
+}
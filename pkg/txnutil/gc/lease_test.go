@@ -0,0 +1,222 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+type leasePDClient struct {
+	MockPDClient
+
+	mu        sync.Mutex
+	calls     int
+	pushed    []uint64
+	services  []string
+	ttls      []int64
+	err       error
+	minSafePt uint64
+}
+
+func (c *leasePDClient) UpdateServiceGCSafePoint(
+	ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if c.err != nil {
+		return 0, c.err
+	}
+	c.pushed = append(c.pushed, safePoint)
+	c.services = append(c.services, serviceID)
+	c.ttls = append(c.ttls, ttl)
+	if c.minSafePt > safePoint {
+		return c.minSafePt, nil
+	}
+	return safePoint, nil
+}
+
+func (c *leasePDClient) pushedCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.pushed)
+}
+
+func (c *leasePDClient) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+func newLeaseManager4Test(pdClient *leasePDClient) (*gcManager, *clock.Mock) {
+	m := NewManager(etcd.GcServiceIDForTest(), pdClient,
+		pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	m.clock = mockClock
+	m.gcTTL = 30
+	return m, mockClock
+}
+
+func TestSafepointLeaseRenewal(t *testing.T) {
+	t.Parallel()
+
+	pdClient := &leasePDClient{}
+	m, mockClock := newLeaseManager4Test(pdClient)
+	lease, err := m.AcquireSafepointLease(context.Background(), 100)
+	require.Nil(t, err)
+	defer lease.Release()
+	require.Equal(t, 1, pdClient.pushedCount())
+
+	for i := 2; i <= 4; i++ {
+		mockClock.Add(10 * time.Second)
+		expected := i
+		require.Eventually(t, func() bool {
+			return pdClient.pushedCount() == expected
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	lease.Update(200)
+	lease.Update(150)
+	require.Equal(t, uint64(200), lease.SafePoint())
+	mockClock.Add(10 * time.Second)
+	require.Eventually(t, func() bool {
+		pdClient.mu.Lock()
+		defer pdClient.mu.Unlock()
+		return pdClient.pushed[len(pdClient.pushed)-1] == 200
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case <-lease.Lost():
+		t.Fatal("lease must not be lost")
+	default:
+	}
+	require.Nil(t, lease.Err())
+
+	// The lease is pushed under its own service ID, and it is removed
+	// after released.
+	lease.Release()
+	pdClient.mu.Lock()
+	defer pdClient.mu.Unlock()
+	leaseServiceID := etcd.GcServiceIDForTest() + "-lease-1"
+	for _, serviceID := range pdClient.services {
+		require.Equal(t, leaseServiceID, serviceID)
+	}
+	require.Equal(t, int64(0), pdClient.ttls[len(pdClient.ttls)-1])
+}
+
+func TestSafepointLeaseWithTryUpdateGCSafePoint(t *testing.T) {
+	t.Parallel()
+
+	pdClient := &leasePDClient{}
+	m, mockClock := newLeaseManager4Test(pdClient)
+	lease, err := m.AcquireSafepointLease(context.Background(), 100)
+	require.Nil(t, err)
+	defer lease.Release()
+	lease2, err := m.AcquireSafepointLease(context.Background(), 150)
+	require.Nil(t, err)
+	defer lease2.Release()
+
+	// The owner pushes safepoints and changes the TTL while the leases are
+	// renewed, they must not overwrite each other.
+	for i := 0; i < 10; i++ {
+		m.SetChangefeedGCTTL(int64(60 + i))
+		require.Nil(t, m.TryUpdateGCSafePoint(context.Background(), uint64(300+i), true))
+		mockClock.Add(10 * time.Second)
+	}
+	require.Equal(t, uint64(309), m.LastSafePointTs())
+	require.Eventually(t, func() bool {
+		pdClient.mu.Lock()
+		defer pdClient.mu.Unlock()
+		renewed := make(map[string]int)
+		for i, serviceID := range pdClient.services {
+			switch serviceID {
+			case etcd.GcServiceIDForTest():
+				require.GreaterOrEqual(t, pdClient.pushed[i], uint64(300))
+			case etcd.GcServiceIDForTest() + "-lease-1":
+				require.Equal(t, uint64(100), pdClient.pushed[i])
+				renewed[serviceID]++
+			case etcd.GcServiceIDForTest() + "-lease-2":
+				require.Equal(t, uint64(150), pdClient.pushed[i])
+				renewed[serviceID]++
+			default:
+				require.FailNow(t, "unexpected service ID", serviceID)
+			}
+		}
+		return renewed[etcd.GcServiceIDForTest()+"-lease-1"] > 1 &&
+			renewed[etcd.GcServiceIDForTest()+"-lease-2"] > 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSafepointLeaseLost(t *testing.T) {
+	t.Parallel()
+
+	// Renewal fails until the lease expires.
+	pdClient := &leasePDClient{}
+	m, mockClock := newLeaseManager4Test(pdClient)
+	lease, err := m.AcquireSafepointLease(context.Background(), 100)
+	require.Nil(t, err)
+	defer lease.Release()
+
+	pdClient.mu.Lock()
+	pdClient.err = context.DeadlineExceeded
+	pdClient.mu.Unlock()
+	for i := 2; i <= 4; i++ {
+		select {
+		case <-lease.Lost():
+			t.Fatal("lease must not be lost before it expires")
+		default:
+		}
+		mockClock.Add(10 * time.Second)
+		expected := i
+		require.Eventually(t, func() bool {
+			return pdClient.callCount() == expected
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	select {
+	case <-lease.Lost():
+	case <-time.After(5 * time.Second):
+		t.Fatal("lease must be lost")
+	}
+	require.ErrorContains(t, lease.Err(), string(cerror.ErrGCSafepointLeaseLost.RFCCode()))
+
+	// The safepoint has been garbage collected.
+	pdClient = &leasePDClient{}
+	m, mockClock = newLeaseManager4Test(pdClient)
+	lease, err = m.AcquireSafepointLease(context.Background(), 100)
+	require.Nil(t, err)
+	defer lease.Release()
+	pdClient.mu.Lock()
+	pdClient.minSafePt = 200
+	pdClient.mu.Unlock()
+	mockClock.Add(10 * time.Second)
+	select {
+	case <-lease.Lost():
+	case <-time.After(5 * time.Second):
+		t.Fatal("lease must be lost")
+	}
+	require.ErrorContains(t, lease.Err(), string(cerror.ErrGCSafepointLeaseLost.RFCCode()))
+
+	// Acquire a lease below the safepoint.
+	_, err = m.AcquireSafepointLease(context.Background(), 100)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(err))
+}
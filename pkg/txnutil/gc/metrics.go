@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var safePointFloorViolationCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "safepoint_floor_violation_count",
+		Help:      "The number of times the computed gc safepoint is below the configured floor",
+	}, []string{"service_id"})
+
+var safePointFloorHeadroomGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "safepoint_floor_headroom",
+		Help:      "The headroom in seconds between the computed gc safepoint and the configured floor",
+	}, []string{"service_id"})
+
+var safePointRegressionCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "safepoint_regression_count",
+		Help:      "The number of times PD returns a gc safepoint ahead of the requested one",
+	}, []string{"service_id"})
+
+var updateSafePointFailureCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "update_safepoint_failure_count",
+		Help:      "The number of failures of updating the service gc safepoint",
+	}, []string{"service_id"})
+
+var updateSafePointSuccessCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "update_safepoint_success_count",
+		Help:      "The number of successes of updating the service gc safepoint",
+	}, []string{"service_id"})
+
+var safePointGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "safepoint_ts",
+		Help:      "The physical time in milliseconds of the last pushed service gc safepoint",
+	}, []string{"service_id"})
+
+var safePointLagGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "safepoint_lag",
+		Help:      "The lag in seconds between PD's current time and the last pushed service gc safepoint",
+	}, []string{"service_id"})
+
+var safePointLastUpdateTimeGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "gc",
+		Name:      "safepoint_last_update_time",
+		Help:      "The unix time in seconds of the last successful update of the service gc safepoint",
+	}, []string{"service_id"})
+
+// InitMetrics registers all metrics in this file.
+func InitMetrics(registry prometheus.Registerer) {
+	registry.MustRegister(safePointFloorViolationCounter)
+	registry.MustRegister(safePointFloorHeadroomGauge)
+	registry.MustRegister(safePointRegressionCounter)
+	registry.MustRegister(updateSafePointFailureCounter)
+	registry.MustRegister(updateSafePointSuccessCounter)
+	registry.MustRegister(safePointGauge)
+	registry.MustRegister(safePointLagGauge)
+	registry.MustRegister(safePointLastUpdateTimeGauge)
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// MultiUpstreamManager manages the service GC safepoints of several upstream
+// PD clusters, each upstream has its own Manager.
+// It is thread-safe, but the Manager of an upstream must not be updated
+// concurrently.
+type MultiUpstreamManager struct {
+	mu       sync.RWMutex
+	managers map[model.UpstreamID]Manager
+}
+
+// NewMultiUpstreamManager creates a new MultiUpstreamManager.
+func NewMultiUpstreamManager() *MultiUpstreamManager {
+	return &MultiUpstreamManager{
+		managers: make(map[model.UpstreamID]Manager),
+	}
+}
+
+// Register registers the Manager of the upstream. It is a no-op and returns
+// false if the upstream is already registered.
+func (m *MultiUpstreamManager) Register(
+	upstreamID model.UpstreamID, manager Manager,
+) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.managers[upstreamID]; ok {
+		return false
+	}
+	m.managers[upstreamID] = manager
+	log.Info("gc manager of upstream registered",
+		zap.Uint64("upstreamID", upstreamID))
+	return true
+}
+
+// Deregister unregisters the service GC safepoint of the upstream and removes
+// its Manager. The Manager is kept if the safepoint fails to be unregistered,
+// so that the caller can retry. It is a no-op if the upstream is unknown.
+func (m *MultiUpstreamManager) Deregister(
+	ctx context.Context, upstreamID model.UpstreamID,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	manager, ok := m.managers[upstreamID]
+	if !ok {
+		return nil
+	}
+	if err := manager.Unregister(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	delete(m.managers, upstreamID)
+	log.Info("gc manager of upstream deregistered",
+		zap.Uint64("upstreamID", upstreamID))
+	return nil
+}
+
+// Get returns the Manager of the upstream.
+func (m *MultiUpstreamManager) Get(upstreamID model.UpstreamID) (Manager, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	manager, ok := m.managers[upstreamID]
+	return manager, ok
+}
+
+// TryUpdateGCSafePoint tries to update the service GC safepoint of the
+// upstream, see Manager.TryUpdateGCSafePoint.
+func (m *MultiUpstreamManager) TryUpdateGCSafePoint(
+	ctx context.Context, upstreamID model.UpstreamID,
+	checkpointTs model.Ts, forceUpdate bool,
+) error {
+	manager, ok := m.Get(upstreamID)
+	if !ok {
+		return cerror.ErrUpstreamNotFound.GenWithStackByArgs(upstreamID)
+	}
+	return manager.TryUpdateGCSafePoint(ctx, checkpointTs, forceUpdate)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiUpstreamManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := clock.NewMock()
+	pushes := make(map[uint64][]uint64)
+	newManager := func(upstreamID uint64) *gcManager {
+		mockPDClient := &MockPDClient{
+			UpdateServiceGCSafePointFunc: func(
+				ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+			) (uint64, error) {
+				pushes[upstreamID] = append(pushes[upstreamID], safePoint)
+				return safePoint, nil
+			},
+		}
+		manager := NewManager(etcd.GcServiceIDForTest(),
+			mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+		manager.clock = mockClock
+		manager.jitter = 0
+		return manager
+	}
+
+	m := NewMultiUpstreamManager()
+	first, second := newManager(1), newManager(2)
+	require.True(t, m.Register(1, first))
+	require.True(t, m.Register(2, second))
+	// Registering an existing upstream is a no-op.
+	require.False(t, m.Register(1, newManager(1)))
+	manager, ok := m.Get(1)
+	require.True(t, ok)
+	require.Same(t, first, manager)
+
+	err := m.TryUpdateGCSafePoint(ctx, 3, 100, true)
+	require.True(t, cerror.ErrUpstreamNotFound.Equal(err))
+
+	// Each upstream has its own safepoint and throttle.
+	require.Nil(t, m.TryUpdateGCSafePoint(ctx, 1, 100, true))
+	mockClock.Add(first.interval / 2)
+	require.Nil(t, m.TryUpdateGCSafePoint(ctx, 2, 200, true))
+	mockClock.Add(first.interval / 2)
+	require.Nil(t, m.TryUpdateGCSafePoint(ctx, 1, 110, false))
+	require.Nil(t, m.TryUpdateGCSafePoint(ctx, 2, 210, false))
+	require.Equal(t, []uint64{100, 110}, pushes[1])
+	require.Equal(t, []uint64{200}, pushes[2])
+	require.Equal(t, uint64(110), first.LastSafePointTs())
+	require.Equal(t, uint64(200), second.LastSafePointTs())
+
+	// Deregistering removes the service safepoint of the upstream only.
+	require.Nil(t, m.Deregister(ctx, 1))
+	require.Equal(t, []uint64{100, 110, math.MaxUint64}, pushes[1])
+	require.Equal(t, []uint64{200}, pushes[2])
+	_, ok = m.Get(1)
+	require.False(t, ok)
+	require.Nil(t, m.Deregister(ctx, 1))
+	require.Len(t, pushes[1], 3)
+	require.True(t, m.Register(1, newManager(1)))
+}
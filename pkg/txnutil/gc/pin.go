@@ -0,0 +1,62 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// PinSafePoint implements Manager.PinSafePoint.
+// The pin is written to PD immediately. While pinned, every update pushes
+// the pinned ts, which refreshes its TTL, unless the checkpoint falls behind
+// it.
+func (m *gcManager) PinSafePoint(ctx context.Context, ts uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := m.setServiceGCSafepoint(ctx, m.gcServiceID, m.serviceGCTTL(), ts)
+	if err != nil {
+		return cerror.ErrUpdateServiceSafepointFailed.Wrap(err)
+	}
+	m.pinnedTs = ts
+	m.pinned = true
+	m.lastSafePointTs = ts
+	m.lastSucceededTime = m.clock.Now()
+	m.lastWrittenTime = m.lastSucceededTime
+	log.Info("gc safe point pinned",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Uint64("pinnedTs", ts))
+	return nil
+}
+
+// UnpinSafePoint implements Manager.UnpinSafePoint.
+func (m *gcManager) UnpinSafePoint(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.pinned {
+		return nil
+	}
+	log.Info("gc safe point unpinned",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Uint64("pinnedTs", m.pinnedTs))
+	m.pinnedTs = 0
+	m.pinned = false
+	// Resume from the current checkpoint on the next update.
+	m.lastUpdatedTime = time.Time{}
+	return nil
+}
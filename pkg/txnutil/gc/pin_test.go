@@ -0,0 +1,83 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinSafePoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	type push struct {
+		ttl       int64
+		safePoint uint64
+	}
+	var pushes []push
+	var pushErr error
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			if pushErr != nil {
+				return 0, pushErr
+			}
+			pushes = append(pushes, push{ttl: ttl, safePoint: safePoint})
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+	manager.gcTTL = 3600
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+
+	// Failed to pin, the safepoint is not pinned.
+	pushErr = context.Canceled
+	require.NotNil(t, manager.PinSafePoint(ctx, 150))
+	require.False(t, manager.pinned)
+	pushErr = nil
+
+	require.Nil(t, manager.PinSafePoint(ctx, 150))
+	require.Equal(t, push{ttl: 3600, safePoint: 150}, pushes[len(pushes)-1])
+	require.Equal(t, uint64(150), manager.LastSafePointTs())
+
+	// The safepoint never advances past the pin, but the pin is re-written
+	// with its TTL on every update.
+	for _, checkpointTs := range []uint64{200, 300} {
+		mockClock.Add(manager.interval)
+		require.Nil(t, manager.TryUpdateGCSafePoint(ctx, checkpointTs, false))
+		require.Equal(t, push{ttl: 3600, safePoint: 150}, pushes[len(pushes)-1])
+		require.Equal(t, uint64(150), manager.LastSafePointTs())
+	}
+	// A checkpoint behind the pin is still protected.
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 120, true))
+	require.Equal(t, push{ttl: 3600, safePoint: 120}, pushes[len(pushes)-1])
+
+	// Unpinning resumes from the current checkpoint without being throttled.
+	require.Nil(t, manager.UnpinSafePoint(ctx))
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 400, false))
+	require.Equal(t, push{ttl: 3600, safePoint: 400}, pushes[len(pushes)-1])
+	require.Equal(t, uint64(400), manager.LastSafePointTs())
+	require.Nil(t, manager.UnpinSafePoint(ctx))
+}
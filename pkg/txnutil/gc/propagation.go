@@ -0,0 +1,88 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// propagationCheckInterval is the interval of checking GC safepoints of
+// stores in VerifyPropagation.
+const propagationCheckInterval = time.Second
+
+// StoreSafePointProvider provides the GC safepoints that TiKV stores have
+// loaded from PD, keyed by store ID.
+type StoreSafePointProvider interface {
+	GetStoreSafePoints(ctx context.Context) (map[uint64]uint64, error)
+}
+
+// WithStoreSafePointProvider sets the provider of GC safepoints of stores
+// used by VerifyPropagation.
+func WithStoreSafePointProvider(p StoreSafePointProvider) ManagerOption {
+	return func(m *gcManager) {
+		m.storeSafePoints = p
+	}
+}
+
+// VerifyPropagation implements Manager.VerifyPropagation.
+func (m *gcManager) VerifyPropagation(
+	ctx context.Context, target uint64, timeout time.Duration,
+) (bool, []uint64, error) {
+	if m.storeSafePoints == nil {
+		return false, nil, cerror.ErrVerifyGCPropagationFailed.GenWithStackByArgs(
+			"store safepoint provider is not set")
+	}
+	deadline := m.clock.Now().Add(timeout)
+	for {
+		safePoints, err := m.storeSafePoints.GetStoreSafePoints(ctx)
+		if err != nil {
+			return false, nil, cerror.ErrVerifyGCPropagationFailed.Wrap(err).
+				GenWithStackByArgs("get store safepoints")
+		}
+		lagging := laggingStores(safePoints, target)
+		if len(lagging) == 0 {
+			return true, nil, nil
+		}
+		remaining := deadline.Sub(m.clock.Now())
+		if remaining <= 0 {
+			return false, lagging, nil
+		}
+		if remaining > propagationCheckInterval {
+			remaining = propagationCheckInterval
+		}
+		select {
+		case <-ctx.Done():
+			return false, lagging, errors.Trace(ctx.Err())
+		case <-m.clock.After(remaining):
+		}
+	}
+}
+
+// laggingStores returns the sorted IDs of stores whose safepoints are below
+// target.
+func laggingStores(safePoints map[uint64]uint64, target uint64) []uint64 {
+	var lagging []uint64
+	for storeID, safePoint := range safePoints {
+		if safePoint < target {
+			lagging = append(lagging, storeID)
+		}
+	}
+	sort.Slice(lagging, func(i, j int) bool { return lagging[i] < lagging[j] })
+	return lagging
+}
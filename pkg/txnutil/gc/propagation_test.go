@@ -0,0 +1,123 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStoreSafePointProvider struct {
+	mu sync.Mutex
+	// rounds are returned in order, the last one is repeated.
+	rounds []map[uint64]uint64
+	calls  int
+	err    error
+}
+
+func (p *mockStoreSafePointProvider) GetStoreSafePoints(
+	ctx context.Context,
+) (map[uint64]uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return nil, p.err
+	}
+	i := p.calls
+	if i >= len(p.rounds) {
+		i = len(p.rounds) - 1
+	}
+	p.calls++
+	return p.rounds[i], nil
+}
+
+func TestVerifyPropagation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &mockStoreSafePointProvider{
+		rounds: []map[uint64]uint64{{1: 100, 2: 90, 3: 80}},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+		pdutil.NewClock4Test(), WithStoreSafePointProvider(provider)).(*gcManager)
+
+	propagated, lagging, err := manager.VerifyPropagation(ctx, 80, 0)
+	require.Nil(t, err)
+	require.True(t, propagated)
+	require.Empty(t, lagging)
+
+	propagated, lagging, err = manager.VerifyPropagation(ctx, 100, 0)
+	require.Nil(t, err)
+	require.False(t, propagated)
+	require.Equal(t, []uint64{2, 3}, lagging)
+
+	provider.err = errors.New("store unavailable")
+	_, _, err = manager.VerifyPropagation(ctx, 100, 0)
+	require.ErrorContains(t, err, string(cerror.ErrVerifyGCPropagationFailed.RFCCode()))
+
+	// No provider.
+	manager = NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+		pdutil.NewClock4Test()).(*gcManager)
+	_, _, err = manager.VerifyPropagation(ctx, 100, 0)
+	require.ErrorContains(t, err, string(cerror.ErrVerifyGCPropagationFailed.RFCCode()))
+}
+
+func TestVerifyPropagationWaitStores(t *testing.T) {
+	t.Parallel()
+
+	provider := &mockStoreSafePointProvider{
+		rounds: []map[uint64]uint64{
+			{1: 100, 2: 90, 3: 80},
+			{1: 100, 2: 100, 3: 90},
+			{1: 100, 2: 100, 3: 100},
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+		pdutil.NewClock4Test(), WithStoreSafePointProvider(provider)).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+
+	type result struct {
+		propagated bool
+		lagging    []uint64
+		err        error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		propagated, lagging, err := manager.VerifyPropagation(
+			context.Background(), 100, time.Minute)
+		resultCh <- result{propagated: propagated, lagging: lagging, err: err}
+	}()
+	for {
+		select {
+		case res := <-resultCh:
+			require.Nil(t, res.err)
+			require.True(t, res.propagated)
+			require.Empty(t, res.lagging)
+			require.Equal(t, 3, provider.calls)
+			return
+		case <-time.After(10 * time.Millisecond):
+			mockClock.Add(propagationCheckInterval)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+// SafePointRegressionHandler is called with the service ID of the Manager
+// when the actual safepoint returned by PD is larger than the requested one,
+// that is, another service has advanced GC past the data TiCDC needs.
+type SafePointRegressionHandler func(serviceID string, requested, actual uint64)
+
+// WithSafePointRegressionHandler sets the handler called when PD's safepoint
+// is ahead of the requested safepoint. It is called once per regression, a
+// regression with the same actual safepoint is not reported again.
+func WithSafePointRegressionHandler(handler SafePointRegressionHandler) ManagerOption {
+	return func(m *gcManager) {
+		m.onRegression = handler
+	}
+}
+
+// observeActualSafePoint records a regression in the metrics and calls the
+// regression handler, if the actual safepoint returned by PD is ahead of the
+// requested safepoint.
+func (m *gcManager) observeActualSafePoint(requested, actual uint64) {
+	if actual <= requested || actual == m.lastRegressionActual {
+		return
+	}
+	m.lastRegressionActual = actual
+	safePointRegressionCounter.WithLabelValues(m.gcServiceID).Inc()
+	if m.onRegression != nil {
+		m.onRegression(m.gcServiceID, requested, actual)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafePointRegressionHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pdSafePoint uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			if safePoint > pdSafePoint {
+				return safePoint, nil
+			}
+			return pdSafePoint, nil
+		},
+	}
+	type regression struct {
+		requested, actual uint64
+	}
+	var regressions []regression
+	manager := NewManager(etcd.GcServiceIDForTest()+t.Name(),
+		mockPDClient, pdutil.NewClock4Test(),
+		WithSafePointRegressionHandler(
+			func(serviceID string, requested, actual uint64) {
+				require.Equal(t, etcd.GcServiceIDForTest()+t.Name(), serviceID)
+				regressions = append(regressions, regression{requested, actual})
+			})).(*gcManager)
+	manager.clock = clock.NewMock()
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Empty(t, regressions)
+
+	// Another service advances GC past the requested safepoint.
+	pdSafePoint = 200
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 110, true))
+	require.Equal(t, []regression{{110, 200}}, regressions)
+	// The same regression is reported only once.
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 120, true))
+	require.Len(t, regressions, 1)
+	// A new regression is reported.
+	pdSafePoint = 300
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 130, true))
+	require.Equal(t, []regression{{110, 200}, {130, 300}}, regressions)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 400, true))
+	require.Len(t, regressions, 2)
+	require.Equal(t, float64(2), testutil.ToFloat64(
+		safePointRegressionCounter.WithLabelValues(etcd.GcServiceIDForTest()+t.Name())))
+
+	// Unregistering resets the regression, it is reported again after
+	// registering.
+	require.Nil(t, manager.Unregister(ctx))
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 290, true))
+	require.Equal(t, []regression{{110, 200}, {130, 300}, {290, 300}}, regressions)
+}
+
+func TestCheckStaleCheckpointTsAfterRegression(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pdSafePoint := uint64(200)
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			if safePoint > pdSafePoint {
+				return safePoint, nil
+			}
+			return pdSafePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test()).(*gcManager)
+	manager.clock = clock.NewMock()
+	changefeedID := model.DefaultChangeFeedID("changefeed")
+
+	// PD is ahead of the requested safepoint, the checkpoint is stale.
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	err := manager.CheckStaleCheckpointTs(ctx, changefeedID, 150)
+	require.True(t, cerror.ErrSnapshotLostByGC.Equal(err))
+	require.Nil(t, manager.CheckStaleCheckpointTs(ctx, changefeedID, 201))
+
+	// The safepoint is re-registered lower, e.g. the other service is gone.
+	// The staleness check follows the last safepoint, not the highest one
+	// ever returned by PD.
+	require.Nil(t, manager.Unregister(ctx))
+	pdSafePoint = 0
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Nil(t, manager.CheckStaleCheckpointTs(ctx, changefeedID, 150))
+}
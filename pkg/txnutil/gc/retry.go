@@ -0,0 +1,103 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"go.uber.org/zap"
+)
+
+// defaultRetryProtectionWindow is the default time a changefeed in an
+// error-retry loop keeps pinning the safepoint.
+const defaultRetryProtectionWindow = 30 * time.Minute
+
+// WithRetryProtectionWindow sets how long a changefeed keeps pinning the
+// safepoint after it starts retrying on errors, see SetRetrying.
+func WithRetryProtectionWindow(window time.Duration) ManagerOption {
+	return func(m *gcManager) {
+		m.retryProtectionWindow = window
+	}
+}
+
+// SetRetrying implements Manager.SetRetrying.
+func (m *gcManager) SetRetrying(changefeedID model.ChangeFeedID, retrying bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !retrying {
+		delete(m.retryingSince, changefeedID)
+		return
+	}
+	if _, ok := m.retryingSince[changefeedID]; ok {
+		return
+	}
+	if m.retryingSince == nil {
+		m.retryingSince = make(map[model.ChangeFeedID]time.Time)
+	}
+	m.retryingSince[changefeedID] = m.clock.Now()
+	log.Info("changefeed starts retrying, protect its checkpoint from gc",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Duration("window", m.retryProtectionWindow))
+}
+
+// SetAutoResumeDeadline implements Manager.SetAutoResumeDeadline.
+func (m *gcManager) SetAutoResumeDeadline(changefeedID model.ChangeFeedID, deadline time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if deadline.IsZero() {
+		delete(m.autoResumeDeadlines, changefeedID)
+		return
+	}
+	if m.autoResumeDeadlines == nil {
+		m.autoResumeDeadlines = make(map[model.ChangeFeedID]time.Time)
+	}
+	if m.autoResumeDeadlines[changefeedID].Equal(deadline) {
+		return
+	}
+	m.autoResumeDeadlines[changefeedID] = deadline
+	log.Info("changefeed may be resumed automatically, protect its checkpoint from gc",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Time("deadline", deadline))
+}
+
+// RemoveChangefeed implements Manager.RemoveChangefeed.
+func (m *gcManager) RemoveChangefeed(changefeedID model.ChangeFeedID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.retryingSince, changefeedID)
+	delete(m.autoResumeDeadlines, changefeedID)
+}
+
+// IgnoreFailedChangeFeedByID implements Manager.IgnoreFailedChangeFeedByID.
+func (m *gcManager) IgnoreFailedChangeFeedByID(
+	changefeedID model.ChangeFeedID, checkpointTs uint64, retention time.Duration,
+) bool {
+	m.mu.Lock()
+	deadline, hasDeadline := m.autoResumeDeadlines[changefeedID]
+	since, retrying := m.retryingSince[changefeedID]
+	m.mu.Unlock()
+	if hasDeadline && m.clock.Now().Before(deadline) {
+		return false
+	}
+	if retrying && m.clock.Since(since) < m.retryProtectionWindow {
+		return false
+	}
+	return m.IgnoreFailedChangeFeed(checkpointTs, retention)
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestIgnoreFailedChangeFeedRetrying(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	manager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+		pdClock, WithRetryProtectionWindow(10*time.Minute)).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+
+	pdTime, err := pdClock.CurrentTime()
+	require.Nil(t, err)
+	// The checkpoint is beyond gcTTL, it is ignored unless it is retrying.
+	checkpointTs := oracle.GoTimeToTS(pdTime.Add(-2 * gcTTL))
+	cf1 := model.DefaultChangeFeedID("cf1")
+	cf2 := model.DefaultChangeFeedID("cf2")
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+
+	manager.SetRetrying(cf1, true)
+	require.False(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf2, checkpointTs, 0))
+
+	// Marking it retrying again does not extend the window.
+	mockClock.Add(5 * time.Minute)
+	manager.SetRetrying(cf1, true)
+	require.False(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+	mockClock.Add(5 * time.Minute)
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+
+	// A recent checkpoint is never ignored.
+	recentTs := oracle.GoTimeToTS(pdTime)
+	require.False(t, manager.IgnoreFailedChangeFeedByID(cf1, recentTs, 0))
+
+	// The window restarts once the changefeed recovers and retries again.
+	manager.SetRetrying(cf1, false)
+	manager.SetRetrying(cf1, true)
+	require.False(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+	manager.SetRetrying(cf1, false)
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+
+	// The state is dropped once the changefeed is removed.
+	manager.SetRetrying(cf1, true)
+	manager.SetAutoResumeDeadline(cf1, mockClock.Now().Add(time.Hour))
+	manager.RemoveChangefeed(cf1)
+	require.NotContains(t, manager.retryingSince, cf1)
+	require.NotContains(t, manager.autoResumeDeadlines, cf1)
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+}
+
+func TestIgnoreFailedChangeFeedAutoResume(t *testing.T) {
+	t.Parallel()
+
+	pdClock := pdutil.NewClock4Test()
+	manager := NewManager(etcd.GcServiceIDForTest(), &MockPDClient{},
+		pdClock).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+
+	pdTime, err := pdClock.CurrentTime()
+	require.Nil(t, err)
+	checkpointTs := oracle.GoTimeToTS(pdTime.Add(-2 * gcTTL))
+	cf1 := model.DefaultChangeFeedID("cf1")
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+
+	// The checkpoint is protected until the deadline.
+	manager.SetAutoResumeDeadline(cf1, mockClock.Now().Add(time.Hour))
+	require.False(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+	mockClock.Add(time.Hour)
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+
+	manager.SetAutoResumeDeadline(cf1, mockClock.Now().Add(time.Hour))
+	require.False(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+	manager.SetAutoResumeDeadline(cf1, time.Time{})
+	require.True(t, manager.IgnoreFailedChangeFeedByID(cf1, checkpointTs, 0))
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// SafePointSource returns the safepoint to push, ok is false if there is
+// nothing to push.
+type SafePointSource func() (safePoint model.Ts, ok bool)
+
+// runState is the state of the Run loop.
+type runState struct {
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (m *gcManager) Run(ctx context.Context, source SafePointSource) error {
+	m.run.mu.Lock()
+	if m.run.cancel != nil {
+		m.run.mu.Unlock()
+		return cerror.ErrGCManagerRunning.GenWithStackByArgs()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	m.run.cancel, m.run.done = cancel, done
+	m.run.mu.Unlock()
+	defer func() {
+		cancel()
+		m.run.mu.Lock()
+		m.run.cancel, m.run.done = nil, nil
+		m.run.mu.Unlock()
+		close(done)
+	}()
+
+	log.Info("gc manager starts running", zap.String("GcManagerID", m.gcServiceID))
+	ticker := m.clock.Ticker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("gc manager stops running", zap.String("GcManagerID", m.gcServiceID))
+			return nil
+		case <-ticker.C:
+		}
+		safePoint, ok := source()
+		if !ok {
+			continue
+		}
+		if err := m.TryUpdateGCSafePoint(ctx, safePoint, false); err != nil {
+			// The push is interrupted by stopping the loop.
+			if ctx.Err() != nil {
+				log.Info("gc manager stops running", zap.String("GcManagerID", m.gcServiceID))
+				return nil
+			}
+			return errors.Trace(err)
+		}
+	}
+}
+
+func (m *gcManager) Stop() {
+	m.run.mu.Lock()
+	cancel, done := m.run.cancel, m.run.done
+	m.run.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (m *gcManager) IsRunning() bool {
+	m.run.mu.Lock()
+	defer m.run.mu.Unlock()
+	return m.run.cancel != nil
+}
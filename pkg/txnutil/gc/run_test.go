@@ -0,0 +1,131 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestRunAndStop(t *testing.T) {
+	// Do not run in parallel, goleak checks goroutines of the whole process.
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	var pushed atomic.Int64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed.Add(1)
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test()).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+	source := func() (model.Ts, bool) { return 100, true }
+
+	// Stop before running is a no-op.
+	manager.Stop()
+	require.False(t, manager.IsRunning())
+
+	for i := 0; i < 10; i++ {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Nil(t, manager.Run(context.Background(), source))
+		}()
+		require.Eventually(t, manager.IsRunning, 5*time.Second, time.Millisecond)
+
+		err := manager.Run(context.Background(), source)
+		require.True(t, cerror.ErrGCManagerRunning.Equal(err))
+
+		manager.Stop()
+		require.False(t, manager.IsRunning())
+		manager.Stop()
+		wg.Wait()
+	}
+
+	// The loop pushes safepoints.
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.Nil(t, manager.Run(ctx, source))
+	}()
+	require.Eventually(t, manager.IsRunning, 5*time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		mockClock.Add(manager.interval)
+		return pushed.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Cancel the context also stops the loop.
+	cancel()
+	wg.Wait()
+	require.False(t, manager.IsRunning())
+}
+
+func TestRunConcurrentWithOtherCallers(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, nil
+		},
+	}
+	manager := NewManagerWithInterval(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), time.Millisecond)
+	var safePoint atomic.Uint64
+	source := func() (model.Ts, bool) { return safePoint.Add(1), true }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.Nil(t, manager.Run(ctx, source))
+	}()
+
+	// The owner keeps calling the Manager while Run is pushing, run with
+	// the race detector to check the Manager state is guarded.
+	changefeedID := model.DefaultChangeFeedID("test")
+	for i := 0; i < 100; i++ {
+		manager.SetChangefeedGCTTL(int64(i))
+		manager.SetBarrierTs(uint64(1000 + i))
+		manager.SetRetrying(changefeedID, i%2 == 0)
+		manager.IgnoreFailedChangeFeedByID(changefeedID, safePoint.Load(), 0)
+		require.Nil(t, manager.TryUpdateGCSafePoint(ctx, safePoint.Add(1), i%2 == 0))
+		_ = manager.CheckStaleCheckpointTs(ctx, changefeedID, safePoint.Load())
+		manager.LastPushKind()
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+
+	pd "github.com/tikv/pd/client"
+)
+
+// SafepointService is the backend which stores service GC safepoints, e.g.
+// the cluster-wide service safepoints of PD, or the service safepoints of a
+// keyspace, see NewPDSafepointService and NewKeyspaceSafepointService.
+type SafepointService interface {
+	// SetServiceGCSafepoint sets the service safepoint with the TTL in
+	// seconds, it returns the minimum service safepoint. It makes a single
+	// attempt, the Manager retries it.
+	SetServiceGCSafepoint(
+		ctx context.Context, serviceID string, TTL int64, safePoint uint64,
+	) (uint64, error)
+	// RemoveServiceGCSafepoint removes the service safepoint.
+	RemoveServiceGCSafepoint(ctx context.Context, serviceID string) error
+}
+
+// pdSafepointService sets cluster-wide service safepoints of PD.
+type pdSafepointService struct {
+	pdClient pd.Client
+}
+
+// NewPDSafepointService returns a SafepointService which sets cluster-wide
+// service safepoints via pdClient, it is the default of a Manager.
+func NewPDSafepointService(pdClient pd.Client) SafepointService {
+	return &pdSafepointService{pdClient: pdClient}
+}
+
+// SetServiceGCSafepoint implements SafepointService.
+func (s *pdSafepointService) SetServiceGCSafepoint(
+	ctx context.Context, serviceID string, TTL int64, safePoint uint64,
+) (uint64, error) {
+	return s.pdClient.UpdateServiceGCSafePoint(ctx, serviceID, TTL, safePoint)
+}
+
+// RemoveServiceGCSafepoint implements SafepointService.
+func (s *pdSafepointService) RemoveServiceGCSafepoint(
+	ctx context.Context, serviceID string,
+) error {
+	return RemoveServiceGCSafepoint(ctx, s.pdClient, serviceID)
+}
+
+// WithSafepointService makes the Manager store service safepoints in s
+// instead of the cluster-wide service safepoints of PD.
+func WithSafepointService(s SafepointService) ManagerOption {
+	return func(m *gcManager) {
+		m.safepoints = s
+	}
+}
+
+// setServiceGCSafepoint sets the service safepoint via the SafepointService.
+func (m *gcManager) setServiceGCSafepoint(
+	ctx context.Context, serviceID string, TTL int64, safePoint uint64,
+) (actual uint64, err error) {
+	err = m.callPD(ctx, func(ctx context.Context) error {
+		var err1 error
+		actual, err1 = m.safepoints.SetServiceGCSafepoint(ctx, serviceID, TTL, safePoint)
+		return err1
+	})
+	return
+}
+
+// removeServiceGCSafepoint removes the service safepoint via the
+// SafepointService.
+func (m *gcManager) removeServiceGCSafepoint(ctx context.Context, serviceID string) error {
+	return m.callPD(ctx, func(ctx context.Context) error {
+		return m.safepoints.RemoveServiceGCSafepoint(ctx, serviceID)
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSafepointService struct {
+	safePoints map[string]uint64
+}
+
+func (s *mockSafepointService) SetServiceGCSafepoint(
+	ctx context.Context, serviceID string, TTL int64, safePoint uint64,
+) (uint64, error) {
+	s.safePoints[serviceID] = safePoint
+	return safePoint, nil
+}
+
+func (s *mockSafepointService) RemoveServiceGCSafepoint(
+	ctx context.Context, serviceID string,
+) error {
+	delete(s.safePoints, serviceID)
+	return nil
+}
+
+func TestWithSafepointService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			require.FailNow(t, "unexpected cluster-wide service safepoint update")
+			return 0, nil
+		},
+	}
+	service := &mockSafepointService{safePoints: make(map[string]uint64)}
+	manager := NewManager(etcd.GcServiceIDForTest(),
+		mockPDClient, pdutil.NewClock4Test(),
+		WithSafepointService(service)).(*gcManager)
+	manager.clock = clock.NewMock()
+
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, 100, true))
+	require.Equal(t, map[string]uint64{etcd.GcServiceIDForTest(): 100}, service.safePoints)
+	require.Equal(t, uint64(100), manager.LastSafePointTs())
+
+	require.Nil(t, manager.Unregister(ctx))
+	require.Empty(t, service.safePoints)
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// WithSetIfUnchangedLister sets the lister of service safepoints used by
+// SetSafepointIfUnchanged to read the current service safepoint.
+func WithSetIfUnchangedLister(lister ServiceSafePointLister) ManagerOption {
+	return func(m *gcManager) {
+		m.ifUnchangedSafePoints = lister
+	}
+}
+
+// SetSafepointIfUnchanged implements Manager.SetSafepointIfUnchanged.
+// PD does not support conditional updates, so it is a best-effort check
+// rather than a compare-and-set: the check and the push are not atomic, a
+// push from another writer in between is not detected.
+func (m *gcManager) SetSafepointIfUnchanged(
+	ctx context.Context, expected, newSafePoint uint64,
+) (bool, uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ifUnchangedSafePoints == nil {
+		return false, 0, cerror.ErrSetGCSafepointIfUnchangedFailed.GenWithStackByArgs(
+			"service safepoint lister is not set")
+	}
+	list, err := m.ifUnchangedSafePoints.ListGcServiceSafePoint(ctx)
+	if err != nil {
+		return false, 0, cerror.ErrSetGCSafepointIfUnchangedFailed.Wrap(err).
+			GenWithStackByArgs("list service safepoints")
+	}
+	var actual uint64
+	now := m.clock.Now().Unix()
+	for _, sp := range list.ServiceGCSafepoints {
+		if sp.ServiceID == m.gcServiceID && sp.ExpiredAt > now {
+			actual = sp.SafePoint
+			break
+		}
+	}
+	if actual != expected {
+		log.Info("gc safe point has been changed, skip setting",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Uint64("expected", expected),
+			zap.Uint64("actual", actual))
+		return false, actual, nil
+	}
+
+	actual, err = m.setServiceGCSafepoint(ctx, m.gcServiceID, m.serviceGCTTL(), newSafePoint)
+	if err != nil {
+		return false, 0, cerror.ErrSetGCSafepointIfUnchangedFailed.Wrap(err).
+			GenWithStackByArgs("update service safepoint")
+	}
+	m.lastSafePointTs = actual
+	m.lastSucceededTime = m.clock.Now()
+	m.lastWrittenTime = m.lastSucceededTime
+	return true, actual, nil
+}
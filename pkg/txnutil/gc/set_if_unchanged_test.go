@@ -0,0 +1,90 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSafepointIfUnchanged(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var pushed []uint64
+	var pdSafePoint uint64
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed = append(pushed, safePoint)
+			if safePoint < pdSafePoint {
+				return pdSafePoint, nil
+			}
+			return safePoint, nil
+		},
+	}
+	lister := &mockServiceSafePointLister{}
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), WithSetIfUnchangedLister(lister)).(*gcManager)
+
+	// There is no service safepoint yet.
+	set, actual, err := manager.SetSafepointIfUnchanged(ctx, 0, 100)
+	require.Nil(t, err)
+	require.True(t, set)
+	require.Equal(t, uint64(100), actual)
+	require.Equal(t, []uint64{100}, pushed)
+	require.Equal(t, uint64(100), manager.lastSafePointTs)
+
+	// Another writer has advanced the safepoint.
+	lister.safePoints = []*pdutil.ServiceSafePoint{
+		{ServiceID: "other", ExpiredAt: math.MaxInt64, SafePoint: 50},
+		{ServiceID: etcd.GcServiceIDForTest(), ExpiredAt: math.MaxInt64, SafePoint: 120},
+	}
+	set, actual, err = manager.SetSafepointIfUnchanged(ctx, 100, 110)
+	require.Nil(t, err)
+	require.False(t, set)
+	require.Equal(t, uint64(120), actual)
+	require.Equal(t, []uint64{100}, pushed)
+	require.Equal(t, uint64(100), manager.lastSafePointTs)
+
+	set, actual, err = manager.SetSafepointIfUnchanged(ctx, 120, 130)
+	require.Nil(t, err)
+	require.True(t, set)
+	require.Equal(t, uint64(130), actual)
+	require.Equal(t, []uint64{100, 130}, pushed)
+
+	// PD returns a safepoint ahead of the pushed one, it is recorded.
+	pdSafePoint = 150
+	lister.safePoints[1].SafePoint = 130
+	set, actual, err = manager.SetSafepointIfUnchanged(ctx, 130, 140)
+	require.Nil(t, err)
+	require.True(t, set)
+	require.Equal(t, uint64(150), actual)
+	require.Equal(t, uint64(150), manager.LastSafePointTs())
+	require.Equal(t, []uint64{100, 130, 140}, pushed)
+
+	// No lister.
+	manager = NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test()).(*gcManager)
+	_, _, err = manager.SetSafepointIfUnchanged(ctx, 0, 100)
+	require.ErrorContains(t, err, string(cerror.ErrSetGCSafepointIfUnchangedFailed.RFCCode()))
+	require.Equal(t, []uint64{100, 130, 140}, pushed)
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// ManagerStatusVersion is the version of the ManagerStatus schema.
+// Bump it when a field is removed or its meaning changes.
+const ManagerStatusVersion = 1
+
+// ManagerStatus is the status of a Manager exposed to the status server, it
+// is a consistent snapshot of the Manager.
+type ManagerStatus struct {
+	Version           int                 `json:"version"`
+	ServiceID         string              `json:"service_id"`
+	SafePointTs       uint64              `json:"safe_point_ts"`
+	LastUpdatedTime   time.Time           `json:"last_updated_time"`
+	LastSucceededTime time.Time           `json:"last_succeeded_time"`
+	LastPushKind      string              `json:"last_push_kind"`
+	Healthy           bool                `json:"healthy"`
+	HandedOff         bool                `json:"handed_off"`
+	Config            ManagerStatusConfig `json:"config"`
+}
+
+// ManagerStatusConfig is the configuration part of ManagerStatus.
+type ManagerStatusConfig struct {
+	// GCTTL is the TTL of the service safepoint, in seconds.
+	GCTTL int64 `json:"gc_ttl"`
+	// UpdateInterval is the minimum interval of pushing safepoints.
+	UpdateInterval   string `json:"update_interval"`
+	SafePointFloor   uint64 `json:"safe_point_floor"`
+	ClockUncertainty bool   `json:"clock_uncertainty"`
+	ExcludePaused    bool   `json:"exclude_paused_changefeeds"`
+}
+
+func (m *gcManager) StatusJSON() ([]byte, error) {
+	m.mu.Lock()
+	status := ManagerStatus{
+		Version:           ManagerStatusVersion,
+		ServiceID:         m.gcServiceID,
+		SafePointTs:       m.lastSafePointTs,
+		LastUpdatedTime:   m.lastUpdatedTime,
+		LastSucceededTime: m.lastSucceededTime,
+		LastPushKind:      m.lastPushKind.String(),
+		// The service safepoint is still held if the last success is
+		// within the TTL.
+		Healthy: m.lastPushKind != PushKindFailed ||
+			m.clock.Since(m.lastSucceededTime) < time.Duration(m.serviceGCTTL())*time.Second,
+		HandedOff: m.handedOff,
+		Config: ManagerStatusConfig{
+			GCTTL:            m.serviceGCTTL(),
+			UpdateInterval:   m.interval.String(),
+			SafePointFloor:   m.safePointFloor,
+			ClockUncertainty: m.clockUncertainty,
+			ExcludePaused:    m.excludePaused,
+		},
+	}
+	m.mu.Unlock()
+
+	data, err := json.Marshal(status)
+	return data, errors.Trace(err)
+}
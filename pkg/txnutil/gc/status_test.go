@@ -0,0 +1,124 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusJSON(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, nil
+		},
+	}
+	gcManager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), WithSafePointFloor(10)).(*gcManager)
+	mockClock := clock.NewMock()
+	gcManager.clock = mockClock
+	gcManager.gcTTL = 3600
+	require.Nil(t, gcManager.TryUpdateGCSafePoint(context.Background(), 100, true))
+
+	data, err := gcManager.StatusJSON()
+	require.Nil(t, err)
+
+	fields := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(data, &fields))
+	for _, field := range []string{
+		"version", "service_id", "safe_point_ts", "last_updated_time",
+		"last_succeeded_time", "last_push_kind", "healthy", "handed_off", "config",
+	} {
+		require.Contains(t, fields, field)
+	}
+	require.Contains(t, fields["config"], "gc_ttl")
+
+	status := ManagerStatus{}
+	require.Nil(t, json.Unmarshal(data, &status))
+	require.True(t, mockClock.Now().Equal(status.LastUpdatedTime))
+	require.True(t, mockClock.Now().Equal(status.LastSucceededTime))
+	require.Equal(t, ManagerStatus{
+		Version:           ManagerStatusVersion,
+		ServiceID:         etcd.GcServiceIDForTest(),
+		SafePointTs:       100,
+		LastUpdatedTime:   status.LastUpdatedTime,
+		LastSucceededTime: status.LastSucceededTime,
+		LastPushKind:      "forced",
+		Healthy:           true,
+		Config: ManagerStatusConfig{
+			GCTTL:          3600,
+			UpdateInterval: defaultGCSafepointUpdateInterval.String(),
+			SafePointFloor: 10,
+		},
+	}, status)
+
+	// Round trip.
+	data2, err := json.Marshal(status)
+	require.Nil(t, err)
+	require.JSONEq(t, string(data), string(data2))
+}
+
+func TestStatusJSONConcurrentWithPushes(t *testing.T) {
+	t.Parallel()
+
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, nil
+		},
+	}
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test())
+
+	// The status server reads the status while the owner pushes safepoints.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			data, err := manager.StatusJSON()
+			require.Nil(t, err)
+			status := ManagerStatus{}
+			require.Nil(t, json.Unmarshal(data, &status))
+			if status.SafePointTs != 0 {
+				require.False(t, status.LastSucceededTime.IsZero())
+			}
+		}
+	}()
+	ctx := context.Background()
+	for i := uint64(1); i <= 100; i++ {
+		manager.SetChangefeedGCTTL(int64(i))
+		require.Nil(t, manager.TryUpdateGCSafePoint(ctx, i, true))
+	}
+	close(done)
+	wg.Wait()
+}
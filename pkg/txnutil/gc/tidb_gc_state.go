@@ -0,0 +1,76 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// TiDBGCState is the state of the GC worker of TiDB.
+type TiDBGCState struct {
+	// Leader is the ID of the TiDB GC leader.
+	Leader string
+	// LeaseExpireTime is the time when the lease of the GC leader expires.
+	LeaseExpireTime time.Time
+	// Running is true if the GC leader is running a GC round.
+	Running bool
+}
+
+// TiDBGCStateProvider provides the state of the GC worker of TiDB.
+type TiDBGCStateProvider interface {
+	GetTiDBGCState(ctx context.Context) (TiDBGCState, error)
+}
+
+// WithTiDBGCStateProvider makes the Manager coordinate with the GC worker of
+// TiDB, it defers pushing the safepoint while TiDB is in the middle of a GC
+// round.
+func WithTiDBGCStateProvider(p TiDBGCStateProvider) ManagerOption {
+	return func(m *gcManager) {
+		m.tidbGCState = p
+	}
+}
+
+// shouldDeferForTiDBGC returns true if TiDB holds a valid GC leader lease and
+// is running a GC round.
+func (m *gcManager) shouldDeferForTiDBGC(ctx context.Context) bool {
+	if m.tidbGCState == nil {
+		return false
+	}
+	state, err := m.tidbGCState.GetTiDBGCState(ctx)
+	if err != nil {
+		log.Warn("failed to get tidb gc state, do not defer updating gc safe point",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.Error(err))
+		return false
+	}
+	if !state.Running || !m.clock.Now().Before(state.LeaseExpireTime) {
+		return false
+	}
+	// Do not defer beyond the TTL, otherwise the service safepoint expires.
+	if m.clock.Since(m.lastSucceededTime) >= time.Second*time.Duration(m.serviceGCTTL())/2 {
+		log.Warn("tidb gc is running for too long, stop deferring gc safe point",
+			zap.String("GcManagerID", m.gcServiceID),
+			zap.String("tidbGCLeader", state.Leader))
+		return false
+	}
+	log.Info("tidb gc is running, defer updating gc safe point",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.String("tidbGCLeader", state.Leader),
+		zap.Time("leaseExpireTime", state.LeaseExpireTime))
+	return true
+}
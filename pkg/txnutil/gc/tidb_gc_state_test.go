@@ -0,0 +1,95 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+type mockTiDBGCStateProvider struct {
+	state TiDBGCState
+	err   error
+}
+
+func (p *mockTiDBGCStateProvider) GetTiDBGCState(
+	ctx context.Context,
+) (TiDBGCState, error) {
+	return p.state, p.err
+}
+
+func TestUpdateGCSafePointDeferForTiDBGC(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pushed := 0
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			pushed++
+			return safePoint, nil
+		},
+	}
+	provider := &mockTiDBGCStateProvider{}
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient,
+		pdutil.NewClock4Test(), WithTiDBGCStateProvider(provider)).(*gcManager)
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+	manager.lastSucceededTime = mockClock.Now()
+	manager.gcTTL = 3600
+
+	ts := oracle.GoTimeToTS(time.Now())
+	// TiDB GC is not running.
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts, true))
+	require.Equal(t, 1, pushed)
+
+	// TiDB GC is running, defer pushes.
+	provider.state = TiDBGCState{
+		Leader:          "tidb-1",
+		LeaseExpireTime: mockClock.Now().Add(10 * time.Minute),
+		Running:         true,
+	}
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts+1, true))
+	require.Equal(t, 1, pushed)
+	require.Equal(t, ts, manager.lastSafePointTs)
+
+	// The lease of TiDB GC leader expires.
+	mockClock.Add(10 * time.Minute)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts+2, true))
+	require.Equal(t, 2, pushed)
+
+	// Failed to get the state, do not defer.
+	provider.state.LeaseExpireTime = mockClock.Now().Add(time.Hour)
+	provider.err = errors.New("unknown")
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts+3, true))
+	require.Equal(t, 3, pushed)
+
+	// Do not defer beyond half of the TTL.
+	provider.err = nil
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts+4, true))
+	require.Equal(t, 3, pushed)
+	mockClock.Add(30 * time.Minute)
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts+5, true))
+	require.Equal(t, 4, pushed)
+	require.Equal(t, ts+5, manager.lastSafePointTs)
+}
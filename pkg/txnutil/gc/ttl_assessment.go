@@ -0,0 +1,135 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+const (
+	// recoveryScanThroughput is the estimated throughput of incremental scan,
+	// in MiB per second, when a changefeed recovers from a failure.
+	recoveryScanThroughput = 64
+	// ttlHeadroomFactor is the factor applied to the estimated recovery time
+	// to get the required gc TTL.
+	ttlHeadroomFactor = 2
+)
+
+// RegionStatsProvider provides statistics of regions of the upstream.
+// pdutil.PDAPIClient implements it.
+type RegionStatsProvider interface {
+	GetRegionStats(ctx context.Context) (*pdutil.RegionStats, error)
+}
+
+// WithRegionStatsProvider sets the provider of region statistics used by
+// AssessTTLSafety.
+func WithRegionStatsProvider(p RegionStatsProvider) ManagerOption {
+	return func(m *gcManager) {
+		m.regionStats = p
+	}
+}
+
+// TTLAssessment is the result of AssessTTLSafety.
+type TTLAssessment struct {
+	// GCTTL is the configured gc TTL.
+	GCTTL time.Duration
+	// EstimatedRecoveryTime is the estimated time a changefeed needs to
+	// recover from a failure.
+	EstimatedRecoveryTime time.Duration
+	// RecommendedGCTTL is the recommended gc TTL.
+	RecommendedGCTTL time.Duration
+	// Safe is true if GCTTL gives enough headroom for recovery.
+	Safe bool
+	// Recommendation is a human-readable recommendation.
+	Recommendation string
+}
+
+// advanceRate tracks the ratio of the safepoint advance to the wall time.
+type advanceRate struct {
+	lastTs   uint64
+	lastTime time.Time
+	// rate is 0 if there are not enough samples.
+	rate float64
+}
+
+func (r *advanceRate) observe(ts uint64, now time.Time) {
+	if r.lastTs != 0 && ts > r.lastTs && now.After(r.lastTime) {
+		advance := oracle.GetTimeFromTS(ts).Sub(oracle.GetTimeFromTS(r.lastTs))
+		r.rate = float64(advance) / float64(now.Sub(r.lastTime))
+	}
+	if ts >= r.lastTs {
+		r.lastTs = ts
+		r.lastTime = now
+	}
+}
+
+// AssessTTLSafety estimates the recovery time of a changefeed with the data
+// size of the upstream and the advance rate of the safepoint, and checks
+// whether the configured gc TTL covers it with enough headroom.
+func (m *gcManager) AssessTTLSafety(ctx context.Context) (TTLAssessment, error) {
+	if m.regionStats == nil {
+		return TTLAssessment{}, cerror.ErrAssessGCTTLFailed.GenWithStackByArgs(
+			"region stats provider is not set")
+	}
+	stats, err := m.regionStats.GetRegionStats(ctx)
+	if err != nil {
+		return TTLAssessment{}, cerror.ErrAssessGCTTLFailed.Wrap(err).GenWithStackByArgs(
+			"get region stats")
+	}
+
+	m.mu.Lock()
+	rate, gcTTL := m.advanceRate.rate, m.serviceGCTTL()
+	m.mu.Unlock()
+
+	recovery := time.Duration(stats.StorageSize) * time.Second / recoveryScanThroughput
+	// A safepoint advancing slower than the wall time means changefeeds
+	// catch up slowly, so the recovery takes longer.
+	if rate > 0 && rate < 1 {
+		recovery = time.Duration(float64(recovery) / rate)
+	}
+	required := recovery * ttlHeadroomFactor
+
+	res := TTLAssessment{
+		GCTTL:                 time.Duration(gcTTL) * time.Second,
+		EstimatedRecoveryTime: recovery,
+	}
+	if res.GCTTL >= required {
+		res.Safe = true
+		res.RecommendedGCTTL = res.GCTTL
+		res.Recommendation = "gc TTL is long enough"
+	} else {
+		res.RecommendedGCTTL = time.Duration(
+			math.Ceil(required.Hours())) * time.Hour
+		res.Recommendation = fmt.Sprintf(
+			"gc TTL too short, increase gc-ttl to at least %s",
+			res.RecommendedGCTTL)
+	}
+	log.Info("assess gc ttl safety",
+		zap.String("GcManagerID", m.gcServiceID),
+		zap.Int64("storageSizeMiB", stats.StorageSize),
+		zap.Float64("advanceRate", rate),
+		zap.Duration("gcTTL", res.GCTTL),
+		zap.Duration("estimatedRecoveryTime", res.EstimatedRecoveryTime),
+		zap.Bool("safe", res.Safe))
+	return res, nil
+}
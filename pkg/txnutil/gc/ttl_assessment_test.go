@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+type mockRegionStatsProvider struct {
+	stats *pdutil.RegionStats
+}
+
+func (p *mockRegionStatsProvider) GetRegionStats(
+	ctx context.Context,
+) (*pdutil.RegionStats, error) {
+	return p.stats, nil
+}
+
+func TestAssessTTLSafety(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockPDClient := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return safePoint, nil
+		},
+	}
+	pdClock := pdutil.NewClock4Test()
+
+	// Without a provider.
+	manager := NewManager(etcd.GcServiceIDForTest(), mockPDClient, pdClock).(*gcManager)
+	_, err := manager.AssessTTLSafety(ctx)
+	require.Error(t, err)
+
+	// 1 hour to scan all data.
+	provider := &mockRegionStatsProvider{
+		stats: &pdutil.RegionStats{StorageSize: recoveryScanThroughput * 3600},
+	}
+	manager = NewManager(etcd.GcServiceIDForTest(), mockPDClient, pdClock,
+		WithRegionStatsProvider(provider)).(*gcManager)
+	manager.gcTTL = 24 * 3600
+	mockClock := clock.NewMock()
+	manager.clock = mockClock
+
+	res, err := manager.AssessTTLSafety(ctx)
+	require.Nil(t, err)
+	require.True(t, res.Safe)
+	require.Equal(t, time.Hour, res.EstimatedRecoveryTime)
+	require.Equal(t, 24*time.Hour, res.RecommendedGCTTL)
+
+	// The safepoint advances at a quarter of the wall time.
+	ts := oracle.GoTimeToTS(time.Now())
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts, true))
+	mockClock.Add(4 * time.Minute)
+	ts = oracle.GoTimeToTS(oracle.GetTimeFromTS(ts).Add(time.Minute))
+	require.Nil(t, manager.TryUpdateGCSafePoint(ctx, ts, true))
+	res, err = manager.AssessTTLSafety(ctx)
+	require.Nil(t, err)
+	require.True(t, res.Safe)
+	require.Equal(t, 4*time.Hour, res.EstimatedRecoveryTime)
+
+	// 8 hours to scan all data, it takes 32 hours to recover.
+	provider.stats = &pdutil.RegionStats{StorageSize: recoveryScanThroughput * 3600 * 8}
+	res, err = manager.AssessTTLSafety(ctx)
+	require.Nil(t, err)
+	require.False(t, res.Safe)
+	require.Equal(t, 24*time.Hour, res.GCTTL)
+	require.Equal(t, 32*time.Hour, res.EstimatedRecoveryTime)
+	require.Equal(t, 64*time.Hour, res.RecommendedGCTTL)
+	require.Contains(t, res.Recommendation, "gc TTL too short")
+}
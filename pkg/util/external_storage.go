@@ -67,6 +67,20 @@ func GetExternalStorage(
 		return nil, errors.Trace(err)
 	}
 
+	var tagging *s3.Tagging
+	if s3Backend := backEnd.GetS3(); s3Backend != nil {
+		if err := validateS3Options(s3Backend); err != nil {
+			return nil, err
+		}
+		u, err := storage.ParseRawURL(uri)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if tagging, err = parseS3Tagging(u); err != nil {
+			return nil, err
+		}
+	}
+
 	ret, err := storage.New(ctx, backEnd, &storage.ExternalStorageOptions{
 		SendCredentials: false,
 		S3Retryer:       retryer,
@@ -75,6 +89,9 @@ func GetExternalStorage(
 		retErr := errors.ErrFailToCreateExternalStorage.Wrap(errors.Trace(err))
 		return nil, retErr.GenWithStackByArgs("creating ExternalStorage for s3")
 	}
+	if s3Storage, ok := ret.(*storage.S3Storage); ok && tagging != nil {
+		return &s3StorageWithTagging{S3Storage: s3Storage, tagging: tagging}, nil
+	}
 	return ret, nil
 }
 
@@ -0,0 +1,194 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// s3TaggingParam is the URI parameter of the tags attached to the objects
+	// written to S3, in the format of `key1=value1,key2=value2`.
+	s3TaggingParam = "tagging"
+
+	// The limits of object tagging, see
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+	maxS3TagCount       = 10
+	maxS3TagKeyLength   = 128
+	maxS3TagValueLength = 256
+)
+
+// validateS3Options checks the server-side encryption, ACL and storage class
+// options of a S3 backend, which are parsed from the URI parameters `sse`,
+// `sse-kms-key-id`, `acl` and `storage-class`, so that a mistyped option is
+// reported when the changefeed is created instead of by the first write.
+func validateS3Options(options *backuppb.S3) error {
+	if options.Sse != "" && !containsString(s3.ServerSideEncryption_Values(), options.Sse) {
+		return errors.ErrExternalStorageInvalidConfig.GenWithStack(
+			"invalid s3 server-side encryption %s, the valid values are %v",
+			options.Sse, s3.ServerSideEncryption_Values())
+	}
+	if options.SseKmsKeyId != "" && options.Sse != s3.ServerSideEncryptionAwsKms {
+		return errors.ErrExternalStorageInvalidConfig.GenWithStack(
+			"sse-kms-key-id can only be used with sse=%s", s3.ServerSideEncryptionAwsKms)
+	}
+	if options.Acl != "" && !containsString(s3.ObjectCannedACL_Values(), options.Acl) {
+		return errors.ErrExternalStorageInvalidConfig.GenWithStack(
+			"invalid s3 acl %s, the valid values are %v",
+			options.Acl, s3.ObjectCannedACL_Values())
+	}
+	if options.StorageClass == "" {
+		return nil
+	}
+	// The S3 compatible services may define their own storage classes.
+	if (options.Provider == "" || options.Provider == "aws") &&
+		!containsString(s3.StorageClass_Values(), options.StorageClass) {
+		return errors.ErrExternalStorageInvalidConfig.GenWithStack(
+			"invalid s3 storage class %s, the valid values are %v",
+			options.StorageClass, s3.StorageClass_Values())
+	}
+	// The objects are read back by the redo log meta manager and the storage
+	// sink consumers, which is impossible before they are restored from the
+	// archive storage classes.
+	switch options.StorageClass {
+	case s3.StorageClassGlacier, s3.StorageClassDeepArchive:
+		return errors.ErrExternalStorageInvalidConfig.GenWithStack(
+			"s3 storage class %s is not supported, as the objects can not be read immediately",
+			options.StorageClass)
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// parseS3Tagging parses the object tags from the URI parameter `tagging`.
+// It returns nil if no tag is specified.
+func parseS3Tagging(u *url.URL) (*s3.Tagging, error) {
+	var param string
+	for key, values := range u.Query() {
+		if len(values) > 0 &&
+			strings.ToLower(strings.ReplaceAll(key, "_", "-")) == s3TaggingParam {
+			param = values[0]
+		}
+	}
+	if param == "" {
+		return nil, nil
+	}
+
+	pairs := strings.Split(param, ",")
+	if len(pairs) > maxS3TagCount {
+		return nil, errors.ErrExternalStorageInvalidConfig.GenWithStack(
+			"too many s3 object tags, at most %d tags are allowed", maxS3TagCount)
+	}
+	tagging := &s3.Tagging{TagSet: make([]*s3.Tag, 0, len(pairs))}
+	keys := make(map[string]struct{}, len(pairs))
+	for _, pair := range pairs {
+		key, value, _ := strings.Cut(pair, "=")
+		if key == "" || len(key) > maxS3TagKeyLength || len(value) > maxS3TagValueLength {
+			return nil, errors.ErrExternalStorageInvalidConfig.GenWithStack(
+				"invalid s3 object tag %s, the key must be 1 to %d characters "+
+					"and the value must be at most %d characters",
+				pair, maxS3TagKeyLength, maxS3TagValueLength)
+		}
+		if _, ok := keys[key]; ok {
+			return nil, errors.ErrExternalStorageInvalidConfig.GenWithStack(
+				"duplicated s3 object tag key %s", key)
+		}
+		keys[key] = struct{}{}
+		tagging.TagSet = append(tagging.TagSet, &s3.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+	return tagging, nil
+}
+
+// s3StorageWithTagging attaches the tags to every object written by the
+// storage. The underlying storage doesn't support tagging on writes, so the
+// tags are put right after the objects are written.
+type s3StorageWithTagging struct {
+	*storage.S3Storage
+	tagging *s3.Tagging
+}
+
+func (s *s3StorageWithTagging) putTagging(ctx context.Context, name string) error {
+	options := s.GetOptions()
+	_, err := s.GetS3APIHandle().PutObjectTaggingWithContext(ctx, &s3.PutObjectTaggingInput{
+		Bucket:  aws.String(options.Bucket),
+		Key:     aws.String(options.Prefix + name),
+		Tagging: s.tagging,
+	})
+	if err != nil {
+		return errors.WrapError(errors.ErrExternalStorageAPI, err)
+	}
+	return nil
+}
+
+// WriteFile writes a complete file to storage and tags it.
+func (s *s3StorageWithTagging) WriteFile(ctx context.Context, name string, data []byte) error {
+	if err := s.S3Storage.WriteFile(ctx, name, data); err != nil {
+		return err
+	}
+	return s.putTagging(ctx, name)
+}
+
+// Create opens a file writer, the file is tagged when the writer is closed.
+func (s *s3StorageWithTagging) Create(
+	ctx context.Context, path string,
+) (storage.ExternalFileWriter, error) {
+	w, err := s.S3Storage.Create(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return &s3WriterWithTagging{ExternalFileWriter: w, storage: s, name: path}, nil
+}
+
+// Rename file name from oldFileName to newFileName and tags the new file.
+func (s *s3StorageWithTagging) Rename(
+	ctx context.Context, oldFileName, newFileName string,
+) error {
+	if err := s.S3Storage.Rename(ctx, oldFileName, newFileName); err != nil {
+		return err
+	}
+	return s.putTagging(ctx, newFileName)
+}
+
+type s3WriterWithTagging struct {
+	storage.ExternalFileWriter
+	storage *s3StorageWithTagging
+	name    string
+}
+
+// Close completes the upload and tags the file.
+func (w *s3WriterWithTagging) Close(ctx context.Context) error {
+	if err := w.ExternalFileWriter.Close(ctx); err != nil {
+		return err
+	}
+	return w.storage.putTagging(ctx, w.name)
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateS3Options(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		uri string
+		err string
+	}{
+		{uri: "s3://bucket/prefix"},
+		{uri: "s3://bucket/prefix?sse=AES256&acl=bucket-owner-full-control&storage-class=STANDARD_IA"},
+		{uri: "s3://bucket/prefix?sse=aws:kms&sse-kms-key-id=key"},
+		{uri: "s3://bucket/prefix?provider=ceph&storage-class=COLD"},
+		{uri: "s3://bucket/prefix?sse=aes", err: "invalid s3 server-side encryption"},
+		{uri: "s3://bucket/prefix?sse=AES256&sse-kms-key-id=key", err: "sse-kms-key-id can only be used"},
+		{uri: "s3://bucket/prefix?sse-kms-key-id=key", err: "sse-kms-key-id can only be used"},
+		{uri: "s3://bucket/prefix?acl=public", err: "invalid s3 acl"},
+		{uri: "s3://bucket/prefix?storage-class=COLD", err: "invalid s3 storage class"},
+		{uri: "s3://bucket/prefix?storage_class=GLACIER", err: "is not supported"},
+	}
+	for _, c := range cases {
+		backend, err := storage.ParseBackend(c.uri, nil)
+		require.NoError(t, err)
+		err = validateS3Options(backend.GetS3())
+		if c.err == "" {
+			require.NoError(t, err, c.uri)
+		} else {
+			require.True(t, errors.ErrExternalStorageInvalidConfig.Equal(err), c.uri)
+			require.ErrorContains(t, err, c.err, c.uri)
+		}
+	}
+}
+
+func TestParseS3Tagging(t *testing.T) {
+	t.Parallel()
+
+	u, err := storage.ParseRawURL("s3://bucket/prefix")
+	require.NoError(t, err)
+	tagging, err := parseS3Tagging(u)
+	require.NoError(t, err)
+	require.Nil(t, tagging)
+
+	u, err = storage.ParseRawURL("s3://bucket/prefix?tagging=env=prod,team=&sse=AES256")
+	require.NoError(t, err)
+	tagging, err = parseS3Tagging(u)
+	require.NoError(t, err)
+	require.Equal(t, &s3.Tagging{TagSet: []*s3.Tag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+		{Key: aws.String("team"), Value: aws.String("")},
+	}}, tagging)
+
+	for _, uri := range []string{
+		"s3://bucket/prefix?tagging==prod",
+		"s3://bucket/prefix?tagging=env=prod,env=test",
+		"s3://bucket/prefix?tagging=a,b,c,d,e,f,g,h,i,j,k",
+	} {
+		u, err = storage.ParseRawURL(uri)
+		require.NoError(t, err)
+		_, err = parseS3Tagging(u)
+		require.True(t, errors.ErrExternalStorageInvalidConfig.Equal(err), uri)
+	}
+}
+
+type mockS3API struct {
+	s3iface.S3API
+	objects   map[string][]byte
+	uploading map[string][]byte
+	tags      map[string]*s3.Tagging
+}
+
+func (m *mockS3API) PutObjectWithContext(
+	_ aws.Context, input *s3.PutObjectInput, _ ...request.Option,
+) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.objects[*input.Key] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (m *mockS3API) CreateMultipartUploadWithContext(
+	_ aws.Context, input *s3.CreateMultipartUploadInput, _ ...request.Option,
+) (*s3.CreateMultipartUploadOutput, error) {
+	return &s3.CreateMultipartUploadOutput{
+		Bucket:   input.Bucket,
+		Key:      input.Key,
+		UploadId: aws.String("upload"),
+	}, nil
+}
+
+func (m *mockS3API) UploadPartWithContext(
+	_ aws.Context, input *s3.UploadPartInput, _ ...request.Option,
+) (*s3.UploadPartOutput, error) {
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.uploading[*input.Key] = append(m.uploading[*input.Key], data...)
+	return &s3.UploadPartOutput{ETag: aws.String("etag")}, nil
+}
+
+func (m *mockS3API) CompleteMultipartUploadWithContext(
+	_ aws.Context, input *s3.CompleteMultipartUploadInput, _ ...request.Option,
+) (*s3.CompleteMultipartUploadOutput, error) {
+	m.objects[*input.Key] = m.uploading[*input.Key]
+	delete(m.uploading, *input.Key)
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (m *mockS3API) WaitUntilObjectExistsWithContext(
+	aws.Context, *s3.HeadObjectInput, ...request.WaiterOption,
+) error {
+	return nil
+}
+
+func (m *mockS3API) PutObjectTaggingWithContext(
+	_ aws.Context, input *s3.PutObjectTaggingInput, _ ...request.Option,
+) (*s3.PutObjectTaggingOutput, error) {
+	if _, ok := m.objects[*input.Key]; !ok {
+		return nil, errors.New("object not found")
+	}
+	m.tags[*input.Key] = input.Tagging
+	return &s3.PutObjectTaggingOutput{}, nil
+}
+
+func TestS3StorageWithTagging(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockS3API{
+		objects:   make(map[string][]byte),
+		uploading: make(map[string][]byte),
+		tags:      make(map[string]*s3.Tagging),
+	}
+	tagging := &s3.Tagging{TagSet: []*s3.Tag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+	}}
+	s := &s3StorageWithTagging{
+		S3Storage: storage.NewS3StorageForTest(svc, &backuppb.S3{
+			Bucket: "bucket",
+			Prefix: "prefix/",
+		}),
+		tagging: tagging,
+	}
+
+	require.NoError(t, s.WriteFile(context.Background(), "meta", []byte("data")))
+	require.Equal(t, []byte("data"), svc.objects["prefix/meta"])
+	require.Equal(t, tagging, svc.tags["prefix/meta"])
+
+	// The file is tagged after the upload is completed.
+	w, err := s.Create(context.Background(), "log")
+	require.NoError(t, err)
+	_, err = w.Write(context.Background(), []byte("log data"))
+	require.NoError(t, err)
+	require.NotContains(t, svc.tags, "prefix/log")
+	require.NoError(t, w.Close(context.Background()))
+	require.Equal(t, []byte("log data"), svc.objects["prefix/log"])
+	require.Equal(t, tagging, svc.tags["prefix/log"])
+}